@@ -11,6 +11,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 )
 
@@ -55,19 +56,27 @@ type TestData struct {
 
 	// resourceLabel is the local used for the resource - generally "test""
 	resourceLabel string
+
+	// recorder records or replays the HTTP interactions for this test, when enabled via `ARM_TEST_RECORDER_MODE`
+	recorder *common.Recorder
 }
 
 // BuildTestData generates some test data for the given resource
 func BuildTestData(t *testing.T, resourceType string, resourceLabel string) TestData {
+	recorder := recorderForTest(t)
+
 	testData := TestData{
-		RandomInteger:   RandTimeInt(),
-		RandomString:    randString(5),
+		RandomInteger: recordedInt(recorder, "random_integer", RandTimeInt),
+		RandomString: recordedString(recorder, "random_string", func() string {
+			return randString(5)
+		}),
 		ResourceName:    fmt.Sprintf("%s.%s", resourceType, resourceLabel),
 		EnvironmentName: EnvironmentName(),
 		MetadataURL:     os.Getenv("ARM_METADATA_HOSTNAME"),
 
 		ResourceType:  resourceType,
 		resourceLabel: resourceLabel,
+		recorder:      recorder,
 	}
 
 	if features.UseDynamicTestLocations() {
@@ -80,6 +89,14 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 		}
 	}
 
+	// the locations are recorded, since these are part of the requests which are replayed
+	locations := testData.Locations
+	testData.Locations = Regions{
+		Primary:   recordedString(recorder, "location_primary", func() string { return locations.Primary }),
+		Secondary: recordedString(recorder, "location_secondary", func() string { return locations.Secondary }),
+		Ternary:   recordedString(recorder, "location_ternary", func() string { return locations.Ternary }),
+	}
+
 	testData.Subscriptions = Subscriptions{
 		Primary:   os.Getenv("ARM_SUBSCRIPTION_ID"),
 		Secondary: os.Getenv("ARM_SUBSCRIPTION_ID_ALT"),
@@ -123,7 +140,9 @@ func (td *TestData) RandomStringOfLength(length int) string {
 		panic("Invalid Test: RandomStringOfLength: length argument must be between 1 and 1024 characters")
 	}
 
-	return randString(length)
+	return recordedString(td.recorder, fmt.Sprintf("random_string_of_length_%d", length), func() string {
+		return randString(length)
+	})
 }
 
 // randString generates a random alphanumeric string of the length specified
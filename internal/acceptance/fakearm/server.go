@@ -0,0 +1,543 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fakearm provides an in-process fake of Azure Resource Manager, which allows the Provider (and in
+// particular the Typed SDK wrappers) to be exercised end-to-end without an Azure Subscription.
+package fakearm
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-uuid"
+)
+
+const (
+	defaultTenantId = "00000000-0000-0000-0000-000000000000"
+	defaultClientId = "11111111-1111-1111-1111-111111111111"
+	defaultObjectId = "22222222-2222-2222-2222-222222222222"
+
+	// DefaultSubscriptionId is the Subscription ID which the Credentials returned by the Server are valid for
+	DefaultSubscriptionId = "33333333-3333-3333-3333-333333333333"
+
+	environmentName = "FakeAzureCloud"
+)
+
+// Server is a fake Azure Resource Manager which supports the generic CRUD semantics of ARM: resources can be
+// created/updated (PUT/PATCH), retrieved (GET/HEAD), listed and deleted (DELETE) within a Resource Group, with
+// a 404 returned for resources (or Resource Groups) which don't exist. Long-running operations are modelled
+// using the `Azure-AsyncOperation` header when AsyncOperations is enabled, which is polled PollsUntilCompleted
+// times before succeeding.
+//
+// The Server also serves the metadata endpoint (used for `metadata_host`) and a token endpoint, such that the
+// Environment returned by the Server can be used with `clients.Build` as-is.
+type Server struct {
+	// URL is the base URL of the Server, e.g. `http://127.0.0.1:1234`
+	URL string
+
+	// AsyncOperations specifies whether PUT requests are completed as long-running operations, and whether DELETE
+	// requests return `202 Accepted` (rather than `200 OK`)
+	AsyncOperations bool
+
+	// PollsUntilCompleted is the number of times that a long-running operation returns `InProgress` before completing
+	PollsUntilCompleted int
+
+	server *httptest.Server
+
+	lock       sync.Mutex
+	resources  map[string]*resource
+	operations map[string]*operation
+	requests   []string
+}
+
+type resource struct {
+	id   string
+	body map[string]interface{}
+}
+
+type operation struct {
+	remainingPolls int
+}
+
+// NewServer starts a new Server, which must be stopped using Close
+func NewServer() *Server {
+	s := &Server{
+		PollsUntilCompleted: 1,
+		resources:           make(map[string]*resource),
+		operations:          make(map[string]*operation),
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.server.URL
+	return s
+}
+
+// Close shuts down the Server
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Environment returns an Environment targeting the Server, retrieved from the Server's metadata endpoint in the
+// same way as when `metadata_host` is specified in the Provider block.
+func (s *Server) Environment(ctx context.Context) (*environments.Environment, error) {
+	env, err := environments.FromEndpoint(ctx, s.URL)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving environment from %q: %+v", s.URL, err)
+	}
+	return env, nil
+}
+
+// Credentials returns Client Secret credentials which are accepted by the Server's token endpoint
+func (s *Server) Credentials(env environments.Environment) auth.Credentials {
+	return auth.Credentials{
+		Environment:  env,
+		ClientID:     defaultClientId,
+		ClientSecret: "fake",
+		TenantID:     defaultTenantId,
+
+		EnableAuthenticatingUsingClientSecret: true,
+	}
+}
+
+// Exists returns whether the resource with the specified ID exists within the Server
+func (s *Server) Exists(id string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	_, ok := s.resources[strings.ToLower(id)]
+	return ok
+}
+
+// Put creates or replaces the resource with the specified ID, bypassing any validation - which is useful to seed
+// resources which exist before a test runs.
+func (s *Server) Put(id string, body map[string]interface{}) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.resources[strings.ToLower(id)] = newResource(id, body)
+}
+
+// Remove deletes the resource with the specified ID (and any nested resources), without going through the API -
+// which is useful to simulate a resource being deleted outside of Terraform.
+func (s *Server) Remove(id string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.removeResource(id)
+}
+
+// Requests returns the method and path of each request received by the Server, in order
+func (s *Server) Requests() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return append([]string{}, s.requests...)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.requests = append(s.requests, fmt.Sprintf("%s %s", r.Method, r.URL.Path))
+	log.Printf("[DEBUG] Fake ARM: %s %s", r.Method, r.URL.String())
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case r.URL.Path == "/metadata/endpoints":
+		s.serveMetadata(w)
+
+	case len(segments) == 4 && strings.EqualFold(segments[1], "oauth2") && strings.EqualFold(segments[3], "token"):
+		s.serveToken(w, segments[0])
+
+	case len(segments) == 2 && strings.EqualFold(segments[0], "operations"):
+		s.serveOperation(w, r, segments[1])
+
+	case len(segments) >= 2 && strings.EqualFold(segments[0], "subscriptions"):
+		s.serveResourceManager(w, r, segments)
+
+	default:
+		writeError(w, http.StatusNotFound, "NotFound", fmt.Sprintf("the path %q is not supported by the fake Resource Manager", r.URL.Path))
+	}
+}
+
+func (s *Server) serveMetadata(w http.ResponseWriter) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"name":            environmentName,
+		"resourceManager": s.URL,
+		"authentication": map[string]interface{}{
+			"loginEndpoint":    s.URL,
+			"audiences":        []string{s.URL},
+			"tenant":           "common",
+			"identityProvider": "AAD",
+		},
+		"microsoftGraphResourceId": s.URL,
+		"graph":                    s.URL,
+		"suffixes": map[string]interface{}{
+			"keyVaultDns": "vault.fake.azure.net",
+			"storage":     "core.fake.azure.net",
+		},
+	})
+}
+
+// serveToken issues an unsigned access token, containing the claims inspected when building the Provider's account
+func (s *Server) serveToken(w http.ResponseWriter, tenantId string) {
+	if tenantId == "common" {
+		tenantId = defaultTenantId
+	}
+
+	claims, err := json.Marshal(map[string]interface{}{
+		"appid": defaultClientId,
+		"oid":   defaultObjectId,
+		"tid":   tenantId,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "InternalServerError", err.Error())
+		return
+	}
+
+	token := strings.Join([]string{
+		base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`)),
+		base64.RawURLEncoding.EncodeToString(claims),
+		base64.RawURLEncoding.EncodeToString([]byte("fake")),
+	}, ".")
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": token,
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+	})
+}
+
+func (s *Server) serveOperation(w http.ResponseWriter, r *http.Request, operationId string) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("%s is not supported for operations", r.Method))
+		return
+	}
+
+	op, ok := s.operations[operationId]
+	if !ok {
+		writeError(w, http.StatusNotFound, "OperationNotFound", fmt.Sprintf("the operation %q was not found", operationId))
+		return
+	}
+
+	w.Header().Set("Retry-After", "0")
+	if op.remainingPolls > 0 {
+		op.remainingPolls--
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"status": "InProgress",
+		})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"status": "Succeeded",
+	})
+}
+
+func (s *Server) serveResourceManager(w http.ResponseWriter, r *http.Request, segments []string) {
+	// Resource Provider registrations are accepted, but there's nothing to register
+	if len(segments) >= 3 && strings.EqualFold(segments[2], "providers") && len(segments) <= 5 {
+		s.serveResourceProviders(w, r, segments)
+		return
+	}
+
+	if len(segments) < 4 || !strings.EqualFold(segments[2], "resourceGroups") {
+		writeError(w, http.StatusNotFound, "NotFound", fmt.Sprintf("the path %q is not supported by the fake Resource Manager", r.URL.Path))
+		return
+	}
+
+	// an odd number of segments (e.g. `.../providers/Microsoft.Foo/bars`) is a collection of resources
+	if len(segments)%2 == 1 {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("%s is not supported for collections", r.Method))
+			return
+		}
+		s.serveList(w, "/"+strings.Join(segments, "/"))
+		return
+	}
+
+	id := "/" + strings.Join(segments, "/")
+	resourceGroupId := "/" + strings.Join(segments[0:4], "/")
+	isResourceGroup := len(segments) == 4
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		existing, ok := s.resources[strings.ToLower(id)]
+		if !ok {
+			writeNotFound(w, id, isResourceGroup)
+			return
+		}
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		writeJSON(w, http.StatusOK, existing.body)
+
+	case http.MethodPut:
+		if !isResourceGroup {
+			if _, ok := s.resources[strings.ToLower(resourceGroupId)]; !ok {
+				writeNotFound(w, resourceGroupId, true)
+				return
+			}
+			if parentId := parentResourceId(segments); parentId != "" {
+				if _, ok := s.resources[strings.ToLower(parentId)]; !ok {
+					writeError(w, http.StatusNotFound, "ParentResourceNotFound", fmt.Sprintf("the parent resource %q was not found", parentId))
+					return
+				}
+			}
+		}
+
+		body, err := readBody(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+			return
+		}
+
+		_, exists := s.resources[strings.ToLower(id)]
+		created := newResource(id, body)
+		s.resources[strings.ToLower(id)] = created
+
+		statusCode := http.StatusOK
+		if !exists {
+			statusCode = http.StatusCreated
+		}
+		s.writeAsync(w, r)
+		writeJSON(w, statusCode, created.body)
+
+	case http.MethodPatch:
+		existing, ok := s.resources[strings.ToLower(id)]
+		if !ok {
+			writeNotFound(w, id, isResourceGroup)
+			return
+		}
+
+		body, err := readBody(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+			return
+		}
+		mergePatch(existing.body, body)
+
+		writeJSON(w, http.StatusOK, existing.body)
+
+	case http.MethodDelete:
+		if _, ok := s.resources[strings.ToLower(id)]; !ok {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		s.removeResource(id)
+
+		if s.AsyncOperations {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("%s is not supported by the fake Resource Manager", r.Method))
+	}
+}
+
+func (s *Server) serveResourceProviders(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 3 && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"value": []interface{}{},
+		})
+
+	case len(segments) >= 4:
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"id":                "/" + strings.Join(segments[0:4], "/"),
+			"namespace":         segments[3],
+			"registrationState": "Registered",
+		})
+
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("%s is not supported for resource providers", r.Method))
+	}
+}
+
+func (s *Server) serveList(w http.ResponseWriter, collectionId string) {
+	prefix := strings.ToLower(collectionId) + "/"
+
+	ids := make([]string, 0)
+	for key := range s.resources {
+		// only direct children of the collection are returned
+		if strings.HasPrefix(key, prefix) && !strings.Contains(strings.TrimPrefix(key, prefix), "/") {
+			ids = append(ids, key)
+		}
+	}
+	sort.Strings(ids)
+
+	values := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, s.resources[id].body)
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"value": values,
+	})
+}
+
+// writeAsync sets the `Azure-AsyncOperation` header for a new long-running operation, when these are enabled
+func (s *Server) writeAsync(w http.ResponseWriter, r *http.Request) {
+	if !s.AsyncOperations {
+		return
+	}
+
+	operationId, err := uuid.GenerateUUID()
+	if err != nil {
+		log.Printf("[WARN] Fake ARM: generating operation ID: %+v", err)
+		return
+	}
+	s.operations[operationId] = &operation{
+		remainingPolls: s.PollsUntilCompleted,
+	}
+
+	operationUrl := url.URL{
+		Scheme: "http",
+		Host:   r.Host,
+		Path:   "/operations/" + operationId,
+	}
+	w.Header().Set("Azure-AsyncOperation", operationUrl.String())
+	w.Header().Set("Retry-After", "0")
+}
+
+func (s *Server) removeResource(id string) {
+	key := strings.ToLower(id)
+	for k := range s.resources {
+		if k == key || strings.HasPrefix(k, key+"/") {
+			delete(s.resources, k)
+		}
+	}
+}
+
+func newResource(id string, body map[string]interface{}) *resource {
+	if body == nil {
+		body = make(map[string]interface{})
+	}
+
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+	body["id"] = id
+	body["name"] = segments[len(segments)-1]
+	body["type"] = resourceType(segments)
+
+	properties, ok := body["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+	}
+	properties["provisioningState"] = "Succeeded"
+	body["properties"] = properties
+
+	return &resource{
+		id:   id,
+		body: body,
+	}
+}
+
+// resourceType returns the type of the resource, e.g. `Microsoft.Foo/bars/bazs`
+func resourceType(segments []string) string {
+	if len(segments) == 4 {
+		return "Microsoft.Resources/resourceGroups"
+	}
+
+	// segments: subscriptions/{id}/resourceGroups/{name}/providers/{namespace}/{type}/{name}[/{type}/{name}]
+	if len(segments) < 8 {
+		return ""
+	}
+	types := []string{segments[5]}
+	for i := 6; i < len(segments); i += 2 {
+		types = append(types, segments[i])
+	}
+	return strings.Join(types, "/")
+}
+
+// parentResourceId returns the ID of the parent resource for a nested resource, or an empty string for a top-level resource
+func parentResourceId(segments []string) string {
+	// a top-level resource is `subscriptions/{id}/resourceGroups/{name}/providers/{namespace}/{type}/{name}`
+	if len(segments) <= 8 {
+		return ""
+	}
+	return "/" + strings.Join(segments[0:len(segments)-2], "/")
+}
+
+func mergePatch(existing, patch map[string]interface{}) {
+	for k, v := range patch {
+		if k == "id" || k == "name" || k == "type" {
+			continue
+		}
+
+		nested, isMap := v.(map[string]interface{})
+		existingNested, existingIsMap := existing[k].(map[string]interface{})
+		if isMap && existingIsMap {
+			mergePatch(existingNested, nested)
+			continue
+		}
+
+		if v == nil {
+			delete(existing, k)
+			continue
+		}
+		existing[k] = v
+	}
+}
+
+func readBody(r *http.Request) (map[string]interface{}, error) {
+	contents, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading request body: %+v", err)
+	}
+
+	body := make(map[string]interface{})
+	if len(contents) == 0 {
+		return body, nil
+	}
+
+	if err := json.Unmarshal(contents, &body); err != nil {
+		return nil, fmt.Errorf("parsing request body: %+v", err)
+	}
+
+	return body, nil
+}
+
+func writeNotFound(w http.ResponseWriter, id string, isResourceGroup bool) {
+	if isResourceGroup {
+		writeError(w, http.StatusNotFound, "ResourceGroupNotFound", fmt.Sprintf("Resource group %q could not be found.", id))
+		return
+	}
+	writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("The Resource %q was not found.", id))
+}
+
+func writeError(w http.ResponseWriter, statusCode int, code, message string) {
+	writeJSON(w, statusCode, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	contents, err := json.Marshal(body)
+	if err != nil {
+		statusCode = http.StatusInternalServerError
+		contents = []byte(fmt.Sprintf(`{"error":{"code":"InternalServerError","message":%q}}`, err.Error()))
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	if _, err := w.Write(contents); err != nil {
+		log.Printf("[WARN] Fake ARM: writing response: %+v", err)
+	}
+}
@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearm_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resourcegroups"
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/fakearm"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedidentity"
+)
+
+func TestServerResourceGroupLifecycle(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	server := fakearm.NewServer()
+	defer server.Close()
+
+	client := testClient(t, ctx, server)
+	groups := client.Resource.ResourceGroupsClient
+	id := commonids.NewResourceGroupID(fakearm.DefaultSubscriptionId, "example-resources")
+
+	existing, err := groups.Get(ctx, id)
+	if !response.WasNotFound(existing.HttpResponse) {
+		t.Fatalf("expected a 404 for a Resource Group which doesn't exist but got: %+v", err)
+	}
+
+	if _, err := groups.CreateOrUpdate(ctx, id, resourcegroups.ResourceGroup{Location: "westeurope"}); err != nil {
+		t.Fatalf("creating %s: %+v", id, err)
+	}
+
+	resp, err := groups.Get(ctx, id)
+	if err != nil {
+		t.Fatalf("retrieving %s: %+v", id, err)
+	}
+	if resp.Model == nil || resp.Model.Location != "westeurope" || resp.Model.Name == nil || *resp.Model.Name != id.ResourceGroupName {
+		t.Fatalf("expected the Resource Group to be returned but got %+v", resp.Model)
+	}
+
+	// nested resources are removed along with the Resource Group
+	identityId := commonids.NewUserAssignedIdentityID(id.SubscriptionId, id.ResourceGroupName, "example")
+	server.Put(identityId.ID(), map[string]interface{}{"location": "westeurope"})
+
+	if _, err := groups.Delete(ctx, id, resourcegroups.DefaultDeleteOperationOptions()); err != nil {
+		t.Fatalf("deleting %s: %+v", id, err)
+	}
+	if server.Exists(id.ID()) || server.Exists(identityId.ID()) {
+		t.Fatalf("expected %s and the resources within it to be deleted", id)
+	}
+}
+
+func TestServerLongRunningOperation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	server := fakearm.NewServer()
+	defer server.Close()
+	server.AsyncOperations = true
+	server.PollsUntilCompleted = 2
+
+	env, err := server.Environment(ctx)
+	if err != nil {
+		t.Fatalf("retrieving environment: %+v", err)
+	}
+	c, err := resourcemanager.NewClient(env.ResourceManager, "test", "2020-01-01")
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	c.Authorizer, err = auth.NewAuthorizerFromCredentials(ctx, server.Credentials(*env), env.ResourceManager)
+	if err != nil {
+		t.Fatalf("building authorizer: %+v", err)
+	}
+
+	resourceGroupId := commonids.NewResourceGroupID(fakearm.DefaultSubscriptionId, "example-resources")
+	server.Put(resourceGroupId.ID(), map[string]interface{}{"location": "westeurope"})
+	id := commonids.NewUserAssignedIdentityID(resourceGroupId.SubscriptionId, resourceGroupId.ResourceGroupName, "example")
+
+	req, err := c.NewRequest(ctx, client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{http.StatusOK, http.StatusCreated},
+		HttpMethod:          http.MethodPut,
+		Path:                id.ID(),
+	})
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	if err := req.Marshal(map[string]interface{}{"location": "westeurope"}); err != nil {
+		t.Fatalf("marshaling request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		t.Fatalf("creating %s: %+v", id, err)
+	}
+	poller, err := resourcemanager.PollerFromResponse(resp, c)
+	if err != nil {
+		t.Fatalf("building poller: %+v", err)
+	}
+	if err := poller.PollUntilDone(ctx); err != nil {
+		t.Fatalf("polling for %s: %+v", id, err)
+	}
+
+	polls := 0
+	for _, request := range server.Requests() {
+		if strings.HasPrefix(request, "GET /operations/") {
+			polls++
+		}
+	}
+	if polls != 3 {
+		t.Fatalf("expected the long-running operation to be polled 3 times but got %d: %v", polls, server.Requests())
+	}
+}
+
+func TestServerTypedResourceLifecycle(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	server := fakearm.NewServer()
+	defer server.Close()
+
+	client := testClient(t, ctx, server)
+	resourceGroupId := commonids.NewResourceGroupID(fakearm.DefaultSubscriptionId, "example-resources")
+
+	wrapper := sdk.NewResourceWrapper(managedidentity.UserAssignedIdentityResource{})
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building resource: %+v", err)
+	}
+	config := map[string]interface{}{
+		"name":                "example",
+		"resource_group_name": resourceGroupId.ResourceGroupName,
+		"location":            "westeurope",
+		"tags": map[string]interface{}{
+			"env": "test",
+		},
+	}
+
+	// the Resource Group doesn't exist yet
+	d := testResourceData(t, resource, config)
+	if diags := resource.CreateContext(ctx, d, client); !diags.HasError() {
+		t.Fatal("expected an error when the Resource Group doesn't exist")
+	}
+
+	server.Put(resourceGroupId.ID(), map[string]interface{}{"location": "westeurope"})
+
+	d = testResourceData(t, resource, config)
+	if diags := resource.CreateContext(ctx, d, client); diags.HasError() {
+		t.Fatalf("creating: %+v", diags)
+	}
+	identityId := commonids.NewUserAssignedIdentityID(resourceGroupId.SubscriptionId, resourceGroupId.ResourceGroupName, "example")
+	if d.Id() != identityId.ID() {
+		t.Fatalf("expected the ID %q but got %q", identityId.ID(), d.Id())
+	}
+	if v := d.Get("tags.env").(string); v != "test" {
+		t.Fatalf("expected the tag `env` to be read back as `test` but got %q", v)
+	}
+
+	// the Resource Identity is set from the ID, since the generated Read function doesn't set this
+	identity, err := d.Identity()
+	if err != nil {
+		t.Fatalf("retrieving identity: %+v", err)
+	}
+	for k, expected := range map[string]string{
+		"subscription_id":     identityId.SubscriptionId,
+		"resource_group_name": identityId.ResourceGroupName,
+		"name":                identityId.UserAssignedIdentityName,
+	} {
+		if v := identity.Get(k).(string); v != expected {
+			t.Fatalf("expected the identity `%s` to be %q but got %q", k, expected, v)
+		}
+	}
+
+	// creating the same resource again must be imported
+	duplicate := testResourceData(t, resource, config)
+	diags := resource.CreateContext(ctx, duplicate, client)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "already exists") {
+		t.Fatalf("expected a requires import error but got: %+v", diags)
+	}
+
+	// a resource deleted outside of Terraform is removed from the state
+	server.Remove(identityId.ID())
+	if diags := resource.ReadContext(ctx, d, client); diags.HasError() {
+		t.Fatalf("reading: %+v", diags)
+	}
+	if d.Id() != "" {
+		t.Fatalf("expected the resource to be marked as gone but got the ID %q", d.Id())
+	}
+
+	d = testResourceData(t, resource, config)
+	if diags := resource.CreateContext(ctx, d, client); diags.HasError() {
+		t.Fatalf("recreating: %+v", diags)
+	}
+	if diags := resource.DeleteContext(ctx, d, client); diags.HasError() {
+		t.Fatalf("deleting: %+v", diags)
+	}
+	if server.Exists(identityId.ID()) {
+		t.Fatalf("expected %s to be deleted", identityId)
+	}
+}
+
+// testResourceData returns a ResourceData for the resource containing the specified config, which (unlike
+// schema.TestResourceDataRaw) includes the Resource Identity schema - so that this can be set during Create/Read
+func testResourceData(t *testing.T, resource *schema.Resource, config map[string]interface{}) *schema.ResourceData {
+	d := resource.Data(nil)
+	for k, v := range config {
+		if err := d.Set(k, v); err != nil {
+			t.Fatalf("setting %q: %+v", k, err)
+		}
+	}
+	return d
+}
+
+func testClient(t *testing.T, ctx context.Context, server *fakearm.Server) *clients.Client {
+	t.Setenv("ARM_PROVIDER_ENHANCED_VALIDATION", "false")
+
+	env, err := server.Environment(ctx)
+	if err != nil {
+		t.Fatalf("retrieving environment: %+v", err)
+	}
+	credentials := server.Credentials(*env)
+
+	client, err := clients.Build(ctx, clients.ClientBuilder{
+		AuthConfig:                &credentials,
+		Features:                  features.Default(),
+		DisableTerraformPartnerID: true,
+		SubscriptionID:            fakearm.DefaultSubscriptionId,
+		TerraformVersion:          "0.0.0",
+	})
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	return client
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+var (
+	recorders     = make(map[string]*common.Recorder)
+	recordersLock = &sync.Mutex{}
+)
+
+// recorderForTest returns the Recorder for this test when running in record/replay mode, which is shared by every
+// TestData built within the test so that random values are generated (and replayed) in a consistent order.
+func recorderForTest(t *testing.T) *common.Recorder {
+	mode := testclient.RecorderMode()
+	if mode == "" {
+		return nil
+	}
+
+	recordersLock.Lock()
+	defer recordersLock.Unlock()
+
+	if recorder, ok := recorders[t.Name()]; ok {
+		return recorder
+	}
+
+	recorder, err := common.NewRecorder(mode, filepath.Join(testclient.CassetteDirectory(), fmt.Sprintf("%s.json", t.Name())), false)
+	if err != nil {
+		t.Fatalf("building recorder: %+v", err)
+	}
+	recorders[t.Name()] = recorder
+
+	t.Cleanup(func() {
+		recordersLock.Lock()
+		defer recordersLock.Unlock()
+
+		if err := recorder.Stop(); err != nil {
+			t.Errorf("saving cassette: %+v", err)
+		}
+		delete(recorders, t.Name())
+
+		// the test client's cassette is shared by all tests, so is saved once each test completes
+		if err := testclient.SaveRecorder(); err != nil {
+			t.Errorf("saving test client cassette: %+v", err)
+		}
+	})
+
+	return recorder
+}
+
+// recordedInt returns an integer which is stored in the cassette when recording, and read from it when replaying
+func recordedInt(recorder *common.Recorder, name string, generate func() int) int {
+	if recorder == nil {
+		return generate()
+	}
+
+	v := recorder.Variable(name, func() string {
+		return strconv.Itoa(generate())
+	})
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		panic(fmt.Sprintf("parsing recorded variable %q as an integer: %+v", name, err))
+	}
+	return i
+}
+
+// recordedString returns a string which is stored in the cassette when recording, and read from it when replaying
+func recordedString(recorder *common.Recorder, name string, generate func() string) string {
+	if recorder == nil {
+		return generate()
+	}
+
+	return recorder.Variable(name, generate)
+}
@@ -7,16 +7,19 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"sync"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 )
 
 var (
 	_client    *clients.Client
+	_recorder  *common.Recorder
 	clientLock = &sync.Mutex{}
 )
 
@@ -64,8 +67,17 @@ func Build() (*clients.Client, error) {
 			EnableAuthenticationUsingGitHubOIDC:        false,
 		}
 
+		// the clients used to check resources exist are shared by all tests, so use a single shared cassette
+		if mode := RecorderMode(); mode != "" && _recorder == nil {
+			_recorder, err = common.NewRecorder(mode, filepath.Join(CassetteDirectory(), "testclient.json"), true)
+			if err != nil {
+				return nil, fmt.Errorf("building recorder for test client: %+v", err)
+			}
+		}
+
 		clientBuilder := clients.ClientBuilder{
 			AuthConfig:        &authConfig,
+			Recorder:          _recorder,
 			TerraformVersion:  os.Getenv("TERRAFORM_CORE_VERSION"),
 			Features:          features.Default(),
 			StorageUseAzureAD: false,
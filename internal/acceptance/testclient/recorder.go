@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testclient
+
+import (
+	"os"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+// RecorderMode returns the mode specified in `ARM_TEST_RECORDER_MODE` which determines whether the HTTP interactions
+// made during the acceptance tests are recorded into cassettes (`record`) or replayed from them (`replay`), an empty
+// value means that the tests are run against Azure as usual.
+func RecorderMode() common.RecorderMode {
+	return common.RecorderMode(os.Getenv("ARM_TEST_RECORDER_MODE"))
+}
+
+// CassetteDirectory returns the directory containing the cassettes, which can be overridden using
+// `ARM_TEST_CASSETTE_DIR` - by default this is the `testdata/recordings` directory within the test package.
+func CassetteDirectory() string {
+	if v := os.Getenv("ARM_TEST_CASSETTE_DIR"); v != "" {
+		return v
+	}
+	return "testdata/recordings"
+}
+
+// SaveRecorder writes the cassette shared by the test client to disk, if the test client is recording
+func SaveRecorder() error {
+	clientLock.Lock()
+	defer clientLock.Unlock()
+
+	return _recorder.Save()
+}
@@ -29,6 +29,9 @@ type ClientBuilder struct {
 	DisableTerraformPartnerID   bool
 	MetadataHost                string
 	PartnerID                   string
+	RateLimits                  common.RateLimits
+	Recorder                    *common.Recorder
+	RequestTraceFilePath        string
 	RegisteredResourceProviders resourceproviders.ResourceProviders
 	StorageUseAzureAD           bool
 	SubscriptionID              string
@@ -48,25 +51,33 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return nil, errors.New(azureStackEnvironmentError)
 	}
 
+	newAuthorizer := func(api environments.Api) (auth.Authorizer, error) {
+		// no requests are sent to Azure when replaying recorded interactions, so there's no need to authenticate
+		if builder.Recorder.Replaying() {
+			return builder.Recorder.Authorizer(), nil
+		}
+		return auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, api)
+	}
+
 	var resourceManagerAuth, storageAuth, synapseAuth, batchManagementAuth, keyVaultAuth auth.Authorizer
 
-	resourceManagerAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ResourceManager)
+	resourceManagerAuth, err = newAuthorizer(builder.AuthConfig.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Resource Manager API: %+v", err)
 	}
 
-	storageAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Storage)
+	storageAuth, err = newAuthorizer(builder.AuthConfig.Environment.Storage)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Storage API: %+v", err)
 	}
 
-	keyVaultAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.KeyVault)
+	keyVaultAuth, err = newAuthorizer(builder.AuthConfig.Environment.KeyVault)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Key Vault API: %+v", err)
 	}
 
 	if builder.AuthConfig.Environment.Synapse.Available() {
-		synapseAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Synapse)
+		synapseAuth, err = newAuthorizer(builder.AuthConfig.Environment.Synapse)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Synapse API: %+v", err)
 		}
@@ -75,7 +86,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	}
 
 	if builder.AuthConfig.Environment.Batch.Available() {
-		batchManagementAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Batch)
+		batchManagementAuth, err = newAuthorizer(builder.AuthConfig.Environment.Batch)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Batch Management API: %+v", err)
 		}
@@ -85,7 +96,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 
 	// Helper for obtaining endpoint-specific tokens
 	authorizerFunc := common.ApiAuthorizerFunc(func(api environments.Api) (auth.Authorizer, error) {
-		authorizer, err := auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, api)
+		authorizer, err := newAuthorizer(api)
 		if err != nil {
 			return nil, fmt.Errorf("building custom authorizer for API %q: %+v", api.Name(), err)
 		}
@@ -93,14 +104,33 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return authorizer, nil
 	})
 
-	account, err := NewResourceManagerAccount(ctx, *builder.AuthConfig, builder.SubscriptionID, builder.RegisteredResourceProviders)
-	if err != nil {
-		return nil, fmt.Errorf("building account: %+v", err)
+	var account *ResourceManagerAccount
+	if builder.Recorder.Replaying() {
+		account = &ResourceManagerAccount{
+			Environment:                      builder.AuthConfig.Environment,
+			ClientId:                         builder.AuthConfig.ClientID,
+			ObjectId:                         common.RecorderPlaceholderId,
+			SubscriptionId:                   builder.SubscriptionID,
+			TenantId:                         builder.AuthConfig.TenantID,
+			AuthenticatedAsAServicePrincipal: true,
+			RegisteredResourceProviders:      builder.RegisteredResourceProviders,
+		}
+	} else {
+		account, err = NewResourceManagerAccount(ctx, *builder.AuthConfig, builder.SubscriptionID, builder.RegisteredResourceProviders)
+		if err != nil {
+			return nil, fmt.Errorf("building account: %+v", err)
+		}
+	}
+
+	if builder.Recorder.Recording() {
+		builder.Recorder.AddReplacement(account.SubscriptionId, common.RecorderPlaceholderId)
+		builder.Recorder.AddReplacement(account.TenantId, common.RecorderPlaceholderId)
+		builder.Recorder.AddReplacement(account.ObjectId, common.RecorderPlaceholderId)
 	}
 
 	var managedHSMAuth auth.Authorizer
 	if builder.AuthConfig.Environment.ManagedHSM.Available() {
-		managedHSMAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ManagedHSM)
+		managedHSMAuth, err = newAuthorizer(builder.AuthConfig.Environment.ManagedHSM)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Managed HSM API: %+v", err)
 		}
@@ -113,8 +143,25 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return nil, errors.New("unable to determine resource manager endpoint for the current environment")
 	}
 
+	var rateLimiter *common.RateLimiter
+	if builder.RateLimits.Enabled() {
+		rateLimiter, err = common.NewRateLimiter(builder.RateLimits, *resourceManagerEndpoint, account.SubscriptionId)
+		if err != nil {
+			return nil, fmt.Errorf("building rate limiter: %+v", err)
+		}
+	}
+
+	var requestTracer *common.RequestTracer
+	if builder.RequestTraceFilePath != "" {
+		requestTracer, err = common.NewRequestTracer(builder.RequestTraceFilePath)
+		if err != nil {
+			return nil, fmt.Errorf("building request tracer: %+v", err)
+		}
+	}
+
 	client := Client{
-		Account: account,
+		Account:       account,
+		RequestTracer: requestTracer,
 	}
 
 	o := &common.ClientOptions{
@@ -150,13 +197,17 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		StorageUseAzureAD:           builder.StorageUseAzureAD,
 
 		ResourceManagerEndpoint: *resourceManagerEndpoint,
+		RateLimiter:             rateLimiter,
+		RequestTracer:           requestTracer,
+		Recorder:                builder.Recorder,
 	}
 
 	if err := client.Build(ctx, o); err != nil {
 		return nil, fmt.Errorf("building Client: %+v", err)
 	}
 
-	if features.EnhancedValidationEnabled() {
+	// the locations and resource providers are retrieved outside of the clients, so can't be replayed
+	if features.EnhancedValidationEnabled() && !builder.Recorder.Replaying() {
 		subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
 
 		ctx2, cancel := context.WithTimeout(ctx, 10*time.Minute)
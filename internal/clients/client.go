@@ -160,6 +160,9 @@ type Client struct {
 	Account  *ResourceManagerAccount
 	Features features.UserFeatures
 
+	// RequestTracer is used to attribute requests to the Terraform Resource which sent them, when tracing is enabled
+	RequestTracer *common.RequestTracer
+
 	AadB2c                            *aadb2c_v2021_04_01_preview.Client
 	Advisor                           *advisor.Client
 	AnalysisServices                  *analysisservices_v2017_08_01.Client
@@ -683,3 +686,11 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 
 	return nil
 }
+
+// DefaultSubscriptionId returns the Subscription ID which the Provider has been configured to use
+func (client *Client) DefaultSubscriptionId() string {
+	if client.Account == nil {
+		return ""
+	}
+	return client.Account.SubscriptionId
+}
@@ -9,7 +9,6 @@ import (
 	"strings"
 
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/hashicorp/go-azure-helpers/sender"
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
@@ -50,6 +49,15 @@ type ClientOptions struct {
 
 	ResourceManagerEndpoint string
 
+	// RateLimiter is shared across all clients to throttle requests before Azure's quotas are exceeded
+	RateLimiter *RateLimiter
+
+	// RequestTracer writes a structured trace of each request, when enabled
+	RequestTracer *RequestTracer
+
+	// Recorder is used by the acceptance tests to record or replay HTTP interactions
+	Recorder *Recorder
+
 	// Legacy authorizers for go-autorest
 	BatchManagementAuthorizer autorest.Authorizer
 	KeyVaultAuthorizer        autorest.Authorizer
@@ -74,8 +82,22 @@ func (o ClientOptions) Configure(c client.BaseClient, authorizer auth.Authorizer
 		c.AppendRequestMiddleware(correlationRequestIDMiddleware(id))
 	}
 
+	if o.RateLimiter != nil {
+		c.AppendRequestMiddleware(rateLimiterMiddleware(o.RateLimiter))
+	}
+
+	if o.RequestTracer != nil {
+		c.AppendRequestMiddleware(requestTracerRequestMiddleware(o.RequestTracer))
+		c.AppendResponseMiddleware(requestTracerResponseMiddleware(o.RequestTracer))
+	}
+
 	c.AppendRequestMiddleware(requestLoggerMiddleware("AzureRM"))
 	c.AppendResponseMiddleware(responseLoggerMiddleware("AzureRM"))
+
+	if o.Recorder != nil {
+		c.AppendRequestMiddleware(o.Recorder.requestMiddleware())
+		c.AppendResponseMiddleware(o.Recorder.responseMiddleware())
+	}
 }
 
 // ConfigureClient sets up an autorest.Client using an autorest.Authorizer
@@ -83,7 +105,7 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 	c.UserAgent = userAgent(c.UserAgent, o.TerraformVersion, o.PartnerId, o.DisableTerraformPartnerID)
 
 	c.Authorizer = authorizer
-	c.Sender = sender.BuildSender("AzureRM")
+	c.Sender = buildSender("AzureRM")
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
 	if !o.DisableCorrelationRequestID {
 		id := o.CustomCorrelationRequestID
@@ -92,6 +114,18 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 		}
 		c.RequestInspector = withCorrelationRequestID(id)
 	}
+
+	if o.RequestTracer != nil {
+		c.Sender = o.RequestTracer.sender(c.Sender)
+	}
+
+	if o.RateLimiter != nil {
+		c.Sender = o.RateLimiter.sender(c.Sender)
+	}
+
+	if o.Recorder != nil {
+		c.Sender = o.Recorder.sender(c.Sender)
+	}
 }
 
 func userAgent(userAgent, tfVersion, partnerID string, disableTerraformPartnerID bool) string {
@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const logRedactedValue = "REDACTED"
+
+// logSensitiveHeaders are removed from requests/responses prior to them being logged
+var logSensitiveHeaders = []string{
+	"Authorization",
+	"Ocp-Apim-Subscription-Key",
+	"x-functions-key",
+	"x-ms-authorization-auxiliary",
+}
+
+// logSensitiveKeys are the (case-insensitive) names of JSON fields whose values are redacted
+var logSensitiveKeys = []string{
+	"accessKey",
+	"accessToken",
+	"accountKey",
+	"primaryAccessKey",
+	"primaryKey",
+	"primaryMasterKey",
+	"primaryReadonlyMasterKey",
+	"privateKey",
+	"refreshToken",
+	"sasToken",
+	"secondaryAccessKey",
+	"secondaryKey",
+	"secondaryMasterKey",
+	"secondaryReadonlyMasterKey",
+	"sharedKey",
+	"storageAccountAccessKey",
+}
+
+// logSensitiveKeySuffixes are the (case-insensitive) suffixes of JSON field names whose values are redacted,
+// e.g. `adminPassword`, `administratorLoginPassword`, `clientSecret` and `primaryConnectionString`
+var logSensitiveKeySuffixes = []string{
+	"connectionString",
+	"password",
+	"secret",
+}
+
+// logSensitivePaths are the paths to JSON fields whose values are redacted, where `*` matches any field name
+// or array index. These are used for fields whose name alone isn't sensitive.
+var logSensitivePaths = []string{
+	// Key Vault Secrets
+	"value",
+	// Storage Account / Cognitive Services / Search Service keys
+	"keys.*.value",
+}
+
+// logSensitiveQueryParameters are the query string parameters of any URL (for example a SAS URL) which are redacted
+var logSensitiveQueryParameters = []string{
+	"code",
+	"sig",
+}
+
+var logSensitiveQueryParameterRegex = regexp.MustCompile(fmt.Sprintf(`(?i)([?&](?:%s)=)[^&\s"'\\]+`, strings.Join(logSensitiveQueryParameters, "|")))
+
+// logSensitiveKeysRegex is used to redact values from bodies which can't be parsed as JSON (e.g. when chunked)
+var logSensitiveKeysRegex = regexp.MustCompile(fmt.Sprintf(`(?i)("(?:%s|[^"]*(?:%s))"\s*:\s*")(?:[^"\\]|\\.)*"`, strings.Join(logSensitiveKeys, "|"), strings.Join(logSensitiveKeySuffixes, "|")))
+
+var (
+	logRedactionPatterns     []*regexp.Regexp
+	logRedactionPatternsOnce sync.Once
+)
+
+// userLogRedactionPatterns returns the additional regular expressions to redact, which are specified as a
+// comma-separated list using the `ARM_LOG_REDACTION_PATTERNS` environment variable
+func userLogRedactionPatterns() []*regexp.Regexp {
+	logRedactionPatternsOnce.Do(func() {
+		logRedactionPatterns = parseLogRedactionPatterns(os.Getenv("ARM_LOG_REDACTION_PATTERNS"))
+	})
+	return logRedactionPatterns
+}
+
+func parseLogRedactionPatterns(input string) []*regexp.Regexp {
+	patterns := make([]*regexp.Regexp, 0)
+	for _, v := range strings.Split(input, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+
+		pattern, err := regexp.Compile(v)
+		if err != nil {
+			log.Printf("[WARN] Ignoring invalid log redaction pattern %q: %+v", v, err)
+			continue
+		}
+		patterns = append(patterns, pattern)
+	}
+	return patterns
+}
+
+func isLogSensitiveHeader(name string) bool {
+	for _, v := range logSensitiveHeaders {
+		if strings.EqualFold(v, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactLogDump redacts secrets from a request/response dumped to wire format, prior to it being logged
+func redactLogDump(dump []byte, userPatterns []*regexp.Regexp) []byte {
+	headers, body, hasBody := bytes.Cut(dump, []byte("\r\n\r\n"))
+	if hasBody && len(body) > 0 {
+		body = redactLogBody(body)
+		dump = bytes.Join([][]byte{headers, body}, []byte("\r\n\r\n"))
+	}
+
+	dump = logSensitiveQueryParameterRegex.ReplaceAll(dump, []byte("${1}"+logRedactedValue))
+	for _, pattern := range userPatterns {
+		dump = pattern.ReplaceAll(dump, []byte(logRedactedValue))
+	}
+
+	return dump
+}
+
+// redactLogUrl redacts secrets from the query string of a URL (for example the signature of a SAS URL), prior to
+// it being logged or traced
+func redactLogUrl(input string, userPatterns []*regexp.Regexp) string {
+	output := logSensitiveQueryParameterRegex.ReplaceAllString(input, "${1}"+logRedactedValue)
+	for _, pattern := range userPatterns {
+		output = pattern.ReplaceAllString(output, logRedactedValue)
+	}
+
+	return output
+}
+
+func redactLogBody(body []byte) []byte {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var parsed interface{}
+	if err := decoder.Decode(&parsed); err != nil || decoder.More() {
+		return logSensitiveKeysRegex.ReplaceAll(body, []byte("${1}"+logRedactedValue+`"`))
+	}
+
+	redacted, changed := redactLogValue(parsed, nil)
+	if !changed {
+		return body
+	}
+
+	// HTML escaping is disabled since otherwise `&` is encoded as `\u0026`, meaning that any sensitive query
+	// parameters (e.g. the signature of a SAS URL) within the body would no longer be redacted
+	out := bytes.Buffer{}
+	encoder := json.NewEncoder(&out)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(redacted); err != nil {
+		return logSensitiveKeysRegex.ReplaceAll(body, []byte("${1}"+logRedactedValue+`"`))
+	}
+	return bytes.TrimSuffix(out.Bytes(), []byte("\n"))
+}
+
+// redactLogValue redacts any sensitive fields within input, returning whether any fields were redacted
+func redactLogValue(input interface{}, path []string) (interface{}, bool) {
+	changed := false
+
+	switch v := input.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if _, isString := value.(string); isString && (isLogSensitiveKey(key) || isLogSensitivePath(append(path, key))) {
+				v[key] = logRedactedValue
+				changed = true
+				continue
+			}
+
+			updated, nestedChanged := redactLogValue(value, append(path, key))
+			if nestedChanged {
+				v[key] = updated
+				changed = true
+			}
+		}
+
+	case []interface{}:
+		for i, value := range v {
+			if _, isString := value.(string); isString && isLogSensitivePath(append(path, "*")) {
+				v[i] = logRedactedValue
+				changed = true
+				continue
+			}
+
+			updated, nestedChanged := redactLogValue(value, append(path, "*"))
+			if nestedChanged {
+				v[i] = updated
+				changed = true
+			}
+		}
+	}
+
+	return input, changed
+}
+
+func isLogSensitiveKey(key string) bool {
+	for _, v := range logSensitiveKeys {
+		if strings.EqualFold(v, key) {
+			return true
+		}
+	}
+
+	for _, v := range logSensitiveKeySuffixes {
+		if strings.HasSuffix(strings.ToLower(key), strings.ToLower(v)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isLogSensitivePath(path []string) bool {
+	for _, v := range logSensitivePaths {
+		segments := strings.Split(v, ".")
+		if len(segments) != len(path) {
+			continue
+		}
+
+		matches := true
+		for i, segment := range segments {
+			if segment != "*" && !strings.EqualFold(segment, path[i]) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRedactLogDump(t *testing.T) {
+	testData := []struct {
+		name        string
+		input       string
+		patterns    string
+		redacted    []string
+		notRedacted []string
+	}{
+		{
+			name:        "no body",
+			input:       "GET /subscriptions/123/resourceGroups/example HTTP/1.1\r\nHost: management.azure.com\r\n\r\n",
+			notRedacted: []string{"/subscriptions/123/resourceGroups/example"},
+		},
+		{
+			name:        "sensitive keys",
+			input:       "PUT /example HTTP/1.1\r\nHost: management.azure.com\r\n\r\n" + `{"properties":{"osProfile":{"adminUsername":"adminuser","adminPassword":"P@ssw0rd1234!"},"primaryConnectionString":"Endpoint=sb://example;SharedAccessKey=abc123","clientSecret":"hunter2"}}`,
+			redacted:    []string{"P@ssw0rd1234!", "SharedAccessKey=abc123", "hunter2"},
+			notRedacted: []string{"adminuser", `"adminPassword":"REDACTED"`},
+		},
+		{
+			name:        "non-string values are left as-is",
+			input:       "PUT /example HTTP/1.1\r\nHost: management.azure.com\r\n\r\n" + `{"properties":{"disablePassword":true,"secretPermissions":["Get","List"]}}`,
+			notRedacted: []string{`"disablePassword":true`, `"secretPermissions":["Get","List"]`},
+		},
+		{
+			name:        "storage account keys",
+			input:       "HTTP/1.1 200 OK\r\nContent-Type: application/json\r\n\r\n" + `{"keys":[{"keyName":"key1","value":"c2VjcmV0MQ==","permissions":"FULL"},{"keyName":"key2","value":"c2VjcmV0Mg==","permissions":"FULL"}]}`,
+			redacted:    []string{"c2VjcmV0MQ==", "c2VjcmV0Mg=="},
+			notRedacted: []string{"key1", "FULL"},
+		},
+		{
+			name:     "key vault secret",
+			input:    "HTTP/1.1 200 OK\r\nContent-Type: application/json\r\n\r\n" + `{"value":"mysecretvalue","id":"https://example.vault.azure.net/secrets/example/1"}`,
+			redacted: []string{"mysecretvalue"},
+		},
+		{
+			name:        "list responses are left as-is",
+			input:       "HTTP/1.1 200 OK\r\nContent-Type: application/json\r\n\r\n" + `{"value":[{"name":"example"}]}`,
+			notRedacted: []string{`{"value":[{"name":"example"}]}`},
+		},
+		{
+			name:        "sas tokens",
+			input:       "GET /container/blob?sv=2020-08-04&se=2030-01-01&sp=r&sig=abc%2Fdef%3D HTTP/1.1\r\nHost: example.blob.core.windows.net\r\n\r\n" + `{"properties":{"uri":"https://example.blob.core.windows.net/c?sv=2020-08-04&sig=xyz123"}}`,
+			redacted:    []string{"abc%2Fdef%3D", "xyz123"},
+			notRedacted: []string{"sv=2020-08-04", "se=2030-01-01"},
+		},
+		{
+			name:        "sas tokens in a body containing sensitive keys",
+			input:       "HTTP/1.1 200 OK\r\nContent-Type: application/json\r\n\r\n" + `{"properties":{"accountKey":"c2VjcmV0MQ==","uri":"https://example.blob.core.windows.net/c?sv=2020-08-04&sig=xyz123"}}`,
+			redacted:    []string{"c2VjcmV0MQ==", "xyz123"},
+			notRedacted: []string{"https://example.blob.core.windows.net/c?sv=2020-08-04&sig=REDACTED"},
+		},
+		{
+			name:        "chunked bodies",
+			input:       "HTTP/1.1 200 OK\r\nTransfer-Encoding: chunked\r\n\r\n4a\r\n" + `{"properties":{"adminPassword":"P@ssw0rd1234!","adminUsername":"adminuser"}}` + "\r\n0\r\n\r\n",
+			redacted:    []string{"P@ssw0rd1234!"},
+			notRedacted: []string{"adminuser"},
+		},
+		{
+			name:        "user-specified patterns",
+			input:       "PUT /example HTTP/1.1\r\nHost: management.azure.com\r\n\r\n" + `{"properties":{"customData":"token-12345","other":"token-abc"}}`,
+			patterns:    `token-\d+, [invalid`,
+			redacted:    []string{"token-12345"},
+			notRedacted: []string{"token-abc"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			actual := string(redactLogDump([]byte(v.input), parseLogRedactionPatterns(v.patterns)))
+
+			for _, value := range v.redacted {
+				if strings.Contains(actual, value) {
+					t.Fatalf("expected %q to be redacted but got:\n%s", value, actual)
+				}
+			}
+			for _, value := range v.notRedacted {
+				if !strings.Contains(actual, value) {
+					t.Fatalf("expected %q not to be redacted but got:\n%s", value, actual)
+				}
+			}
+		})
+	}
+}
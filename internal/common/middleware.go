@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httputil"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 )
 
@@ -23,39 +24,78 @@ func correlationRequestIDMiddleware(id string) client.RequestMiddleware {
 
 func requestLoggerMiddleware(providerName string) client.RequestMiddleware {
 	return func(request *http.Request) (*http.Request, error) {
-		// strip the authorization header prior to printing
-		authHeaderName := "Authorization"
-		auth := request.Header.Get(authHeaderName)
-		if auth != "" {
-			request.Header.Del(authHeaderName)
-		}
-
-		// dump request to wire format
-		if dump, err := httputil.DumpRequestOut(request, true); err == nil {
-			log.Printf("[DEBUG] %s Request: \n%s\n", providerName, dump)
-		} else {
-			// fallback to basic message
-			log.Printf("[DEBUG] %s Request: %s to %s\n", providerName, request.Method, request.URL)
-		}
-
-		// add the auth header back
-		if auth != "" {
-			request.Header.Add(authHeaderName, auth)
-		}
-
+		logRequest(providerName, request)
 		return request, nil
 	}
 }
 
 func responseLoggerMiddleware(providerName string) client.ResponseMiddleware {
 	return func(request *http.Request, response *http.Response) (*http.Response, error) {
-		// dump response to wire format
-		if dump, err2 := httputil.DumpResponse(response, true); err2 == nil {
-			log.Printf("[DEBUG] %s Response for %s: \n%s\n", providerName, request.URL, dump)
-		} else {
-			// fallback to basic message
-			log.Printf("[DEBUG] %s Response: %s for %s\n", providerName, response.Status, request.URL)
-		}
+		logResponse(providerName, request, response)
 		return response, nil
 	}
 }
+
+// buildSender returns an autorest.Sender which logs requests/responses (with any secrets redacted), replacing
+// `sender.BuildSender` from go-azure-helpers which logs these verbatim
+func buildSender(providerName string) autorest.Sender {
+	return autorest.DecorateSender(&http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+		},
+	}, withRequestLogging(providerName))
+}
+
+func withRequestLogging(providerName string) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			logRequest(providerName, r)
+
+			resp, err := s.Do(r)
+			if resp != nil {
+				logResponse(providerName, r, resp)
+			} else if err != nil {
+				log.Printf("[DEBUG] %s Response Error: %s for %s\n", providerName, err, redactLogDump([]byte(r.URL.String()), userLogRedactionPatterns()))
+			} else {
+				log.Printf("[DEBUG] Request to %s completed with no response", redactLogDump([]byte(r.URL.String()), userLogRedactionPatterns()))
+			}
+			return resp, err
+		})
+	}
+}
+
+func logRequest(providerName string, request *http.Request) {
+	// strip any sensitive headers (such as the authorization header) prior to printing
+	sensitiveHeaders := make(http.Header)
+	for name, values := range request.Header {
+		if isLogSensitiveHeader(name) {
+			sensitiveHeaders[name] = values
+			request.Header.Del(name)
+		}
+	}
+
+	// dump request to wire format
+	if dump, err := httputil.DumpRequestOut(request, true); err == nil {
+		log.Printf("[DEBUG] %s Request: \n%s\n", providerName, redactLogDump(dump, userLogRedactionPatterns()))
+	} else {
+		// fallback to basic message
+		log.Printf("[DEBUG] %s Request: %s to %s\n", providerName, request.Method, redactLogDump([]byte(request.URL.String()), userLogRedactionPatterns()))
+	}
+
+	// add the sensitive headers back
+	for name, values := range sensitiveHeaders {
+		request.Header[name] = values
+	}
+}
+
+func logResponse(providerName string, request *http.Request, response *http.Response) {
+	url := redactLogDump([]byte(request.URL.String()), userLogRedactionPatterns())
+
+	// dump response to wire format
+	if dump, err := httputil.DumpResponse(response, true); err == nil {
+		log.Printf("[DEBUG] %s Response for %s: \n%s\n", providerName, url, redactLogDump(dump, userLogRedactionPatterns()))
+	} else {
+		// fallback to basic message
+		log.Printf("[DEBUG] %s Response: %s for %s\n", providerName, response.Status, url)
+	}
+}
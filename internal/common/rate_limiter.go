@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+// RateLimits defines the maximum number of requests per minute which the provider will send to a single
+// endpoint, a value of 0 means that requests of that type are not limited.
+type RateLimits struct {
+	ResourceManagerReadsPerMinute  int
+	ResourceManagerWritesPerMinute int
+	DataPlaneReadsPerMinute        int
+	DataPlaneWritesPerMinute       int
+}
+
+// Enabled returns whether any of the rate limits have been configured
+func (l RateLimits) Enabled() bool {
+	return l.ResourceManagerReadsPerMinute > 0 || l.ResourceManagerWritesPerMinute > 0 || l.DataPlaneReadsPerMinute > 0 || l.DataPlaneWritesPerMinute > 0
+}
+
+// RateLimiter is a client-side token bucket rate limiter which is shared across all the clients built by the
+// provider. A separate bucket is maintained for each combination of Subscription, Resource Provider (or Data
+// Plane host) and request type (read or write), so that requests are throttled before they would exceed the
+// quotas enforced by Azure.
+type RateLimiter struct {
+	limits                 RateLimits
+	resourceManagerHost    string
+	defaultSubscriptionId  string
+	buckets                map[rateLimiterKey]*tokenBucket
+	bucketsLock            sync.Mutex
+	now                    func() time.Time
+	waitForNextAvailableFn func(ctx context.Context, d time.Duration) error
+}
+
+type rateLimiterKey struct {
+	subscriptionId   string
+	host             string
+	resourceProvider string
+	write            bool
+}
+
+// NewRateLimiter returns a RateLimiter for the specified limits, the Resource Manager endpoint is used to
+// determine whether a request is sent to Resource Manager or to a Data Plane API.
+func NewRateLimiter(limits RateLimits, resourceManagerEndpoint, defaultSubscriptionId string) (*RateLimiter, error) {
+	for name, v := range map[string]int{
+		"resource manager reads":  limits.ResourceManagerReadsPerMinute,
+		"resource manager writes": limits.ResourceManagerWritesPerMinute,
+		"data plane reads":        limits.DataPlaneReadsPerMinute,
+		"data plane writes":       limits.DataPlaneWritesPerMinute,
+	} {
+		if v < 0 {
+			return nil, fmt.Errorf("the rate limit for %s must be 0 or greater but got %d", name, v)
+		}
+	}
+
+	endpoint, err := url.Parse(resourceManagerEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Resource Manager endpoint %q: %+v", resourceManagerEndpoint, err)
+	}
+
+	return &RateLimiter{
+		limits:                 limits,
+		resourceManagerHost:    strings.ToLower(endpoint.Host),
+		defaultSubscriptionId:  strings.ToLower(defaultSubscriptionId),
+		buckets:                make(map[rateLimiterKey]*tokenBucket),
+		now:                    time.Now,
+		waitForNextAvailableFn: sleepWithContext,
+	}, nil
+}
+
+// Wait blocks until the request is permitted by the configured rate limits, or the context is cancelled
+func (l *RateLimiter) Wait(ctx context.Context, request *http.Request) error {
+	if l == nil || request == nil || request.URL == nil {
+		return nil
+	}
+
+	key, limit := l.keyForRequest(request)
+	if limit <= 0 {
+		return nil
+	}
+
+	bucket := l.bucketFor(key, limit)
+	delay := bucket.reserve(l.now())
+	if delay <= 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Rate Limiter: delaying %s %s by %s", request.Method, request.URL.Host, delay)
+	if err := l.waitForNextAvailableFn(ctx, delay); err != nil {
+		// the request won't be sent, so give the token back for the next one
+		bucket.cancelReservation()
+		return fmt.Errorf("waiting for rate limit on %s: %+v", request.URL.Host, err)
+	}
+
+	return nil
+}
+
+func (l *RateLimiter) keyForRequest(request *http.Request) (rateLimiterKey, int) {
+	host := strings.ToLower(request.URL.Host)
+	write := isWriteRequest(request.Method)
+
+	key := rateLimiterKey{
+		subscriptionId: l.defaultSubscriptionId,
+		host:           host,
+		write:          write,
+	}
+	if subscriptionId := subscriptionIdFromPath(request.URL.Path); subscriptionId != "" {
+		key.subscriptionId = subscriptionId
+	}
+
+	isResourceManager := host == l.resourceManagerHost
+	if isResourceManager {
+		// all Resource Providers are served from the same host, so requests are bucketed by the RP instead
+		key.resourceProvider = resourceProviderFromPath(request.URL.Path)
+	}
+
+	switch {
+	case isResourceManager && write:
+		return key, l.limits.ResourceManagerWritesPerMinute
+	case isResourceManager:
+		return key, l.limits.ResourceManagerReadsPerMinute
+	case write:
+		return key, l.limits.DataPlaneWritesPerMinute
+	default:
+		return key, l.limits.DataPlaneReadsPerMinute
+	}
+}
+
+func (l *RateLimiter) bucketFor(key rateLimiterKey, limit int) *tokenBucket {
+	l.bucketsLock.Lock()
+	defer l.bucketsLock.Unlock()
+
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = newTokenBucket(limit, l.now())
+		l.buckets[key] = bucket
+	}
+
+	return bucket
+}
+
+func isWriteRequest(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+
+	return true
+}
+
+func subscriptionIdFromPath(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := 0; i < len(segments)-1; i++ {
+		if strings.EqualFold(segments[i], "subscriptions") {
+			return strings.ToLower(segments[i+1])
+		}
+	}
+
+	return ""
+}
+
+// resourceProviderFromPath returns the namespace of the Resource Provider which serves the request, for extension
+// resources (e.g. Role Assignments scoped to another resource) this is the last `providers` segment in the path.
+// Requests which don't target a Resource Provider (e.g. Resource Groups) return an empty string.
+func resourceProviderFromPath(path string) string {
+	resourceProvider := ""
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := 0; i < len(segments)-1; i++ {
+		if strings.EqualFold(segments[i], "providers") {
+			resourceProvider = strings.ToLower(segments[i+1])
+		}
+	}
+
+	return resourceProvider
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// tokenBucket holds up to one minutes worth of tokens, which are refilled continuously
+type tokenBucket struct {
+	lock       sync.Mutex
+	capacity   float64
+	tokens     float64
+	refillRate float64
+	lastRefill time.Time
+}
+
+func newTokenBucket(requestsPerMinute int, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		capacity:   float64(requestsPerMinute),
+		tokens:     float64(requestsPerMinute),
+		refillRate: float64(requestsPerMinute) / time.Minute.Seconds(),
+		lastRefill: now,
+	}
+}
+
+// reserve takes a token from the bucket, returning how long the caller must wait before the token is available
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if elapsed := now.Sub(b.lastRefill); elapsed > 0 {
+		b.tokens = min(b.capacity, b.tokens+elapsed.Seconds()*b.refillRate)
+		b.lastRefill = now
+	}
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.refillRate * float64(time.Second))
+}
+
+func (b *tokenBucket) cancelReservation() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.tokens = min(b.capacity, b.tokens+1)
+}
+
+func rateLimiterMiddleware(limiter *RateLimiter) client.RequestMiddleware {
+	return func(request *http.Request) (*http.Request, error) {
+		if err := limiter.Wait(request.Context(), request); err != nil {
+			return nil, err
+		}
+		return request, nil
+	}
+}
+
+// sender wraps an autorest.Sender so that requests sent using go-autorest block until they're permitted by the
+// RateLimiter
+func (l *RateLimiter) sender(next autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		if err := l.Wait(r.Context(), r); err != nil {
+			return nil, err
+		}
+		return next.Do(r)
+	})
+}
@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestRateLimiter(t *testing.T) {
+	testData := []struct {
+		name     string
+		limits   RateLimits
+		requests []*http.Request
+		expected []time.Duration
+	}{
+		{
+			name:   "disabled",
+			limits: RateLimits{},
+			requests: []*http.Request{
+				testRateLimiterRequest(t, http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1"),
+				testRateLimiterRequest(t, http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg2"),
+			},
+			expected: []time.Duration{0, 0},
+		},
+		{
+			name: "resource manager writes are limited",
+			limits: RateLimits{
+				ResourceManagerWritesPerMinute: 1,
+			},
+			requests: []*http.Request{
+				testRateLimiterRequest(t, http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1"),
+				testRateLimiterRequest(t, http.MethodDelete, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg2"),
+				testRateLimiterRequest(t, http.MethodGet, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg2"),
+			},
+			expected: []time.Duration{0, time.Minute, 0},
+		},
+		{
+			name: "buckets are per subscription",
+			limits: RateLimits{
+				ResourceManagerWritesPerMinute: 1,
+			},
+			requests: []*http.Request{
+				testRateLimiterRequest(t, http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1"),
+				testRateLimiterRequest(t, http.MethodPut, "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/rg1"),
+				testRateLimiterRequest(t, http.MethodPut, "https://management.azure.com/SUBSCRIPTIONS/00000000-0000-0000-0000-000000000000/resourceGroups/rg2"),
+			},
+			expected: []time.Duration{0, 0, time.Minute},
+		},
+		{
+			name: "resource manager buckets are per resource provider",
+			limits: RateLimits{
+				ResourceManagerWritesPerMinute: 1,
+			},
+			requests: []*http.Request{
+				testRateLimiterRequest(t, http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.Network/virtualNetworks/vnet1"),
+				testRateLimiterRequest(t, http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.Storage/storageAccounts/account1"),
+				testRateLimiterRequest(t, http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.Network/virtualNetworks/vnet1/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000"),
+				testRateLimiterRequest(t, http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/microsoft.network/networkSecurityGroups/nsg1"),
+			},
+			expected: []time.Duration{0, 0, 0, time.Minute},
+		},
+		{
+			name: "data plane requests use the default subscription and are per host",
+			limits: RateLimits{
+				ResourceManagerReadsPerMinute: 1,
+				DataPlaneReadsPerMinute:       2,
+			},
+			requests: []*http.Request{
+				testRateLimiterRequest(t, http.MethodGet, "https://vault1.vault.azure.net/secrets/secret1"),
+				testRateLimiterRequest(t, http.MethodGet, "https://vault1.vault.azure.net/secrets/secret2"),
+				testRateLimiterRequest(t, http.MethodGet, "https://vault1.vault.azure.net/secrets/secret3"),
+				testRateLimiterRequest(t, http.MethodGet, "https://vault2.vault.azure.net/secrets/secret1"),
+				testRateLimiterRequest(t, http.MethodPut, "https://vault2.vault.azure.net/secrets/secret1"),
+			},
+			expected: []time.Duration{0, 0, 30 * time.Second, 0, 0},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		now := time.Now()
+		limiter, err := NewRateLimiter(v.limits, "https://management.azure.com/", "00000000-0000-0000-0000-000000000000")
+		if err != nil {
+			t.Fatalf("building rate limiter: %+v", err)
+		}
+		limiter.now = func() time.Time {
+			return now
+		}
+
+		var waited time.Duration
+		limiter.waitForNextAvailableFn = func(_ context.Context, d time.Duration) error {
+			waited = d
+			return nil
+		}
+
+		for i, request := range v.requests {
+			waited = 0
+			if err := limiter.Wait(context.Background(), request); err != nil {
+				t.Fatalf("unexpected error for request %d: %+v", i, err)
+			}
+			if waited != v.expected[i] {
+				t.Fatalf("expected request %d to wait %s but got %s", i, v.expected[i], waited)
+			}
+		}
+	}
+}
+
+func TestRateLimiterRefill(t *testing.T) {
+	now := time.Now()
+	limiter, err := NewRateLimiter(RateLimits{ResourceManagerWritesPerMinute: 2}, "https://management.azure.com/", "00000000-0000-0000-0000-000000000000")
+	if err != nil {
+		t.Fatalf("building rate limiter: %+v", err)
+	}
+	limiter.now = func() time.Time {
+		return now
+	}
+	limiter.waitForNextAvailableFn = func(_ context.Context, d time.Duration) error {
+		return errors.New("unexpected wait")
+	}
+
+	request := testRateLimiterRequest(t, http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1")
+	for i := 0; i < 2; i++ {
+		if err := limiter.Wait(context.Background(), request); err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+	}
+
+	// a token is refilled every 30 seconds
+	now = now.Add(30 * time.Second)
+	if err := limiter.Wait(context.Background(), request); err != nil {
+		t.Fatalf("unexpected error after refill: %+v", err)
+	}
+
+	if err := limiter.Wait(context.Background(), request); err == nil {
+		t.Fatal("expected an error when the bucket is empty and the wait fails")
+	}
+
+	// the cancelled reservation should have been returned
+	now = now.Add(30 * time.Second)
+	if err := limiter.Wait(context.Background(), request); err != nil {
+		t.Fatalf("unexpected error after cancelled reservation: %+v", err)
+	}
+}
+
+func TestRateLimiterSender(t *testing.T) {
+	limiter, err := NewRateLimiter(RateLimits{ResourceManagerWritesPerMinute: 1}, "https://management.azure.com/", "00000000-0000-0000-0000-000000000000")
+	if err != nil {
+		t.Fatalf("building rate limiter: %+v", err)
+	}
+	limiter.waitForNextAvailableFn = func(_ context.Context, d time.Duration) error {
+		return errors.New("context cancelled")
+	}
+
+	sent := 0
+	sender := limiter.sender(autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		sent++
+		return &http.Response{StatusCode: http.StatusOK, Request: r}, nil
+	}))
+
+	request := testRateLimiterRequest(t, http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1")
+	if _, err := sender.Do(request); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	// the request shouldn't be sent when waiting for the rate limit fails
+	if _, err := sender.Do(request); err == nil {
+		t.Fatal("expected an error when waiting for the rate limit fails")
+	}
+	if sent != 1 {
+		t.Fatalf("expected 1 request to be sent but got %d", sent)
+	}
+}
+
+func TestNewRateLimiterInvalid(t *testing.T) {
+	if _, err := NewRateLimiter(RateLimits{DataPlaneWritesPerMinute: -1}, "https://management.azure.com/", ""); err == nil {
+		t.Fatal("expected an error for a negative rate limit")
+	}
+}
+
+func testRateLimiterRequest(t *testing.T, method, uri string) *http.Request {
+	req, err := http.NewRequest(method, uri, nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	return req
+}
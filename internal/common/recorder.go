@@ -0,0 +1,515 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"golang.org/x/oauth2"
+)
+
+// RecorderMode determines whether HTTP interactions are recorded to, or replayed from, a Cassette
+type RecorderMode string
+
+const (
+	RecorderModeRecord RecorderMode = "record"
+	RecorderModeReplay RecorderMode = "replay"
+)
+
+// RecorderPlaceholderId replaces the Subscription and Tenant IDs used when recording a Cassette, this value should
+// be used for `ARM_SUBSCRIPTION_ID` and `ARM_TENANT_ID` when replaying a Cassette
+const RecorderPlaceholderId = "00000000-0000-0000-0000-000000000000"
+
+// Cassette is the set of HTTP interactions (and any generated values, such as random names) captured for a test
+type Cassette struct {
+	Variables    map[string]string `json:"variables"`
+	Interactions []*Interaction    `json:"interactions"`
+}
+
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+
+	replayed bool
+}
+
+type RecordedRequest struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Recorder captures sanitized HTTP interactions into a Cassette on disk, or replays a previously recorded Cassette
+// so that requests are served without talking to Azure. Secrets are redacted from recorded interactions in the same
+// way as when they're logged.
+type Recorder struct {
+	mode         RecorderMode
+	cassettePath string
+
+	cassette      Cassette
+	lock          sync.Mutex
+	replacements  map[string]string
+	variableCount map[string]int
+
+	// when recording into an existing Cassette, existing interactions for a request are dropped once it's re-recorded
+	rerecorded map[string]struct{}
+
+	server *httptest.Server
+}
+
+// NewRecorder returns a Recorder for the Cassette at cassettePath. When recording, the Cassette is written to disk
+// by Save or Stop. When replaying, a local server is started to serve the recorded responses - which should be
+// stopped using Stop. When recording and appendToExisting is set,
+// interactions in any existing Cassette are retained unless the same request is recorded again.
+func NewRecorder(mode RecorderMode, cassettePath string, appendToExisting bool) (*Recorder, error) {
+	r := &Recorder{
+		mode:         mode,
+		cassettePath: cassettePath,
+		cassette: Cassette{
+			Variables:    make(map[string]string),
+			Interactions: make([]*Interaction, 0),
+		},
+		replacements:  make(map[string]string),
+		variableCount: make(map[string]int),
+		rerecorded:    make(map[string]struct{}),
+	}
+
+	switch mode {
+	case RecorderModeRecord:
+		if appendToExisting {
+			if err := r.load(); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return nil, err
+			}
+		}
+
+	case RecorderModeReplay:
+		if err := r.load(); err != nil {
+			return nil, err
+		}
+		r.server = httptest.NewServer(http.HandlerFunc(r.serveReplay))
+
+	default:
+		return nil, fmt.Errorf("unsupported recorder mode %q, expected %q or %q", mode, RecorderModeRecord, RecorderModeReplay)
+	}
+
+	return r, nil
+}
+
+// Replaying returns whether requests are served from a previously recorded Cassette
+func (r *Recorder) Replaying() bool {
+	return r != nil && r.mode == RecorderModeReplay
+}
+
+// Recording returns whether requests are being captured into a Cassette
+func (r *Recorder) Recording() bool {
+	return r != nil && r.mode == RecorderModeRecord
+}
+
+// AddReplacement ensures that any occurrence of value is replaced with placeholder in the recorded Cassette
+func (r *Recorder) AddReplacement(value, placeholder string) {
+	if value == "" || value == placeholder {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.replacements[value] = placeholder
+}
+
+// Variable returns the value for the named variable - when recording this is generated and stored in the Cassette,
+// when replaying the recorded value is returned. Each call with the same name returns the next value in sequence.
+func (r *Recorder) Variable(name string, generate func() string) string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	key := fmt.Sprintf("%s_%d", name, r.variableCount[name])
+	r.variableCount[name]++
+
+	if r.mode == RecorderModeReplay {
+		if v, ok := r.cassette.Variables[key]; ok {
+			return v
+		}
+		log.Printf("[WARN] Recorder: variable %q was not found in the cassette %q, generating a new value", key, r.cassettePath)
+		return generate()
+	}
+
+	v := generate()
+	r.cassette.Variables[key] = v
+
+	return v
+}
+
+// Authorizer returns an auth.Authorizer which issues a static access token, used when replaying a Cassette
+func (r *Recorder) Authorizer() auth.Authorizer {
+	return replayAuthorizer{}
+}
+
+// Save writes the Cassette to disk when recording, this is a no-op when replaying
+func (r *Recorder) Save() error {
+	if !r.Recording() {
+		return nil
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return r.save()
+}
+
+// Stop writes the Cassette to disk when recording, and shuts down the replay server if one was started
+func (r *Recorder) Stop() error {
+	if r == nil {
+		return nil
+	}
+
+	if r.server != nil {
+		r.server.Close()
+	}
+
+	return r.Save()
+}
+
+func (r *Recorder) load() error {
+	contents, err := os.ReadFile(r.cassettePath)
+	if err != nil {
+		return fmt.Errorf("reading cassette %q: %w", r.cassettePath, err)
+	}
+
+	if err := json.Unmarshal(contents, &r.cassette); err != nil {
+		return fmt.Errorf("parsing cassette %q: %+v", r.cassettePath, err)
+	}
+
+	if r.cassette.Variables == nil {
+		r.cassette.Variables = make(map[string]string)
+	}
+
+	return nil
+}
+
+// save writes the Cassette to disk, this must be called whilst holding the lock
+func (r *Recorder) save() error {
+	contents, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("serializing cassette: %+v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.cassettePath), 0o755); err != nil {
+		return fmt.Errorf("creating directory for cassette: %+v", err)
+	}
+
+	return os.WriteFile(r.cassettePath, contents, 0o600)
+}
+
+// sanitize redacts any secrets from input, and replaces any values (such as the Subscription ID) with their placeholder
+func (r *Recorder) sanitize(input string) string {
+	input = redactLogUrl(input, userLogRedactionPatterns())
+	for value, placeholder := range r.replacements {
+		input = strings.ReplaceAll(input, value, placeholder)
+	}
+	return input
+}
+
+func (r *Recorder) sanitizeHeaders(headers http.Header) http.Header {
+	output := make(http.Header, len(headers))
+	for k, values := range headers {
+		// sensitive headers are stripped from recorded interactions, in the same way as when they're logged
+		if isLogSensitiveHeader(k) {
+			continue
+		}
+		for _, v := range values {
+			output.Add(k, r.sanitize(v))
+		}
+	}
+	return output
+}
+
+func (r *Recorder) sanitizeBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	return r.sanitize(string(redactLogBody(body)))
+}
+
+// interactionKey identifies a request irrespective of the scheme, since requests are replayed over plain HTTP, and
+// irrespective of any redacted query parameters (e.g. the signature of a SAS URL)
+func interactionKey(method, uri string) string {
+	uri = redactLogUrl(uri, userLogRedactionPatterns())
+	if u, err := url.Parse(uri); err == nil {
+		u.Scheme = ""
+		uri = u.String()
+	}
+	return strings.ToUpper(method) + " " + strings.ToLower(uri)
+}
+
+func (r *Recorder) record(request *http.Request, requestBody []byte, response *http.Response) error {
+	var responseBody []byte
+	if response.Body != nil {
+		var err error
+		responseBody, err = io.ReadAll(response.Body)
+		if err != nil {
+			return fmt.Errorf("reading response body: %+v", err)
+		}
+		response.Body.Close()
+		response.Body = io.NopCloser(bytes.NewReader(responseBody))
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	interaction := &Interaction{
+		Request: RecordedRequest{
+			Method:  request.Method,
+			URL:     r.sanitize(request.URL.String()),
+			Headers: r.sanitizeHeaders(request.Header),
+			Body:    r.sanitizeBody(requestBody),
+		},
+		Response: RecordedResponse{
+			StatusCode: response.StatusCode,
+			Headers:    r.sanitizeHeaders(response.Header),
+			Body:       r.sanitizeBody(responseBody),
+		},
+	}
+
+	key := interactionKey(interaction.Request.Method, interaction.Request.URL)
+	if _, ok := r.rerecorded[key]; !ok {
+		r.rerecorded[key] = struct{}{}
+		interactions := make([]*Interaction, 0, len(r.cassette.Interactions))
+		for _, v := range r.cassette.Interactions {
+			if interactionKey(v.Request.Method, v.Request.URL) != key {
+				interactions = append(interactions, v)
+			}
+		}
+		r.cassette.Interactions = interactions
+	}
+
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+
+	return nil
+}
+
+// nextInteraction returns the first interaction for this request which hasn't been replayed yet, falling back to
+// the last matching interaction so that requests made more often than when recording (e.g. polling) still succeed.
+func (r *Recorder) nextInteraction(method, uri string) *Interaction {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	key := interactionKey(method, uri)
+
+	var last *Interaction
+	for _, v := range r.cassette.Interactions {
+		if interactionKey(v.Request.Method, v.Request.URL) != key {
+			continue
+		}
+		if !v.replayed {
+			v.replayed = true
+			return v
+		}
+		last = v
+	}
+
+	return last
+}
+
+func (r *Recorder) replay(request *http.Request, originalUrl string) *http.Response {
+	interaction := r.nextInteraction(request.Method, originalUrl)
+	if interaction == nil {
+		log.Printf("[WARN] Recorder: no interaction was recorded in %q for %s %s", r.cassettePath, request.Method, originalUrl)
+		body := fmt.Sprintf(`{"error":{"code":"RecordingNotFound","message":"no interaction was recorded for %s %s"}}`, request.Method, originalUrl)
+		return &http.Response{
+			// a 501 is used since this isn't retried by the clients
+			StatusCode: http.StatusNotImplemented,
+			Status:     fmt.Sprintf("%d %s", http.StatusNotImplemented, http.StatusText(http.StatusNotImplemented)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Request:    request,
+		}
+	}
+
+	headers := interaction.Response.Headers.Clone()
+	if headers == nil {
+		headers = make(http.Header)
+	}
+	headers.Del("Content-Length")
+	if headers.Get("Retry-After") != "" {
+		// there's no need to wait when replaying
+		headers.Set("Retry-After", "1")
+	}
+
+	return &http.Response{
+		StatusCode:    interaction.Response.StatusCode,
+		Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+		Header:        headers,
+		Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+		ContentLength: int64(len(interaction.Response.Body)),
+		Request:       request,
+	}
+}
+
+// serveReplay handles requests redirected to the replay server, where the original host is the first path segment
+func (r *Recorder) serveReplay(w http.ResponseWriter, request *http.Request) {
+	originalUrl := *request.URL
+	segments := strings.SplitN(strings.TrimPrefix(request.URL.Path, "/"), "/", 2)
+	originalUrl.Scheme = "https"
+	originalUrl.Host = segments[0]
+	originalUrl.Path = ""
+	if len(segments) > 1 {
+		originalUrl.Path = "/" + segments[1]
+	}
+	originalUrl.RawPath = ""
+
+	response := r.replay(request, originalUrl.String())
+	defer response.Body.Close()
+
+	for k, values := range response.Header {
+		for _, v := range values {
+			w.Header().Add(k, v)
+		}
+	}
+	w.WriteHeader(response.StatusCode)
+	if _, err := io.Copy(w, response.Body); err != nil {
+		log.Printf("[WARN] Recorder: writing replayed response: %+v", err)
+	}
+}
+
+type recorderRequestBodyKey struct{}
+
+func (r *Recorder) requestMiddleware() client.RequestMiddleware {
+	return func(request *http.Request) (*http.Request, error) {
+		if r.Replaying() {
+			server, err := url.Parse(r.server.URL)
+			if err != nil {
+				return nil, fmt.Errorf("parsing replay server URL: %+v", err)
+			}
+
+			// requests to the replay server (e.g. when polling using the original URL) are already redirected
+			if request.URL.Host == server.Host {
+				return request, nil
+			}
+
+			request.URL.Path = "/" + request.URL.Host + request.URL.Path
+			if request.URL.RawPath != "" {
+				request.URL.RawPath = "/" + request.URL.Host + request.URL.RawPath
+			}
+			request.URL.Scheme = server.Scheme
+			request.URL.Host = server.Host
+			request.Host = server.Host
+			return request, nil
+		}
+
+		var body []byte
+		if request.Body != nil {
+			var err error
+			body, err = io.ReadAll(request.Body)
+			if err != nil {
+				return nil, fmt.Errorf("reading request body: %+v", err)
+			}
+			request.Body.Close()
+			request.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		return request.WithContext(context.WithValue(request.Context(), recorderRequestBodyKey{}, body)), nil
+	}
+}
+
+func (r *Recorder) responseMiddleware() client.ResponseMiddleware {
+	return func(request *http.Request, response *http.Response) (*http.Response, error) {
+		if !r.Recording() || response == nil {
+			return response, nil
+		}
+
+		body, _ := request.Context().Value(recorderRequestBodyKey{}).([]byte)
+		if err := r.record(request, body, response); err != nil {
+			log.Printf("[WARN] Recorder: recording %s %s: %+v", request.Method, request.URL, err)
+		}
+
+		return response, nil
+	}
+}
+
+// sender returns an autorest.Sender which records interactions sent using the underlying sender, or replays them
+func (r *Recorder) sender(sender autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+		if r.Replaying() {
+			return r.replay(request, request.URL.String()), nil
+		}
+
+		var body []byte
+		if request.Body != nil {
+			var err error
+			body, err = io.ReadAll(request.Body)
+			if err != nil {
+				return nil, fmt.Errorf("reading request body: %+v", err)
+			}
+			request.Body.Close()
+			request.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		response, err := sender.Do(request)
+		if err != nil || response == nil {
+			return response, err
+		}
+
+		if err := r.record(request, body, response); err != nil {
+			log.Printf("[WARN] Recorder: recording %s %s: %+v", request.Method, request.URL, err)
+		}
+
+		return response, nil
+	})
+}
+
+// replayAuthorizer issues a static access token, since no requests are sent to Azure when replaying
+type replayAuthorizer struct{}
+
+func (replayAuthorizer) Token(_ context.Context, _ *http.Request) (*oauth2.Token, error) {
+	return &oauth2.Token{
+		AccessToken: "replayed",
+		TokenType:   "Bearer",
+		Expiry:      time.Now().Add(time.Hour),
+	}, nil
+}
+
+func (replayAuthorizer) AuxiliaryTokens(_ context.Context, _ *http.Request) ([]*oauth2.Token, error) {
+	return nil, nil
+}
+
+type recorderContextKey struct{}
+
+// ContextWithRecorder returns a copy of ctx which carries the Recorder, for use when configuring the Provider
+func ContextWithRecorder(ctx context.Context, recorder *Recorder) context.Context {
+	return context.WithValue(ctx, recorderContextKey{}, recorder)
+}
+
+// RecorderFromContext returns the Recorder carried by ctx, if any
+func RecorderFromContext(ctx context.Context) *Recorder {
+	if ctx == nil {
+		return nil
+	}
+	recorder, _ := ctx.Value(recorderContextKey{}).(*Recorder)
+	return recorder
+}
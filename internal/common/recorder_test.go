@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+func TestRecorderRecordAndReplay(t *testing.T) {
+	subscriptionId := "11111111-1111-1111-1111-111111111111"
+	cassettePath := filepath.Join(t.TempDir(), "cassette.json")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write([]byte(`{"id":"` + r.URL.Path + `","name":"rg1"}`)); err != nil {
+			t.Errorf("writing response: %+v", err)
+		}
+	}))
+	baseUri := server.URL
+
+	recorder, err := NewRecorder(RecorderModeRecord, cassettePath, false)
+	if err != nil {
+		t.Fatalf("building recorder: %+v", err)
+	}
+	recorder.AddReplacement(subscriptionId, RecorderPlaceholderId)
+	if v := recorder.Variable("random_integer", func() string { return "1234" }); v != "1234" {
+		t.Fatalf("expected the generated variable but got %q", v)
+	}
+
+	body := testRecorderExecute(t, recorder, baseUri, "/subscriptions/"+subscriptionId+"/resourceGroups/rg1")
+	if !strings.Contains(body, subscriptionId) {
+		t.Fatalf("expected the live response to be returned unmodified but got %q", body)
+	}
+	server.Close()
+
+	if err := recorder.Stop(); err != nil {
+		t.Fatalf("saving cassette: %+v", err)
+	}
+
+	contents, err := os.ReadFile(cassettePath)
+	if err != nil {
+		t.Fatalf("reading cassette: %+v", err)
+	}
+	if strings.Contains(string(contents), subscriptionId) {
+		t.Fatalf("expected the subscription ID to be replaced in the cassette:\n%s", contents)
+	}
+	if strings.Contains(string(contents), "Authorization") {
+		t.Fatalf("expected the Authorization header to be stripped from the cassette:\n%s", contents)
+	}
+
+	var cassette Cassette
+	if err := json.Unmarshal(contents, &cassette); err != nil {
+		t.Fatalf("parsing cassette: %+v", err)
+	}
+	if len(cassette.Interactions) != 1 {
+		t.Fatalf("expected 1 interaction but got %d", len(cassette.Interactions))
+	}
+
+	replayer, err := NewRecorder(RecorderModeReplay, cassettePath, false)
+	if err != nil {
+		t.Fatalf("building replayer: %+v", err)
+	}
+	defer replayer.Stop()
+
+	if v := replayer.Variable("random_integer", func() string { return "5678" }); v != "1234" {
+		t.Fatalf("expected the recorded variable but got %q", v)
+	}
+
+	// the live server has been stopped, so this can only succeed when served from the cassette
+	body = testRecorderExecute(t, replayer, baseUri, "/subscriptions/"+RecorderPlaceholderId+"/resourceGroups/rg1")
+	if !strings.Contains(body, RecorderPlaceholderId) {
+		t.Fatalf("expected the recorded response but got %q", body)
+	}
+
+	// requests which weren't recorded are rejected
+	c := testRecorderClient(replayer, baseUri)
+	req, err := c.NewRequest(context.Background(), client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{http.StatusOK},
+		HttpMethod:          http.MethodGet,
+		Path:                "/subscriptions/" + RecorderPlaceholderId + "/resourceGroups/rg2",
+	})
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	if _, err := c.Execute(context.Background(), req); err == nil {
+		t.Fatal("expected an error for a request which wasn't recorded")
+	}
+}
+
+func TestRecorderRedactsSecrets(t *testing.T) {
+	cassettePath := filepath.Join(t.TempDir(), "cassette.json")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Location", "https://example.blob.core.windows.net/container?sv=2020-01-01&sig=locationsignature")
+		if _, err := w.Write([]byte(`{"primaryKey":"responsekey","url":"https://example.blob.core.windows.net/?sv=2020-01-01&sig=bodysignature"}`)); err != nil {
+			t.Errorf("writing response: %+v", err)
+		}
+	}))
+	defer server.Close()
+
+	recorder, err := NewRecorder(RecorderModeRecord, cassettePath, false)
+	if err != nil {
+		t.Fatalf("building recorder: %+v", err)
+	}
+
+	uri := server.URL + "/api/function?code=functionkey"
+	body := testRecorderSend(t, recorder, uri, `{"properties":{"adminPassword":"requestpassword"}}`)
+	if !strings.Contains(body, "responsekey") {
+		t.Fatalf("expected the live response to be returned unmodified but got %q", body)
+	}
+
+	if err := recorder.Stop(); err != nil {
+		t.Fatalf("saving cassette: %+v", err)
+	}
+
+	contents, err := os.ReadFile(cassettePath)
+	if err != nil {
+		t.Fatalf("reading cassette: %+v", err)
+	}
+	for _, secret := range []string{"secret", "functionkey", "requestpassword", "responsekey", "locationsignature", "bodysignature"} {
+		if strings.Contains(string(contents), secret) {
+			t.Fatalf("expected %q to be redacted from the cassette:\n%s", secret, contents)
+		}
+	}
+
+	replayer, err := NewRecorder(RecorderModeReplay, cassettePath, false)
+	if err != nil {
+		t.Fatalf("building replayer: %+v", err)
+	}
+	defer replayer.Stop()
+
+	// requests are matched irrespective of the values of any redacted query parameters
+	body = testRecorderSend(t, replayer, uri, "")
+	if !strings.Contains(body, logRedactedValue) {
+		t.Fatalf("expected the recorded response but got %q", body)
+	}
+}
+
+func testRecorderClient(recorder *Recorder, baseUri string) *client.Client {
+	c := client.NewClient(baseUri, "Test", "2020-01-01")
+	c.DisableRetries = true
+	c.AppendRequestMiddleware(recorder.requestMiddleware())
+	c.AppendResponseMiddleware(recorder.responseMiddleware())
+	return c
+}
+
+func testRecorderExecute(t *testing.T, recorder *Recorder, baseUri, path string) string {
+	c := testRecorderClient(recorder, baseUri)
+	req, err := c.NewRequest(context.Background(), client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{http.StatusOK},
+		HttpMethod:          http.MethodGet,
+		Path:                path,
+	})
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	req.Header.Set("Authorization", "Bearer secret")
+
+	resp, err := c.Execute(context.Background(), req)
+	if err != nil {
+		t.Fatalf("executing request: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading response: %+v", err)
+	}
+	return string(body)
+}
+
+func testRecorderSend(t *testing.T, recorder *Recorder, uri, body string) string {
+	req, err := http.NewRequest(http.MethodPost, uri, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	req.Header.Set("Authorization", "Bearer secret")
+
+	resp, err := recorder.sender(http.DefaultClient).Do(req)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	contents, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading response: %+v", err)
+	}
+	return string(contents)
+}
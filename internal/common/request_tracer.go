@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+// RequestTracer writes a structured trace of each request sent to Azure as a line of JSON (JSON Lines), tagged
+// with the Terraform Resource (and CRUD operation) which sent the request - so that the slow/long-running
+// operations within an apply can be identified.
+type RequestTracer struct {
+	lock sync.Mutex
+	path string
+
+	now func() time.Time
+}
+
+// TraceEvent is a single line within the trace
+type TraceEvent struct {
+	Time          time.Time `json:"time"`
+	Type          string    `json:"type"`
+	CorrelationId string    `json:"correlation_id,omitempty"`
+
+	// the Terraform Resource/Data Source which sent the request, if known
+	ResourceType string `json:"resource_type,omitempty"`
+	ResourceId   string `json:"resource_id,omitempty"`
+	Operation    string `json:"operation,omitempty"`
+
+	// populated for `request` events
+	Method     string `json:"method,omitempty"`
+	Url        string `json:"url,omitempty"`
+	StatusCode int    `json:"status_code,omitempty"`
+	Poll       int    `json:"poll,omitempty"`
+
+	// populated for `operation` events
+	Requests int `json:"requests,omitempty"`
+	Polls    int `json:"polls,omitempty"`
+
+	DurationMs int64  `json:"duration_ms"`
+	Error      string `json:"error,omitempty"`
+}
+
+const (
+	TraceEventTypeOperation = "operation"
+	TraceEventTypeRequest   = "request"
+
+	TraceOperationCreate = "create"
+	TraceOperationRead   = "read"
+	TraceOperationUpdate = "update"
+	TraceOperationDelete = "delete"
+)
+
+// NewRequestTracer returns a RequestTracer which appends the trace to the file at the specified path
+func NewRequestTracer(path string) (*RequestTracer, error) {
+	// ensure the file can be written to up-front, rather than only logging this when each request is traced
+	f, err := openRequestTraceFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, fmt.Errorf("closing request trace file %q: %+v", path, err)
+	}
+
+	return &RequestTracer{
+		path: path,
+		now:  time.Now,
+	}, nil
+}
+
+// openRequestTraceFile opens the trace file for appending - since the file is shared by each instance of the
+// Provider it's appended to rather than truncated, and is opened for each write so that it's not held open by
+// Provider instances which are no longer in use
+func openRequestTraceFile(path string) (*os.File, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("opening request trace file %q: %+v", path, err)
+	}
+	return f, nil
+}
+
+// TraceScope tracks the requests sent during a single CRUD operation for a Terraform Resource
+type TraceScope struct {
+	ResourceType string
+	Operation    string
+
+	// ResourceId returns the ID of the Resource - which is evaluated for each request, since this isn't
+	// known until partway through a Create
+	ResourceId func() string
+
+	lock        sync.Mutex
+	started     time.Time
+	requests    int
+	polls       int
+	pollingUrls map[string]int
+}
+
+// NewTraceScope returns a TraceScope for the specified operation on a Terraform Resource
+func NewTraceScope(resourceType, operation string, resourceId func() string) *TraceScope {
+	return &TraceScope{
+		ResourceType: resourceType,
+		Operation:    operation,
+		ResourceId:   resourceId,
+		pollingUrls:  make(map[string]int),
+	}
+}
+
+type traceScopeContextKey struct{}
+
+type traceRequestContextKey struct{}
+
+// ContextWithTraceScope returns a copy of ctx which attributes requests sent using it to the TraceScope
+func ContextWithTraceScope(ctx context.Context, scope *TraceScope) context.Context {
+	return context.WithValue(ctx, traceScopeContextKey{}, scope)
+}
+
+// TraceScopeFromContext returns the TraceScope for ctx, or nil if one isn't present
+func TraceScopeFromContext(ctx context.Context) *TraceScope {
+	if v, ok := ctx.Value(traceScopeContextKey{}).(*TraceScope); ok {
+		return v
+	}
+	return nil
+}
+
+// Start marks the beginning of the operation tracked by the TraceScope
+func (t *RequestTracer) Start(scope *TraceScope) {
+	scope.lock.Lock()
+	defer scope.lock.Unlock()
+
+	scope.started = t.now()
+}
+
+// Finish writes a summary of the operation tracked by the TraceScope, including how long it took and how many
+// requests (and long-running operation polls) were made
+func (t *RequestTracer) Finish(scope *TraceScope, err error) {
+	scope.lock.Lock()
+	event := TraceEvent{
+		Time:         t.now(),
+		Type:         TraceEventTypeOperation,
+		ResourceType: scope.ResourceType,
+		ResourceId:   scope.resourceId(),
+		Operation:    scope.Operation,
+		Requests:     scope.requests,
+		Polls:        scope.polls,
+		DurationMs:   t.now().Sub(scope.started).Milliseconds(),
+	}
+	scope.lock.Unlock()
+
+	if err != nil {
+		event.Error = err.Error()
+	}
+	t.write(event)
+}
+
+func (s *TraceScope) resourceId() string {
+	if s.ResourceId == nil {
+		return ""
+	}
+	return s.ResourceId()
+}
+
+// trackRequest records the request against the TraceScope, returning the poll number if this request is polling
+// a long-running operation started earlier in the scope
+func (s *TraceScope) trackRequest(req *http.Request) int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.requests++
+	if req.Method != http.MethodGet {
+		return 0
+	}
+
+	key := traceUrlKey(req.URL.String())
+	if _, ok := s.pollingUrls[key]; !ok {
+		return 0
+	}
+	s.pollingUrls[key]++
+	s.polls++
+	return s.pollingUrls[key]
+}
+
+// trackResponse records any URL which should be polled for completion of a long-running operation
+func (s *TraceScope) trackResponse(req *http.Request, resp *http.Response) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, header := range []string{"Azure-AsyncOperation", "Location"} {
+		if v := resp.Header.Get(header); v != "" {
+			if _, ok := s.pollingUrls[traceUrlKey(v)]; !ok {
+				s.pollingUrls[traceUrlKey(v)] = 0
+			}
+		}
+	}
+
+	// otherwise the resource itself is polled until it's been provisioned/deleted
+	if req.Method != http.MethodGet && (resp.StatusCode == http.StatusCreated || resp.StatusCode == http.StatusAccepted) {
+		if _, ok := s.pollingUrls[traceUrlKey(req.URL.String())]; !ok {
+			s.pollingUrls[traceUrlKey(req.URL.String())] = 0
+		}
+	}
+}
+
+// traceUrlKey normalises a URL so that polling requests can be matched, ignoring the query string (which
+// commonly differs in the `api-version`)
+func traceUrlKey(input string) string {
+	return strings.ToLower(strings.SplitN(input, "?", 2)[0])
+}
+
+type traceRequest struct {
+	started time.Time
+	poll    int
+}
+
+func (t *RequestTracer) startRequest(req *http.Request) *http.Request {
+	tr := &traceRequest{
+		started: t.now(),
+	}
+	if scope := TraceScopeFromContext(req.Context()); scope != nil {
+		tr.poll = scope.trackRequest(req)
+	}
+	return req.WithContext(context.WithValue(req.Context(), traceRequestContextKey{}, tr))
+}
+
+func (t *RequestTracer) finishRequest(req *http.Request, resp *http.Response, err error) {
+	event := TraceEvent{
+		Time:          t.now(),
+		Type:          TraceEventTypeRequest,
+		CorrelationId: req.Header.Get(HeaderCorrelationRequestID),
+		Method:        req.Method,
+		Url:           redactLogUrl(req.URL.String(), userLogRedactionPatterns()),
+	}
+
+	if tr, ok := req.Context().Value(traceRequestContextKey{}).(*traceRequest); ok {
+		event.DurationMs = t.now().Sub(tr.started).Milliseconds()
+		event.Poll = tr.poll
+	}
+
+	if scope := TraceScopeFromContext(req.Context()); scope != nil {
+		event.ResourceType = scope.ResourceType
+		event.ResourceId = scope.resourceId()
+		event.Operation = scope.Operation
+		if resp != nil {
+			scope.trackResponse(req, resp)
+		}
+	}
+
+	if resp != nil {
+		event.StatusCode = resp.StatusCode
+	}
+	if err != nil {
+		event.Error = err.Error()
+	}
+
+	t.write(event)
+}
+
+func (t *RequestTracer) write(event TraceEvent) {
+	line, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("[DEBUG] Marshaling Request Trace: %+v", err)
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	f, err := openRequestTraceFile(t.path)
+	if err != nil {
+		log.Printf("[DEBUG] Writing Request Trace: %+v", err)
+		return
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		log.Printf("[DEBUG] Writing Request Trace: %+v", err)
+	}
+}
+
+func requestTracerRequestMiddleware(tracer *RequestTracer) client.RequestMiddleware {
+	return func(req *http.Request) (*http.Request, error) {
+		return tracer.startRequest(req), nil
+	}
+}
+
+func requestTracerResponseMiddleware(tracer *RequestTracer) client.ResponseMiddleware {
+	return func(req *http.Request, resp *http.Response) (*http.Response, error) {
+		tracer.finishRequest(req, resp, nil)
+		return resp, nil
+	}
+}
+
+// sender wraps an autorest.Sender so that requests sent using go-autorest are traced
+func (t *RequestTracer) sender(next autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+		req = t.startRequest(req)
+		resp, err := next.Do(req)
+		t.finishRequest(req, resp, err)
+		return resp, err
+	})
+}
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+func TestRequestTracer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+	tracer, err := NewRequestTracer(path)
+	if err != nil {
+		t.Fatalf("building tracer: %+v", err)
+	}
+
+	// the resource is created using a long-running operation, which is then polled
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/operations/1" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.Header().Set("Azure-AsyncOperation", "http://"+r.Host+"/operations/1?api-version=2020-01-01")
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "Test", "2020-01-01")
+	c.DisableRetries = true
+	c.AppendRequestMiddleware(correlationRequestIDMiddleware("abc123"))
+	c.AppendRequestMiddleware(requestTracerRequestMiddleware(tracer))
+	c.AppendResponseMiddleware(requestTracerResponseMiddleware(tracer))
+
+	resourceId := ""
+	scope := NewTraceScope("azurerm_example", TraceOperationCreate, func() string { return resourceId })
+	ctx := ContextWithTraceScope(context.Background(), scope)
+
+	tracer.Start(scope)
+	testRequestTracerExecute(t, ctx, c, http.MethodPut, "/example", http.StatusCreated)
+	for i := 0; i < 2; i++ {
+		testRequestTracerExecute(t, ctx, c, http.MethodGet, "/operations/1", http.StatusOK)
+	}
+	resourceId = "/example"
+	tracer.Finish(scope, nil)
+
+	// requests sent outside of a Resource are traced, but not attributed to one
+	testRequestTracerExecute(t, context.Background(), c, http.MethodGet, "/example", http.StatusCreated)
+
+	events := testRequestTracerEvents(t, path)
+	if len(events) != 5 {
+		t.Fatalf("expected 5 events but got %d: %+v", len(events), events)
+	}
+
+	for i, expected := range []TraceEvent{
+		{Type: TraceEventTypeRequest, Method: http.MethodPut, StatusCode: http.StatusCreated, ResourceType: "azurerm_example", Operation: TraceOperationCreate, CorrelationId: "abc123"},
+		{Type: TraceEventTypeRequest, Method: http.MethodGet, StatusCode: http.StatusOK, ResourceType: "azurerm_example", Operation: TraceOperationCreate, CorrelationId: "abc123", Poll: 1},
+		{Type: TraceEventTypeRequest, Method: http.MethodGet, StatusCode: http.StatusOK, ResourceType: "azurerm_example", Operation: TraceOperationCreate, CorrelationId: "abc123", Poll: 2},
+		{Type: TraceEventTypeOperation, ResourceType: "azurerm_example", ResourceId: "/example", Operation: TraceOperationCreate, Requests: 3, Polls: 2},
+		{Type: TraceEventTypeRequest, Method: http.MethodGet, StatusCode: http.StatusCreated, CorrelationId: "abc123"},
+	} {
+		actual := events[i]
+		actual.Time = time.Time{}
+		actual.Url = ""
+		actual.DurationMs = 0
+		if actual != expected {
+			t.Fatalf("expected event %d to be %+v but got %+v", i, expected, actual)
+		}
+	}
+}
+
+func TestRequestTracerRedactsUrl(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+	tracer, err := NewRequestTracer(path)
+	if err != nil {
+		t.Fatalf("building tracer: %+v", err)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "https://account1.blob.core.windows.net/container/blob?sig=s3cr3tS1gnature&sv=2022-11-02", nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	tracer.finishRequest(tracer.startRequest(req), &http.Response{StatusCode: http.StatusOK}, nil)
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading trace: %+v", err)
+	}
+	if strings.Contains(string(contents), "s3cr3tS1gnature") {
+		t.Fatalf("expected the `sig` query parameter to be redacted from the trace but got: %s", contents)
+	}
+
+	events := testRequestTracerEvents(t, path)
+	if len(events) != 1 {
+		t.Fatalf("expected 1 event but got %d: %+v", len(events), events)
+	}
+	if !strings.Contains(events[0].Url, "sig="+logRedactedValue) || !strings.Contains(events[0].Url, "sv=2022-11-02") {
+		t.Fatalf("expected only the `sig` query parameter to be redacted but got %q", events[0].Url)
+	}
+}
+
+func testRequestTracerExecute(t *testing.T, ctx context.Context, c *client.Client, method, path string, expectedStatusCode int) {
+	req, err := c.NewRequest(ctx, client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{expectedStatusCode},
+		HttpMethod:          method,
+		Path:                path,
+	})
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+
+	if _, err := c.Execute(ctx, req); err != nil {
+		t.Fatalf("executing request: %+v", err)
+	}
+}
+
+func testRequestTracerEvents(t *testing.T, path string) []TraceEvent {
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("opening trace: %+v", err)
+	}
+	defer f.Close()
+
+	events := make([]TraceEvent, 0)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var event TraceEvent
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			t.Fatalf("parsing trace line %q: %+v", scanner.Text(), err)
+		}
+		events = append(events, event)
+	}
+
+	return events
+}
@@ -23,3 +23,16 @@ func EnhancedValidationEnabled() bool {
 
 	return strings.EqualFold(value, "true")
 }
+
+// DeepValidationEnabled returns whether the feature for Deep Validation is enabled.
+//
+// This functionality retrieves the Resources referenced by some well-known properties (for example `subnet_id`)
+// during the plan, to confirm that they exist (and where applicable, are in the same region and delegated to the
+// required service) - rather than surfacing this as an error during the apply.
+//
+// Since this requires additional API calls during each plan this is disabled by default, and can be enabled
+// by setting the Environment Variable `ARM_PROVIDER_DEEP_VALIDATION` to `true`. This also requires that
+// Enhanced Validation is enabled.
+func DeepValidationEnabled() bool {
+	return EnhancedValidationEnabled() && strings.EqualFold(os.Getenv("ARM_PROVIDER_DEEP_VALIDATION"), "true")
+}
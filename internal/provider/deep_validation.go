@@ -0,0 +1,305 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-02-01/vaults"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/managedidentity/2023-01-31/managedidentities"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/subnets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/virtualnetworks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+// deepValidationClient retrieves the Resources referenced during Deep Validation
+type deepValidationClient interface {
+	GetSubnet(ctx context.Context, id commonids.SubnetId) (subnets.GetOperationResponse, error)
+	GetVirtualNetwork(ctx context.Context, id commonids.VirtualNetworkId) (virtualnetworks.GetOperationResponse, error)
+	GetKeyVault(ctx context.Context, id commonids.KeyVaultId) (vaults.GetOperationResponse, error)
+	GetUserAssignedIdentity(ctx context.Context, id commonids.UserAssignedIdentityId) (managedidentities.UserAssignedIdentitiesGetOperationResponse, error)
+}
+
+var _ deepValidationClient = azureDeepValidationClient{}
+
+type azureDeepValidationClient struct {
+	client *clients.Client
+}
+
+func (c azureDeepValidationClient) GetSubnet(ctx context.Context, id commonids.SubnetId) (subnets.GetOperationResponse, error) {
+	return c.client.Network.Subnets.Get(ctx, id, subnets.DefaultGetOperationOptions())
+}
+
+func (c azureDeepValidationClient) GetVirtualNetwork(ctx context.Context, id commonids.VirtualNetworkId) (virtualnetworks.GetOperationResponse, error) {
+	return c.client.Network.VirtualNetworks.Get(ctx, id, virtualnetworks.DefaultGetOperationOptions())
+}
+
+func (c azureDeepValidationClient) GetKeyVault(ctx context.Context, id commonids.KeyVaultId) (vaults.GetOperationResponse, error) {
+	return c.client.KeyVault.VaultsClient.Get(ctx, id)
+}
+
+func (c azureDeepValidationClient) GetUserAssignedIdentity(ctx context.Context, id commonids.UserAssignedIdentityId) (managedidentities.UserAssignedIdentitiesGetOperationResponse, error) {
+	return c.client.ManagedIdentity.V20230131.ManagedIdentities.UserAssignedIdentitiesGet(ctx, id)
+}
+
+// deepValidationReference describes the Resource referencing the Resource being validated
+type deepValidationReference struct {
+	// key is the property containing the ID of the Resource being validated
+	key string
+
+	// location is the (normalized) location of the Resource, which is empty when the Resource doesn't have a
+	// `location` or it's not yet known
+	location string
+
+	// subnetDelegation is the name of the service which the referenced Subnet must be delegated to, if any
+	subnetDelegation string
+}
+
+// deepValidationCheck confirms that the Resource referenced in `value` exists
+type deepValidationCheck func(ctx context.Context, client deepValidationClient, value string, reference deepValidationReference) error
+
+// deepValidationChecks are the well-known properties which are validated during the plan when Deep Validation is enabled
+var deepValidationChecks = map[string]deepValidationCheck{
+	"delegated_subnet_id":      deepValidateSubnet,
+	"infrastructure_subnet_id": deepValidateSubnet,
+	"subnet_id":                deepValidateSubnet,
+	"key_vault_id":             deepValidateKeyVault,
+	"identity.0.identity_ids":  deepValidateUserAssignedIdentity,
+}
+
+// deepValidationSubnetDelegation is a Subnet Delegation required by a Resource
+type deepValidationSubnetDelegation struct {
+	key         string
+	serviceName string
+
+	// requiredFunc optionally returns whether the delegation is required, based on the configuration of the Resource
+	requiredFunc func(d *schema.ResourceDiff) bool
+}
+
+// deepValidationSubnetDelegations are the Subnet Delegations required by Resources, keyed by the Resource Type
+var deepValidationSubnetDelegations = map[string]deepValidationSubnetDelegation{
+	"azurerm_container_app_environment": {
+		key:         "infrastructure_subnet_id",
+		serviceName: "Microsoft.App/environments",
+		// only Environments using Workload Profiles require a delegated Subnet
+		requiredFunc: func(d *schema.ResourceDiff) bool {
+			v, ok := d.Get("workload_profile").(*schema.Set)
+			return ok && v.Len() > 0
+		},
+	},
+	"azurerm_mysql_flexible_server": {
+		key:         "delegated_subnet_id",
+		serviceName: "Microsoft.DBforMySQL/flexibleServers",
+	},
+	"azurerm_postgresql_flexible_server": {
+		key:         "delegated_subnet_id",
+		serviceName: "Microsoft.DBforPostgreSQL/flexibleServers",
+	},
+	"azurerm_postgresql_flexible_server_replica": {
+		key:         "delegated_subnet_id",
+		serviceName: "Microsoft.DBforPostgreSQL/flexibleServers",
+	},
+}
+
+// deepValidateResource wraps the CustomizeDiff function for the Resource so that the Resources referenced by any
+// well-known properties (e.g. `subnet_id`) are retrieved during the plan, to confirm that they exist.
+//
+// This is best-effort, errors other than the referenced Resource not existing (for example, insufficient
+// permissions to retrieve it) are logged and otherwise ignored, since these will be surfaced during the apply.
+func deepValidateResource(resourceType string, resource *schema.Resource) {
+	keys := deepValidationKeys(resource.Schema)
+	if len(keys) == 0 {
+		return
+	}
+
+	existing := resource.CustomizeDiff
+	resource.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if existing != nil {
+			if err := existing(ctx, d, meta); err != nil {
+				return err
+			}
+		}
+
+		client, ok := meta.(*clients.Client)
+		if !ok {
+			return nil
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+		defer cancel()
+
+		resourceLocation := ""
+		if _, ok := resource.Schema["location"]; ok && d.NewValueKnown("location") {
+			resourceLocation = location.Normalize(d.Get("location").(string))
+		}
+
+		for _, key := range keys {
+			if !d.HasChange(key) || !d.NewValueKnown(key) {
+				continue
+			}
+
+			reference := deepValidationReference{
+				key:      key,
+				location: resourceLocation,
+			}
+			if delegation, ok := deepValidationSubnetDelegations[resourceType]; ok && delegation.key == key {
+				if delegation.requiredFunc == nil || delegation.requiredFunc(d) {
+					reference.subnetDelegation = delegation.serviceName
+				}
+			}
+
+			values := make([]string, 0)
+			switch v := d.Get(key).(type) {
+			case string:
+				values = append(values, v)
+			case *schema.Set:
+				for _, item := range v.List() {
+					if s, ok := item.(string); ok {
+						values = append(values, s)
+					}
+				}
+			}
+
+			for _, value := range values {
+				if value == "" {
+					continue
+				}
+
+				if err := deepValidationChecks[key](ctx, azureDeepValidationClient{client: client}, value, reference); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+// deepValidationKeys returns the well-known properties defined within the Schema, in a consistent order
+func deepValidationKeys(input map[string]*schema.Schema) []string {
+	output := make([]string, 0)
+	for _, key := range []string{"delegated_subnet_id", "infrastructure_subnet_id", "subnet_id", "key_vault_id"} {
+		if v, ok := input[key]; ok && v.Type == schema.TypeString && !v.Computed {
+			output = append(output, key)
+		}
+	}
+
+	if v, ok := input["identity"]; ok && v.Type == schema.TypeList {
+		if elem, ok := v.Elem.(*schema.Resource); ok {
+			if identityIds, ok := elem.Schema["identity_ids"]; ok && identityIds.Type == schema.TypeSet {
+				output = append(output, "identity.0.identity_ids")
+			}
+		}
+	}
+
+	sort.Strings(output)
+	return output
+}
+
+// deepValidationRetrieveError returns an error when the Resource referenced by `key` doesn't exist - other errors
+// are logged and ignored, since the Resource may exist but can't be retrieved (e.g. due to insufficient permissions)
+func deepValidationRetrieveError(resp *http.Response, id resourceids.ResourceId, key string, err error) error {
+	if response.WasNotFound(resp) {
+		return fmt.Errorf("the %s referenced by `%s` was not found", id, key)
+	}
+
+	if response.WasForbidden(resp) {
+		log.Printf("[WARN] Deep Validation - unable to confirm that the %s referenced by %q exists since the credentials in use don't have permission to retrieve it - skipping", id, key)
+		return nil
+	}
+
+	log.Printf("[DEBUG] Deep Validation - retrieving %s referenced by %q: %+v", id, key, err)
+	return nil
+}
+
+// deepValidateSubnet confirms that the Subnet exists, that it's delegated to the service required by the Resource
+// referencing it (if any) and that it's in the same region as this Resource - which is required since Virtual
+// Networks can't span regions
+func deepValidateSubnet(ctx context.Context, client deepValidationClient, value string, reference deepValidationReference) error {
+	// the Schema validates the format of the ID
+	id, err := commonids.ParseSubnetIDInsensitively(value)
+	if err != nil {
+		return nil
+	}
+
+	resp, err := client.GetSubnet(ctx, *id)
+	if err != nil {
+		return deepValidationRetrieveError(resp.HttpResponse, id, reference.key, err)
+	}
+
+	if reference.subnetDelegation != "" {
+		delegated := false
+		if model := resp.Model; model != nil && model.Properties != nil && model.Properties.Delegations != nil {
+			for _, delegation := range *model.Properties.Delegations {
+				if props := delegation.Properties; props != nil && props.ServiceName != nil && strings.EqualFold(*props.ServiceName, reference.subnetDelegation) {
+					delegated = true
+				}
+			}
+		}
+		if !delegated {
+			return fmt.Errorf("the %s referenced by `%s` must be delegated to %q", id, reference.key, reference.subnetDelegation)
+		}
+	}
+
+	if reference.location == "" {
+		return nil
+	}
+
+	virtualNetworkId := commonids.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroupName, id.VirtualNetworkName)
+	virtualNetwork, err := client.GetVirtualNetwork(ctx, virtualNetworkId)
+	if err != nil {
+		return deepValidationRetrieveError(virtualNetwork.HttpResponse, &virtualNetworkId, reference.key, err)
+	}
+
+	if model := virtualNetwork.Model; model != nil && model.Location != nil {
+		if virtualNetworkLocation := location.Normalize(*model.Location); virtualNetworkLocation != reference.location {
+			return fmt.Errorf("the %s referenced by `%s` is in the region %q but this resource is in the region %q - these must be in the same region", id, reference.key, virtualNetworkLocation, reference.location)
+		}
+	}
+
+	return nil
+}
+
+// deepValidateKeyVault confirms that the Key Vault exists - since Key Vaults can be referenced from other regions
+// (e.g. for Customer Managed Keys) the region isn't validated
+func deepValidateKeyVault(ctx context.Context, client deepValidationClient, value string, reference deepValidationReference) error {
+	id, err := commonids.ParseKeyVaultIDInsensitively(value)
+	if err != nil {
+		return nil
+	}
+
+	resp, err := client.GetKeyVault(ctx, *id)
+	if err != nil {
+		return deepValidationRetrieveError(resp.HttpResponse, id, reference.key, err)
+	}
+
+	return nil
+}
+
+// deepValidateUserAssignedIdentity confirms that the User Assigned Identity exists - since these can be assigned
+// to Resources in other regions the region isn't validated
+func deepValidateUserAssignedIdentity(ctx context.Context, client deepValidationClient, value string, reference deepValidationReference) error {
+	id, err := commonids.ParseUserAssignedIdentityIDInsensitively(value)
+	if err != nil {
+		return nil
+	}
+
+	resp, err := client.GetUserAssignedIdentity(ctx, *id)
+	if err != nil {
+		return deepValidationRetrieveError(resp.HttpResponse, id, reference.key, err)
+	}
+
+	return nil
+}
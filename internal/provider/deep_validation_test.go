@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-02-01/vaults"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/managedidentity/2023-01-31/managedidentities"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/subnets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/virtualnetworks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDeepValidationKeys(t *testing.T) {
+	testData := []struct {
+		name     string
+		input    map[string]*schema.Schema
+		expected []string
+	}{
+		{
+			name: "no well-known properties",
+			input: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+			expected: []string{},
+		},
+		{
+			name: "well-known properties",
+			input: map[string]*schema.Schema{
+				"key_vault_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"subnet_id": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"delegated_subnet_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
+			},
+			expected: []string{
+				"delegated_subnet_id",
+				"identity.0.identity_ids",
+				"key_vault_id",
+				"subnet_id",
+			},
+		},
+		{
+			name: "computed properties",
+			input: map[string]*schema.Schema{
+				"subnet_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"identity": commonschema.SystemAssignedIdentityOptional(),
+			},
+			expected: []string{},
+		},
+	}
+
+	for _, test := range testData {
+		t.Logf("[DEBUG] Testing %q..", test.name)
+
+		actual := deepValidationKeys(test.input)
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Fatalf("expected %+v but got %+v", test.expected, actual)
+		}
+	}
+}
+
+// fakeDeepValidationError returns an error for unsuccessful responses, as the SDK does
+func fakeDeepValidationError(resp *http.Response) error {
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+type fakeDeepValidationClient struct {
+	subnets         map[string]subnets.GetOperationResponse
+	virtualNetworks map[string]virtualnetworks.GetOperationResponse
+	keyVaults       map[string]vaults.GetOperationResponse
+}
+
+func (c fakeDeepValidationClient) GetSubnet(_ context.Context, id commonids.SubnetId) (subnets.GetOperationResponse, error) {
+	if v, ok := c.subnets[id.ID()]; ok {
+		return v, fakeDeepValidationError(v.HttpResponse)
+	}
+	return subnets.GetOperationResponse{HttpResponse: &http.Response{StatusCode: http.StatusNotFound}}, fmt.Errorf("unexpected status 404")
+}
+
+func (c fakeDeepValidationClient) GetVirtualNetwork(_ context.Context, id commonids.VirtualNetworkId) (virtualnetworks.GetOperationResponse, error) {
+	if v, ok := c.virtualNetworks[id.ID()]; ok {
+		return v, nil
+	}
+	return virtualnetworks.GetOperationResponse{HttpResponse: &http.Response{StatusCode: http.StatusNotFound}}, fmt.Errorf("unexpected status 404")
+}
+
+func (c fakeDeepValidationClient) GetKeyVault(_ context.Context, id commonids.KeyVaultId) (vaults.GetOperationResponse, error) {
+	if v, ok := c.keyVaults[id.ID()]; ok {
+		return v, nil
+	}
+	return vaults.GetOperationResponse{HttpResponse: &http.Response{StatusCode: http.StatusNotFound}}, fmt.Errorf("unexpected status 404")
+}
+
+func (c fakeDeepValidationClient) GetUserAssignedIdentity(_ context.Context, _ commonids.UserAssignedIdentityId) (managedidentities.UserAssignedIdentitiesGetOperationResponse, error) {
+	return managedidentities.UserAssignedIdentitiesGetOperationResponse{HttpResponse: &http.Response{StatusCode: http.StatusForbidden}}, fmt.Errorf("unexpected status 403")
+}
+
+func TestDeepValidateSubnet(t *testing.T) {
+	virtualNetworkId := commonids.NewVirtualNetworkID("00000000-0000-0000-0000-000000000000", "example-resources", "example-network")
+	subnetId := commonids.NewSubnetID(virtualNetworkId.SubscriptionId, virtualNetworkId.ResourceGroupName, virtualNetworkId.VirtualNetworkName, "example")
+	delegatedSubnetId := commonids.NewSubnetID(virtualNetworkId.SubscriptionId, virtualNetworkId.ResourceGroupName, virtualNetworkId.VirtualNetworkName, "delegated")
+	forbiddenSubnetId := commonids.NewSubnetID(virtualNetworkId.SubscriptionId, virtualNetworkId.ResourceGroupName, virtualNetworkId.VirtualNetworkName, "forbidden")
+	missingSubnetId := commonids.NewSubnetID(virtualNetworkId.SubscriptionId, virtualNetworkId.ResourceGroupName, virtualNetworkId.VirtualNetworkName, "missing")
+
+	client := fakeDeepValidationClient{
+		subnets: map[string]subnets.GetOperationResponse{
+			subnetId.ID(): {
+				HttpResponse: &http.Response{StatusCode: http.StatusOK},
+				Model:        &subnets.Subnet{},
+			},
+			delegatedSubnetId.ID(): {
+				HttpResponse: &http.Response{StatusCode: http.StatusOK},
+				Model: &subnets.Subnet{
+					Properties: &subnets.SubnetPropertiesFormat{
+						Delegations: &[]subnets.Delegation{
+							{
+								Properties: &subnets.ServiceDelegationPropertiesFormat{
+									ServiceName: pointer.To("Microsoft.DBforPostgreSQL/flexibleServers"),
+								},
+							},
+						},
+					},
+				},
+			},
+			forbiddenSubnetId.ID(): {
+				HttpResponse: &http.Response{StatusCode: http.StatusForbidden},
+			},
+		},
+		virtualNetworks: map[string]virtualnetworks.GetOperationResponse{
+			virtualNetworkId.ID(): {
+				HttpResponse: &http.Response{StatusCode: http.StatusOK},
+				Model: &virtualnetworks.VirtualNetwork{
+					Location: pointer.To("West Europe"),
+				},
+			},
+		},
+	}
+
+	testData := []struct {
+		name      string
+		value     string
+		reference deepValidationReference
+		error     bool
+	}{
+		{
+			name:      "existing subnet",
+			value:     subnetId.ID(),
+			reference: deepValidationReference{key: "subnet_id"},
+		},
+		{
+			name:      "missing subnet",
+			value:     missingSubnetId.ID(),
+			reference: deepValidationReference{key: "subnet_id"},
+			error:     true,
+		},
+		{
+			name:      "forbidden subnet",
+			value:     forbiddenSubnetId.ID(),
+			reference: deepValidationReference{key: "subnet_id"},
+		},
+		{
+			name:      "same region",
+			value:     subnetId.ID(),
+			reference: deepValidationReference{key: "subnet_id", location: "westeurope"},
+		},
+		{
+			name:      "different region",
+			value:     subnetId.ID(),
+			reference: deepValidationReference{key: "subnet_id", location: "eastus"},
+			error:     true,
+		},
+		{
+			name:      "delegated subnet",
+			value:     delegatedSubnetId.ID(),
+			reference: deepValidationReference{key: "delegated_subnet_id", subnetDelegation: "Microsoft.DBforPostgreSQL/flexibleServers"},
+		},
+		{
+			name:      "subnet delegated to another service",
+			value:     delegatedSubnetId.ID(),
+			reference: deepValidationReference{key: "delegated_subnet_id", subnetDelegation: "Microsoft.DBforMySQL/flexibleServers"},
+			error:     true,
+		},
+		{
+			name:      "subnet not delegated",
+			value:     subnetId.ID(),
+			reference: deepValidationReference{key: "infrastructure_subnet_id", subnetDelegation: "Microsoft.App/environments"},
+			error:     true,
+		},
+	}
+
+	for _, test := range testData {
+		t.Logf("[DEBUG] Testing %q..", test.name)
+
+		err := deepValidateSubnet(context.Background(), client, test.value, test.reference)
+		if test.error && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !test.error && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+	}
+}
+
+func TestDeepValidateKeyVaultAndUserAssignedIdentity(t *testing.T) {
+	keyVaultId := commonids.NewKeyVaultID("00000000-0000-0000-0000-000000000000", "example-resources", "example")
+	client := fakeDeepValidationClient{
+		keyVaults: map[string]vaults.GetOperationResponse{
+			keyVaultId.ID(): {
+				HttpResponse: &http.Response{StatusCode: http.StatusOK},
+			},
+		},
+	}
+
+	if err := deepValidateKeyVault(context.Background(), client, keyVaultId.ID(), deepValidationReference{key: "key_vault_id"}); err != nil {
+		t.Fatalf("expected no error for an existing Key Vault but got: %+v", err)
+	}
+
+	missingKeyVaultId := commonids.NewKeyVaultID(keyVaultId.SubscriptionId, keyVaultId.ResourceGroupName, "missing")
+	if err := deepValidateKeyVault(context.Background(), client, missingKeyVaultId.ID(), deepValidationReference{key: "key_vault_id"}); err == nil {
+		t.Fatalf("expected an error for a missing Key Vault but didn't get one")
+	}
+
+	// the fake client returns a 403 for all User Assigned Identities, which should be ignored
+	identityId := commonids.NewUserAssignedIdentityID(keyVaultId.SubscriptionId, keyVaultId.ResourceGroupName, "example")
+	if err := deepValidateUserAssignedIdentity(context.Background(), client, identityId.ID(), deepValidationReference{key: "identity.0.identity_ids"}); err != nil {
+		t.Fatalf("expected no error for a forbidden User Assigned Identity but got: %+v", err)
+	}
+}
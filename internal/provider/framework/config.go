@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	providerfeatures "github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
@@ -127,6 +128,33 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 	p.clientBuilder.DisableTerraformPartnerID = getEnvBoolOrDefault(data.DisableTerraformPartnerId, "ARM_DISABLE_TERRAFORM_PARTNER_ID", false)
 	p.clientBuilder.StorageUseAzureAD = getEnvBoolOrDefault(data.StorageUseAzureAD, "ARM_STORAGE_USE_AZUREAD", false)
 
+	rateLimitResourceManagerReads, err := getEnvIntOrDefault(data.RateLimitResourceManagerReads, "ARM_RATE_LIMIT_RESOURCE_MANAGER_READS_PER_MINUTE", 0)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("parsing ARM_RATE_LIMIT_RESOURCE_MANAGER_READS_PER_MINUTE", err.Error()))
+		return
+	}
+	rateLimitResourceManagerWrites, err := getEnvIntOrDefault(data.RateLimitResourceManagerWrites, "ARM_RATE_LIMIT_RESOURCE_MANAGER_WRITES_PER_MINUTE", 0)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("parsing ARM_RATE_LIMIT_RESOURCE_MANAGER_WRITES_PER_MINUTE", err.Error()))
+		return
+	}
+	rateLimitDataPlaneReads, err := getEnvIntOrDefault(data.RateLimitDataPlaneReads, "ARM_RATE_LIMIT_DATA_PLANE_READS_PER_MINUTE", 0)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("parsing ARM_RATE_LIMIT_DATA_PLANE_READS_PER_MINUTE", err.Error()))
+		return
+	}
+	rateLimitDataPlaneWrites, err := getEnvIntOrDefault(data.RateLimitDataPlaneWrites, "ARM_RATE_LIMIT_DATA_PLANE_WRITES_PER_MINUTE", 0)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("parsing ARM_RATE_LIMIT_DATA_PLANE_WRITES_PER_MINUTE", err.Error()))
+		return
+	}
+	p.clientBuilder.RateLimits = common.RateLimits{
+		ResourceManagerReadsPerMinute:  rateLimitResourceManagerReads,
+		ResourceManagerWritesPerMinute: rateLimitResourceManagerWrites,
+		DataPlaneReadsPerMinute:        rateLimitDataPlaneReads,
+		DataPlaneWritesPerMinute:       rateLimitDataPlaneWrites,
+	}
+
 	f := providerfeatures.UserFeatures{}
 
 	// features is required, but we'll play safe here
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 )
 
@@ -47,6 +48,9 @@ func ProtoV5ProviderFactoriesInit(ctx context.Context, providerNames ...string)
 
 func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.ProviderServer, *schema.Provider, error) {
 	v2Provider := provider.AzureProvider()
+	if recorder := common.RecorderFromContext(ctx); recorder != nil {
+		v2Provider = provider.AzureProviderWithRecorder(recorder)
+	}
 
 	providers := []func() tfprotov5.ProviderServer{
 		v2Provider.GRPCProvider,
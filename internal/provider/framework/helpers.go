@@ -8,6 +8,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -182,6 +183,25 @@ func getEnvBoolOrDefault(val types.Bool, envVar string, def bool) bool {
 	return val.ValueBool()
 }
 
+// getEnvIntOrDefault returns the value of the Int64Value if this is not Null / Unknown, otherwise the integer value
+// of the Environment Variable `envVar` if set, falling back to `def` in all other cases.
+func getEnvIntOrDefault(val types.Int64, envVar string, def int) (int, error) {
+	if val.IsNull() || val.IsUnknown() {
+		v := os.Getenv(envVar)
+		if v == "" {
+			return def, nil
+		}
+
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, fmt.Errorf("parsing %q as an integer: %+v", v, err)
+		}
+		return i, nil
+	}
+
+	return int(val.ValueInt64()), nil
+}
+
 // getEnvListOfStringsIfAbsent returns a []string for the types.List, or the contents of the supplied Environment
 // Variable `envVar` if set. If the separator is an empty string, then "," will be used as a default.
 func getEnvListOfStringsIfAbsent(val types.List, envVar string, separator string) []string {
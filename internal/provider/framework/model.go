@@ -36,6 +36,10 @@ type ProviderModel struct {
 	DisableCorrelationRequestId    types.Bool   `tfsdk:"disable_correlation_request_id"`
 	DisableTerraformPartnerId      types.Bool   `tfsdk:"disable_terraform_partner_id"`
 	StorageUseAzureAD              types.Bool   `tfsdk:"storage_use_azuread"`
+	RateLimitResourceManagerReads  types.Int64  `tfsdk:"rate_limit_resource_manager_reads_per_minute"`
+	RateLimitResourceManagerWrites types.Int64  `tfsdk:"rate_limit_resource_manager_writes_per_minute"`
+	RateLimitDataPlaneReads        types.Int64  `tfsdk:"rate_limit_data_plane_reads_per_minute"`
+	RateLimitDataPlaneWrites       types.Int64  `tfsdk:"rate_limit_data_plane_writes_per_minute"`
 	Features                       types.List   `tfsdk:"features"`
 	SkipProviderRegistration       types.Bool   `tfsdk:"skip_provider_registration"` // TODO - Remove in 5.0
 	ResourceProviderRegistrations  types.String `tfsdk:"resource_provider_registrations"`
@@ -210,6 +210,26 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 				Description: "Should the AzureRM Provider use Azure AD Authentication when accessing the Storage Data Plane APIs?",
 			},
 
+			"rate_limit_resource_manager_reads_per_minute": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of read requests per minute which should be sent to each Resource Provider in each Subscription. Defaults to `0`, which means requests are not limited.",
+			},
+
+			"rate_limit_resource_manager_writes_per_minute": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of write requests per minute which should be sent to each Resource Provider in each Subscription. Defaults to `0`, which means requests are not limited.",
+			},
+
+			"rate_limit_data_plane_reads_per_minute": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of read requests per minute which should be sent to each Data Plane endpoint. Defaults to `0`, which means requests are not limited.",
+			},
+
+			"rate_limit_data_plane_writes_per_minute": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of write requests per minute which should be sent to each Data Plane endpoint. Defaults to `0`, which means requests are not limited.",
+			},
+
 			"resource_provider_registrations": schema.StringAttribute{
 				Optional:    true,
 				Description: "The set of Resource Providers which should be automatically registered for the subscription.",
@@ -17,8 +17,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
@@ -30,6 +33,17 @@ func TestAzureProvider() *schema.Provider {
 	return azureProvider(true)
 }
 
+// AzureProviderWithRecorder returns the Provider configured to record HTTP interactions to, or replay them from,
+// the specified Recorder - which is used to run the acceptance tests against recorded cassettes.
+func AzureProviderWithRecorder(recorder *common.Recorder) *schema.Provider {
+	p := azureProvider(false)
+	configure := p.ConfigureContextFunc
+	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		return configure(common.ContextWithRecorder(ctx, recorder), d)
+	}
+	return p
+}
+
 func ValidatePartnerID(i interface{}, k string) ([]string, []error) {
 	// ValidatePartnerID checks if partner_id is any of the following:
 	//  * a valid UUID - will add "pid-" prefix to the ID if it is not already present
@@ -134,10 +148,25 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				panic(fmt.Sprintf("An existing Resource exists for %q", k))
 			}
 
+			// the Typed Resources are re-cased within the Wrapper
+			pluginsdk.RecaseResourceIdsOnRead(v)
+
 			resources[k] = v
 		}
 	}
 
+	for k, v := range dataSources {
+		traceResource("data."+k, v)
+	}
+	for k, v := range resources {
+		traceResource(k, v)
+	}
+	if features.DeepValidationEnabled() {
+		for k, v := range resources {
+			deepValidateResource(k, v)
+		}
+	}
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
@@ -375,6 +404,38 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("ARM_STORAGE_USE_AZUREAD", false),
 				Description: "Should the AzureRM Provider use Azure AD Authentication when accessing the Storage Data Plane APIs?",
 			},
+
+			"rate_limit_resource_manager_reads_per_minute": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_RATE_LIMIT_RESOURCE_MANAGER_READS_PER_MINUTE", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of read requests per minute which should be sent to each Resource Provider in each Subscription. Defaults to `0`, which means requests are not limited.",
+			},
+
+			"rate_limit_resource_manager_writes_per_minute": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_RATE_LIMIT_RESOURCE_MANAGER_WRITES_PER_MINUTE", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of write requests per minute which should be sent to each Resource Provider in each Subscription. Defaults to `0`, which means requests are not limited.",
+			},
+
+			"rate_limit_data_plane_reads_per_minute": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_RATE_LIMIT_DATA_PLANE_READS_PER_MINUTE", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of read requests per minute which should be sent to each Data Plane endpoint. Defaults to `0`, which means requests are not limited.",
+			},
+
+			"rate_limit_data_plane_writes_per_minute": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_RATE_LIMIT_DATA_PLANE_WRITES_PER_MINUTE", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of write requests per minute which should be sent to each Data Plane endpoint. Defaults to `0`, which means requests are not limited.",
+			},
 		},
 
 		DataSourcesMap: dataSources,
@@ -529,6 +590,13 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 		Features:                    expandFeatures(d.Get("features").([]interface{})),
 		MetadataHost:                d.Get("metadata_host").(string),
 		PartnerID:                   d.Get("partner_id").(string),
+		RateLimits: common.RateLimits{
+			ResourceManagerReadsPerMinute:  d.Get("rate_limit_resource_manager_reads_per_minute").(int),
+			ResourceManagerWritesPerMinute: d.Get("rate_limit_resource_manager_writes_per_minute").(int),
+			DataPlaneReadsPerMinute:        d.Get("rate_limit_data_plane_reads_per_minute").(int),
+			DataPlaneWritesPerMinute:       d.Get("rate_limit_data_plane_writes_per_minute").(int),
+		},
+		Recorder:                    common.RecorderFromContext(ctx),
 		RegisteredResourceProviders: requiredResourceProviders,
 		StorageUseAzureAD:           d.Get("storage_use_azuread").(bool),
 		SubscriptionID:              d.Get("subscription_id").(string),
@@ -537,6 +605,9 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 		// this field is intentionally not exposed in the provider block, since it's only used for
 		// platform level tracing
 		CustomCorrelationRequestID: os.Getenv("ARM_CORRELATION_REQUEST_ID"),
+
+		// this is intended for debugging slow applies, so is also only available as an environment variable
+		RequestTraceFilePath: os.Getenv("ARM_REQUEST_TRACE_FILE_PATH"),
 	}
 
 	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
@@ -315,6 +315,10 @@ func TestResourcesDoNotContainANameFieldWithADefaultOfDefault(t *testing.T) {
 		},
 
 		// 2: "default" is the expected name:
+		"azurerm_kubernetes_cluster_maintenance_configuration": {
+			// `default` is one of the three supported Maintenance Configurations, alongside the auto upgrade schedules
+			"name": {},
+		},
 		"azurerm_managed_redis_database": {
 			"name": {},
 		},
@@ -193,6 +193,7 @@ func SupportedTypedServices() []sdk.TypedServiceRegistration {
 		maintenance.Registration{},
 		managedhsm.Registration{},
 		managedredis.Registration{},
+		managementgroup.Registration{},
 		mobilenetwork.Registration{},
 		mongocluster.Registration{},
 		monitor.Registration{},
@@ -349,6 +350,7 @@ func SupportedFrameworkServices() []sdk.FrameworkServiceRegistration {
 		compute.Registration{},
 		keyvault.Registration{},
 		network.Registration{},
+		postgres.Registration{},
 		storage.Registration{},
 	}
 
@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+func TestResourcesSupportResourceIdentity(t *testing.T) {
+	// This test confirms that each of the Resources registered by each Service defines a valid Resource Identity,
+	// (and supports importing using it) - or is listed in resourcesWhichDontSupportResourceIdentity.
+	for _, service := range SupportedTypedServices() {
+		t.Logf("Service %q..", service.Name())
+		for _, resource := range service.Resources() {
+			t.Logf("- Resource %q..", resource.ResourceType())
+			wrapper := sdk.NewResourceWrapper(resource)
+			r, err := wrapper.Resource()
+			if err != nil {
+				t.Fatalf("building Resource %q: %+v", resource.ResourceType(), err)
+			}
+
+			validateResourceIdentity(t, resource.ResourceType(), r)
+		}
+	}
+
+	for _, service := range SupportedUntypedServices() {
+		t.Logf("Service %q..", service.Name())
+		for resourceType, resource := range service.SupportedResources() {
+			t.Logf("- Resource %q..", resourceType)
+			validateResourceIdentity(t, resourceType, resource)
+		}
+	}
+}
+
+func validateResourceIdentity(t *testing.T, resourceType string, resource *schema.Resource) {
+	if _, ok := resourcesWhichDontSupportResourceIdentity[resourceType]; ok {
+		if resource.Identity != nil {
+			t.Errorf("the Resource %q supports Resource Identity - please remove it from `resourcesWhichDontSupportResourceIdentity`", resourceType)
+		}
+		return
+	}
+
+	if resource.Identity == nil {
+		t.Errorf("the Resource %q doesn't support Resource Identity", resourceType)
+		return
+	}
+
+	if err := resource.Identity.InternalIdentityValidate(); err != nil {
+		t.Errorf("the Resource Identity for the Resource %q is invalid: %+v", resourceType, err)
+	}
+
+	if resource.Importer == nil {
+		t.Errorf("the Resource %q supports Resource Identity but doesn't support import", resourceType)
+	}
+}
+
+// resourcesWhichDontSupportResourceIdentity are the Resources which don't (yet) support Resource Identity, see
+// `contributing/topics/guide-resource-identity.md` for more information.
+//
+// New Resources shouldn't be added to this list.
+var resourcesWhichDontSupportResourceIdentity = map[string]struct{}{
+	// the Resource ID doesn't implement `resourceids.ResourceId` (for example a handwritten, Composite or Data Plane Resource ID)
+	"azurerm_advanced_threat_protection":                                             {},
+	"azurerm_app_configuration_feature":                                              {},
+	"azurerm_app_configuration_key":                                                  {},
+	"azurerm_app_service_certificate_binding":                                        {},
+	"azurerm_app_service_custom_hostname_binding":                                    {},
+	"azurerm_app_service_source_control_token":                                       {},
+	"azurerm_automation_job_schedule":                                                {},
+	"azurerm_billing_account_cost_management_export":                                 {},
+	"azurerm_communication_service_email_domain_association":                         {},
+	"azurerm_iot_security_device_group":                                              {},
+	"azurerm_ip_group_cidr":                                                          {},
+	"azurerm_key_vault_access_policy":                                                {},
+	"azurerm_key_vault_certificate":                                                  {},
+	"azurerm_key_vault_certificate_contacts":                                         {},
+	"azurerm_key_vault_certificate_issuer":                                           {},
+	"azurerm_key_vault_key":                                                          {},
+	"azurerm_key_vault_managed_hardware_security_module_key":                         {},
+	"azurerm_key_vault_managed_hardware_security_module_key_rotation_policy":         {},
+	"azurerm_key_vault_managed_hardware_security_module_role_assignment":             {},
+	"azurerm_key_vault_managed_hardware_security_module_role_definition":             {},
+	"azurerm_key_vault_managed_storage_account":                                      {},
+	"azurerm_key_vault_managed_storage_account_sas_token_definition":                 {},
+	"azurerm_key_vault_secret":                                                       {},
+	"azurerm_management_group":                                                       {},
+	"azurerm_management_group_policy_exemption":                                      {},
+	"azurerm_management_group_policy_remediation":                                    {},
+	"azurerm_management_group_template_deployment":                                   {},
+	"azurerm_marketplace_role_assignment":                                            {},
+	"azurerm_nat_gateway_public_ip_association":                                      {},
+	"azurerm_nat_gateway_public_ip_prefix_association":                               {},
+	"azurerm_network_interface_application_gateway_backend_address_pool_association": {},
+	"azurerm_network_interface_application_security_group_association":               {},
+	"azurerm_network_interface_backend_address_pool_association":                     {},
+	"azurerm_network_interface_nat_rule_association":                                 {},
+	"azurerm_network_interface_security_group_association":                           {},
+	"azurerm_network_manager_deployment":                                             {},
+	"azurerm_pim_active_role_assignment":                                             {},
+	"azurerm_pim_eligible_role_assignment":                                           {},
+	"azurerm_policy_definition":                                                      {},
+	"azurerm_policy_set_definition":                                                  {},
+	"azurerm_portal_tenant_configuration":                                            {},
+	"azurerm_postgresql_flexible_server_virtual_endpoint":                            {},
+	"azurerm_private_endpoint_application_security_group_association":                {},
+	"azurerm_resource_policy_exemption":                                              {},
+	"azurerm_resource_policy_remediation":                                            {},
+	"azurerm_resource_provider_registration":                                         {},
+	"azurerm_role_definition":                                                        {},
+	"azurerm_role_management_policy":                                                 {},
+	"azurerm_security_center_assessment":                                             {},
+	"azurerm_source_control_token":                                                   {},
+	"azurerm_storage_blob":                                                           {},
+	"azurerm_storage_data_lake_gen2_filesystem":                                      {},
+	"azurerm_storage_data_lake_gen2_path":                                            {},
+	"azurerm_storage_object_replication":                                             {},
+	"azurerm_storage_share_directory":                                                {},
+	"azurerm_storage_share_file":                                                     {},
+	"azurerm_storage_table":                                                          {},
+	"azurerm_storage_table_entity":                                                   {},
+	"azurerm_subscription":                                                           {},
+	"azurerm_synapse_role_assignment":                                                {},
+	"azurerm_tenant_template_deployment":                                             {},
+	"azurerm_virtual_desktop_host_pool_registration_info":                            {},
+	"azurerm_virtual_desktop_scaling_plan_host_pool_association":                     {},
+	"azurerm_virtual_desktop_workspace_application_group_association":                {},
+	"azurerm_virtual_machine_gallery_application_assignment":                         {},
+
+	// the Resource ID is parsed case-insensitively during import, which importing using the Resource Identity doesn't support
+	"azurerm_api_management_email_template":        {},
+	"azurerm_data_protection_backup_vault":         {},
+	"azurerm_maintenance_configuration":            {},
+	"azurerm_signalr_shared_private_link_resource": {},
+
+	// the Resource ID contains a segment which isn't part of the Azure Resource ID (the Initial Replica Set ID)
+	"azurerm_active_directory_domain_service": {},
+
+	// a custom importer is used, which needs to support importing using the Resource Identity
+	"azurerm_api_management_identity_provider_aad":                                  {},
+	"azurerm_api_management_identity_provider_aadb2c":                               {},
+	"azurerm_api_management_identity_provider_facebook":                             {},
+	"azurerm_api_management_identity_provider_google":                               {},
+	"azurerm_api_management_identity_provider_microsoft":                            {},
+	"azurerm_api_management_identity_provider_twitter":                              {},
+	"azurerm_application_insights_analytics_item":                                   {},
+	"azurerm_databricks_workspace_root_dbfs_customer_managed_key":                   {},
+	"azurerm_lb_backend_address_pool":                                               {},
+	"azurerm_lb_nat_pool":                                                           {},
+	"azurerm_lb_nat_rule":                                                           {},
+	"azurerm_lb_outbound_rule":                                                      {},
+	"azurerm_lb_probe":                                                              {},
+	"azurerm_lb_rule":                                                               {},
+	"azurerm_maintenance_assignment_dedicated_host":                                 {},
+	"azurerm_maintenance_assignment_virtual_machine":                                {},
+	"azurerm_maintenance_assignment_virtual_machine_scale_set":                      {},
+	"azurerm_sentinel_data_connector_aws_cloud_trail":                               {},
+	"azurerm_sentinel_data_connector_azure_active_directory":                        {},
+	"azurerm_sentinel_data_connector_azure_advanced_threat_protection":              {},
+	"azurerm_sentinel_data_connector_azure_security_center":                         {},
+	"azurerm_sentinel_data_connector_microsoft_cloud_app_security":                  {},
+	"azurerm_sentinel_data_connector_microsoft_defender_advanced_threat_protection": {},
+	"azurerm_sentinel_data_connector_office_365":                                    {},
+	"azurerm_sentinel_data_connector_office_atp":                                    {},
+	"azurerm_sentinel_data_connector_threat_intelligence":                           {},
+	"azurerm_sentinel_data_connector_threat_intelligence_taxii":                     {},
+	"azurerm_traffic_manager_azure_endpoint":                                        {},
+	"azurerm_traffic_manager_external_endpoint":                                     {},
+	"azurerm_traffic_manager_nested_endpoint":                                       {},
+
+	// the Resource is deprecated or the Azure Service has been retired, so the acceptance tests are skipped
+	"azurerm_automation_software_update_configuration":   {},
+	"azurerm_cdn_endpoint":                               {},
+	"azurerm_cdn_endpoint_custom_domain":                 {},
+	"azurerm_cdn_profile":                                {},
+	"azurerm_data_protection_backup_instance_postgresql": {},
+	"azurerm_databricks_workspace_customer_managed_key":  {},
+	"azurerm_extended_custom_location":                   {},
+	"azurerm_frontdoor":                                  {},
+	"azurerm_frontdoor_custom_https_configuration":       {},
+	"azurerm_frontdoor_firewall_policy":                  {},
+	"azurerm_frontdoor_rules_engine":                     {},
+	"azurerm_hpc_cache":                                  {},
+	"azurerm_hpc_cache_access_policy":                    {},
+	"azurerm_hpc_cache_blob_nfs_target":                  {},
+	"azurerm_hpc_cache_blob_target":                      {},
+	"azurerm_hpc_cache_nfs_target":                       {},
+	"azurerm_maps_creator":                               {},
+	"azurerm_network_packet_capture":                     {},
+	"azurerm_orbital_contact":                            {},
+	"azurerm_orbital_contact_profile":                    {},
+	"azurerm_orbital_spacecraft":                         {},
+	"azurerm_postgresql_active_directory_administrator":  {},
+	"azurerm_postgresql_configuration":                   {},
+	"azurerm_postgresql_database":                        {},
+	"azurerm_postgresql_firewall_rule":                   {},
+	"azurerm_postgresql_server":                          {},
+	"azurerm_postgresql_server_key":                      {},
+	"azurerm_postgresql_virtual_network_rule":            {},
+	"azurerm_security_center_auto_provisioning":          {},
+
+	// the acceptance tests require environment variables, credentials or manually provisioned infrastructure
+	"azurerm_active_directory_domain_service_trust":                                      {},
+	"azurerm_app_service_certificate_order":                                              {},
+	"azurerm_app_service_managed_certificate":                                            {},
+	"azurerm_app_service_slot_custom_hostname_binding":                                   {},
+	"azurerm_arc_machine_automanage_configuration_assignment":                            {},
+	"azurerm_arc_machine_extension":                                                      {},
+	"azurerm_automation_source_control":                                                  {},
+	"azurerm_bot_channel_email":                                                          {},
+	"azurerm_bot_channel_facebook":                                                       {},
+	"azurerm_bot_channel_line":                                                           {},
+	"azurerm_bot_channel_slack":                                                          {},
+	"azurerm_bot_channel_sms":                                                            {},
+	"azurerm_cdn_frontdoor_secret":                                                       {},
+	"azurerm_container_app_custom_domain":                                                {},
+	"azurerm_container_app_environment_custom_domain":                                    {},
+	"azurerm_container_registry_task_schedule_run_now":                                   {},
+	"azurerm_datadog_monitor":                                                            {},
+	"azurerm_datadog_monitor_sso_configuration":                                          {},
+	"azurerm_datadog_monitor_tag_rule":                                                   {},
+	"azurerm_dynatrace_monitor":                                                          {},
+	"azurerm_dynatrace_tag_rules":                                                        {},
+	"azurerm_lighthouse_assignment":                                                      {},
+	"azurerm_lighthouse_definition":                                                      {},
+	"azurerm_log_analytics_cluster":                                                      {},
+	"azurerm_log_analytics_cluster_customer_managed_key":                                 {},
+	"azurerm_management_group_subscription_association":                                  {},
+	"azurerm_network_function_collector_policy":                                          {},
+	"azurerm_network_manager_management_group_connection":                                {},
+	"azurerm_palo_alto_next_generation_firewall_virtual_hub_panorama":                    {},
+	"azurerm_palo_alto_next_generation_firewall_virtual_network_panorama":                {},
+	"azurerm_site_recovery_hyperv_network_mapping":                                       {},
+	"azurerm_site_recovery_vmware_replicated_vm":                                         {},
+	"azurerm_site_recovery_vmware_replication_policy_association":                        {},
+	"azurerm_spring_cloud_custom_domain":                                                 {},
+	"azurerm_stack_hci_deployment_setting":                                               {},
+	"azurerm_stack_hci_extension":                                                        {},
+	"azurerm_stack_hci_logical_network":                                                  {},
+	"azurerm_stack_hci_marketplace_gallery_image":                                        {},
+	"azurerm_stack_hci_network_interface":                                                {},
+	"azurerm_stack_hci_storage_path":                                                     {},
+	"azurerm_stack_hci_virtual_hard_disk":                                                {},
+	"azurerm_storage_sync_server_endpoint":                                               {},
+	"azurerm_system_center_virtual_machine_manager_availability_set":                     {},
+	"azurerm_system_center_virtual_machine_manager_cloud":                                {},
+	"azurerm_system_center_virtual_machine_manager_server":                               {},
+	"azurerm_system_center_virtual_machine_manager_virtual_machine_instance":             {},
+	"azurerm_system_center_virtual_machine_manager_virtual_machine_instance_guest_agent": {},
+	"azurerm_system_center_virtual_machine_manager_virtual_machine_template":             {},
+	"azurerm_system_center_virtual_machine_manager_virtual_network":                      {},
+	"azurerm_workloads_sap_discovery_virtual_instance":                                   {},
+
+	// there's no acceptance test config the generated test can use (for example it needs arguments other than `acceptance.TestData`,
+	// values set on the test Resource or a label other than `test`)
+	"azurerm_active_directory_domain_service_replica_set":              {},
+	"azurerm_arc_kubernetes_cluster":                                   {},
+	"azurerm_arc_kubernetes_cluster_extension":                         {},
+	"azurerm_arc_kubernetes_flux_configuration":                        {},
+	"azurerm_attestation_provider":                                     {},
+	"azurerm_container_registry_token_password":                        {},
+	"azurerm_extended_location_custom_location":                        {},
+	"azurerm_log_analytics_query_pack_query":                           {},
+	"azurerm_marketplace_agreement":                                    {},
+	"azurerm_mssql_managed_instance_active_directory_administrator":    {},
+	"azurerm_netapp_account_encryption":                                {},
+	"azurerm_new_relic_monitor":                                        {},
+	"azurerm_new_relic_tag_rule":                                       {},
+	"azurerm_nginx_api_key":                                            {},
+	"azurerm_palo_alto_virtual_network_appliance":                      {},
+	"azurerm_resource_management_private_link_association":             {},
+	"azurerm_security_center_assessment_policy":                        {},
+	"azurerm_security_center_contact":                                  {},
+	"azurerm_security_center_server_vulnerability_assessments_setting": {},
+	"azurerm_security_center_setting":                                  {},
+	"azurerm_security_center_subscription_pricing":                     {},
+	"azurerm_security_center_workspace":                                {},
+	"azurerm_sentinel_automation_rule":                                 {},
+	"azurerm_sentinel_data_connector_aws_s3":                           {},
+	"azurerm_service_fabric_managed_cluster":                           {},
+	"azurerm_servicebus_namespace_authorization_rule":                  {},
+	"azurerm_servicebus_namespace_disaster_recovery_config":            {},
+	"azurerm_site_recovery_hyperv_replication_policy":                  {},
+	"azurerm_site_recovery_hyperv_replication_policy_association":      {},
+	"azurerm_site_recovery_replication_policy":                         {},
+	"azurerm_site_recovery_vmware_replication_policy":                  {},
+	"azurerm_ssh_public_key":                                           {},
+	"azurerm_virtual_desktop_scaling_plan":                             {},
+	"azurerm_virtual_network_peering":                                  {},
+	"azurerm_workloads_sap_single_node_virtual_instance":               {},
+	"azurerm_workloads_sap_three_tier_virtual_instance":                {},
+}
@@ -207,3 +207,31 @@ func TestTypedResourcesUsePointersForOptionalProperties(t *testing.T) {
 		t.Fatalf("schema properties found with incorrect types - `Optional` should be pointers, `Required` should not be pointers")
 	}
 }
+
+func TestTypedDataSourcesModelObjectsMatchSchema(t *testing.T) {
+	// The Model Objects are validated against the Schema when building the Data Source
+	for _, service := range SupportedTypedServices() {
+		t.Logf("Service %q..", service.Name())
+		for _, dataSource := range service.DataSources() {
+			t.Logf("- DataSource %q..", dataSource.ResourceType())
+			wrapper := sdk.NewDataSourceWrapper(dataSource)
+			if _, err := wrapper.DataSource(); err != nil {
+				t.Fatalf("building Data Source %q: %+v", dataSource.ResourceType(), err)
+			}
+		}
+	}
+}
+
+func TestTypedResourcesModelObjectsMatchSchema(t *testing.T) {
+	// The Model Objects are validated against the Schema when building the Resource
+	for _, service := range SupportedTypedServices() {
+		t.Logf("Service %q..", service.Name())
+		for _, resource := range service.Resources() {
+			t.Logf("- Resource %q..", resource.ResourceType())
+			wrapper := sdk.NewResourceWrapper(resource)
+			if _, err := wrapper.Resource(); err != nil {
+				t.Fatalf("building Resource %q: %+v", resource.ResourceType(), err)
+			}
+		}
+	}
+}
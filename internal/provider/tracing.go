@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+// traceResource wraps the CRUD functions for the Resource so that, when request tracing is enabled, each request
+// sent to Azure is attributed to the Resource (and the operation) which sent it.
+//
+// Terraform doesn't send the address of the Resource (e.g. `azurerm_resource_group.example`) to the Provider, as
+// such the Resource Type and Resource ID are used to identify the Resource instead.
+func traceResource(resourceType string, resource *schema.Resource) {
+	if f := resource.CreateContext; f != nil {
+		resource.CreateContext = traceContextFunc(resourceType, common.TraceOperationCreate, f)
+	}
+	if f := resource.ReadContext; f != nil {
+		resource.ReadContext = traceContextFunc(resourceType, common.TraceOperationRead, f)
+	}
+	if f := resource.UpdateContext; f != nil {
+		resource.UpdateContext = traceContextFunc(resourceType, common.TraceOperationUpdate, f)
+	}
+	if f := resource.DeleteContext; f != nil {
+		resource.DeleteContext = traceContextFunc(resourceType, common.TraceOperationDelete, f)
+	}
+
+	if f := resource.Create; f != nil { //nolint:staticcheck
+		resource.Create = traceFunc(resourceType, common.TraceOperationCreate, f) //nolint:staticcheck
+	}
+	if f := resource.Read; f != nil { //nolint:staticcheck
+		resource.Read = traceFunc(resourceType, common.TraceOperationRead, f) //nolint:staticcheck
+	}
+	if f := resource.Update; f != nil { //nolint:staticcheck
+		resource.Update = traceFunc(resourceType, common.TraceOperationUpdate, f) //nolint:staticcheck
+	}
+	if f := resource.Delete; f != nil { //nolint:staticcheck
+		resource.Delete = traceFunc(resourceType, common.TraceOperationDelete, f) //nolint:staticcheck
+	}
+}
+
+type contextFunc = func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+
+func traceContextFunc(resourceType, operation string, f contextFunc) contextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client, ok := meta.(*clients.Client)
+		if !ok || client.RequestTracer == nil {
+			return f(ctx, d, meta)
+		}
+
+		scope := common.NewTraceScope(resourceType, operation, d.Id)
+		client.RequestTracer.Start(scope)
+		diags := f(common.ContextWithTraceScope(ctx, scope), d, meta)
+
+		var err error
+		if diags.HasError() {
+			err = diagnosticsError(diags)
+		}
+		client.RequestTracer.Finish(scope, err)
+
+		return diags
+	}
+}
+
+type legacyFunc = func(*schema.ResourceData, interface{}) error
+
+func traceFunc(resourceType, operation string, f legacyFunc) legacyFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		client, ok := meta.(*clients.Client)
+		if !ok || client.RequestTracer == nil {
+			return f(d, meta)
+		}
+
+		// these functions use the StopContext from the Client to send requests, so a copy of the Client is
+		// used to attribute these requests to the Resource
+		scope := common.NewTraceScope(resourceType, operation, d.Id)
+		traced := *client
+		traced.StopContext = common.ContextWithTraceScope(client.StopContext, scope)
+
+		client.RequestTracer.Start(scope)
+		err := f(d, &traced)
+		client.RequestTracer.Finish(scope, err)
+
+		return err
+	}
+}
+
+type diagnosticsError diag.Diagnostics
+
+func (e diagnosticsError) Error() string {
+	for _, d := range e {
+		if d.Severity == diag.Error {
+			return d.Summary
+		}
+	}
+	return ""
+}
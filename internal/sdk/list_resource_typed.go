@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// TypedListResourceFunc returns the Plugin SDK representation of the Typed Resource, which is intended to be
+// returned from FrameworkListWrappedResource.ResourceFunc for List Resources representing a Typed Resource.
+func TypedListResourceFunc(resource Resource) *pluginsdk.Resource {
+	wrapper := NewResourceWrapper(resource)
+	output, err := wrapper.Resource()
+	if err != nil {
+		panic(fmt.Errorf("creating Wrapper for Resource %q: %+v", resource.ResourceType(), err))
+	}
+	return output
+}
+
+// EncodeTypedListResult populates the ListResult `result` for the Typed Resource `resource` from the model `input`,
+// which must be a pointer to the Model Object for the Typed Resource, in the same way as during a Read.
+func EncodeTypedListResult(ctx context.Context, resource Resource, client *clients.Client, id resourceids.ResourceId, input interface{}, result *list.ListResult) error {
+	d := TypedListResourceFunc(resource).Data(&terraform.InstanceState{})
+	d.SetId(id.ID())
+
+	metadata := ResourceMetaData{
+		Client:                   client,
+		Logger:                   NullLogger{},
+		ResourceData:             d,
+		serializationDebugLogger: NullLogger{},
+	}
+	if err := metadata.Encode(input); err != nil {
+		return fmt.Errorf("encoding Resource data: %+v", err)
+	}
+
+	if _, ok := resource.(ResourceWithIdentity); ok {
+		var idType pluginsdk.ResourceTypeForIdentity = pluginsdk.ResourceTypeForIdentityDefault
+		if v, ok := resource.(ResourceWithIdentityTypeOverride); ok {
+			idType = v.IdentityType()
+		}
+
+		if err := pluginsdk.SetResourceIdentityData(d, id, idType); err != nil {
+			return fmt.Errorf("setting Identity data: %+v", err)
+		}
+
+		identity, err := d.TfTypeIdentityState()
+		if err != nil {
+			return fmt.Errorf("converting Identity State: %+v", err)
+		}
+		if diags := result.Identity.Set(ctx, *identity); diags.HasError() {
+			return fmt.Errorf("setting Identity data: %+v", diags)
+		}
+	}
+
+	state, err := d.TfTypeResourceState()
+	if err != nil {
+		return fmt.Errorf("converting Resource State data: %+v", err)
+	}
+	if diags := result.Resource.Set(ctx, *state); diags.HasError() {
+		return fmt.Errorf("setting Resource data: %+v", diags)
+	}
+
+	return nil
+}
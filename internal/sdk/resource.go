@@ -213,6 +213,9 @@ type ResourceMetaData struct {
 
 	// serializationDebugLogger is used for testing purposes
 	serializationDebugLogger Logger
+
+	// warnings contains the Warning Diagnostics raised during this operation, see AddWarning
+	warnings *warningDiagnostics
 }
 
 // MarkAsGone marks this resource as removed in the Remote API, so this is no longer available
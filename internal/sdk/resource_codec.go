@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// modelCodec is the pre-computed plan used to Encode/Decode a model of a given type, which is built once
+// per type and reused, so that the struct tags don't need to be parsed on every call to Encode/Decode.
+type modelCodec struct {
+	// fields contains the fields within this model which have a `tfschema` struct tag, in the order they're defined
+	fields []fieldCodec
+}
+
+type fieldCodec struct {
+	decodedStructTags
+
+	// index is the index of this field within the model
+	index int
+
+	// name is the name of this field within the model
+	name string
+
+	// fieldType is the Go type of this field
+	fieldType reflect.Type
+}
+
+// modelCodecs is a cache of reflect.Type to *modelCodec
+var modelCodecs sync.Map
+
+// codecForType returns the modelCodec for the specified model type, building (and caching) it if necessary
+func codecForType(objType reflect.Type) (*modelCodec, error) {
+	if v, ok := modelCodecs.Load(objType); ok {
+		return v.(*modelCodec), nil
+	}
+
+	if objType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("need a struct but got %q", objType.String())
+	}
+
+	codec := &modelCodec{
+		fields: make([]fieldCodec, 0, objType.NumField()),
+	}
+	for i := 0; i < objType.NumField(); i++ {
+		field := objType.Field(i)
+		structTags, err := parseStructTags(field.Tag)
+		if err != nil {
+			return nil, fmt.Errorf("parsing struct tags for %q: %+v", field.Name, err)
+		}
+
+		if structTags == nil {
+			continue
+		}
+
+		codec.fields = append(codec.fields, fieldCodec{
+			decodedStructTags: *structTags,
+			index:             i,
+			name:              field.Name,
+			fieldType:         field.Type,
+		})
+	}
+
+	// another goroutine may have built this codec in the meantime, in which case use that one
+	v, _ := modelCodecs.LoadOrStore(objType, codec)
+	return v.(*modelCodec), nil
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCodecForType(t *testing.T) {
+	type Person struct {
+		Name     string `tfschema:"name"`
+		internal string
+		Nickname string `tfschema:"nickname,addedInNextMajorVersion"`
+	}
+
+	codec, err := codecForType(reflect.TypeOf(Person{}))
+	if err != nil {
+		t.Fatalf("building codec: %+v", err)
+	}
+
+	if len(codec.fields) != 2 {
+		t.Fatalf("expected 2 fields but got %d", len(codec.fields))
+	}
+	if codec.fields[0].hclPath != "name" || codec.fields[0].index != 0 {
+		t.Fatalf("expected the first field to be `name` at index 0 but got %q at index %d", codec.fields[0].hclPath, codec.fields[0].index)
+	}
+	if codec.fields[1].hclPath != "nickname" || codec.fields[1].index != 2 || !codec.fields[1].addedInNextMajorVersion {
+		t.Fatalf("expected the second field to be `nickname` at index 2 but got %q at index %d", codec.fields[1].hclPath, codec.fields[1].index)
+	}
+
+	cached, err := codecForType(reflect.TypeOf(Person{}))
+	if err != nil {
+		t.Fatalf("building codec: %+v", err)
+	}
+	if cached != codec {
+		t.Fatalf("expected the codec to be cached")
+	}
+}
+
+func TestCodecForTypeInvalidStructTags(t *testing.T) {
+	type Person struct {
+		Name string `tfschema:""`
+	}
+
+	if _, err := codecForType(reflect.TypeOf(Person{})); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -15,6 +16,10 @@ import (
 // NOTE: this object must be passed by value - and must contain `tfschema`
 // struct tags for all fields
 //
+// Fields which are a pointer to a string, int, float or bool are left as nil
+// when the value is null in the config (rather than being set to the zero value)
+// - allowing a field which isn't set to be differentiated from `""`, `0` or `false`.
+//
 // Example Usage:
 //
 //	type Person struct {
@@ -47,40 +52,47 @@ type stateRetriever interface {
 	GetOkExists(key string) (interface{}, bool)
 }
 
+// rawConfigRetriever is implemented by both the ResourceData and ResourceDiff, and allows determining whether
+// a value has been explicitly set in the config
+type rawConfigRetriever interface {
+	GetRawConfig() cty.Value
+}
+
 func decodeReflectedType(input interface{}, stateRetriever stateRetriever, debugLogger Logger) error {
 	if reflect.TypeOf(input).Kind() != reflect.Ptr {
 		return fmt.Errorf("need a pointer")
 	}
 
-	objType := reflect.TypeOf(input).Elem()
-	for i := 0; i < objType.NumField(); i++ {
-		field := objType.Field(i)
-		debugLogger.Infof("Field: %#v", field.Name)
+	codec, err := codecForType(reflect.TypeOf(input).Elem())
+	if err != nil {
+		return err
+	}
 
-		structTags, err := parseStructTags(field.Tag)
-		if err != nil {
-			return fmt.Errorf("parsing struct tags for %q: %+v", field.Name, err)
-		}
+	// the config is used to determine whether a value is null, rather than the zero value, during Create/Update
+	// and within a CustomizeDiff - however it's unavailable during Read, in which case the value from the state is used
+	config := cty.NilVal
+	if v, ok := stateRetriever.(rawConfigRetriever); ok {
+		config = v.GetRawConfig()
+	}
 
-		if structTags != nil {
-			tfschemaValue, valExists := stateRetriever.GetOkExists(structTags.hclPath)
-			if !valExists {
-				continue
-			}
+	for _, field := range codec.fields {
+		if isPointerToPrimitive(field.fieldType) && attributeIsNullInConfig(config, field.hclPath) {
+			continue
+		}
 
-			debugLogger.Infof("TFSchemaValue: %+v", tfschemaValue)
-			debugLogger.Infof("Input Type: %+v", reflect.ValueOf(input).Elem().Field(i).Type())
+		tfschemaValue, valExists := stateRetriever.GetOkExists(field.hclPath)
+		if !valExists {
+			continue
+		}
 
-			if err := setValue(input, tfschemaValue, i, field.Name, debugLogger); err != nil {
-				return fmt.Errorf("while setting value %+v of model field %q: %+v", tfschemaValue, field.Name, err)
-			}
+		if err := setValue(input, tfschemaValue, field.index, field.name, configAttribute(config, field.hclPath), debugLogger); err != nil {
+			return fmt.Errorf("while setting value %+v of model field %q: %+v", tfschemaValue, field.name, err)
 		}
 	}
 	return nil
 }
 
-func setValue(input, tfschemaValue interface{}, index int, fieldName string, debugLogger Logger) (errOut error) {
-	debugLogger.Infof("setting value for %q..", fieldName)
+func setValue(input, tfschemaValue interface{}, index int, fieldName string, config cty.Value, debugLogger Logger) (errOut error) {
 	defer func() {
 		if r := recover(); r != nil {
 			debugLogger.Warnf("error setting value for %q: %+v", fieldName, r)
@@ -96,14 +108,10 @@ func setValue(input, tfschemaValue interface{}, index int, fieldName string, deb
 	if v, ok := tfschemaValue.(string); ok {
 		n := reflect.ValueOf(input).Elem().Field(index)
 		if n.Kind() == reflect.Pointer {
-			debugLogger.Infof("*[String] Decode %+v", v)
 			tmp := reflect.New(n.Type().Elem())
 			tmp.Elem().SetString(v)
 			n.Set(tmp)
 		} else {
-			debugLogger.Infof("[String] Decode %+v", v)
-			debugLogger.Infof("Input %+v", reflect.ValueOf(input))
-			debugLogger.Infof("Input Elem %+v", reflect.ValueOf(input).Elem())
 			n.SetString(v)
 		}
 		return nil
@@ -112,12 +120,10 @@ func setValue(input, tfschemaValue interface{}, index int, fieldName string, deb
 	if v, ok := tfschemaValue.(int); ok {
 		n := reflect.ValueOf(input).Elem().Field(index)
 		if n.Kind() == reflect.Pointer {
-			debugLogger.Infof("*[INT] Decode %+v", v)
 			tmp := reflect.New(n.Type().Elem())
 			tmp.Elem().Set(reflect.ValueOf(int64(v)))
 			n.Set(tmp)
 		} else {
-			debugLogger.Infof("[INT] Decode %+v", v)
 			n.SetInt(int64(v))
 		}
 		return nil
@@ -126,12 +132,10 @@ func setValue(input, tfschemaValue interface{}, index int, fieldName string, deb
 	if v, ok := tfschemaValue.(float64); ok {
 		n := reflect.ValueOf(input).Elem().Field(index)
 		if n.Kind() == reflect.Pointer {
-			debugLogger.Infof("*[Float] Decode %+v", v)
 			tmp := reflect.New(n.Type().Elem())
 			tmp.Elem().SetFloat(v)
 			n.Set(tmp)
 		} else {
-			debugLogger.Infof("[Float] Decode %+v", v)
 			n.SetFloat(v)
 		}
 		return nil
@@ -140,19 +144,18 @@ func setValue(input, tfschemaValue interface{}, index int, fieldName string, deb
 	if v, ok := tfschemaValue.(bool); ok {
 		n := reflect.ValueOf(input).Elem().Field(index)
 		if n.Kind() == reflect.Pointer {
-			debugLogger.Infof("*[Bool] Decode %+v", v)
 			tmp := reflect.New(n.Type().Elem())
 			tmp.Elem().Set(reflect.ValueOf(v))
 			n.Set(tmp)
 		} else {
-			debugLogger.Infof("[BOOL] Decode %+v", v)
 			n.Set(reflect.ValueOf(v))
 		}
 		return nil
 	}
 
 	if v, ok := tfschemaValue.(*schema.Set); ok {
-		return setListValue(input, index, fieldName, v.List(), debugLogger)
+		// the items within a Set can't be matched to the items within the config, since the ordering differs
+		return setListValue(input, index, fieldName, v.List(), cty.NilVal, debugLogger)
 	}
 
 	if mapConfig, ok := tfschemaValue.(map[string]interface{}); ok {
@@ -221,13 +224,13 @@ func setValue(input, tfschemaValue interface{}, index int, fieldName string, deb
 	}
 
 	if v, ok := tfschemaValue.([]interface{}); ok {
-		return setListValue(input, index, fieldName, v, debugLogger)
+		return setListValue(input, index, fieldName, v, config, debugLogger)
 	}
 
 	return nil
 }
 
-func setListValue(input interface{}, index int, fieldName string, v []interface{}, debugLogger Logger) error {
+func setListValue(input interface{}, index int, fieldName string, v []interface{}, config cty.Value, debugLogger Logger) error {
 	fieldType := reflect.ValueOf(input).Elem().Field(index).Type()
 	var slice reflect.Value
 	if reflect.TypeOf(input).Elem().Field(index).Type.Kind() != reflect.Ptr {
@@ -306,26 +309,25 @@ func setListValue(input interface{}, index int, fieldName string, v []interface{
 	default:
 		n := reflect.ValueOf(input).Elem().Field(index)
 		if n.Kind() == reflect.Pointer {
+			nestedCodec, err := codecForType(fieldType.Elem().Elem())
+			if err != nil {
+				return fmt.Errorf("building codec for nested field %q: %+v", fieldName, err)
+			}
+
 			tmp := reflect.New(fieldType.Elem())
 			valueToSet := reflect.MakeSlice(tmp.Elem().Type(), 0, 0)
-			for _, mapVal := range v {
+			for i, mapVal := range v {
 				if test, ok := mapVal.(map[string]interface{}); ok && test != nil {
 					elem := reflect.New(fieldType.Elem().Elem())
-					debugLogger.Infof("element %s", elem.String())
-					for j := 0; j < elem.Type().Elem().NumField(); j++ {
-						nestedField := elem.Type().Elem().Field(j)
-						debugLogger.Infof("nestedField Name: '%s', Tags: '%+v'", nestedField.Name, nestedField.Tag)
-
-						structTags, err := parseStructTags(nestedField.Tag)
-						if err != nil {
-							return fmt.Errorf("parsing struct tags for nested field `%s`: %+v", nestedField.Name, err)
+					elemConfig := configListElement(config, i)
+					for _, nestedField := range nestedCodec.fields {
+						if isPointerToPrimitive(nestedField.fieldType) && attributeIsNullInConfig(elemConfig, nestedField.hclPath) {
+							continue
 						}
 
-						if structTags != nil {
-							nestedTFSchemaValue := test[structTags.hclPath]
-							if err := setValue(elem.Interface(), nestedTFSchemaValue, j, fieldName, debugLogger); err != nil {
-								return err
-							}
+						nestedTFSchemaValue := test[nestedField.hclPath]
+						if err := setValue(elem.Interface(), nestedTFSchemaValue, nestedField.index, fieldName, configAttribute(elemConfig, nestedField.hclPath), debugLogger); err != nil {
+							return err
 						}
 					}
 
@@ -338,35 +340,30 @@ func setListValue(input interface{}, index int, fieldName string, v []interface{
 					} else {
 						valueToSet = reflect.Append(valueToSet, elem)
 					}
-
-					debugLogger.Infof("value to set type after changes %s", valueToSet.Type().String())
 				}
 			}
 
 			tmp.Elem().Set(valueToSet)
 			n.Set(tmp)
 		} else {
-			valueToSet := reflect.MakeSlice(n.Type(), 0, 0)
-			debugLogger.Infof("List Type '%s'", valueToSet.Type().String())
+			nestedCodec, err := codecForType(fieldType.Elem())
+			if err != nil {
+				return fmt.Errorf("building codec for nested field %q: %+v", fieldName, err)
+			}
 
-			for _, mapVal := range v {
+			valueToSet := reflect.MakeSlice(n.Type(), 0, 0)
+			for i, mapVal := range v {
 				if test, ok := mapVal.(map[string]interface{}); ok && test != nil {
 					elem := reflect.New(fieldType.Elem())
-					debugLogger.Infof("element '%s'", elem.String())
-					for j := 0; j < elem.Type().Elem().NumField(); j++ {
-						nestedField := elem.Type().Elem().Field(j)
-						debugLogger.Infof("nestedField Name '%s', Tags: '%+v'", nestedField.Name, nestedField.Tag)
-
-						structTags, err := parseStructTags(nestedField.Tag)
-						if err != nil {
-							return fmt.Errorf("parsing struct tags for nested field '%s': %+v", nestedField.Name, err)
+					elemConfig := configListElement(config, i)
+					for _, nestedField := range nestedCodec.fields {
+						if isPointerToPrimitive(nestedField.fieldType) && attributeIsNullInConfig(elemConfig, nestedField.hclPath) {
+							continue
 						}
 
-						if structTags != nil {
-							nestedTFSchemaValue := test[structTags.hclPath]
-							if err := setValue(elem.Interface(), nestedTFSchemaValue, j, nestedField.Name, debugLogger); err != nil {
-								return err
-							}
+						nestedTFSchemaValue := test[nestedField.hclPath]
+						if err := setValue(elem.Interface(), nestedTFSchemaValue, nestedField.index, nestedField.name, configAttribute(elemConfig, nestedField.hclPath), debugLogger); err != nil {
+							return err
 						}
 					}
 
@@ -379,8 +376,6 @@ func setListValue(input interface{}, index int, fieldName string, v []interface{
 					} else {
 						valueToSet = reflect.Append(valueToSet, elem)
 					}
-
-					debugLogger.Infof("value to set type after changes '%s'", valueToSet.Type().String())
 				}
 			}
 
@@ -392,3 +387,61 @@ func setListValue(input interface{}, index int, fieldName string, v []interface{
 
 	return nil
 }
+
+// isPointerToPrimitive returns whether the specified type is a pointer to a string, int, float or bool - which
+// are left as nil during Decode when the value is null in the config, rather than being set to the zero value
+func isPointerToPrimitive(input reflect.Type) bool {
+	if input.Kind() != reflect.Pointer {
+		return false
+	}
+
+	switch input.Elem().Kind() {
+	case reflect.String, reflect.Int, reflect.Int64, reflect.Float64, reflect.Bool:
+		return true
+	}
+
+	return false
+}
+
+// attributeIsNullInConfig returns whether the attribute is null in the specified config object - returning false
+// when the config is unavailable (for example during Read, or for items within a Set)
+func attributeIsNullInConfig(config cty.Value, attributeName string) bool {
+	attribute := configAttribute(config, attributeName)
+	if attribute == cty.NilVal {
+		return false
+	}
+
+	return attribute.IsKnown() && attribute.IsNull()
+}
+
+// configAttribute returns the value for the specified attribute within the config object, or cty.NilVal when
+// this is unavailable
+func configAttribute(config cty.Value, attributeName string) cty.Value {
+	if config == cty.NilVal || !config.IsKnown() || config.IsNull() {
+		return cty.NilVal
+	}
+
+	if !config.Type().IsObjectType() || !config.Type().HasAttribute(attributeName) {
+		return cty.NilVal
+	}
+
+	return config.GetAttr(attributeName)
+}
+
+// configListElement returns the value at the specified index within the config list, or cty.NilVal when
+// this is unavailable
+func configListElement(config cty.Value, index int) cty.Value {
+	if config == cty.NilVal || !config.IsKnown() || config.IsNull() {
+		return cty.NilVal
+	}
+
+	if !config.Type().IsListType() && !config.Type().IsTupleType() {
+		return cty.NilVal
+	}
+
+	if index >= config.LengthInt() {
+		return cty.NilVal
+	}
+
+	return config.Index(cty.NumberIntVal(int64(index)))
+}
@@ -4,14 +4,17 @@
 package sdk
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-cty/cty"
 )
 
 type decodeTestData struct {
 	State       map[string]interface{}
+	Config      cty.Value
 	Input       interface{}
 	Expected    interface{}
 	ExpectError bool
@@ -1035,6 +1038,111 @@ func TestResourceDecode_NestedThreeLevelsDeepMultipleOptionalItems(t *testing.T)
 	}.test(t)
 }
 
+func TestDecode_TopLevelFieldsOptionalNullInConfig(t *testing.T) {
+	type Type struct {
+		String *string  `tfschema:"string"`
+		Int64  *int64   `tfschema:"int64"`
+		Float  *float64 `tfschema:"float"`
+		Bool   *bool    `tfschema:"bool"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"string": "",
+			"int64":  0,
+			"float":  float64(0),
+			"bool":   false,
+		},
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"string": cty.NullVal(cty.String),
+			"int64":  cty.NumberIntVal(0),
+			"float":  cty.NullVal(cty.Number),
+			"bool":   cty.False,
+		}),
+		Input: &Type{},
+		Expected: &Type{
+			Int64: pointer.To(int64(0)),
+			Bool:  pointer.To(false),
+		},
+	}.test(t)
+}
+
+func TestDecode_TopLevelFieldsOptionalNoConfig(t *testing.T) {
+	// during Read the config is null, so the values from the state should be used
+	type Type struct {
+		String *string `tfschema:"string"`
+		Bool   *bool   `tfschema:"bool"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"string": "",
+			"bool":   false,
+		},
+		Config: cty.NullVal(cty.Object(map[string]cty.Type{
+			"string": cty.String,
+			"bool":   cty.Bool,
+		})),
+		Input: &Type{},
+		Expected: &Type{
+			String: pointer.To(""),
+			Bool:   pointer.To(false),
+		},
+	}.test(t)
+}
+
+func TestResourceDecode_NestedOneLevelDeepOptionalNullInConfig(t *testing.T) {
+	type Inner struct {
+		Name    string  `tfschema:"name"`
+		Value   *string `tfschema:"value"`
+		Enabled *bool   `tfschema:"enabled"`
+	}
+	type Type struct {
+		List []Inner `tfschema:"list"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"list": []interface{}{
+				map[string]interface{}{
+					"name":    "first",
+					"value":   "",
+					"enabled": false,
+				},
+				map[string]interface{}{
+					"name":    "second",
+					"value":   "",
+					"enabled": false,
+				},
+			},
+		},
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"list": cty.ListVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"name":    cty.StringVal("first"),
+					"value":   cty.NullVal(cty.String),
+					"enabled": cty.False,
+				}),
+				cty.ObjectVal(map[string]cty.Value{
+					"name":    cty.StringVal("second"),
+					"value":   cty.StringVal(""),
+					"enabled": cty.NullVal(cty.Bool),
+				}),
+			}),
+		}),
+		Input: &Type{},
+		Expected: &Type{
+			List: []Inner{
+				{
+					Name:    "first",
+					Enabled: pointer.To(false),
+				},
+				{
+					Name:  "second",
+					Value: pointer.To(""),
+				},
+			},
+		},
+	}.test(t)
+}
+
 func (testData decodeTestData) test(t *testing.T) {
 	debugLogger := ConsoleLogger{}
 	state := testData.stateWrapper()
@@ -1058,11 +1166,17 @@ func (testData decodeTestData) test(t *testing.T) {
 func (testData decodeTestData) stateWrapper() testDataGetter {
 	return testDataGetter{
 		values: testData.State,
+		config: testData.Config,
 	}
 }
 
 type testDataGetter struct {
 	values map[string]interface{}
+	config cty.Value
+}
+
+func (td testDataGetter) GetRawConfig() cty.Value {
+	return td.config
 }
 
 func (td testDataGetter) Get(key string) interface{} {
@@ -1079,3 +1193,86 @@ func (td testDataGetter) GetOkExists(key string) (interface{}, bool) {
 	val, ok := td.values[key]
 	return val, ok
 }
+
+type benchmarkNestedModel struct {
+	Name        string             `tfschema:"name"`
+	Enabled     bool               `tfschema:"enabled"`
+	Count       int64              `tfschema:"count"`
+	Weight      *float64           `tfschema:"weight"`
+	Description *string            `tfschema:"description"`
+	Values      []string           `tfschema:"values"`
+	Settings    map[string]string  `tfschema:"settings"`
+	Inner       []benchmarkInner   `tfschema:"inner"`
+	InnerPtr    *[]benchmarkInner  `tfschema:"inner_ptr"`
+	Limits      map[string]float64 `tfschema:"limits"`
+}
+
+type benchmarkInner struct {
+	Name    string   `tfschema:"name"`
+	Port    int64    `tfschema:"port"`
+	Enabled *bool    `tfschema:"enabled"`
+	Hosts   []string `tfschema:"hosts"`
+}
+
+type benchmarkModel struct {
+	Name              string                 `tfschema:"name"`
+	ResourceGroupName string                 `tfschema:"resource_group_name"`
+	Location          string                 `tfschema:"location"`
+	Enabled           bool                   `tfschema:"enabled"`
+	Replicas          int64                  `tfschema:"replicas"`
+	Tags              map[string]string      `tfschema:"tags"`
+	Nested            []benchmarkNestedModel `tfschema:"nested"`
+}
+
+func benchmarkModelState() map[string]interface{} {
+	inner := make([]interface{}, 0)
+	for i := 0; i < 5; i++ {
+		inner = append(inner, map[string]interface{}{
+			"name":    fmt.Sprintf("inner-%d", i),
+			"port":    8080 + i,
+			"enabled": true,
+			"hosts":   []interface{}{"first", "second"},
+		})
+	}
+
+	nested := make([]interface{}, 0)
+	for i := 0; i < 10; i++ {
+		nested = append(nested, map[string]interface{}{
+			"name":        fmt.Sprintf("nested-%d", i),
+			"enabled":     true,
+			"count":       i,
+			"weight":      1.5,
+			"description": "example",
+			"values":      []interface{}{"a", "b", "c"},
+			"settings":    map[string]interface{}{"key": "value"},
+			"inner":       inner,
+			"inner_ptr":   inner,
+			"limits":      map[string]interface{}{"cpu": 0.5},
+		})
+	}
+
+	return map[string]interface{}{
+		"name":                "example",
+		"resource_group_name": "example-resources",
+		"location":            "westeurope",
+		"enabled":             true,
+		"replicas":            3,
+		"tags":                map[string]interface{}{"environment": "test"},
+		"nested":              nested,
+	}
+}
+
+func BenchmarkDecode(b *testing.B) {
+	state := testDataGetter{
+		values: benchmarkModelState(),
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var model benchmarkModel
+		if err := decodeReflectedType(&model, state, NullLogger{}); err != nil {
+			b.Fatalf("decoding: %+v", err)
+		}
+	}
+}
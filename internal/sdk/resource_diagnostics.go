@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"log"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// warningDiagnostics collects the Warning Diagnostics raised during a single operation (e.g. Create or Read),
+// which are returned to Terraform alongside the result of that operation.
+type warningDiagnostics struct {
+	mutex       sync.Mutex
+	diagnostics diag.Diagnostics
+}
+
+func (w *warningDiagnostics) append(diagnostic diag.Diagnostic) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.diagnostics = append(w.diagnostics, diagnostic)
+}
+
+func (w *warningDiagnostics) list() diag.Diagnostics {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return append(diag.Diagnostics{}, w.diagnostics...)
+}
+
+// AddWarning adds a Warning Diagnostic which is surfaced to the user once the current operation completes,
+// for example to highlight that the SKU being used is being retired.
+//
+// Unlike an error this doesn't cause the operation to fail. Warnings can't be surfaced from a CustomizeDiff
+// or during Import, in which case these are logged instead.
+func (rmd ResourceMetaData) AddWarning(summary, detail string) {
+	rmd.addWarning(diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  summary,
+		Detail:   detail,
+	})
+}
+
+// AddAttributeWarning adds a Warning Diagnostic for the specified attribute (e.g. `sku_name` or
+// `network_profile.0.subnet_id`) which is surfaced to the user once the current operation completes.
+func (rmd ResourceMetaData) AddAttributeWarning(attribute, summary, detail string) {
+	rmd.addWarning(diag.Diagnostic{
+		Severity:      diag.Warning,
+		Summary:       summary,
+		Detail:        detail,
+		AttributePath: ConstructCtyPath(attribute),
+	})
+}
+
+func (rmd ResourceMetaData) addWarning(diagnostic diag.Diagnostic) {
+	if rmd.warnings == nil {
+		log.Printf("[WARN] %s: %s", diagnostic.Summary, diagnostic.Detail)
+		return
+	}
+
+	rmd.warnings.append(diagnostic)
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+func TestDiagnosticsWrapperReturnsWarnings(t *testing.T) {
+	wrapped := diagnosticsWrapper(func(ctx context.Context, metaData ResourceMetaData) error {
+		metaData.AddWarning("the SKU is being retired", "the SKU `Basic` is being retired")
+		metaData.AddAttributeWarning("sku_name", "the SKU is being retired", "the SKU `Basic` is being retired")
+		return nil
+	}, NullLogger{})
+
+	diags := wrapped(context.TODO(), nil, &clients.Client{})
+	if diags.HasError() {
+		t.Fatalf("expected no errors but got: %+v", diags)
+	}
+	if len(diags) != 2 {
+		t.Fatalf("expected 2 diagnostics but got %d", len(diags))
+	}
+	for _, v := range diags {
+		if v.Severity != diag.Warning {
+			t.Fatalf("expected a Warning but got %+v", v.Severity)
+		}
+	}
+	if len(diags[1].AttributePath) != 1 {
+		t.Fatalf("expected the second warning to be for the attribute `sku_name` but got %+v", diags[1].AttributePath)
+	}
+
+	// warnings shouldn't be carried over into subsequent operations
+	diags = diagnosticsWrapper(func(ctx context.Context, metaData ResourceMetaData) error {
+		return nil
+	}, NullLogger{})(context.TODO(), nil, &clients.Client{})
+	if len(diags) != 0 {
+		t.Fatalf("expected no diagnostics but got %d", len(diags))
+	}
+}
+
+func TestDiagnosticsWrapperReturnsWarningsAlongsideErrors(t *testing.T) {
+	wrapped := diagnosticsWrapper(func(ctx context.Context, metaData ResourceMetaData) error {
+		metaData.AddWarning("the SKU is being retired", "the SKU `Basic` is being retired")
+		return errors.New("creating the resource")
+	}, NullLogger{})
+
+	diags := wrapped(context.TODO(), nil, &clients.Client{})
+	if !diags.HasError() {
+		t.Fatalf("expected an error but didn't get one")
+	}
+	if len(diags) != 2 {
+		t.Fatalf("expected 2 diagnostics but got %d", len(diags))
+	}
+}
+
+func TestAddWarningWithoutDiagnostics(t *testing.T) {
+	// e.g. within a CustomizeDiff, where warnings can't be returned
+	metaData := ResourceMetaData{
+		Logger: NullLogger{},
+	}
+	metaData.AddWarning("the SKU is being retired", "the SKU `Basic` is being retired")
+}
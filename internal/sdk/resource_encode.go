@@ -49,96 +49,155 @@ func recurse(objType reflect.Type, objVal reflect.Value, debugLogger Logger) (ou
 		}
 	}()
 
-	output = make(map[string]interface{})
-	for i := 0; i < objType.NumField(); i++ {
-		field := objType.Field(i)
-		fieldName = field.Name
-		fieldVal := objVal.Field(i)
-		structTags, err := parseStructTags(field.Tag)
-		if err != nil {
-			return nil, fmt.Errorf("parsing struct tags for %q: %+v", field.Name, err)
+	codec, err := codecForType(objType)
+	if err != nil {
+		return nil, err
+	}
+
+	output = make(map[string]interface{}, len(codec.fields))
+	for _, field := range codec.fields {
+		fieldName = field.name
+		fieldVal := objVal.Field(field.index)
+		if field.removedInNextMajorVersion && features.FivePointOh() {
+			debugLogger.Infof("The HCL Path %q is marked as removed - skipping", field.hclPath)
+			continue
 		}
 
-		if structTags != nil {
-			if structTags.removedInNextMajorVersion && features.FivePointOh() {
-				debugLogger.Infof("The HCL Path %q is marked as removed - skipping", structTags.hclPath)
-				continue
+		if field.addedInNextMajorVersion && !features.FivePointOh() {
+			debugLogger.Infof("The HCL Path %q is marked as not yet present - skipping", field.hclPath)
+			continue
+		}
+
+		switch field.fieldType.Kind() {
+		case reflect.Int64:
+			output[field.hclPath] = fieldVal.Int()
+
+		case reflect.Float64:
+			output[field.hclPath] = fieldVal.Float()
+
+		case reflect.String:
+			output[field.hclPath] = fieldVal.String()
+
+		case reflect.Bool:
+			output[field.hclPath] = fieldVal.Bool()
+
+		case reflect.Map:
+			iter := fieldVal.MapRange()
+			attr := make(map[string]interface{})
+			for iter.Next() {
+				attr[iter.Key().String()] = iter.Value().Interface()
+			}
+			output[field.hclPath] = attr
+
+		case reflect.Slice:
+			sv := fieldVal.Slice(0, fieldVal.Len())
+			attr := make([]interface{}, sv.Len())
+			switch sv.Type().Elem().Kind() {
+			case reflect.String:
+				if sv.Len() > 0 {
+					output[field.hclPath] = sv.Interface()
+				} else {
+					output[field.hclPath] = make([]string, 0)
+				}
+
+			case reflect.Int64:
+				if sv.Len() > 0 {
+					output[field.hclPath] = sv.Interface()
+				} else {
+					output[field.hclPath] = make([]int64, 0)
+				}
+
+			case reflect.Float64:
+				if sv.Len() > 0 {
+					output[field.hclPath] = sv.Interface()
+				} else {
+					output[field.hclPath] = make([]float64, 0)
+				}
+
+			case reflect.Bool:
+				if sv.Len() > 0 {
+					output[field.hclPath] = sv.Interface()
+				} else {
+					output[field.hclPath] = make([]bool, 0)
+				}
+
+			default:
+				for i := 0; i < sv.Len(); i++ {
+					nestedType := sv.Index(i).Type()
+					nestedValue := sv.Index(i)
+
+					serialized, err := recurse(nestedType, nestedValue, debugLogger)
+					if err != nil {
+						return nil, fmt.Errorf("serializing nested object %q: %+v", sv.Type(), err)
+					}
+					attr[i] = serialized
+				}
+				output[field.hclPath] = attr
 			}
 
-			if structTags.addedInNextMajorVersion && !features.FivePointOh() {
-				debugLogger.Infof("The HCL Path %q is marked as not yet present - skipping", structTags.hclPath)
+		case reflect.Pointer:
+			if fieldVal.IsNil() {
+				// a nil pointer is explicitly set to null, so that any existing value in the state is removed
+				output[field.hclPath] = nil
 				continue
 			}
 
-			switch field.Type.Kind() {
-			case reflect.Int64:
-				iv := fieldVal.Int()
-				debugLogger.Infof("Setting %q to %d", structTags.hclPath, iv)
-				output[structTags.hclPath] = iv
+			pv := fieldVal.Elem()
+			switch pv.Kind() {
+			case reflect.Int, reflect.Int64:
+				output[field.hclPath] = pv.Int()
 
 			case reflect.Float64:
-				fv := fieldVal.Float()
-				debugLogger.Infof("Setting %q to %f", structTags.hclPath, fv)
-				output[structTags.hclPath] = fv
+				output[field.hclPath] = pv.Float()
 
 			case reflect.String:
-				sv := fieldVal.String()
-				debugLogger.Infof("Setting %q to %q", structTags.hclPath, sv)
-				output[structTags.hclPath] = sv
+				output[field.hclPath] = pv.String()
 
 			case reflect.Bool:
-				bv := fieldVal.Bool()
-				debugLogger.Infof("Setting %q to %t", structTags.hclPath, bv)
-				output[structTags.hclPath] = bv
+				output[field.hclPath] = pv.Bool()
 
 			case reflect.Map:
-				iter := fieldVal.MapRange()
+				iter := pv.MapRange()
 				attr := make(map[string]interface{})
 				for iter.Next() {
 					attr[iter.Key().String()] = iter.Value().Interface()
 				}
-				output[structTags.hclPath] = attr
+				output[field.hclPath] = attr
 
 			case reflect.Slice:
-				sv := fieldVal.Slice(0, fieldVal.Len())
+				sv := pv.Slice(0, pv.Len())
 				attr := make([]interface{}, sv.Len())
 				switch sv.Type().Elem().Kind() {
 				case reflect.String:
-					debugLogger.Infof("Setting %q to []string", structTags.hclPath)
 					if sv.Len() > 0 {
-						output[structTags.hclPath] = sv.Interface()
+						output[field.hclPath] = sv.Interface()
 					} else {
-						output[structTags.hclPath] = make([]string, 0)
+						output[field.hclPath] = make([]string, 0)
 					}
 
 				case reflect.Int64:
-					debugLogger.Infof("Setting %q to []int", structTags.hclPath)
 					if sv.Len() > 0 {
-						output[structTags.hclPath] = sv.Interface()
+						output[field.hclPath] = sv.Interface()
 					} else {
-						output[structTags.hclPath] = make([]int64, 0)
+						output[field.hclPath] = make([]int64, 0)
 					}
 
 				case reflect.Float64:
-					debugLogger.Infof("Setting %q to []float64", structTags.hclPath)
 					if sv.Len() > 0 {
-						output[structTags.hclPath] = sv.Interface()
+						output[field.hclPath] = sv.Interface()
 					} else {
-						output[structTags.hclPath] = make([]float64, 0)
+						output[field.hclPath] = make([]float64, 0)
 					}
 
 				case reflect.Bool:
-					debugLogger.Infof("Setting %q to []bool", structTags.hclPath)
 					if sv.Len() > 0 {
-						output[structTags.hclPath] = sv.Interface()
+						output[field.hclPath] = sv.Interface()
 					} else {
-						output[structTags.hclPath] = make([]bool, 0)
+						output[field.hclPath] = make([]bool, 0)
 					}
 
 				default:
 					for i := 0; i < sv.Len(); i++ {
-						debugLogger.Infof("[SLICE] Index %d is %q", i, sv.Index(i).Interface())
-						debugLogger.Infof("[SLICE] Type %+v", sv.Type())
 						nestedType := sv.Index(i).Type()
 						nestedValue := sv.Index(i)
 
@@ -148,103 +207,12 @@ func recurse(objType reflect.Type, objVal reflect.Value, debugLogger Logger) (ou
 						}
 						attr[i] = serialized
 					}
-					debugLogger.Infof("[SLICE] Setting %q to %+v", structTags.hclPath, attr)
-					output[structTags.hclPath] = attr
-				}
-
-			case reflect.Pointer:
-				if !fieldVal.IsNil() {
-					pv := fieldVal.Elem()
-					switch pv.Kind() {
-					case reflect.Int, reflect.Int64:
-						iv := pv.Int()
-						debugLogger.Infof("Setting %q to %d", structTags.hclPath, iv)
-						output[structTags.hclPath] = iv
-
-					case reflect.Float64:
-						fv := pv.Float()
-						debugLogger.Infof("Setting %q to %f", structTags.hclPath, fv)
-						output[structTags.hclPath] = fv
-
-					case reflect.String:
-						sv := pv.String()
-						debugLogger.Infof("Setting %q to %q", structTags.hclPath, sv)
-						output[structTags.hclPath] = sv
-
-					case reflect.Bool:
-						bv := pv.Bool()
-						debugLogger.Infof("Setting %q to %t", structTags.hclPath, bv)
-						output[structTags.hclPath] = bv
-
-					case reflect.Map:
-						iter := pv.MapRange()
-						attr := make(map[string]interface{})
-						for iter.Next() {
-							attr[iter.Key().String()] = iter.Value().Interface()
-						}
-						output[structTags.hclPath] = attr
-
-					case reflect.Slice:
-						sv := pv.Slice(0, pv.Len())
-						attr := make([]interface{}, sv.Len())
-						switch sv.Type().Elem().Kind() {
-						case reflect.String:
-							debugLogger.Infof("Setting %q to []string", structTags.hclPath)
-							if sv.Len() > 0 {
-								output[structTags.hclPath] = sv.Interface()
-							} else {
-								output[structTags.hclPath] = make([]string, 0)
-							}
-
-						case reflect.Int64:
-							debugLogger.Infof("Setting %q to []int", structTags.hclPath)
-							if sv.Len() > 0 {
-								output[structTags.hclPath] = sv.Interface()
-							} else {
-								output[structTags.hclPath] = make([]int64, 0)
-							}
-
-						case reflect.Float64:
-							debugLogger.Infof("Setting %q to []float64", structTags.hclPath)
-							if sv.Len() > 0 {
-								output[structTags.hclPath] = sv.Interface()
-							} else {
-								output[structTags.hclPath] = make([]float64, 0)
-							}
-
-						case reflect.Bool:
-							debugLogger.Infof("Setting %q to []bool", structTags.hclPath)
-							if sv.Len() > 0 {
-								output[structTags.hclPath] = sv.Interface()
-							} else {
-								output[structTags.hclPath] = make([]bool, 0)
-							}
-
-						default:
-							for i := 0; i < sv.Len(); i++ {
-								debugLogger.Infof("[SLICE] Index %d is %q", i, sv.Index(i).Interface())
-								debugLogger.Infof("[SLICE] Type %+v", sv.Type())
-								nestedType := sv.Index(i).Type()
-								nestedValue := sv.Index(i)
-
-								serialized, err := recurse(nestedType, nestedValue, debugLogger)
-								if err != nil {
-									return nil, fmt.Errorf("serializing nested object %q: %+v", sv.Type(), err)
-								}
-								attr[i] = serialized
-							}
-							debugLogger.Infof("[SLICE] Setting %q to %+v", structTags.hclPath, attr)
-							output[structTags.hclPath] = attr
-						}
-					}
-				} else {
-					debugLogger.Infof("Setting %q to nil", structTags.hclPath)
-					output[structTags.hclPath] = nil
+					output[field.hclPath] = attr
 				}
-
-			default:
-				return output, fmt.Errorf("unknown type %+v for key %q", field.Type.Kind(), structTags.hclPath)
 			}
+
+		default:
+			return output, fmt.Errorf("unknown type %+v for key %q", field.fieldType.Kind(), field.hclPath)
 		}
 	}
 
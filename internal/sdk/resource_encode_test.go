@@ -9,6 +9,8 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 type encodeTestData struct {
@@ -1048,6 +1050,83 @@ func TestResourceEncode_NestedThreeLevelsDeepMultipleOptionalItems(t *testing.T)
 	}.test(t)
 }
 
+func TestResourceEncode_TopLevelPointersSetToNil(t *testing.T) {
+	type SimpleType struct {
+		StringPtr        *string            `tfschema:"string_ptr"`
+		NumberPtr        *int64             `tfschema:"number_ptr"`
+		EnabledPtr       *bool              `tfschema:"enabled_ptr"`
+		ListOfStringsPtr *[]string          `tfschema:"list_of_strings_ptr"`
+		MapOfStringsPtr  *map[string]string `tfschema:"map_of_strings_ptr"`
+	}
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"string_ptr": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"number_ptr": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"enabled_ptr": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"list_of_strings_ptr": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"map_of_strings_ptr": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+
+	// the values previously set in the state should be removed when the pointers are nil
+	d := resource.Data(&terraform.InstanceState{
+		ID: "example",
+		Attributes: map[string]string{
+			"id":                    "example",
+			"string_ptr":            "hello",
+			"number_ptr":            "42",
+			"enabled_ptr":           "true",
+			"list_of_strings_ptr.#": "1",
+			"list_of_strings_ptr.0": "world",
+			"map_of_strings_ptr.%":  "1",
+			"map_of_strings_ptr.k":  "v",
+		},
+	})
+
+	metadata := ResourceMetaData{
+		ResourceData:             d,
+		serializationDebugLogger: ConsoleLogger{},
+	}
+	if err := metadata.Encode(&SimpleType{}); err != nil {
+		t.Fatalf("encoding: %+v", err)
+	}
+
+	// the Plugin SDK stores null primitives as their zero value
+	expected := map[string]string{
+		"id":                    "example",
+		"string_ptr":            "",
+		"number_ptr":            "0",
+		"enabled_ptr":           "false",
+		"list_of_strings_ptr.#": "0",
+		"map_of_strings_ptr.%":  "0",
+	}
+	if diff := cmp.Diff(expected, d.State().Attributes); diff != "" {
+		t.Fatalf("State mismatch, diff:\n\n %s", diff)
+	}
+}
+
 func (testData encodeTestData) test(t *testing.T) {
 	objType := reflect.TypeOf(testData.Input).Elem()
 	objVal := reflect.ValueOf(testData.Input).Elem()
@@ -1070,3 +1149,20 @@ func (testData encodeTestData) test(t *testing.T) {
 		t.Fatalf("Output mismatch, diff:\n\n %s", diff)
 	}
 }
+
+func BenchmarkEncode(b *testing.B) {
+	var model benchmarkModel
+	if err := decodeReflectedType(&model, testDataGetter{values: benchmarkModelState()}, NullLogger{}); err != nil {
+		b.Fatalf("decoding: %+v", err)
+	}
+	objType := reflect.TypeOf(model)
+	objVal := reflect.ValueOf(model)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := recurse(objType, objVal, NullLogger{}); err != nil {
+			b.Fatalf("encoding: %+v", err)
+		}
+	}
+}
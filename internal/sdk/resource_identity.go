@@ -42,6 +42,7 @@ func segmentTypeSupported(segment resourceids.SegmentType) bool {
 	supportedSegmentTypes := []resourceids.SegmentType{
 		resourceids.SubscriptionIdSegmentType,
 		resourceids.ResourceGroupSegmentType,
+		resourceids.ScopeSegmentType,
 		resourceids.UserSpecifiedSegmentType,
 	}
 
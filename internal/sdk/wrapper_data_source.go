@@ -38,6 +38,9 @@ func (dw *DataSourceWrapper) DataSource() (*schema.Resource, error) {
 		if err := ValidateModelObject(modelObj); err != nil {
 			return nil, fmt.Errorf("validating model for %q: %+v", dw.dataSource.ResourceType(), err)
 		}
+		if err := ValidateModelObjectFieldsMatchSchema(modelObj, *resourceSchema, dataSourceSchemaKeysOutsideOfModel[dw.dataSource.ResourceType()]...); err != nil {
+			return nil, fmt.Errorf("validating model for %q: %+v", dw.dataSource.ResourceType(), err)
+		}
 	}
 
 	d := func(duration time.Duration) *time.Duration {
@@ -46,8 +49,7 @@ func (dw *DataSourceWrapper) DataSource() (*schema.Resource, error) {
 
 	resource := schema.Resource{
 		Schema: *resourceSchema,
-		ReadContext: dw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, dw.logger)
+		ReadContext: dw.diagnosticsWrapper(func(ctx context.Context, metaData ResourceMetaData) error {
 			return dw.dataSource.Read().Func(ctx, metaData)
 		}),
 		Timeouts: &schema.ResourceTimeout{
@@ -71,6 +73,6 @@ and we recommend using the %[2]q datasource instead.
 	return &resource, nil
 }
 
-func (dw *DataSourceWrapper) diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error) schema.ReadContextFunc {
+func (dw *DataSourceWrapper) diagnosticsWrapper(in func(ctx context.Context, metaData ResourceMetaData) error) schema.ReadContextFunc {
 	return diagnosticsWrapper(in, dw.logger)
 }
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -42,6 +43,9 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		if err := ValidateModelObject(modelObj); err != nil {
 			return nil, fmt.Errorf("validating model for %q: %+v", rw.resource.ResourceType(), err)
 		}
+		if err := ValidateModelObjectFieldsMatchSchema(modelObj, *resourceSchema, resourceSchemaKeysOutsideOfModel[rw.resource.ResourceType()]...); err != nil {
+			return nil, fmt.Errorf("validating model for %q: %+v", rw.resource.ResourceType(), err)
+		}
 	}
 
 	d := func(duration time.Duration) *time.Duration {
@@ -51,8 +55,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 	resource := schema.Resource{
 		Schema: *resourceSchema,
 
-		CreateContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
+		CreateContext: rw.diagnosticsWrapper(func(ctx context.Context, metaData ResourceMetaData) error {
 			err := rw.resource.Create().Func(ctx, metaData)
 			if err != nil {
 				return err
@@ -60,16 +63,14 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 			// NOTE: whilst this may look like we should use the Read
 			// functions timeout here, we're still /technically/ in the
 			// Create function so reusing that timeout should be sufficient
-			return rw.resource.Read().Func(ctx, metaData)
+			return rw.read(ctx, metaData)
 		}),
 
 		// looks like these could be reused, easiest if they're not
-		ReadContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
-			return rw.resource.Read().Func(ctx, metaData)
+		ReadContext: rw.diagnosticsWrapper(func(ctx context.Context, metaData ResourceMetaData) error {
+			return rw.read(ctx, metaData)
 		}),
-		DeleteContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
+		DeleteContext: rw.diagnosticsWrapper(func(ctx context.Context, metaData ResourceMetaData) error {
 			return rw.resource.Delete().Func(ctx, metaData)
 		}),
 
@@ -117,9 +118,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 	// Not all resources support update - so this is an separate interface
 	// implementations can opt to interface
 	if v, ok := rw.resource.(ResourceWithUpdate); ok {
-		resource.UpdateContext = rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
-
+		resource.UpdateContext = rw.diagnosticsWrapper(func(ctx context.Context, metaData ResourceMetaData) error {
 			err := v.Update().Func(ctx, metaData)
 			if err != nil {
 				return err
@@ -127,7 +126,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 			// whilst this may look like we should use the Update timeout here
 			// we're still "technically" in the update method, so reusing the
 			// Update's timeout should be fine
-			return rw.resource.Read().Func(ctx, metaData)
+			return rw.read(ctx, metaData)
 		})
 		resource.Timeouts.Update = d(v.Update().Timeout)
 	}
@@ -177,11 +176,7 @@ and we recommend using the %[2]q resource instead.
 	// TODO: State Migrations
 
 	if v, ok := rw.resource.(ResourceWithIdentity); ok {
-		var idType pluginsdk.ResourceTypeForIdentity = pluginsdk.ResourceTypeForIdentityDefault
-		if v, ok := rw.resource.(ResourceWithIdentityTypeOverride); ok {
-			idType = v.IdentityType()
-		}
-
+		idType := rw.identityType()
 		resourceId := v.Identity()
 		resource.Identity = &schema.ResourceIdentity{
 			SchemaFunc: pluginsdk.GenerateIdentitySchema(resourceId, idType),
@@ -205,17 +200,64 @@ and we recommend using the %[2]q resource instead.
 		}, idType)
 	}
 
+	pluginsdk.RecaseResourceIdsOnRead(&resource)
+
 	return &resource, nil
 }
 
-func (rw *ResourceWrapper) diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func (rw *ResourceWrapper) identityType() pluginsdk.ResourceTypeForIdentity {
+	if v, ok := rw.resource.(ResourceWithIdentityTypeOverride); ok {
+		return v.IdentityType()
+	}
+	return pluginsdk.ResourceTypeForIdentityDefault
+}
+
+// read runs the Read function for the resource, after which the Resource Identity data is set from the ID of the
+// resource if the Read function hasn't done so - which is the case for resources generated by Pandora
+func (rw *ResourceWrapper) read(ctx context.Context, metaData ResourceMetaData) error {
+	if err := rw.resource.Read().Func(ctx, metaData); err != nil {
+		return err
+	}
+
+	v, ok := rw.resource.(ResourceWithIdentity)
+	if !ok || metaData.ResourceData.Id() == "" {
+		return nil
+	}
+
+	idType := rw.identityType()
+	identity, err := metaData.ResourceData.Identity()
+	if err != nil {
+		return fmt.Errorf("getting identity: %+v", err)
+	}
+	for key := range pluginsdk.GenerateIdentitySchema(v.Identity(), idType)() {
+		if _, ok := identity.GetOk(key); ok {
+			return nil
+		}
+	}
+
+	id := v.Identity()
+	parsed, err := resourceids.NewParserFromResourceIdType(id).Parse(metaData.ResourceData.Id(), true)
+	if err != nil {
+		return fmt.Errorf("parsing %q: %+v", metaData.ResourceData.Id(), err)
+	}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return fmt.Errorf("populating %q: %+v", metaData.ResourceData.Id(), err)
+	}
+
+	return pluginsdk.SetResourceIdentityData(metaData.ResourceData, id, idType)
+}
+
+func (rw *ResourceWrapper) diagnosticsWrapper(in func(ctx context.Context, metaData ResourceMetaData) error) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return diagnosticsWrapper(in, rw.logger)
 }
 
-func diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error, logger Logger) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func diagnosticsWrapper(in func(ctx context.Context, metaData ResourceMetaData) error, logger Logger) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		metaData := runArgs(d, meta, logger)
+		metaData.warnings = &warningDiagnostics{}
+
 		out := make([]diag.Diagnostic, 0)
-		if err := in(ctx, d, meta); err != nil {
+		if err := in(ctx, metaData); err != nil {
 			out = append(out, diag.Diagnostic{
 				Severity:      diag.Error,
 				Summary:       err.Error(),
@@ -224,6 +266,9 @@ func diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, met
 			})
 		}
 
+		// warnings are returned irrespective of whether the operation succeeded
+		out = append(out, metaData.warnings.list()...)
+
 		if diagsLogger, ok := logger.(*DiagnosticsLogger); ok {
 			out = append(out, diagsLogger.diagnostics...)
 		}
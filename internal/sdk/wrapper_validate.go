@@ -6,7 +6,10 @@ package sdk
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // ValidateModelObject validates that the object contains the specified `tfschema` tags
@@ -71,3 +74,150 @@ func validateModelObjectRecursively(prefix string, objType reflect.Type, objVal
 
 	return nil
 }
+
+// ValidateModelObjectFieldsMatchSchema validates that each field in the model object has a corresponding key in the
+// schema of a compatible type, and that each key in the schema has a corresponding field in the model - returning the
+// details of each mismatch. Schema keys (or paths to nested schema keys, e.g. `site_config.linux_fx_version`) listed
+// in `schemaKeysOutsideOfModel` are allowed to have no corresponding field, since these are set outside of the model.
+func ValidateModelObjectFieldsMatchSchema(input interface{}, schema map[string]*schema.Schema, schemaKeysOutsideOfModel ...string) error {
+	keysOutsideOfModel := make(map[string]struct{})
+	for _, key := range schemaKeysOutsideOfModel {
+		keysOutsideOfModel[key] = struct{}{}
+	}
+
+	return validateModelObjectMatchesSchema(input, schema, keysOutsideOfModel)
+}
+
+func validateModelObjectMatchesSchema(input interface{}, schema map[string]*schema.Schema, keysOutsideOfModel map[string]struct{}) error {
+	if input == nil {
+		// model not used for this resource
+		return nil
+	}
+
+	if reflect.TypeOf(input).Kind() != reflect.Ptr {
+		return fmt.Errorf("need a pointer to the model object")
+	}
+
+	objType := reflect.TypeOf(input).Elem()
+	if objType.Kind() != reflect.Struct {
+		return fmt.Errorf("need a pointer to a struct, got %q", objType.String())
+	}
+
+	mismatches := validateModelObjectMatchesSchemaRecursively("", objType, schema, keysOutsideOfModel)
+	if len(mismatches) == 0 {
+		return nil
+	}
+
+	sort.Strings(mismatches)
+	return fmt.Errorf("the model %q doesn't match the schema:\n* %s", objType.Name(), strings.Join(mismatches, "\n* "))
+}
+
+func validateModelObjectMatchesSchemaRecursively(prefix string, objType reflect.Type, schemaMap map[string]*schema.Schema, keysOutsideOfModel map[string]struct{}) (mismatches []string) {
+	fieldsForKeys := make(map[string]struct{})
+
+	for i := 0; i < objType.NumField(); i++ {
+		field := objType.Field(i)
+		structTags, err := parseStructTags(field.Tag)
+		if err != nil || structTags == nil {
+			// missing/invalid struct tags are caught by ValidateModelObject
+			continue
+		}
+
+		path := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, structTags.hclPath), ".")
+		fieldsForKeys[structTags.hclPath] = struct{}{}
+
+		item, ok := schemaMap[structTags.hclPath]
+		if !ok {
+			// fields for the next major version are only present in the schema when the feature flag is enabled
+			if structTags.addedInNextMajorVersion || structTags.removedInNextMajorVersion {
+				continue
+			}
+			mismatches = append(mismatches, fmt.Sprintf("the field %q (%s) has no corresponding key in the schema", field.Name, path))
+			continue
+		}
+
+		mismatches = append(mismatches, validateFieldMatchesSchema(path, field.Name, field.Type, item, keysOutsideOfModel)...)
+	}
+
+	for key := range schemaMap {
+		if _, ok := fieldsForKeys[key]; ok {
+			continue
+		}
+
+		path := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, key), ".")
+		if _, ok := keysOutsideOfModel[path]; !ok {
+			mismatches = append(mismatches, fmt.Sprintf("the schema key %q has no corresponding field in the model", path))
+		}
+	}
+
+	return mismatches
+}
+
+func validateFieldMatchesSchema(path, fieldName string, fieldType reflect.Type, item *schema.Schema, keysOutsideOfModel map[string]struct{}) []string {
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+
+	switch item.Type {
+	case schema.TypeList, schema.TypeSet:
+		if fieldType.Kind() != reflect.Slice {
+			return []string{fmt.Sprintf("the field %q (%s) should be a slice for a %s but got %q", fieldName, path, item.Type, fieldType.String())}
+		}
+
+		elemType := fieldType.Elem()
+		if elemType.Kind() == reflect.Ptr {
+			elemType = elemType.Elem()
+		}
+
+		switch elem := item.Elem.(type) {
+		case *schema.Resource:
+			if elemType.Kind() != reflect.Struct {
+				return []string{fmt.Sprintf("the field %q (%s) should be a slice of structs for a nested block but got %q", fieldName, path, fieldType.String())}
+			}
+			return validateModelObjectMatchesSchemaRecursively(path, elemType, elem.Schema, keysOutsideOfModel)
+
+		case *schema.Schema:
+			if !kindMatchesSchemaType(elemType, elem.Type) {
+				return []string{fmt.Sprintf("the field %q (%s) should be a slice of a type compatible with %s but got %q", fieldName, path, elem.Type, fieldType.String())}
+			}
+		}
+
+	case schema.TypeMap:
+		if fieldType.Kind() != reflect.Map || fieldType.Key().Kind() != reflect.String {
+			return []string{fmt.Sprintf("the field %q (%s) should be a map with string keys for a %s but got %q", fieldName, path, item.Type, fieldType.String())}
+		}
+
+		elemType := schema.TypeString
+		if elem, ok := item.Elem.(*schema.Schema); ok {
+			elemType = elem.Type
+		}
+		if !kindMatchesSchemaType(fieldType.Elem(), elemType) {
+			return []string{fmt.Sprintf("the field %q (%s) should be a map of a type compatible with %s but got %q", fieldName, path, elemType, fieldType.String())}
+		}
+
+	default:
+		if !kindMatchesSchemaType(fieldType, item.Type) {
+			return []string{fmt.Sprintf("the field %q (%s) should be a type compatible with %s but got %q", fieldName, path, item.Type, fieldType.String())}
+		}
+	}
+
+	return nil
+}
+
+func kindMatchesSchemaType(t reflect.Type, schemaType schema.ValueType) bool {
+	switch t.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.String:
+		return schemaType == schema.TypeString
+	case reflect.Bool:
+		return schemaType == schema.TypeBool
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		// the width of the integer is checked by the `checkBittiness` static analysis rule
+		return schemaType == schema.TypeInt
+	case reflect.Float32, reflect.Float64:
+		return schemaType == schema.TypeFloat
+	}
+
+	return false
+}
@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+// dataSourceSchemaKeysOutsideOfModel are the Schema keys (or paths to nested Schema keys) for each Typed Data Source
+// which have no corresponding field in the Model, since these are set outside of the Model.
+var dataSourceSchemaKeysOutsideOfModel = map[string][]string{
+	// new Data Sources shouldn't be added to this list - instead add the field to the Model
+	"azurerm_arc_machine": {
+		"active_directory_fqdn",
+		"agent",
+		"agent_version",
+		"client_public_key",
+		"cloud_metadata",
+		"detected_properties",
+		"display_name",
+		"dns_fqdn",
+		"domain_name",
+		"identity",
+		"last_status_change_time",
+		"location",
+		"location_data",
+		"machine_fqdn",
+		"mssql_discovered",
+		"name",
+		"os_name",
+		"os_profile",
+		"os_sku",
+		"os_type",
+		"os_version",
+		"parent_cluster_resource_id",
+		"private_link_scope_resource_id",
+		"resource_group_name",
+		"service_status",
+		"status",
+		"tags",
+		"vm_id",
+		"vm_uuid",
+	},
+	"azurerm_automation_variables": {
+		"encrypted.value",
+		"null.value",
+	},
+	"azurerm_databricks_access_connector": {
+		"identity",
+		"location",
+		"tags",
+	},
+	"azurerm_linux_function_app": {
+		"identity",
+	},
+	"azurerm_mobile_network_sim_group": {
+		"identity.principal_id",
+		"identity.tenant_id",
+	},
+	"azurerm_monitor_data_collection_rule": {
+		"identity",
+	},
+	"azurerm_monitor_workspace": {
+		"default_data_collection_endpoint_id",
+		"default_data_collection_rule_id",
+		"location",
+		"name",
+		"public_network_access_enabled",
+		"query_endpoint",
+		"resource_group_name",
+		"tags",
+	},
+	"azurerm_mssql_managed_database": {
+		"long_term_retention_policy.immutable_backups_enabled",
+	},
+	"azurerm_netapp_backup_policy": {
+		"daily_backups_to_keep",
+		"enabled",
+		"monthly_backups_to_keep",
+		"weekly_backups_to_keep",
+	},
+	"azurerm_oracle_autonomous_database": {
+		"db_node_storage_size_in_gbs",
+	},
+	"azurerm_oracle_exadata_infrastructure": {
+		"maintenance_window.custom_action_timeout_enabled",
+		"maintenance_window.custom_action_timeout_in_mins",
+		"maintenance_window.monthly_patching_enabled",
+	},
+	"azurerm_orchestrated_virtual_machine_scale_set": {
+		"network_interface.ip_configuration.load_balancer_inbound_nat_rules_ids",
+	},
+	"azurerm_site_recovery_replication_recovery_plan": {
+		"failover_deployment_model",
+	},
+	"azurerm_vpn_server_configuration": {
+		"azure_active_directory_authentication",
+		"client_revoked_certificate",
+		"client_root_certificate",
+		"ipsec_policy",
+		"location",
+		"name",
+		"radius",
+		"resource_group_name",
+		"tags",
+		"vpn_authentication_types",
+		"vpn_protocols",
+	},
+	"azurerm_windows_web_app": {
+		"site_config.application_stack.python_version",
+	},
+}
+
+// resourceSchemaKeysOutsideOfModel are the Schema keys (or paths to nested Schema keys) for each Typed Resource
+// which have no corresponding field in the Model, since these are set outside of the Model.
+var resourceSchemaKeysOutsideOfModel = map[string][]string{
+	// new Resources shouldn't be added to this list - instead add the field to the Model
+	"azurerm_ai_services": {
+		"storage",
+	},
+	"azurerm_app_configuration_feature": {
+		"etag",
+	},
+	"azurerm_application_insights_workbook": {
+		"identity",
+	},
+	"azurerm_arc_kubernetes_cluster_extension": {
+		"identity",
+	},
+	"azurerm_arc_resource_bridge_appliance": {
+		"distro",
+		"identity",
+		"infrastructure_provider",
+		"location",
+		"name",
+		"public_key_base64",
+		"resource_group_name",
+		"tags",
+	},
+	"azurerm_container_app_environment_dapr_component": {
+		"secret.identity",
+		"secret.key_vault_secret_id",
+	},
+	"azurerm_container_registry_task": {
+		"identity",
+	},
+	"azurerm_cosmosdb_postgresql_coordinator_configuration": {
+		"cluster_id",
+		"name",
+		"value",
+	},
+	"azurerm_cosmosdb_postgresql_node_configuration": {
+		"cluster_id",
+		"name",
+		"value",
+	},
+	"azurerm_dashboard_grafana": {
+		"identity",
+	},
+	"azurerm_data_protection_backup_vault_customer_managed_key": {
+		"data_protection_backup_vault_id",
+		"key_vault_key_id",
+	},
+	"azurerm_databricks_access_connector": {
+		"identity",
+	},
+	"azurerm_fabric_capacity": {
+		"administration_members",
+		"location",
+		"name",
+		"resource_group_name",
+		"sku",
+		"tags",
+	},
+	"azurerm_iothub_device_update_account": {
+		"identity",
+	},
+	"azurerm_iothub_endpoint_cosmosdb_account": {
+		"authentication_type",
+		"container_name",
+		"database_name",
+		"endpoint_uri",
+		"identity_id",
+		"iothub_id",
+		"name",
+		"partition_key_name",
+		"partition_key_template",
+		"primary_key",
+		"resource_group_name",
+		"secondary_key",
+		"subscription_id",
+	},
+	"azurerm_kubernetes_cluster_extension": {
+		"aks_assigned_identity",
+	},
+	"azurerm_kubernetes_fleet_manager": {
+		"hub_profile",
+	},
+	"azurerm_monitor_data_collection_rule": {
+		"identity",
+	},
+	"azurerm_mssql_managed_database": {
+		"long_term_retention_policy.immutable_backups_enabled",
+	},
+	"azurerm_network_manager_deployment": {
+		"triggers",
+	},
+	"azurerm_new_relic_monitor": {
+		"identity",
+	},
+	"azurerm_oracle_autonomous_database": {
+		"admin_password",
+		"allowed_ips",
+		"auto_scaling_enabled",
+		"auto_scaling_for_storage_enabled",
+		"backup_retention_period_in_days",
+		"character_set",
+		"compute_count",
+		"compute_model",
+		"customer_contacts",
+		"data_storage_size_in_tbs",
+		"db_version",
+		"db_workload",
+		"display_name",
+		"license_model",
+		"location",
+		"long_term_backup_schedule",
+		"mtls_connection_required",
+		"name",
+		"national_character_set",
+		"resource_group_name",
+		"subnet_id",
+		"tags",
+		"virtual_network_id",
+	},
+	"azurerm_oracle_cloud_vm_cluster": {
+		"backup_subnet_cidr",
+		"cloud_exadata_infrastructure_id",
+		"cluster_name",
+		"cpu_core_count",
+		"data_collection_options",
+		"data_storage_percentage",
+		"data_storage_size_in_tbs",
+		"db_node_storage_size_in_gbs",
+		"db_servers",
+		"display_name",
+		"domain",
+		"file_system_configuration",
+		"gi_version",
+		"hostname",
+		"hostname_actual",
+		"license_model",
+		"local_backup_enabled",
+		"location",
+		"memory_size_in_gbs",
+		"name",
+		"ocid",
+		"resource_group_name",
+		"scan_listener_port_tcp",
+		"scan_listener_port_tcp_ssl",
+		"sparse_diskgroup_enabled",
+		"ssh_public_keys",
+		"subnet_id",
+		"system_version",
+		"tags",
+		"time_zone",
+		"virtual_network_id",
+		"zone_id",
+	},
+	"azurerm_oracle_exadata_infrastructure": {
+		"compute_count",
+		"customer_contacts",
+		"database_server_type",
+		"display_name",
+		"location",
+		"maintenance_window",
+		"name",
+		"resource_group_name",
+		"shape",
+		"storage_count",
+		"storage_server_type",
+		"tags",
+		"zones",
+	},
+	"azurerm_oracle_exascale_database_storage_vault": {
+		"additional_flash_cache_percentage",
+		"description",
+		"display_name",
+		"high_capacity_database_storage",
+		"location",
+		"name",
+		"resource_group_name",
+		"tags",
+		"time_zone",
+		"zones",
+	},
+	"azurerm_resource_deployment_script_azure_cli": {
+		"identity",
+	},
+	"azurerm_resource_deployment_script_azure_power_shell": {
+		"identity",
+	},
+	"azurerm_static_web_app_custom_domain": {
+		"domain_name",
+		"static_web_app_id",
+		"validation_token",
+		"validation_type",
+	},
+	"azurerm_windows_function_app": {
+		"identity",
+	},
+	"azurerm_windows_web_app": {
+		"site_config.linux_fx_version",
+	},
+}
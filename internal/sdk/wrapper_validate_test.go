@@ -3,7 +3,12 @@
 
 package sdk
 
-import "testing"
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
 
 func TestValidateTopLevelObjectValid(t *testing.T) {
 	type Person struct {
@@ -69,3 +74,145 @@ func TestValidateNestedObjectInvalid(t *testing.T) {
 		t.Fatalf("expected an error but didn't get one")
 	}
 }
+
+func TestValidateModelObjectMatchesSchemaValid(t *testing.T) {
+	type Pet struct {
+		Name string `tfschema:"name"`
+	}
+	type Person struct {
+		Name    string            `tfschema:"name"`
+		Age     int64             `tfschema:"age"`
+		Height  float64           `tfschema:"height"`
+		Enabled bool              `tfschema:"enabled"`
+		Aliases []string          `tfschema:"aliases"`
+		Tags    map[string]string `tfschema:"tags"`
+		Pets    []Pet             `tfschema:"pets"`
+	}
+	s := map[string]*pluginsdk.Schema{
+		"name":    {Type: pluginsdk.TypeString, Required: true},
+		"age":     {Type: pluginsdk.TypeInt, Optional: true},
+		"height":  {Type: pluginsdk.TypeFloat, Optional: true},
+		"enabled": {Type: pluginsdk.TypeBool, Optional: true},
+		"aliases": {Type: pluginsdk.TypeSet, Optional: true, Elem: &pluginsdk.Schema{Type: pluginsdk.TypeString}},
+		"tags":    {Type: pluginsdk.TypeMap, Optional: true, Elem: &pluginsdk.Schema{Type: pluginsdk.TypeString}},
+		"pets": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {Type: pluginsdk.TypeString, Required: true},
+				},
+			},
+		},
+	}
+	if err := ValidateModelObjectFieldsMatchSchema(&Person{}, s); err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+}
+
+func TestValidateModelObjectMatchesSchemaMissingSchemaKey(t *testing.T) {
+	type Person struct {
+		Name string `tfschema:"name"`
+		Age  int64  `tfschema:"age"`
+	}
+	s := map[string]*pluginsdk.Schema{
+		"name": {Type: pluginsdk.TypeString, Required: true},
+	}
+	if err := ValidateModelObjectFieldsMatchSchema(&Person{}, s); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+func TestValidateModelObjectMatchesSchemaMissingField(t *testing.T) {
+	type Pet struct {
+		Name string `tfschema:"name"`
+	}
+	type Person struct {
+		Name string `tfschema:"name"`
+		Pets []Pet  `tfschema:"pets"`
+	}
+	s := map[string]*pluginsdk.Schema{
+		"name": {Type: pluginsdk.TypeString, Required: true},
+		"pets": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {Type: pluginsdk.TypeString, Required: true},
+					"age":  {Type: pluginsdk.TypeInt, Optional: true},
+				},
+			},
+		},
+	}
+	err := ValidateModelObjectFieldsMatchSchema(&Person{}, s)
+	if err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+	if !strings.Contains(err.Error(), `"pets.age"`) {
+		t.Fatalf("expected the error to contain the path %q but got: %+v", "pets.age", err)
+	}
+
+	// schema keys without a field are allowed when these are set outside of the model
+	if err := ValidateModelObjectFieldsMatchSchema(&Person{}, s, "pets.age"); err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+}
+
+func TestValidateModelObjectMatchesSchemaIncompatibleTypes(t *testing.T) {
+	testData := []struct {
+		name       string
+		model      interface{}
+		schemaType pluginsdk.ValueType
+	}{
+		{
+			name: "string for an int",
+			model: &struct {
+				Value string `tfschema:"value"`
+			}{},
+			schemaType: pluginsdk.TypeInt,
+		},
+		{
+			name: "int for a string",
+			model: &struct {
+				Value int64 `tfschema:"value"`
+			}{},
+			schemaType: pluginsdk.TypeString,
+		},
+		{
+			name: "slice for a string",
+			model: &struct {
+				Value []string `tfschema:"value"`
+			}{},
+			schemaType: pluginsdk.TypeString,
+		},
+		{
+			name: "string for a list",
+			model: &struct {
+				Value string `tfschema:"value"`
+			}{},
+			schemaType: pluginsdk.TypeList,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+		s := map[string]*pluginsdk.Schema{
+			"value": {Type: v.schemaType, Optional: true, Elem: &pluginsdk.Schema{Type: pluginsdk.TypeString}},
+		}
+		if err := ValidateModelObjectFieldsMatchSchema(v.model, s); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
+
+func TestValidateModelObjectMatchesSchemaNextMajorVersion(t *testing.T) {
+	type Person struct {
+		Name     string `tfschema:"name"`
+		Nickname string `tfschema:"nickname,addedInNextMajorVersion"`
+	}
+	s := map[string]*pluginsdk.Schema{
+		"name": {Type: pluginsdk.TypeString, Required: true},
+	}
+	if err := ValidateModelObjectFieldsMatchSchema(&Person{}, s); err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+}
@@ -12,12 +12,15 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/aadb2c/2021-04-01-preview/tenants"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name aadb2c_directory -service-package-name aadb2c -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group:id,name:id" -test-resource-type AadB2cDirectoryResource
+
 type AadB2cDirectoryModel struct {
 	BillingType           string            `tfschema:"billing_type"`
 	CountryCode           string            `tfschema:"country_code"`
@@ -34,10 +37,15 @@ type AadB2cDirectoryModel struct {
 type AadB2cDirectoryResource struct{}
 
 var (
-	_ sdk.Resource           = AadB2cDirectoryResource{}
-	_ sdk.ResourceWithUpdate = AadB2cDirectoryResource{}
+	_ sdk.Resource             = AadB2cDirectoryResource{}
+	_ sdk.ResourceWithUpdate   = AadB2cDirectoryResource{}
+	_ sdk.ResourceWithIdentity = AadB2cDirectoryResource{}
 )
 
+func (r AadB2cDirectoryResource) Identity() resourceids.ResourceId {
+	return &tenants.B2CDirectoryId{}
+}
+
 func (r AadB2cDirectoryResource) ResourceType() string {
 	return "azurerm_aadb2c_directory"
 }
@@ -306,6 +314,10 @@ func (r AadB2cDirectoryResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
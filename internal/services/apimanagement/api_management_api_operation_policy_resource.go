@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apioperationpolicy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/migration"
@@ -22,16 +23,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_api_operation_policy -service-package-name apimanagement -properties "resource_group_name,service_name:api_management_name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "api_id:api_name,operation_id:operation_id"
+
 func resourceApiManagementApiOperationPolicy() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceApiManagementAPIOperationPolicyCreateUpdate,
-		Read:   resourceApiManagementAPIOperationPolicyRead,
-		Update: resourceApiManagementAPIOperationPolicyCreateUpdate,
-		Delete: resourceApiManagementAPIOperationPolicyDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := apioperationpolicy.ParseOperationID(id)
-			return err
-		}),
+		Create:   resourceApiManagementAPIOperationPolicyCreateUpdate,
+		Read:     resourceApiManagementAPIOperationPolicyRead,
+		Update:   resourceApiManagementAPIOperationPolicyCreateUpdate,
+		Delete:   resourceApiManagementAPIOperationPolicyDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&apioperationpolicy.OperationId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&apioperationpolicy.OperationId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -163,7 +167,7 @@ func resourceApiManagementAPIOperationPolicyRead(d *pluginsdk.ResourceData, meta
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceApiManagementAPIOperationPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apioperation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
@@ -22,16 +23,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_api_operation -service-package-name apimanagement -properties "resource_group_name,service_name:api_management_name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "api_id:api_name,operation_id:operation_id"
+
 func resourceApiManagementApiOperation() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceApiManagementApiOperationCreateUpdate,
-		Read:   resourceApiManagementApiOperationRead,
-		Update: resourceApiManagementApiOperationCreateUpdate,
-		Delete: resourceApiManagementApiOperationDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := apioperation.ParseOperationID(id)
-			return err
-		}),
+		Create:   resourceApiManagementApiOperationCreateUpdate,
+		Read:     resourceApiManagementApiOperationRead,
+		Update:   resourceApiManagementApiOperationCreateUpdate,
+		Delete:   resourceApiManagementApiOperationDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&apioperation.OperationId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&apioperation.OperationId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -277,7 +281,7 @@ func resourceApiManagementApiOperationRead(d *pluginsdk.ResourceData, meta inter
 			}
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceApiManagementApiOperationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
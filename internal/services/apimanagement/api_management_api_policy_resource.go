@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apipolicy"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/migration"
@@ -22,16 +23,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_api_policy -service-package-name apimanagement -properties "resource_group_name,service_name:api_management_name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "api_id:id"
+
 func resourceApiManagementApiPolicy() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceApiManagementAPIPolicyCreateUpdate,
-		Read:   resourceApiManagementAPIPolicyRead,
-		Update: resourceApiManagementAPIPolicyCreateUpdate,
-		Delete: resourceApiManagementAPIPolicyDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := apipolicy.ParseApiID(id)
-			return err
-		}),
+		Create:   resourceApiManagementAPIPolicyCreateUpdate,
+		Read:     resourceApiManagementAPIPolicyRead,
+		Update:   resourceApiManagementAPIPolicyCreateUpdate,
+		Delete:   resourceApiManagementAPIPolicyDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&apipolicy.ApiId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&apipolicy.ApiId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -166,7 +170,7 @@ func resourceApiManagementAPIPolicyRead(d *pluginsdk.ResourceData, meta interfac
 			d.Set("xml_content", policyContent)
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceApiManagementAPIPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
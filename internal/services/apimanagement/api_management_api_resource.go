@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/api"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/custompollers"
@@ -27,16 +28,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_api -service-package-name apimanagement -properties "resource_group_name,service_name:api_management_name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "api_id:id"
+
 func resourceApiManagementApi() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
-		Create: resourceApiManagementApiCreate,
-		Read:   resourceApiManagementApiRead,
-		Update: resourceApiManagementApiUpdate,
-		Delete: resourceApiManagementApiDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := api.ParseApiID(id)
-			return err
-		}),
+		Create:   resourceApiManagementApiCreate,
+		Read:     resourceApiManagementApiRead,
+		Update:   resourceApiManagementApiUpdate,
+		Delete:   resourceApiManagementApiDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&api.ApiId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&api.ApiId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -768,7 +772,7 @@ func resourceApiManagementApiRead(d *pluginsdk.ResourceData, meta interface{}) e
 			}
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceApiManagementApiDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apiversionset"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apiversionsets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/migration"
@@ -23,16 +24,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_api_version_set -service-package-name apimanagement -properties "resource_group_name,service_name:api_management_name,version_set_id:name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceApiManagementApiVersionSet() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceApiManagementApiVersionSetCreateUpdate,
-		Read:   resourceApiManagementApiVersionSetRead,
-		Update: resourceApiManagementApiVersionSetCreateUpdate,
-		Delete: resourceApiManagementApiVersionSetDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := apiversionset.ParseApiVersionSetID(id)
-			return err
-		}),
+		Create:   resourceApiManagementApiVersionSetCreateUpdate,
+		Read:     resourceApiManagementApiVersionSetRead,
+		Update:   resourceApiManagementApiVersionSetCreateUpdate,
+		Delete:   resourceApiManagementApiVersionSetDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&apiversionset.ApiVersionSetId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&apiversionset.ApiVersionSetId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -202,7 +206,7 @@ func resourceApiManagementApiVersionSetRead(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceApiManagementApiVersionSetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
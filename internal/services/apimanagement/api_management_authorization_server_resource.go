@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/authorizationserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
@@ -20,16 +21,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_authorization_server -service-package-name apimanagement -properties "resource_group_name,service_name:api_management_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceApiManagementAuthorizationServer() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceApiManagementAuthorizationServerCreateUpdate,
-		Read:   resourceApiManagementAuthorizationServerRead,
-		Update: resourceApiManagementAuthorizationServerCreateUpdate,
-		Delete: resourceApiManagementAuthorizationServerDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := authorizationserver.ParseAuthorizationServerID(id)
-			return err
-		}),
+		Create:   resourceApiManagementAuthorizationServerCreateUpdate,
+		Read:     resourceApiManagementAuthorizationServerRead,
+		Update:   resourceApiManagementAuthorizationServerCreateUpdate,
+		Delete:   resourceApiManagementAuthorizationServerDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&authorizationserver.AuthorizationServerId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&authorizationserver.AuthorizationServerId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -336,7 +340,7 @@ func resourceApiManagementAuthorizationServerRead(d *pluginsdk.ResourceData, met
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceApiManagementAuthorizationServerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
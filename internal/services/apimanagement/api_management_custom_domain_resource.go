@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/apimanagementservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -23,6 +24,8 @@ import (
 
 var apiManagementCustomDomainResourceName = "azurerm_api_management_custom_domain"
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_custom_domain -service-package-name apimanagement -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,service_name:id,name:id"
+
 func resourceApiManagementCustomDomain() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: apiManagementCustomDomainCreateUpdate,
@@ -30,10 +33,11 @@ func resourceApiManagementCustomDomain() *pluginsdk.Resource {
 		Update: apiManagementCustomDomainCreateUpdate,
 		Delete: apiManagementCustomDomainDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.CustomDomainID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.CustomDomainId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.CustomDomainId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
@@ -204,7 +208,7 @@ func apiManagementCustomDomainRead(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func apiManagementCustomDomainDelete(d *pluginsdk.ResourceData, meta interface{}) error {
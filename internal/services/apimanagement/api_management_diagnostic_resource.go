@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/diagnostic"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/logger"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
@@ -23,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_diagnostic -service-package-name apimanagement -properties "resource_group_name,service_name:api_management_name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "diagnostic_id:id"
+
 func resourceApiManagementDiagnostic() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceApiManagementDiagnosticCreateUpdate,
@@ -30,10 +33,11 @@ func resourceApiManagementDiagnostic() *pluginsdk.Resource {
 		Update: resourceApiManagementDiagnosticCreateUpdate,
 		Delete: resourceApiManagementDiagnosticDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := diagnostic.ParseDiagnosticID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&diagnostic.DiagnosticId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&diagnostic.DiagnosticId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -283,7 +287,7 @@ func resourceApiManagementDiagnosticRead(d *pluginsdk.ResourceData, meta interfa
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, diagnosticId)
 }
 
 func resourceApiManagementDiagnosticDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/gatewaycertificateauthority"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/apimanagementservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_gateway_certificate_authority -service-package-name apimanagement -compare-values "subscription_id:api_management_id,resource_group_name:api_management_id,service_name:api_management_id,gateway_id:api_management_id,certificate_id:api_management_id"
+
 func resourceApiManagementGatewayCertificateAuthority() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceApiManagementGatewayCertificateAuthorityCreateUpdate,
@@ -27,10 +30,11 @@ func resourceApiManagementGatewayCertificateAuthority() *pluginsdk.Resource {
 		Update: resourceApiManagementGatewayCertificateAuthorityCreateUpdate,
 		Delete: resourceApiManagementGatewayCertificateAuthorityDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := gatewaycertificateauthority.ParseCertificateAuthorityID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&gatewaycertificateauthority.CertificateAuthorityId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&gatewaycertificateauthority.CertificateAuthorityId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -133,7 +137,7 @@ func resourceApiManagementGatewayCertificateAuthorityRead(d *pluginsdk.ResourceD
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceApiManagementGatewayCertificateAuthorityDelete(d *pluginsdk.ResourceData, meta interface{}) error {
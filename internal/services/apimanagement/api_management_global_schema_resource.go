@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/schema"
+	pluginSchema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
@@ -21,16 +22,20 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_global_schema -service-package-name apimanagement -properties "resource_group_name,service_name:api_management_name,schema_id" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceApiManagementGlobalSchema() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceApiManagementGlobalSchemaCreateUpdate,
 		Read:   resourceApiManagementGlobalSchemaRead,
 		Update: resourceApiManagementGlobalSchemaCreateUpdate,
 		Delete: resourceApiManagementGlobalSchemaDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := schema.ParseSchemaID(id)
-			return err
-		}),
+
+		Importer: pluginsdk.ImporterValidatingIdentity(&schema.SchemaId{}),
+
+		Identity: &pluginSchema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&schema.SchemaId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -162,7 +167,7 @@ func resourceApiManagementGlobalSchemaRead(d *pluginsdk.ResourceData, meta inter
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceApiManagementGlobalSchemaDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/group"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
@@ -20,16 +21,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_group -service-package-name apimanagement -properties "resource_group_name,service_name:api_management_name,group_id:name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceApiManagementGroup() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceApiManagementGroupCreateUpdate,
-		Read:   resourceApiManagementGroupRead,
-		Update: resourceApiManagementGroupCreateUpdate,
-		Delete: resourceApiManagementGroupDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := group.ParseGroupID(id)
-			return err
-		}),
+		Create:   resourceApiManagementGroupCreateUpdate,
+		Read:     resourceApiManagementGroupRead,
+		Update:   resourceApiManagementGroupCreateUpdate,
+		Delete:   resourceApiManagementGroupDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&group.GroupId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&group.GroupId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -154,7 +158,7 @@ func resourceApiManagementGroupRead(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceApiManagementGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
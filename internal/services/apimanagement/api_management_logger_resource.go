@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/logger"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -23,16 +24,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_logger -service-package-name apimanagement -properties "resource_group_name,service_name:api_management_name,logger_id:name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name basicEventHub
+
 func resourceApiManagementLogger() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceApiManagementLoggerCreate,
-		Read:   resourceApiManagementLoggerRead,
-		Update: resourceApiManagementLoggerUpdate,
-		Delete: resourceApiManagementLoggerDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := logger.ParseLoggerID(id)
-			return err
-		}),
+		Create:   resourceApiManagementLoggerCreate,
+		Read:     resourceApiManagementLoggerRead,
+		Update:   resourceApiManagementLoggerUpdate,
+		Delete:   resourceApiManagementLoggerDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&logger.LoggerId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&logger.LoggerId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -251,7 +255,7 @@ func resourceApiManagementLoggerRead(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceApiManagementLoggerUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/namedvalue"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
@@ -24,16 +25,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_named_value -service-package-name apimanagement -properties "resource_group_name,service_name:api_management_name,named_value_id:name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceApiManagementNamedValue() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceApiManagementNamedValueCreateUpdate,
-		Read:   resourceApiManagementNamedValueRead,
-		Update: resourceApiManagementNamedValueCreateUpdate,
-		Delete: resourceApiManagementNamedValueDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := namedvalue.ParseNamedValueID(id)
-			return err
-		}),
+		Create:   resourceApiManagementNamedValueCreateUpdate,
+		Read:     resourceApiManagementNamedValueRead,
+		Update:   resourceApiManagementNamedValueCreateUpdate,
+		Delete:   resourceApiManagementNamedValueDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&namedvalue.NamedValueId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&namedvalue.NamedValueId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -193,7 +197,7 @@ func resourceApiManagementNamedValueRead(d *pluginsdk.ResourceData, meta interfa
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceApiManagementNamedValueDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/notificationrecipientemail"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/apimanagementservice"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,9 +25,12 @@ type ApiManagementNotificationRecipientEmailModel struct {
 	Email            string `tfschema:"email"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_notification_recipient_email -service-package-name apimanagement -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,service_name:id,notification_name:id,name:id"
+
 type ApiManagementNotificationRecipientEmailResource struct{}
 
 var _ sdk.Resource = ApiManagementNotificationRecipientEmailResource{}
+var _ sdk.ResourceWithIdentity = ApiManagementNotificationRecipientEmailResource{}
 
 func (r ApiManagementNotificationRecipientEmailResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -154,6 +158,10 @@ func (r ApiManagementNotificationRecipientEmailResource) Read() sdk.ResourceFunc
 				Email:            id.RecipientEmailName,
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&model)
 		},
 	}
@@ -183,3 +191,7 @@ func (r ApiManagementNotificationRecipientEmailResource) Delete() sdk.ResourceFu
 func (r ApiManagementNotificationRecipientEmailResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return validate.NotificationRecipientEmailID
 }
+
+func (r ApiManagementNotificationRecipientEmailResource) Identity() resourceids.ResourceId {
+	return &notificationrecipientemail.RecipientEmailId{}
+}
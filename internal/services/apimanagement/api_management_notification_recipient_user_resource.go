@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/notificationrecipientuser"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/apimanagementservice"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,9 +25,12 @@ type ApiManagementNotificationRecipientUserModel struct {
 	UserId           string `tfschema:"user_id"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_notification_recipient_user -service-package-name apimanagement -properties "user_id" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,service_name:id,notification_name:id"
+
 type ApiManagementNotificationRecipientUserResource struct{}
 
 var _ sdk.Resource = ApiManagementNotificationRecipientUserResource{}
+var _ sdk.ResourceWithIdentity = ApiManagementNotificationRecipientUserResource{}
 
 func (r ApiManagementNotificationRecipientUserResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -154,6 +158,10 @@ func (r ApiManagementNotificationRecipientUserResource) Read() sdk.ResourceFunc
 				UserId:           id.UserId,
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&model)
 		},
 	}
@@ -183,3 +191,7 @@ func (r ApiManagementNotificationRecipientUserResource) Delete() sdk.ResourceFun
 func (r ApiManagementNotificationRecipientUserResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return validate.NotificationRecipientUserID
 }
+
+func (r ApiManagementNotificationRecipientUserResource) Identity() resourceids.ResourceId {
+	return &notificationrecipientuser.RecipientUserId{}
+}
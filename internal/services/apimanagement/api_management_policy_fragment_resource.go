@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/policyfragment"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/apimanagementservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
@@ -22,16 +23,15 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_policy_fragment -service-package-name apimanagement -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,service_name:id"
+
 func resourceApiManagementPolicyFragment() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceApiManagementPolicyFragmentCreate,
 		Read:   resourceApiManagementPolicyFragmentRead,
 		Update: resourceApiManagementPolicyFragmentUpdate,
 		Delete: resourceApiManagementPolicyFragmentDelete,
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := policyfragment.ParsePolicyFragmentID(id)
-			return err
-		}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&policyfragment.PolicyFragmentId{}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
 			client := meta.(*clients.Client).ApiManagement.PolicyFragmentClient
 
 			id, err := policyfragment.ParsePolicyFragmentID(d.Id())
@@ -55,6 +55,10 @@ func resourceApiManagementPolicyFragment() *pluginsdk.Resource {
 			return []*pluginsdk.ResourceData{d}, nil
 		}),
 
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&policyfragment.PolicyFragmentId{}),
+		},
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -228,7 +232,7 @@ func resourceApiManagementPolicyFragmentRead(d *pluginsdk.ResourceData, meta int
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceApiManagementPolicyFragmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/producttag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
@@ -19,15 +20,18 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_product_tag -service-package-name apimanagement -properties "resource_group_name,service_name:api_management_name,tag_id:name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "product_id:id"
+
 func resourceApiManagementProductTag() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceApiManagementProductTagCreate,
-		Read:   resourceApiManagementProductTagRead,
-		Delete: resourceApiManagementProductTagDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := producttag.ParseProductTagID(id)
-			return err
-		}),
+		Create:   resourceApiManagementProductTagCreate,
+		Read:     resourceApiManagementProductTagRead,
+		Delete:   resourceApiManagementProductTagDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&producttag.ProductTagId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&producttag.ProductTagId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -111,7 +115,7 @@ func resourceApiManagementProductTagRead(d *pluginsdk.ResourceData, meta interfa
 		d.Set("name", productTagId.TagId)
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceApiManagementProductTagDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -45,6 +45,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management -service-package-name apimanagement -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 var (
 	apimBackendProtocolSsl3                  = "Microsoft.WindowsAzure.ApiManagement.Gateway.Security.Backend.Protocols.Ssl30"
 	apimBackendProtocolTls10                 = "Microsoft.WindowsAzure.ApiManagement.Gateway.Security.Backend.Protocols.Tls10"
@@ -68,14 +70,15 @@ var (
 
 func resourceApiManagementService() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceApiManagementServiceCreate,
-		Read:   resourceApiManagementServiceRead,
-		Update: resourceApiManagementServiceUpdate,
-		Delete: resourceApiManagementServiceDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := apimanagementservice.ParseServiceID(id)
-			return err
-		}),
+		Create:   resourceApiManagementServiceCreate,
+		Read:     resourceApiManagementServiceRead,
+		Update:   resourceApiManagementServiceUpdate,
+		Delete:   resourceApiManagementServiceDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&apimanagementservice.ServiceId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&apimanagementservice.ServiceId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(3 * time.Hour),
@@ -1412,7 +1415,7 @@ func resourceApiManagementServiceRead(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceApiManagementServiceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/certificate"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/workspace"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_workspace_certificate -service-package-name apimanagement -properties "certificate_id:name" -compare-values "subscription_id:id,resource_group_name:id,service_name:id,workspace_id:id"
+
 type ApiManagementWorkspaceCertificateModel struct {
 	Name                         string `tfschema:"name"`
 	ApiManagementWorkspaceId     string `tfschema:"api_management_workspace_id"`
@@ -38,6 +41,11 @@ type ApiManagementWorkspaceCertificateModel struct {
 type ApiManagementWorkspaceCertificateResource struct{}
 
 var _ sdk.ResourceWithUpdate = ApiManagementWorkspaceCertificateResource{}
+var _ sdk.ResourceWithIdentity = ApiManagementWorkspaceCertificateResource{}
+
+func (r ApiManagementWorkspaceCertificateResource) Identity() resourceids.ResourceId {
+	return &certificate.WorkspaceCertificateId{}
+}
 
 func (r ApiManagementWorkspaceCertificateResource) ResourceType() string {
 	return "azurerm_api_management_workspace_certificate"
@@ -217,6 +225,10 @@ func (r ApiManagementWorkspaceCertificateResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&model)
 		},
 	}
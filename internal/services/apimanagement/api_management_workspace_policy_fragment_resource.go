@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/policyfragment"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_workspace_policy_fragment -service-package-name apimanagement -properties "name" -compare-values "subscription_id:id,resource_group_name:id,service_name:id,workspace_id:id" -test-resource-type ApiManagementWorkspacePolicyFragmentTestResource
+
 type ApiManagementWorkspacePolicyFragmentModel struct {
 	Name                     string `tfschema:"name"`
 	ApiManagementWorkspaceId string `tfschema:"api_management_workspace_id"`
@@ -29,6 +32,11 @@ type ApiManagementWorkspacePolicyFragmentModel struct {
 type ApiManagementWorkspacePolicyFragmentResource struct{}
 
 var _ sdk.ResourceWithUpdate = ApiManagementWorkspacePolicyFragmentResource{}
+var _ sdk.ResourceWithIdentity = ApiManagementWorkspacePolicyFragmentResource{}
+
+func (r ApiManagementWorkspacePolicyFragmentResource) Identity() resourceids.ResourceId {
+	return &policyfragment.WorkspacePolicyFragmentId{}
+}
 
 func (r ApiManagementWorkspacePolicyFragmentResource) ResourceType() string {
 	return "azurerm_api_management_workspace_policy_fragment"
@@ -220,6 +228,10 @@ func (r ApiManagementWorkspacePolicyFragmentResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/workspace"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/workspacepolicy"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_workspace_policy -service-package-name apimanagement -compare-values "subscription_id:id,resource_group_name:id,service_name:id,workspace_id:id" -test-resource-type ApiManagementWorkspacePolicyTestResource
+
 type ApiManagementWorkspacePolicyModel struct {
 	ApiManagementWorkspaceId string `tfschema:"api_management_workspace_id"`
 	XmlContent               string `tfschema:"xml_content"`
@@ -28,6 +31,11 @@ type ApiManagementWorkspacePolicyModel struct {
 type ApiManagementWorkspacePolicyResource struct{}
 
 var _ sdk.ResourceWithUpdate = ApiManagementWorkspacePolicyResource{}
+var _ sdk.ResourceWithIdentity = ApiManagementWorkspacePolicyResource{}
+
+func (r ApiManagementWorkspacePolicyResource) Identity() resourceids.ResourceId {
+	return &workspacepolicy.WorkspaceId{}
+}
 
 func (r ApiManagementWorkspacePolicyResource) ResourceType() string {
 	return "azurerm_api_management_workspace_policy"
@@ -202,6 +210,10 @@ func (r ApiManagementWorkspacePolicyResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/apimanagementservice"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/workspace"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_workspace -service-package-name apimanagement -properties "workspace_id:name" -compare-values "subscription_id:id,resource_group_name:id,service_name:id" -test-resource-type ApiManagementWorkspaceTestResource
+
 type ApiManagementWorkspaceModel struct {
 	Name            string `tfschema:"name"`
 	ApiManagementId string `tfschema:"api_management_id"`
@@ -29,6 +32,11 @@ type ApiManagementWorkspaceModel struct {
 type ApiManagementWorkspaceResource struct{}
 
 var _ sdk.ResourceWithUpdate = ApiManagementWorkspaceResource{}
+var _ sdk.ResourceWithIdentity = ApiManagementWorkspaceResource{}
+
+func (r ApiManagementWorkspaceResource) Identity() resourceids.ResourceId {
+	return &workspace.WorkspaceId{}
+}
 
 func (r ApiManagementWorkspaceResource) ResourceType() string {
 	return "azurerm_api_management_workspace"
@@ -204,6 +212,10 @@ func (r ApiManagementWorkspaceResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -21,6 +21,24 @@ func TestApiOperationPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestApiOperationPolicyIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&ApiOperationPolicyId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/operations/operation1/policies/policy1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual ApiOperationPolicyId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/operations/operation1/policies/policy1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestApiOperationPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
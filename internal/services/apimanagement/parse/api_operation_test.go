@@ -21,6 +21,24 @@ func TestApiOperationIDFormatter(t *testing.T) {
 	}
 }
 
+func TestApiOperationIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&ApiOperationId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/operations/operation1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual ApiOperationId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/operations/operation1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestApiOperationID(t *testing.T) {
 	testData := []struct {
 		Input    string
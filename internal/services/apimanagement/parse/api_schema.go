@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &ApiSchemaId{}
+
 type ApiSchemaId struct {
 	SubscriptionId string
 	ResourceGroup  string
@@ -47,6 +49,51 @@ func (id ApiSchemaId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.ApiName, id.SchemaName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ApiSchema ID
+func (id ApiSchemaId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement", "Microsoft.ApiManagement"),
+		resourceids.StaticSegment("staticService", "service", "service"),
+		resourceids.UserSpecifiedSegment("serviceName", "service1"),
+		resourceids.StaticSegment("staticApis", "apis", "apis"),
+		resourceids.UserSpecifiedSegment("apiName", "api1"),
+		resourceids.StaticSegment("staticSchemas", "schemas", "schemas"),
+		resourceids.UserSpecifiedSegment("schemaName", "schema1"),
+	}
+}
+
+// FromParseResult populates the fields of this ApiSchema ID from the ParseResult, for use with resourceids.Parser
+func (id *ApiSchemaId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ServiceName, ok = input.Parsed["serviceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "serviceName", input)
+	}
+
+	if id.ApiName, ok = input.Parsed["apiName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "apiName", input)
+	}
+
+	if id.SchemaName, ok = input.Parsed["schemaName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "schemaName", input)
+	}
+
+	return nil
+}
+
 // ApiSchemaID parses a ApiSchema ID into an ApiSchemaId struct
 func ApiSchemaID(input string) (*ApiSchemaId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -21,6 +21,24 @@ func TestApiTagDescriptionsIDFormatter(t *testing.T) {
 	}
 }
 
+func TestApiTagDescriptionsIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&ApiTagDescriptionsId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/tagDescriptions/tagDescriptionId1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual ApiTagDescriptionsId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/tagDescriptions/tagDescriptionId1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestApiTagDescriptionsID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,24 @@ func TestCustomDomainIDFormatter(t *testing.T) {
 	}
 }
 
+func TestCustomDomainIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&CustomDomainId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/customDomains/customdomain", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual CustomDomainId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/customDomains/customdomain"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestCustomDomainID(t *testing.T) {
 	testData := []struct {
 		Input    string
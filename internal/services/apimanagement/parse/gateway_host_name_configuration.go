@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &GatewayHostNameConfigurationId{}
+
 type GatewayHostNameConfigurationId struct {
 	SubscriptionId            string
 	ResourceGroup             string
@@ -47,6 +49,51 @@ func (id GatewayHostNameConfigurationId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.GatewayName, id.HostnameConfigurationName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this GatewayHostNameConfiguration ID
+func (id GatewayHostNameConfigurationId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement", "Microsoft.ApiManagement"),
+		resourceids.StaticSegment("staticService", "service", "service"),
+		resourceids.UserSpecifiedSegment("serviceName", "service1"),
+		resourceids.StaticSegment("staticGateways", "gateways", "gateways"),
+		resourceids.UserSpecifiedSegment("gatewayName", "gateway1"),
+		resourceids.StaticSegment("staticHostnameConfigurations", "hostnameConfigurations", "hostnameConfigurations"),
+		resourceids.UserSpecifiedSegment("hostnameConfigurationName", "hostname1"),
+	}
+}
+
+// FromParseResult populates the fields of this GatewayHostNameConfiguration ID from the ParseResult, for use with resourceids.Parser
+func (id *GatewayHostNameConfigurationId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ServiceName, ok = input.Parsed["serviceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "serviceName", input)
+	}
+
+	if id.GatewayName, ok = input.Parsed["gatewayName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "gatewayName", input)
+	}
+
+	if id.HostnameConfigurationName, ok = input.Parsed["hostnameConfigurationName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "hostnameConfigurationName", input)
+	}
+
+	return nil
+}
+
 // GatewayHostNameConfigurationID parses a GatewayHostNameConfiguration ID into an GatewayHostNameConfigurationId struct
 func GatewayHostNameConfigurationID(input string) (*GatewayHostNameConfigurationId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -21,6 +21,24 @@ func TestIdentityProviderIDFormatter(t *testing.T) {
 	}
 }
 
+func TestIdentityProviderIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&IdentityProviderId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/identityProviders/identityProvider1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual IdentityProviderId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/identityProviders/identityProvider1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestIdentityProviderID(t *testing.T) {
 	testData := []struct {
 		Input    string
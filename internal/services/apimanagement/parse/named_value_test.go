@@ -21,6 +21,24 @@ func TestNamedValueIDFormatter(t *testing.T) {
 	}
 }
 
+func TestNamedValueIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&NamedValueId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/namedValues/namedValue1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual NamedValueId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/namedValues/namedValue1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestNamedValueID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,24 @@ func TestNotificationRecipientUserIDFormatter(t *testing.T) {
 	}
 }
 
+func TestNotificationRecipientUserIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&NotificationRecipientUserId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/notifications/notificationName1/recipientUsers/user1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual NotificationRecipientUserId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/notifications/notificationName1/recipientUsers/user1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestNotificationRecipientUserID(t *testing.T) {
 	testData := []struct {
 		Input    string
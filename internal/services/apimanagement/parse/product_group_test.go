@@ -21,6 +21,24 @@ func TestProductGroupIDFormatter(t *testing.T) {
 	}
 }
 
+func TestProductGroupIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&ProductGroupId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/products/product1/groups/group1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual ProductGroupId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/products/product1/groups/group1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestProductGroupID(t *testing.T) {
 	testData := []struct {
 		Input    string
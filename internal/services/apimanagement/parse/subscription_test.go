@@ -21,6 +21,24 @@ func TestSubscriptionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSubscriptionIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&SubscriptionId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/subscriptions/subscription1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual SubscriptionId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/subscriptions/subscription1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestSubscriptionID(t *testing.T) {
 	testData := []struct {
 		Input    string
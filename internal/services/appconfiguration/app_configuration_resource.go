@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2024-05-01/replicas"
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/validate"
@@ -33,6 +34,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name app_configuration -service-package-name appconfiguration -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name free
+
 func resourceAppConfiguration() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceAppConfigurationCreate,
@@ -47,10 +50,11 @@ func resourceAppConfiguration() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := configurationstores.ParseConfigurationStoreID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&configurationstores.ConfigurationStoreId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&configurationstores.ConfigurationStoreId{}),
+		},
 
 		CustomizeDiff: pluginsdk.CustomDiffWithAll(
 			// sku cannot be downgraded from a production tier (`premium` or `standard`) to a non-production tier (`developer` or `free`), or downgraded from `developer` to `free`
@@ -680,10 +684,12 @@ func resourceAppConfigurationRead(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 		d.Set("replica", replica)
 
-		return tags.FlattenAndSet(d, model.Tags)
+		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+			return err
+		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceAppConfigurationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
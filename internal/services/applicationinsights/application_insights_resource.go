@@ -17,6 +17,7 @@ import (
 	billing "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2015-05-01/componentfeaturesandpricingapis"
 	components "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2020-02-02/componentsapis"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/migration"
@@ -25,6 +26,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name application_insights -service-package-name applicationinsights -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name basicWorkspaceMode -test-resource-type AppInsightsResource
+
 func resourceApplicationInsights() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceApplicationInsightsCreate,
@@ -32,10 +35,11 @@ func resourceApplicationInsights() *pluginsdk.Resource {
 		Update: resourceApplicationInsightsUpdate,
 		Delete: resourceApplicationInsightsDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := components.ParseComponentID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&components.ComponentId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&components.ComponentId{}),
+		},
 
 		SchemaVersion: 2,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -404,7 +408,7 @@ func resourceApplicationInsightsRead(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceApplicationInsightsUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	smartdetection "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2015-05-01/componentproactivedetectionapis"
 	components "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2020-02-02/componentsapis"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name application_insights_smart_detection_rule -service-package-name applicationinsights -properties "configuration_id:name" -compare-values "subscription_id:id,resource_group_name:id,component_name:id" -test-resource-type AppInsightsSmartDetectionRule
+
 func resourceApplicationInsightsSmartDetectionRule() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceApplicationInsightsSmartDetectionRuleUpdate,
@@ -28,10 +31,11 @@ func resourceApplicationInsightsSmartDetectionRule() *pluginsdk.Resource {
 		Update: resourceApplicationInsightsSmartDetectionRuleUpdate,
 		Delete: resourceApplicationInsightsSmartDetectionRuleDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := smartdetection.ParseProactiveDetectionConfigID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&smartdetection.ProactiveDetectionConfigId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&smartdetection.ProactiveDetectionConfigId{}),
+		},
 
 		SchemaVersion: 2,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -160,7 +164,7 @@ func resourceApplicationInsightsSmartDetectionRuleRead(d *pluginsdk.ResourceData
 		d.Set("send_emails_to_subscription_owners", model.SendEmailsToSubscriptionOwners)
 		d.Set("additional_email_recipients", utils.FlattenStringSlice(model.CustomEmails))
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceApplicationInsightsSmartDetectionRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	components "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2020-02-02/componentsapis"
 	webtests "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2022-06-15/webtestsapis"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -26,8 +27,11 @@ import (
 var (
 	_ sdk.ResourceWithUpdate        = ApplicationInsightsStandardWebTestResource{}
 	_ sdk.ResourceWithCustomizeDiff = ApplicationInsightsStandardWebTestResource{}
+	_ sdk.ResourceWithIdentity      = ApplicationInsightsStandardWebTestResource{}
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name application_insights_standard_web_test -service-package-name applicationinsights -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name basicConfig
+
 type ApplicationInsightsStandardWebTestResource struct{}
 
 type ApplicationInsightsStandardWebTestResourceModel struct {
@@ -514,6 +518,10 @@ func (ApplicationInsightsStandardWebTestResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -545,6 +553,10 @@ func (ApplicationInsightsStandardWebTestResource) IDValidationFunc() pluginsdk.S
 	return webtests.ValidateWebTestID
 }
 
+func (ApplicationInsightsStandardWebTestResource) Identity() resourceids.ResourceId {
+	return &webtests.WebTestId{}
+}
+
 func expandApplicationInsightsStandardWebTestRequest(input []RequestModel) (request *webtests.WebTestPropertiesRequest) {
 	if len(input) == 0 {
 		return nil
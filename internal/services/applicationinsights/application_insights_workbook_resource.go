@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	workbooks "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2022-04-01/workbooksapis"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/validate"
@@ -33,9 +34,12 @@ type ApplicationInsightsWorkbookModel struct {
 	Tags               map[string]string `tfschema:"tags"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name application_insights_workbook -service-package-name applicationinsights -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name complete
+
 type ApplicationInsightsWorkbookResource struct{}
 
 var _ sdk.ResourceWithUpdate = ApplicationInsightsWorkbookResource{}
+var _ sdk.ResourceWithIdentity = ApplicationInsightsWorkbookResource{}
 
 func (r ApplicationInsightsWorkbookResource) ResourceType() string {
 	return "azurerm_application_insights_workbook"
@@ -49,6 +53,10 @@ func (r ApplicationInsightsWorkbookResource) IDValidationFunc() pluginsdk.Schema
 	return workbooks.ValidateWorkbookID
 }
 
+func (r ApplicationInsightsWorkbookResource) Identity() resourceids.ResourceId {
+	return &workbooks.WorkbookId{}
+}
+
 func (r ApplicationInsightsWorkbookResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -308,6 +316,10 @@ func (r ApplicationInsightsWorkbookResource) Read() sdk.ResourceFunc {
 				state.Tags = *model.Tags
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
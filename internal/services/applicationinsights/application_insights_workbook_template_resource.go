@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	workbooktemplates "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2020-11-20/workbooktemplatesapis"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name application_insights_workbook_template -service-package-name applicationinsights -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type ApplicationInsightsWorkbookTemplateModel struct {
 	Name              string                         `tfschema:"name"`
 	ResourceGroupName string                         `tfschema:"resource_group_name"`
@@ -42,6 +45,11 @@ type WorkbookTemplateGalleryModel struct {
 type ApplicationInsightsWorkbookTemplateResource struct{}
 
 var _ sdk.ResourceWithUpdate = ApplicationInsightsWorkbookTemplateResource{}
+var _ sdk.ResourceWithIdentity = ApplicationInsightsWorkbookTemplateResource{}
+
+func (r ApplicationInsightsWorkbookTemplateResource) Identity() resourceids.ResourceId {
+	return &workbooktemplates.WorkbookTemplateId{}
+}
 
 func (r ApplicationInsightsWorkbookTemplateResource) ResourceType() string {
 	return "azurerm_application_insights_workbook_template"
@@ -345,6 +353,10 @@ func (r ApplicationInsightsWorkbookTemplateResource) Read() sdk.ResourceFunc {
 				state.Tags = *model.Tags
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &AnalyticsUserItemId{}
+
 type AnalyticsUserItemId struct {
 	SubscriptionId      string
 	ResourceGroup       string
@@ -44,6 +46,45 @@ func (id AnalyticsUserItemId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ComponentName, id.MyAnalyticsItemName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this AnalyticsUserItem ID
+func (id AnalyticsUserItemId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "group1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftInsights", "Microsoft.Insights", "Microsoft.Insights"),
+		resourceids.StaticSegment("staticComponents", "components", "components"),
+		resourceids.UserSpecifiedSegment("componentName", "component1"),
+		resourceids.StaticSegment("staticMyAnalyticsItems", "myAnalyticsItems", "myAnalyticsItems"),
+		resourceids.UserSpecifiedSegment("myAnalyticsItemName", "item1"),
+	}
+}
+
+// FromParseResult populates the fields of this AnalyticsUserItem ID from the ParseResult, for use with resourceids.Parser
+func (id *AnalyticsUserItemId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ComponentName, ok = input.Parsed["componentName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "componentName", input)
+	}
+
+	if id.MyAnalyticsItemName, ok = input.Parsed["myAnalyticsItemName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "myAnalyticsItemName", input)
+	}
+
+	return nil
+}
+
 // AnalyticsUserItemID parses a AnalyticsUserItem ID into an AnalyticsUserItemId struct
 func AnalyticsUserItemID(input string) (*AnalyticsUserItemId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -21,6 +21,24 @@ func TestAnalyticsUserItemIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAnalyticsUserItemIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&AnalyticsUserItemId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Insights/components/component1/myAnalyticsItems/item1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual AnalyticsUserItemId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Insights/components/component1/myAnalyticsItems/item1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestAnalyticsUserItemID(t *testing.T) {
 	testData := []struct {
 		Input    string
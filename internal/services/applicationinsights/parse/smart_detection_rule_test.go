@@ -21,6 +21,24 @@ func TestSmartDetectionRuleIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSmartDetectionRuleIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&SmartDetectionRuleId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Insights/components/component1/smartDetectionRule/rule1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual SmartDetectionRuleId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Insights/components/component1/smartDetectionRule/rule1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestSmartDetectionRuleID(t *testing.T) {
 	testData := []struct {
 		Input    string
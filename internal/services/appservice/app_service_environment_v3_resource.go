@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/virtualnetworks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/appserviceenvironments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -58,11 +59,14 @@ type AppServiceV3InboundDependencies struct {
 
 // (@jackofallops) - Important property missing from the SDK / Swagger that will need to be added later: `upgrade_preference` https://docs.microsoft.com/en-us/azure/app-service/environment/using#upgrade-preference
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name app_service_environment_v3 -service-package-name appservice -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type AppServiceEnvironmentV3Resource struct{}
 
 var (
-	_ sdk.Resource           = AppServiceEnvironmentV3Resource{}
-	_ sdk.ResourceWithUpdate = AppServiceEnvironmentV3Resource{}
+	_ sdk.Resource             = AppServiceEnvironmentV3Resource{}
+	_ sdk.ResourceWithUpdate   = AppServiceEnvironmentV3Resource{}
+	_ sdk.ResourceWithIdentity = AppServiceEnvironmentV3Resource{}
 )
 
 func (r AppServiceEnvironmentV3Resource) Arguments() map[string]*pluginsdk.Schema {
@@ -405,6 +409,10 @@ func (r AppServiceEnvironmentV3Resource) Read() sdk.ResourceFunc {
 				state.Tags = pointer.From(model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -438,6 +446,10 @@ func (r AppServiceEnvironmentV3Resource) IDValidationFunc() pluginsdk.SchemaVali
 	return validate.AppServiceEnvironmentID
 }
 
+func (r AppServiceEnvironmentV3Resource) Identity() resourceids.ResourceId {
+	return &commonids.AppServiceEnvironmentId{}
+}
+
 func (r AppServiceEnvironmentV3Resource) Update() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 6 * time.Hour,
@@ -12,12 +12,15 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name app_service_source_control -service-package-name appservice -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:app_id,name:app_id" -test-name windowsExternalGit -test-resource-type AppServiceSourceControlResource
+
 type SourceControlResource struct{}
 
 type SourceControlModel struct {
@@ -35,6 +38,8 @@ type SourceControlModel struct {
 
 var _ sdk.Resource = SourceControlResource{}
 
+var _ sdk.ResourceWithIdentity = SourceControlResource{}
+
 func (r SourceControlResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"app_id": {
@@ -305,3 +310,7 @@ func (r SourceControlResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	// This is a meta resource with a 1:1 relationship with the service it's pointed at so we use the same ID
 	return commonids.ValidateAppServiceID
 }
+
+func (r SourceControlResource) Identity() resourceids.ResourceId {
+	return &commonids.AppServiceId{}
+}
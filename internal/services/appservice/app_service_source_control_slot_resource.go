@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name app_service_source_control_slot -service-package-name appservice -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:slot_id,site_name:slot_id,name:slot_id" -test-name windowsExternalGit -test-resource-type SourceControlSlotResource
+
 type SourceControlSlotResource struct{}
 
 type SourceControlSlotModel struct {
@@ -37,6 +40,8 @@ type SourceControlSlotModel struct {
 
 var _ sdk.Resource = SourceControlSlotResource{}
 
+var _ sdk.ResourceWithIdentity = SourceControlSlotResource{}
+
 func (r SourceControlSlotResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"slot_id": {
@@ -307,3 +312,7 @@ func (r SourceControlSlotResource) IDValidationFunc() pluginsdk.SchemaValidateFu
 	// This is a meta resource with a 1:1 relationship with the slot it's pointed at, so we use the same ID
 	return webapps.ValidateSlotID
 }
+
+func (r SourceControlSlotResource) Identity() resourceids.ResourceId {
+	return &webapps.SlotId{}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name function_app_active_slot -service-package-name appservice -compare-values "subscription_id:slot_id,resource_group_name:slot_id,name:slot_id" -test-name basicLinux -test-resource-type FunctionApActiveSlotResource
 type FunctionAppActiveSlotResource struct{}
 
 type FunctionAppActiveSlotModel struct {
@@ -29,6 +31,8 @@ type FunctionAppActiveSlotModel struct {
 
 var _ sdk.ResourceWithUpdate = FunctionAppActiveSlotResource{}
 
+var _ sdk.ResourceWithIdentity = FunctionAppActiveSlotResource{}
+
 func (r FunctionAppActiveSlotResource) ModelObject() interface{} {
 	return &FunctionAppActiveSlotModel{}
 }
@@ -41,6 +45,10 @@ func (r FunctionAppActiveSlotResource) IDValidationFunc() pluginsdk.SchemaValida
 	return commonids.ValidateFunctionAppID
 }
 
+func (r FunctionAppActiveSlotResource) Identity() resourceids.ResourceId {
+	return &commonids.FunctionAppId{}
+}
+
 func (r FunctionAppActiveSlotResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"slot_id": {
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/resourceproviders"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -31,6 +32,7 @@ const (
 	StorageStringFmt = "DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name function_app_flex_consumption -service-package-name appservice -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type FunctionAppFlexConsumptionResource
 type FunctionAppFlexConsumptionResource struct{}
 
 type FunctionAppFlexConsumptionModel struct {
@@ -88,6 +90,8 @@ type FunctionAppAlwaysReady struct {
 
 var _ sdk.ResourceWithUpdate = FunctionAppFlexConsumptionResource{}
 
+var _ sdk.ResourceWithIdentity = FunctionAppFlexConsumptionResource{}
+
 func (r FunctionAppFlexConsumptionResource) ModelObject() interface{} {
 	return &FunctionAppFlexConsumptionModel{}
 }
@@ -100,6 +104,10 @@ func (r FunctionAppFlexConsumptionResource) IDValidationFunc() pluginsdk.SchemaV
 	return commonids.ValidateFunctionAppID
 }
 
+func (r FunctionAppFlexConsumptionResource) Identity() resourceids.ResourceId {
+	return &commonids.FunctionAppId{}
+}
+
 func (r FunctionAppFlexConsumptionResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name function_app_function -service-package-name appservice -properties "name" -compare-values "subscription_id:id,resource_group_name:id,site_name:id"
+
 type FunctionAppFunctionResource struct{}
 
 type FunctionAppFunctionModel struct {
@@ -46,6 +49,11 @@ type FunctionFiles struct {
 }
 
 var _ sdk.ResourceWithUpdate = FunctionAppFunctionResource{}
+var _ sdk.ResourceWithIdentity = FunctionAppFunctionResource{}
+
+func (r FunctionAppFunctionResource) Identity() resourceids.ResourceId {
+	return &webapps.FunctionId{}
+}
 
 func (r FunctionAppFunctionResource) ModelObject() interface{} {
 	return &FunctionAppFunctionModel{}
@@ -331,6 +339,10 @@ func (r FunctionAppFunctionResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&appFunc)
 		},
 	}
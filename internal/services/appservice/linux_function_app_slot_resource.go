@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/resourceproviders"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -30,6 +31,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name linux_function_app_slot -service-package-name appservice -properties "name" -test-params "S1" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:function_app_id,site_name:function_app_id"
+
 type LinuxFunctionAppSlotResource struct{}
 
 type LinuxFunctionAppSlotModel struct {
@@ -80,6 +83,8 @@ var _ sdk.ResourceWithUpdate = LinuxFunctionAppSlotResource{}
 
 var _ sdk.ResourceWithStateMigration = LinuxFunctionAppSlotResource{}
 
+var _ sdk.ResourceWithIdentity = LinuxFunctionAppSlotResource{}
+
 func (r LinuxFunctionAppSlotResource) ModelObject() interface{} {
 	return &LinuxFunctionAppSlotModel{}
 }
@@ -92,6 +97,10 @@ func (r LinuxFunctionAppSlotResource) IDValidationFunc() pluginsdk.SchemaValidat
 	return webapps.ValidateSlotID
 }
 
+func (r LinuxFunctionAppSlotResource) Identity() resourceids.ResourceId {
+	return &webapps.SlotId{}
+}
+
 func (r LinuxFunctionAppSlotResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
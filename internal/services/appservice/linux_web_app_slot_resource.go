@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -26,6 +27,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name linux_web_app_slot -service-package-name appservice -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:app_service_id,site_name:app_service_id"
+
 type LinuxWebAppSlotResource struct{}
 
 type LinuxWebAppSlotModel struct {
@@ -72,6 +75,8 @@ var _ sdk.ResourceWithUpdate = LinuxWebAppSlotResource{}
 
 var _ sdk.ResourceWithStateMigration = LinuxWebAppSlotResource{}
 
+var _ sdk.ResourceWithIdentity = LinuxWebAppSlotResource{}
+
 func (r LinuxWebAppSlotResource) ModelObject() interface{} {
 	return &LinuxWebAppSlotModel{}
 }
@@ -84,6 +89,10 @@ func (r LinuxWebAppSlotResource) IDValidationFunc() pluginsdk.SchemaValidateFunc
 	return webapps.ValidateSlotID
 }
 
+func (r LinuxWebAppSlotResource) Identity() resourceids.ResourceId {
+	return &webapps.SlotId{}
+}
+
 func (r LinuxWebAppSlotResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/appserviceplans"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
@@ -25,14 +26,21 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name service_plan -service-package-name appservice -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type ServicePlanResource struct{}
 
 var (
 	_ sdk.ResourceWithUpdate         = ServicePlanResource{}
 	_ sdk.ResourceWithStateMigration = ServicePlanResource{}
 	_ sdk.ResourceWithCustomizeDiff  = ServicePlanResource{}
+	_ sdk.ResourceWithIdentity       = ServicePlanResource{}
 )
 
+func (r ServicePlanResource) Identity() resourceids.ResourceId {
+	return &commonids.AppServicePlanId{}
+}
+
 type OSType string
 
 const (
@@ -281,6 +289,10 @@ func (r ServicePlanResource) Read() sdk.ResourceFunc {
 				state.Tags = pointer.From(model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
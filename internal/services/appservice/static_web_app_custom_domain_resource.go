@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/staticsites"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,9 +20,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name static_web_app_custom_domain -service-package-name appservice -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,static_site_name:id,name:id"
+
 type StaticWebAppCustomDomainResource struct{}
 
 var _ sdk.Resource = StaticWebAppCustomDomainResource{}
+var _ sdk.ResourceWithIdentity = StaticWebAppCustomDomainResource{}
 
 type StaticWebAppCustomDomainResourceModel struct {
 	DomainName      string `tfschema:"domain_name"`
@@ -204,6 +208,10 @@ func (r StaticWebAppCustomDomainResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -232,3 +240,7 @@ func (r StaticWebAppCustomDomainResource) Delete() sdk.ResourceFunc {
 func (r StaticWebAppCustomDomainResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return staticsites.ValidateCustomDomainID
 }
+
+func (r StaticWebAppCustomDomainResource) Identity() resourceids.ResourceId {
+	return &staticsites.CustomDomainId{}
+}
@@ -12,14 +12,22 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/staticsites"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name static_web_app_function_app_registration -service-package-name appservice -compare-values "subscription_id:id,resource_group_name:id,static_site_name:id,name:id"
+
 type StaticWebAppFunctionAppRegistrationResource struct{}
 
 var _ sdk.Resource = StaticWebAppFunctionAppRegistrationResource{}
+var _ sdk.ResourceWithIdentity = StaticWebAppFunctionAppRegistrationResource{}
+
+func (r StaticWebAppFunctionAppRegistrationResource) Identity() resourceids.ResourceId {
+	return &staticsites.UserProvidedFunctionAppId{}
+}
 
 type StaticWebAppFunctionAppRegistrationModel struct {
 	StaticWebAppID string `tfschema:"static_web_app_id"`
@@ -168,6 +176,10 @@ func (r StaticWebAppFunctionAppRegistrationResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
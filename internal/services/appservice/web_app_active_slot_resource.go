@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name web_app_active_slot -service-package-name appservice -compare-values "subscription_id:slot_id,resource_group_name:slot_id,name:slot_id" -test-name basicLinux -test-resource-type WebAppActiveSlotResource
 type WebAppActiveSlotResource struct{}
 
 type WebAppActiveSlotModel struct {
@@ -29,6 +31,8 @@ type WebAppActiveSlotModel struct {
 
 var _ sdk.ResourceWithUpdate = WebAppActiveSlotResource{}
 
+var _ sdk.ResourceWithIdentity = WebAppActiveSlotResource{}
+
 func (r WebAppActiveSlotResource) ModelObject() interface{} {
 	return &WebAppActiveSlotModel{}
 }
@@ -41,6 +45,10 @@ func (r WebAppActiveSlotResource) IDValidationFunc() pluginsdk.SchemaValidateFun
 	return commonids.ValidateAppServiceID
 }
 
+func (r WebAppActiveSlotResource) Identity() resourceids.ResourceId {
+	return &commonids.AppServiceId{}
+}
+
 func (r WebAppActiveSlotResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"slot_id": {
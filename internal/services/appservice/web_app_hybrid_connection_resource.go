@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/hybridconnections"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/namespaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name web_app_hybrid_connection -service-package-name appservice -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,site_name:id,hybrid_connection_namespace_name:id,name:id"
+
 type WebAppHybridConnectionResource struct{}
 
 type WebAppHybridConnectionModel struct {
@@ -41,6 +44,8 @@ var _ sdk.ResourceWithUpdate = WebAppHybridConnectionResource{}
 
 var _ sdk.ResourceWithCustomImporter = WebAppHybridConnectionResource{}
 
+var _ sdk.ResourceWithIdentity = WebAppHybridConnectionResource{}
+
 func (r WebAppHybridConnectionResource) ModelObject() interface{} {
 	return &WebAppHybridConnectionModel{}
 }
@@ -53,6 +58,10 @@ func (r WebAppHybridConnectionResource) IDValidationFunc() pluginsdk.SchemaValid
 	return webapps.ValidateRelayID
 }
 
+func (r WebAppHybridConnectionResource) Identity() resourceids.ResourceId {
+	return &webapps.RelayId{}
+}
+
 func (r WebAppHybridConnectionResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"web_app_id": {
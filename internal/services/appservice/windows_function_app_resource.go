@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/resourceproviders"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -30,6 +31,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name windows_function_app -service-package-name appservice -properties "name,resource_group_name" -test-params "B1" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type WindowsFunctionAppResource struct{}
 
 type WindowsFunctionAppModel struct {
@@ -91,6 +94,8 @@ var _ sdk.ResourceWithCustomizeDiff = WindowsFunctionAppResource{}
 
 var _ sdk.ResourceWithStateMigration = WindowsFunctionAppResource{}
 
+var _ sdk.ResourceWithIdentity = WindowsFunctionAppResource{}
+
 func (r WindowsFunctionAppResource) ModelObject() interface{} {
 	return &WindowsFunctionAppModel{}
 }
@@ -103,6 +108,10 @@ func (r WindowsFunctionAppResource) IDValidationFunc() pluginsdk.SchemaValidateF
 	return commonids.ValidateFunctionAppID
 }
 
+func (r WindowsFunctionAppResource) Identity() resourceids.ResourceId {
+	return &commonids.FunctionAppId{}
+}
+
 func (r WindowsFunctionAppResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/resourceproviders"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -28,6 +29,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name windows_web_app -service-package-name appservice -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type WindowsWebAppResource struct{}
 
 type WindowsWebAppModel struct {
@@ -75,6 +78,7 @@ var (
 	_ sdk.ResourceWithCustomImporter = WindowsWebAppResource{}
 	_ sdk.ResourceWithCustomizeDiff  = WindowsWebAppResource{}
 	_ sdk.ResourceWithStateMigration = WindowsWebAppResource{}
+	_ sdk.ResourceWithIdentity       = WindowsWebAppResource{}
 )
 
 func (r WindowsWebAppResource) Arguments() map[string]*pluginsdk.Schema {
@@ -796,6 +800,10 @@ func (r WindowsWebAppResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return commonids.ValidateAppServiceID
 }
 
+func (r WindowsWebAppResource) Identity() resourceids.ResourceId {
+	return &commonids.WebAppId{}
+}
+
 func (r WindowsWebAppResource) Update() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -28,6 +29,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name windows_web_app_slot -service-package-name appservice -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:app_service_id,site_name:app_service_id"
+
 type WindowsWebAppSlotResource struct{}
 
 type WindowsWebAppSlotModel struct {
@@ -73,6 +76,7 @@ var (
 	_ sdk.ResourceWithCustomizeDiff  = WindowsWebAppSlotResource{}
 	_ sdk.ResourceWithUpdate         = WindowsWebAppSlotResource{}
 	_ sdk.ResourceWithStateMigration = WindowsWebAppSlotResource{}
+	_ sdk.ResourceWithIdentity       = WindowsWebAppSlotResource{}
 )
 
 func (r WindowsWebAppSlotResource) ModelObject() interface{} {
@@ -87,6 +91,10 @@ func (r WindowsWebAppSlotResource) IDValidationFunc() pluginsdk.SchemaValidateFu
 	return webapps.ValidateSlotID
 }
 
+func (r WindowsWebAppSlotResource) Identity() resourceids.ResourceId {
+	return &webapps.SlotId{}
+}
+
 func (r WindowsWebAppSlotResource) Arguments() map[string]*pluginsdk.Schema {
 	s := map[string]*pluginsdk.Schema{
 		"name": {
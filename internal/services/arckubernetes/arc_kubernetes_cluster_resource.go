@@ -39,7 +39,7 @@ func resourceArcKubernetesCluster() *pluginsdk.Resource {
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := arckubernetes.ParseConnectedClusterID(id)
 			return err
-		}),
+		}, &arckubernetes.ConnectedClusterId{}),
 
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
@@ -14,17 +14,25 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	arckubernetes "github.com/hashicorp/go-azure-sdk/resource-manager/hybridkubernetes/2024-01-01/connectedclusters"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name arc_kubernetes_provisioned_cluster -service-package-name arckubernetes -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 var (
-	_ sdk.Resource           = ArcKubernetesProvisionedClusterResource{}
-	_ sdk.ResourceWithUpdate = ArcKubernetesProvisionedClusterResource{}
+	_ sdk.Resource             = ArcKubernetesProvisionedClusterResource{}
+	_ sdk.ResourceWithUpdate   = ArcKubernetesProvisionedClusterResource{}
+	_ sdk.ResourceWithIdentity = ArcKubernetesProvisionedClusterResource{}
 )
 
+func (r ArcKubernetesProvisionedClusterResource) Identity() resourceids.ResourceId {
+	return &arckubernetes.ConnectedClusterId{}
+}
+
 // This resource is same type as the ArcKubernetesClusterResource but with kind="ProvisionedCluster".
 type ArcKubernetesProvisionedClusterResource struct{}
 
@@ -343,6 +351,10 @@ func (r ArcKubernetesProvisionedClusterResource) Read() sdk.ResourceFunc {
 				state.ArcAgentDesiredVersion = arcAgentdesiredVersion
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
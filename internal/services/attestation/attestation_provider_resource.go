@@ -46,7 +46,7 @@ func resourceAttestationProvider() *pluginsdk.Resource {
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := attestationproviders.ParseAttestationProvidersID(id)
 			return err
-		}),
+		}, &attestationproviders.AttestationProvidersId{}),
 
 		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
 			if o, n := diff.GetChange("open_enclave_policy_base64"); o.(string) != "" && n.(string) == "" {
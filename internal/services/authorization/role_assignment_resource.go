@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2022-05-01-preview/roledefinitions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-12-01/subscriptions"
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -32,16 +33,23 @@ import (
 
 // TODO: this wants splitting into virtual resources with Virtual IDs
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name role_assignment -service-package-name authorization -properties "name,scope" -test-name resourceGroupScoped
+
 func resourceArmRoleAssignment() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceArmRoleAssignmentCreate,
 		Read:   resourceArmRoleAssignmentRead,
 		Delete: resourceArmRoleAssignmentDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		// the Tenant ID used for cross-tenant Role Assignments isn't part of the resource identity, these must be imported by ID
+		Importer: pluginsdk.ImporterValidatingResourceIdOrIdentity(func(id string) error {
 			_, err := parse.RoleAssignmentID(id)
 			return err
-		}),
+		}, &roleassignments.ScopedRoleAssignmentId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&roleassignments.ScopedRoleAssignmentId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -337,7 +345,7 @@ func resourceArmRoleAssignmentRead(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, &id.ScopedId)
 }
 
 func resourceArmRoleAssignmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
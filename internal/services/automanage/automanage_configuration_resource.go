@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automanage/2022-05-04/configurationprofiles"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automanage/migration"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name automanage_configuration -service-package-name automanage -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type AutoManageConfigurationProfileResource
+
 type AzureSecurityBaselineConfiguration struct {
 	AssignmentType string `tfschema:"assignment_type"`
 }
@@ -93,8 +96,13 @@ type AutoManageConfigurationResource struct{}
 var (
 	_ sdk.ResourceWithUpdate         = AutoManageConfigurationResource{}
 	_ sdk.ResourceWithStateMigration = AutoManageConfigurationResource{}
+	_ sdk.ResourceWithIdentity       = AutoManageConfigurationResource{}
 )
 
+func (r AutoManageConfigurationResource) Identity() resourceids.ResourceId {
+	return &configurationprofiles.ConfigurationProfileId{}
+}
+
 func (r AutoManageConfigurationResource) ResourceType() string {
 	return "azurerm_automanage_configuration"
 }
@@ -598,6 +606,10 @@ func (r AutoManageConfigurationResource) Read() sdk.ResourceFunc {
 				state.Tags = pointer.From(model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -29,16 +29,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name automation_account -service-package-name automation -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceAutomationAccount() *pluginsdk.Resource {
 	r := &pluginsdk.Resource{
-		Create: resourceAutomationAccountCreate,
-		Read:   resourceAutomationAccountRead,
-		Update: resourceAutomationAccountUpdate,
-		Delete: resourceAutomationAccountDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := automationaccount.ParseAutomationAccountID(id)
-			return err
-		}),
+		Create:   resourceAutomationAccountCreate,
+		Read:     resourceAutomationAccountRead,
+		Update:   resourceAutomationAccountUpdate,
+		Delete:   resourceAutomationAccountDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&automationaccount.AutomationAccountId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&automationaccount.AutomationAccountId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -352,7 +355,7 @@ func resourceAutomationAccountRead(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceAutomationAccountDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/connection"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/validate"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name automation_connection_certificate -service-package-name automation -properties "resource_group_name,automation_account_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceAutomationConnectionCertificate() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceAutomationConnectionCertificateCreateUpdate,
@@ -27,10 +30,11 @@ func resourceAutomationConnectionCertificate() *pluginsdk.Resource {
 		Update: resourceAutomationConnectionCertificateCreateUpdate,
 		Delete: resourceAutomationConnectionCertificateDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := connection.ParseConnectionID(id)
-			return err
-		}, importAutomationConnection("Azure")),
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&connection.ConnectionId{}, importAutomationConnection("Azure")),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&connection.ConnectionId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -162,7 +166,7 @@ func resourceAutomationConnectionCertificateRead(d *pluginsdk.ResourceData, meta
 			d.Set("description", props.Description)
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceAutomationConnectionCertificateDelete(d *pluginsdk.ResourceData, meta interface{}) error {
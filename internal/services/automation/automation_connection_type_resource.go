@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/automationaccount"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/connectiontype"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -33,10 +34,14 @@ type AutomationConnectionTypeModel struct {
 	Field                 []Field `json:"field"                   tfschema:"field"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name automation_connection_type -service-package-name automation -properties "resource_group_name,automation_account_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type AutomationConnectionTypeResource
+
 type AutomationConnectionTypeResource struct{}
 
 var _ sdk.Resource = (*AutomationConnectionTypeResource)(nil)
 
+var _ sdk.ResourceWithIdentity = AutomationConnectionTypeResource{}
+
 func (m AutomationConnectionTypeResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"resource_group_name": commonschema.ResourceGroupName(),
@@ -220,3 +225,7 @@ func (m AutomationConnectionTypeResource) Delete() sdk.ResourceFunc {
 func (m AutomationConnectionTypeResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return connectiontype.ValidateConnectionTypeID
 }
+
+func (m AutomationConnectionTypeResource) Identity() resourceids.ResourceId {
+	return &connectiontype.ConnectionTypeId{}
+}
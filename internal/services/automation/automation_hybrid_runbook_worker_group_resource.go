@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/hybridrunbookworkergroup"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -25,10 +26,14 @@ type HybridRunbookWorkerGroupModel struct {
 	CredentialName        string `tfschema:"credential_name"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name automation_hybrid_runbook_worker_group -service-package-name automation -properties "resource_group_name,automation_account_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type HybridRunbookWorkerGroupResource
+
 type HybridRunbookWorkerGroupResource struct{}
 
 var _ sdk.Resource = (*HybridRunbookWorkerGroupResource)(nil)
 
+var _ sdk.ResourceWithIdentity = HybridRunbookWorkerGroupResource{}
+
 func (m HybridRunbookWorkerGroupResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"resource_group_name": commonschema.ResourceGroupName(), // end if common
@@ -195,3 +200,7 @@ func (m HybridRunbookWorkerGroupResource) Delete() sdk.ResourceFunc {
 func (m HybridRunbookWorkerGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return hybridrunbookworkergroup.ValidateHybridRunbookWorkerGroupID
 }
+
+func (m HybridRunbookWorkerGroupResource) Identity() resourceids.ResourceId {
+	return &hybridrunbookworkergroup.HybridRunbookWorkerGroupId{}
+}
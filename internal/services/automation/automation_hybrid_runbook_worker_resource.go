@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/hybridrunbookworker"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -32,10 +33,14 @@ type HybridRunbookWorkerModel struct {
 	WorkerType            string `tfschema:"worker_type"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name automation_hybrid_runbook_worker -service-package-name automation -properties "resource_group_name,automation_account_name,hybrid_runbook_worker_group_name:worker_group_name,hybrid_runbook_worker_id:worker_id" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type HybridRunbookWorkerResource
+
 type HybridRunbookWorkerResource struct{}
 
 var _ sdk.Resource = (*HybridRunbookWorkerResource)(nil)
 
+var _ sdk.ResourceWithIdentity = HybridRunbookWorkerResource{}
+
 func (m HybridRunbookWorkerResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"resource_group_name": commonschema.ResourceGroupName(),
@@ -210,3 +215,7 @@ func (m HybridRunbookWorkerResource) Delete() sdk.ResourceFunc {
 func (m HybridRunbookWorkerResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return hybridrunbookworker.ValidateHybridRunbookWorkerID
 }
+
+func (m HybridRunbookWorkerResource) Identity() resourceids.ResourceId {
+	return &hybridrunbookworker.HybridRunbookWorkerId{}
+}
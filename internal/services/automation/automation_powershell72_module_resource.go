@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/module"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,7 +20,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name automation_powershell72_module -service-package-name automation -properties "name" -compare-values "subscription_id:id,resource_group_name:id,automation_account_name:id" -test-resource-type PowerShell72ModuleResource
+
 var _ sdk.Resource = PowerShell72ModuleResource{}
+var _ sdk.ResourceWithIdentity = PowerShell72ModuleResource{}
+
+func (r PowerShell72ModuleResource) Identity() resourceids.ResourceId {
+	return &module.PowerShell72ModuleId{}
+}
 
 type ModuleLinkModel struct {
 	Uri  string       `tfschema:"uri"`
@@ -331,6 +339,10 @@ func (r PowerShell72ModuleResource) Read() sdk.ResourceFunc {
 				output.Tags = tags.Flatten(resp.Model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&output)
 		},
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/python3package"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -28,10 +29,14 @@ type Python3PackageModel struct {
 	Tags                  map[string]string `tfschema:"tags"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name automation_python3_package -service-package-name automation -properties "resource_group_name,automation_account_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type Python3PackageResource
+
 type Python3PackageResource struct{}
 
 var _ sdk.Resource = (*Python3PackageResource)(nil)
 
+var _ sdk.ResourceWithIdentity = Python3PackageResource{}
+
 func (m Python3PackageResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -255,3 +260,7 @@ func (m Python3PackageResource) Delete() sdk.ResourceFunc {
 func (m Python3PackageResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return python3package.ValidatePython3PackageID
 }
+
+func (m Python3PackageResource) Identity() resourceids.ResourceId {
+	return &python3package.Python3PackageId{}
+}
@@ -79,6 +79,8 @@ func contentLinkSchema(isDraft bool) *pluginsdk.Schema {
 	return ins
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name automation_runbook -service-package-name automation -properties "resource_group_name,automation_account_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name PSWorkflow
+
 func resourceAutomationRunbook() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceAutomationRunbookCreateUpdate,
@@ -86,10 +88,11 @@ func resourceAutomationRunbook() *pluginsdk.Resource {
 		Update: resourceAutomationRunbookCreateUpdate,
 		Delete: resourceAutomationRunbookDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := runbook.ParseRunbookID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&runbook.RunbookId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&runbook.RunbookId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -468,7 +471,7 @@ func resourceAutomationRunbookRead(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceAutomationRunbookDelete(d *pluginsdk.ResourceData, meta interface{}) error {
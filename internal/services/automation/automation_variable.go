@@ -242,7 +242,7 @@ func resourceAutomationVariableRead(d *pluginsdk.ResourceData, meta interface{},
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func dataSourceAutomationVariableRead(d *pluginsdk.ResourceData, meta interface{}, varType string) error {
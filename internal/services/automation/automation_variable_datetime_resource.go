@@ -7,10 +7,13 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/variable"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name automation_variable_datetime -service-package-name automation -properties "resource_group_name,automation_account_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type AutomationVariableDateTimeResource
+
 func resourceAutomationVariableDateTime() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceAutomationVariableDateTimeCreateUpdate,
@@ -18,10 +21,11 @@ func resourceAutomationVariableDateTime() *pluginsdk.Resource {
 		Update: resourceAutomationVariableDateTimeCreateUpdate,
 		Delete: resourceAutomationVariableDateTimeDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := variable.ParseVariableID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&variable.VariableId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&variable.VariableId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
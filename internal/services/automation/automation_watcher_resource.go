@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2020-01-13-preview/watcher"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -32,10 +33,14 @@ type WatcherModel struct {
 	Status                      string                 `tfschema:"status"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name automation_watcher -service-package-name automation -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:automation_account_id,automation_account_name:automation_account_id" -test-resource-type WatcherResource
+
 type WatcherResource struct{}
 
 var _ sdk.Resource = (*WatcherResource)(nil)
 
+var _ sdk.ResourceWithIdentity = WatcherResource{}
+
 func (m WatcherResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"automation_account_id": {
@@ -263,3 +268,7 @@ func (m WatcherResource) Delete() sdk.ResourceFunc {
 func (m WatcherResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return watcher.ValidateWatcherID
 }
+
+func (m WatcherResource) Identity() resourceids.ResourceId {
+	return &watcher.WatcherId{}
+}
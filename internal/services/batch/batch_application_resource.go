@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2024-07-01/application"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name batch_application -service-package-name batch -properties "resource_group_name,name,batch_account_name:account_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name template -test-params "allow_updates = true"
+
 func resourceBatchApplication() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceBatchApplicationCreate,
@@ -33,10 +36,11 @@ func resourceBatchApplication() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := application.ParseApplicationID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&application.ApplicationId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&application.ApplicationId{}),
+		},
 
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
@@ -147,7 +151,7 @@ func resourceBatchApplicationRead(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceBatchApplicationUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2024-07-01/batchaccount"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2024-07-01/pool"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -20,9 +21,12 @@ import (
 	batchDataplane "github.com/jackofallops/kermit/sdk/batch/2022-01.15.0/batch"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name batch_job -service-package-name batch -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,batch_account_name:id,pool_name:id"
+
 type BatchJobResource struct{}
 
 var _ sdk.ResourceWithUpdate = BatchJobResource{}
+var _ sdk.ResourceWithIdentity = BatchJobResource{}
 
 type BatchJobModel struct {
 	Name                        string            `tfschema:"name"`
@@ -91,6 +95,10 @@ func (r BatchJobResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return validate.JobID
 }
 
+func (r BatchJobResource) Identity() resourceids.ResourceId {
+	return &parse.JobId{}
+}
+
 func (r BatchJobResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
@@ -190,6 +198,10 @@ func (r BatchJobResource) Read() sdk.ResourceFunc {
 
 			model.CommonEnvironmentProperties = r.flattenEnvironmentSettings(resp.CommonEnvironmentSettings)
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&model)
 		},
 	}
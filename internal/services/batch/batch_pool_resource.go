@@ -30,6 +30,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name batch_pool -service-package-name batch -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "batch_account_name:id"
+
 func resourceBatchPool() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceBatchPoolCreate,
@@ -44,10 +46,12 @@ func resourceBatchPool() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := pool.ParsePoolID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&pool.PoolId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&pool.PoolId{}),
+		},
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -1339,7 +1343,7 @@ func resourceBatchPoolRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceBatchPoolDelete(d *pluginsdk.ResourceData, meta interface{}) error {
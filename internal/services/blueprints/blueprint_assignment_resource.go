@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/blueprints/2018-11-01-preview/assignment"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/blueprints/2018-11-01-preview/publishedblueprint"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -26,6 +27,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name blueprint_assignment -service-package-name blueprints -properties "name" -compare-values "resource_scope:id" -test-params "testAcc_basicSubscription,v0.1_testAcc"
+
 func resourceBlueprintAssignment() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceBlueprintAssignmentCreateUpdate,
@@ -33,10 +36,11 @@ func resourceBlueprintAssignment() *pluginsdk.Resource {
 		Read:   resourceBlueprintAssignmentRead,
 		Delete: resourceBlueprintAssignmentDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := assignment.ParseScopedBlueprintAssignmentID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&assignment.ScopedBlueprintAssignmentId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&assignment.ScopedBlueprintAssignmentId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -301,7 +305,7 @@ func resourceBlueprintAssignmentRead(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceBlueprintAssignmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
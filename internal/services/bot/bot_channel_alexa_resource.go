@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -25,6 +26,8 @@ import (
 	"github.com/jackofallops/kermit/sdk/botservice/2021-05-01-preview/botservice"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name bot_channel_alexa -service-package-name bot -properties "resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "bot_service_name:id,name:id"
+
 func resourceBotChannelAlexa() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceBotChannelAlexaCreate,
@@ -32,10 +35,11 @@ func resourceBotChannelAlexa() *pluginsdk.Resource {
 		Delete: resourceBotChannelAlexaDelete,
 		Update: resourceBotChannelAlexaUpdate,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.BotChannelID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.BotChannelId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.BotChannelId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -154,7 +158,7 @@ func resourceBotChannelAlexaRead(d *pluginsdk.ResourceData, meta interface{}) er
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceBotChannelAlexaUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -25,6 +25,8 @@ import (
 	"github.com/jackofallops/kermit/sdk/botservice/2021-05-01-preview/botservice"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name bot_channel_direct_line_speech -service-package-name bot -properties "resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "bot_service_name:id,name:id" -test-name cognitiveAccount
+
 func resourceBotChannelDirectLineSpeech() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceBotChannelDirectLineSpeechCreate,
@@ -32,10 +34,11 @@ func resourceBotChannelDirectLineSpeech() *pluginsdk.Resource {
 		Delete: resourceBotChannelDirectLineSpeechDelete,
 		Update: resourceBotChannelDirectLineSpeechUpdate,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.BotChannelID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.BotChannelId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.BotChannelId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -177,7 +180,7 @@ func resourceBotChannelDirectLineSpeechRead(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceBotChannelDirectLineSpeechUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -22,6 +23,8 @@ import (
 	"github.com/jackofallops/kermit/sdk/botservice/2021-05-01-preview/botservice"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name bot_channel_directline -service-package-name bot -properties "resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "bot_service_name:id,name:id" -test-name basicConfig
+
 func resourceBotChannelDirectline() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceBotChannelDirectlineCreate,
@@ -29,10 +32,11 @@ func resourceBotChannelDirectline() *pluginsdk.Resource {
 		Delete: resourceBotChannelDirectlineDelete,
 		Update: resourceBotChannelDirectlineUpdate,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.BotChannelID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.BotChannelId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.BotChannelId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -225,7 +229,7 @@ func resourceBotChannelDirectlineRead(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceBotChannelDirectlineUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -22,6 +23,8 @@ import (
 	"github.com/jackofallops/kermit/sdk/botservice/2021-05-01-preview/botservice"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name bot_channel_web_chat -service-package-name bot -properties "resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "bot_service_name:id,name:id"
+
 func resourceBotChannelWebChat() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceBotChannelWebChatCreate,
@@ -29,10 +32,11 @@ func resourceBotChannelWebChat() *pluginsdk.Resource {
 		Delete: resourceBotChannelWebChatDelete,
 		Update: resourceBotChannelWebChatUpdate,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.BotChannelID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.BotChannelId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.BotChannelId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -184,7 +188,7 @@ func resourceBotChannelWebChatRead(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceBotChannelWebChatUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/parse"
@@ -25,6 +26,8 @@ import (
 	"github.com/jackofallops/kermit/sdk/botservice/2021-05-01-preview/botservice"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name bot_channels_registration -service-package-name bot -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name basicConfig
+
 func resourceBotChannelsRegistration() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceBotChannelsRegistrationCreate,
@@ -39,10 +42,7 @@ func resourceBotChannelsRegistration() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := parse.BotServiceID(id)
-			return err
-		}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&parse.BotServiceId{}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
 			client := meta.(*clients.Client).Bot.BotClient
 
 			id, err := parse.BotServiceID(d.Id())
@@ -65,6 +65,10 @@ func resourceBotChannelsRegistration() *pluginsdk.Resource {
 			return []*pluginsdk.ResourceData{d}, nil
 		}),
 
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.BotServiceId{}),
+		},
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -274,7 +278,11 @@ func resourceBotChannelsRegistrationRead(d *pluginsdk.ResourceData, meta interfa
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	if err := tags.FlattenAndSet(d, resp.Tags); err != nil {
+		return err
+	}
+
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceBotChannelsRegistrationUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -4,13 +4,17 @@
 package bot
 
 import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/jackofallops/kermit/sdk/botservice/2021-05-01-preview/botservice"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name bot_service_azure_bot -service-package-name bot -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,name:id"
+
 type AzureBotServiceResource struct {
 	base botBaseResource
 }
@@ -19,6 +23,8 @@ var _ sdk.ResourceWithUpdate = AzureBotServiceResource{}
 
 var _ sdk.ResourceWithCustomImporter = AzureBotServiceResource{}
 
+var _ sdk.ResourceWithIdentity = AzureBotServiceResource{}
+
 func (r AzureBotServiceResource) Arguments() map[string]*pluginsdk.Schema {
 	schema := map[string]*pluginsdk.Schema{
 		"name": {
@@ -47,6 +53,10 @@ func (r AzureBotServiceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc
 	return validate.BotServiceID
 }
 
+func (r AzureBotServiceResource) Identity() resourceids.ResourceId {
+	return &parse.BotServiceId{}
+}
+
 func (r AzureBotServiceResource) Create() sdk.ResourceFunc {
 	return r.base.createFunc(r.ResourceType(), string(botservice.KindAzurebot))
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/parse"
@@ -24,16 +25,15 @@ import (
 	"github.com/jackofallops/kermit/sdk/botservice/2021-05-01-preview/botservice"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name bot_web_app -service-package-name bot -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name basicConfig
+
 func resourceBotWebApp() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceBotWebAppCreate,
 		Read:   resourceBotWebAppRead,
 		Update: resourceBotWebAppUpdate,
 		Delete: resourceBotWebAppDelete,
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := parse.BotServiceID(id)
-			return err
-		}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&parse.BotServiceId{}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
 			client := meta.(*clients.Client).Bot.BotClient
 
 			id, err := parse.BotServiceID(d.Id())
@@ -56,6 +56,10 @@ func resourceBotWebApp() *pluginsdk.Resource {
 			return []*pluginsdk.ResourceData{d}, nil
 		}),
 
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.BotServiceId{}),
+		},
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -234,7 +238,11 @@ func resourceBotWebAppRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		d.Set("luis_app_ids", props.LuisAppIds)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	if err := tags.FlattenAndSet(d, resp.Tags); err != nil {
+		return err
+	}
+
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceBotWebAppUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
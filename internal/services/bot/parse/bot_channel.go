@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &BotChannelId{}
+
 type BotChannelId struct {
 	SubscriptionId string
 	ResourceGroup  string
@@ -44,6 +46,45 @@ func (id BotChannelId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.BotServiceName, id.ChannelName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this BotChannel ID
+func (id BotChannelId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftBotService", "Microsoft.BotService", "Microsoft.BotService"),
+		resourceids.StaticSegment("staticBotServices", "botServices", "botServices"),
+		resourceids.UserSpecifiedSegment("botServiceName", "botService1"),
+		resourceids.StaticSegment("staticChannels", "channels", "channels"),
+		resourceids.UserSpecifiedSegment("channelName", "Discovery1"),
+	}
+}
+
+// FromParseResult populates the fields of this BotChannel ID from the ParseResult, for use with resourceids.Parser
+func (id *BotChannelId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.BotServiceName, ok = input.Parsed["botServiceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "botServiceName", input)
+	}
+
+	if id.ChannelName, ok = input.Parsed["channelName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "channelName", input)
+	}
+
+	return nil
+}
+
 // BotChannelID parses a BotChannel ID into an BotChannelId struct
 func BotChannelID(input string) (*BotChannelId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
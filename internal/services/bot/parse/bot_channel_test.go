@@ -21,6 +21,24 @@ func TestBotChannelIDFormatter(t *testing.T) {
 	}
 }
 
+func TestBotChannelIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&BotChannelId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.BotService/botServices/botService1/channels/Discovery1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual BotChannelId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.BotService/botServices/botService1/channels/Discovery1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestBotChannelID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,24 @@ func TestBotConnectionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestBotConnectionIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&BotConnectionId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.BotService/botServices/botService1/connections/connection1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual BotConnectionId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.BotService/botServices/botService1/connections/connection1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestBotConnectionID(t *testing.T) {
 	testData := []struct {
 		Input    string
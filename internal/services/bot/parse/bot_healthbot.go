@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &BotHealthbotId{}
+
 type BotHealthbotId struct {
 	SubscriptionId string
 	ResourceGroup  string
@@ -41,6 +43,39 @@ func (id BotHealthbotId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.HealthBotName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this BotHealthbot ID
+func (id BotHealthbotId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resourceGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftHealthBot", "Microsoft.HealthBot", "Microsoft.HealthBot"),
+		resourceids.StaticSegment("staticHealthBots", "healthBots", "healthBots"),
+		resourceids.UserSpecifiedSegment("healthBotName", "bot1"),
+	}
+}
+
+// FromParseResult populates the fields of this BotHealthbot ID from the ParseResult, for use with resourceids.Parser
+func (id *BotHealthbotId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.HealthBotName, ok = input.Parsed["healthBotName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "healthBotName", input)
+	}
+
+	return nil
+}
+
 // BotHealthbotID parses a BotHealthbot ID into an BotHealthbotId struct
 func BotHealthbotID(input string) (*BotHealthbotId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -21,6 +21,24 @@ func TestBotHealthbotIDFormatter(t *testing.T) {
 	}
 }
 
+func TestBotHealthbotIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&BotHealthbotId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.HealthBot/healthBots/bot1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual BotHealthbotId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.HealthBot/healthBots/bot1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestBotHealthbotID(t *testing.T) {
 	testData := []struct {
 		Input    string
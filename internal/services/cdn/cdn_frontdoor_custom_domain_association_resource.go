@@ -8,6 +8,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
@@ -21,6 +22,8 @@ var (
 	cdnFrontDoorRouteResourceName        = "azurerm_cdn_frontdoor_route"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name cdn_frontdoor_custom_domain_association -service-package-name cdn -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,profile_name:id,name:id" -test-resource-type CdnFrontDoorCustomDomainAssociationResource
+
 func resourceCdnFrontDoorCustomDomainAssociation() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceCdnFrontDoorCustomDomainAssociationCreate,
@@ -35,10 +38,11 @@ func resourceCdnFrontDoorCustomDomainAssociation() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := parse.FrontDoorCustomDomainAssociationID(id)
-			return err
-		}, importCdnFrontDoorCustomDomainAssociation()),
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&parse.FrontDoorCustomDomainAssociationId{}, importCdnFrontDoorCustomDomainAssociation()),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.FrontDoorCustomDomainAssociationId{}),
+		},
 
 		Schema: map[string]*pluginsdk.Schema{
 			"cdn_frontdoor_custom_domain_id": {
@@ -117,7 +121,7 @@ func resourceCdnFrontDoorCustomDomainAssociationRead(d *pluginsdk.ResourceData,
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceCdnFrontDoorCustomDomainAssociationUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
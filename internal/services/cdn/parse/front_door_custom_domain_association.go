@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &FrontDoorCustomDomainAssociationId{}
+
 type FrontDoorCustomDomainAssociationId struct {
 	SubscriptionId  string
 	ResourceGroup   string
@@ -44,6 +46,45 @@ func (id FrontDoorCustomDomainAssociationId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.AssociationName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this FrontDoorCustomDomainAssociation ID
+func (id FrontDoorCustomDomainAssociationId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCdn", "Microsoft.Cdn", "Microsoft.Cdn"),
+		resourceids.StaticSegment("staticProfiles", "profiles", "profiles"),
+		resourceids.UserSpecifiedSegment("profileName", "profile1"),
+		resourceids.StaticSegment("staticAssociations", "associations", "associations"),
+		resourceids.UserSpecifiedSegment("associationName", "assoc1"),
+	}
+}
+
+// FromParseResult populates the fields of this FrontDoorCustomDomainAssociation ID from the ParseResult, for use with resourceids.Parser
+func (id *FrontDoorCustomDomainAssociationId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ProfileName, ok = input.Parsed["profileName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "profileName", input)
+	}
+
+	if id.AssociationName, ok = input.Parsed["associationName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "associationName", input)
+	}
+
+	return nil
+}
+
 // FrontDoorCustomDomainAssociationID parses a FrontDoorCustomDomainAssociation ID into an FrontDoorCustomDomainAssociationId struct
 func FrontDoorCustomDomainAssociationID(input string) (*FrontDoorCustomDomainAssociationId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -21,6 +21,24 @@ func TestFrontDoorCustomDomainIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFrontDoorCustomDomainIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&FrontDoorCustomDomainId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cdn/profiles/profile1/customDomains/customDomain1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual FrontDoorCustomDomainId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cdn/profiles/profile1/customDomains/customDomain1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestFrontDoorCustomDomainID(t *testing.T) {
 	testData := []struct {
 		Input    string
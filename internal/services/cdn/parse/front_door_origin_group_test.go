@@ -21,6 +21,24 @@ func TestFrontDoorOriginGroupIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFrontDoorOriginGroupIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&FrontDoorOriginGroupId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cdn/profiles/profile1/originGroups/originGroup1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual FrontDoorOriginGroupId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cdn/profiles/profile1/originGroups/originGroup1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestFrontDoorOriginGroupID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &FrontDoorRouteId{}
+
 type FrontDoorRouteId struct {
 	SubscriptionId  string
 	ResourceGroup   string
@@ -47,6 +49,51 @@ func (id FrontDoorRouteId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this FrontDoorRoute ID
+func (id FrontDoorRouteId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCdn", "Microsoft.Cdn", "Microsoft.Cdn"),
+		resourceids.StaticSegment("staticProfiles", "profiles", "profiles"),
+		resourceids.UserSpecifiedSegment("profileName", "profile1"),
+		resourceids.StaticSegment("staticAfdEndpoints", "afdEndpoints", "afdEndpoints"),
+		resourceids.UserSpecifiedSegment("afdEndpointName", "endpoint1"),
+		resourceids.StaticSegment("staticRoutes", "routes", "routes"),
+		resourceids.UserSpecifiedSegment("routeName", "route1"),
+	}
+}
+
+// FromParseResult populates the fields of this FrontDoorRoute ID from the ParseResult, for use with resourceids.Parser
+func (id *FrontDoorRouteId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ProfileName, ok = input.Parsed["profileName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "profileName", input)
+	}
+
+	if id.AfdEndpointName, ok = input.Parsed["afdEndpointName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "afdEndpointName", input)
+	}
+
+	if id.RouteName, ok = input.Parsed["routeName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "routeName", input)
+	}
+
+	return nil
+}
+
 // FrontDoorRouteID parses a FrontDoorRoute ID into an FrontDoorRouteId struct
 func FrontDoorRouteID(input string) (*FrontDoorRouteId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &FrontDoorSecurityPolicyId{}
+
 type FrontDoorSecurityPolicyId struct {
 	SubscriptionId     string
 	ResourceGroup      string
@@ -44,6 +46,45 @@ func (id FrontDoorSecurityPolicyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.SecurityPolicyName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this FrontDoorSecurityPolicy ID
+func (id FrontDoorSecurityPolicyId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCdn", "Microsoft.Cdn", "Microsoft.Cdn"),
+		resourceids.StaticSegment("staticProfiles", "profiles", "profiles"),
+		resourceids.UserSpecifiedSegment("profileName", "profile1"),
+		resourceids.StaticSegment("staticSecurityPolicies", "securityPolicies", "securityPolicies"),
+		resourceids.UserSpecifiedSegment("securityPolicyName", "securityPolicy1"),
+	}
+}
+
+// FromParseResult populates the fields of this FrontDoorSecurityPolicy ID from the ParseResult, for use with resourceids.Parser
+func (id *FrontDoorSecurityPolicyId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ProfileName, ok = input.Parsed["profileName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "profileName", input)
+	}
+
+	if id.SecurityPolicyName, ok = input.Parsed["securityPolicyName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "securityPolicyName", input)
+	}
+
+	return nil
+}
+
 // FrontDoorSecurityPolicyID parses a FrontDoorSecurityPolicy ID into an FrontDoorSecurityPolicyId struct
 func FrontDoorSecurityPolicyID(input string) (*FrontDoorSecurityPolicyId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
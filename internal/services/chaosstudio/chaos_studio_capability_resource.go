@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2023-11-01/capabilities"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2023-11-01/capabilitytypes"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,11 +20,14 @@ import (
 )
 
 var _ sdk.Resource = ChaosStudioCapabilityResource{}
+var _ sdk.ResourceWithIdentity = ChaosStudioCapabilityResource{}
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name chaos_studio_capability -service-package-name chaosstudio -compare-values "scope:id,target_name:id,name:id" -test-resource-type ChaosStudioCapabilityTestResource
 
 type ChaosStudioCapabilityResource struct{}
 
 func (r ChaosStudioCapabilityResource) ModelObject() interface{} {
-	return &ChaosStudioTargetResourceSchema{}
+	return &ChaosStudioCapabilityResourceSchema{}
 }
 
 type ChaosStudioCapabilityResourceSchema struct {
@@ -36,6 +40,10 @@ func (r ChaosStudioCapabilityResource) IDValidationFunc() pluginsdk.SchemaValida
 	return commonids.ValidateChaosStudioCapabilityID
 }
 
+func (r ChaosStudioCapabilityResource) Identity() resourceids.ResourceId {
+	return &commonids.ChaosStudioCapabilityId{}
+}
+
 func (r ChaosStudioCapabilityResource) ResourceType() string {
 	return "azurerm_chaos_studio_capability"
 }
@@ -175,6 +183,10 @@ func (r ChaosStudioCapabilityResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 		Timeout: 5 * time.Minute,
@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chaosstudio
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.ResourceWithIdentity = ChaosStudioTargetResource{}
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name chaos_studio_target -service-package-name chaosstudio -properties "scope:target_resource_id,name:target_type" -test-resource-type ChaosStudioTargetTestResource
+
+// Identity is defined here rather than in the generated resource, the Resource Identity data is then set from the
+// ID of the resource once the generated Read function completes
+func (r ChaosStudioTargetResource) Identity() resourceids.ResourceId {
+	return &commonids.ChaosStudioTargetId{}
+}
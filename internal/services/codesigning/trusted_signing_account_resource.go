@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/codesigning/2024-09-30-preview/codesigningaccounts"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -28,10 +29,14 @@ type TrustedSigningAccountModel struct {
 	Tags              map[string]string `tfschema:"tags"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name trusted_signing_account -service-package-name codesigning -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type TrustedSigningAccountResource
+
 type TrustedSigningAccountResource struct{}
 
 var _ sdk.Resource = (*TrustedSigningAccountResource)(nil)
 
+var _ sdk.ResourceWithIdentity = TrustedSigningAccountResource{}
+
 func (m TrustedSigningAccountResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -220,3 +225,7 @@ func (m TrustedSigningAccountResource) Delete() sdk.ResourceFunc {
 func (m TrustedSigningAccountResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return codesigningaccounts.ValidateCodeSigningAccountID
 }
+
+func (m TrustedSigningAccountResource) Identity() resourceids.ResourceId {
+	return &codesigningaccounts.CodeSigningAccountId{}
+}
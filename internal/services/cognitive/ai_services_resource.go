@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2025-06-01/cognitiveservicesaccounts"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -38,6 +39,9 @@ import (
 var _ sdk.ResourceWithUpdate = AIServices{}
 
 var _ sdk.ResourceWithCustomImporter = AIServices{}
+var _ sdk.ResourceWithIdentity = AIServices{}
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name ai_services -service-package-name cognitive -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type AIServices
 
 type AIServices struct{}
 
@@ -464,6 +468,10 @@ func (AIServices) Read() sdk.ResourceFunc {
 				state.Tags = pointer.From(model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -620,6 +628,10 @@ func (AIServices) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return cognitiveservicesaccounts.ValidateAccountID
 }
 
+func (AIServices) Identity() resourceids.ResourceId {
+	return &cognitiveservicesaccounts.AccountId{}
+}
+
 func expandCustomerManagedKey(input []CustomerManagedKey) (*cognitiveservicesaccounts.Encryption, error) {
 	if len(input) == 0 {
 		return &cognitiveservicesaccounts.Encryption{
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2025-06-01/raiblocklists"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name cognitive_account_rai_blocklist -service-package-name cognitive -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:cognitive_account_id,account_name:cognitive_account_id" -test-resource-type CognitiveRaiBlocklistTestResource -test-sequential
+
 type cognitiveRaiBlocklistModel struct {
 	Name               string `tfschema:"name"`
 	CognitiveAccountId string `tfschema:"cognitive_account_id"`
@@ -28,6 +31,8 @@ type CognitiveRaiBlocklistResource struct{}
 
 var _ sdk.Resource = CognitiveRaiBlocklistResource{}
 
+var _ sdk.ResourceWithIdentity = CognitiveRaiBlocklistResource{}
+
 func (c CognitiveRaiBlocklistResource) Arguments() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"name": {
@@ -182,6 +187,10 @@ func (c CognitiveRaiBlocklistResource) IDValidationFunc() func(interface{}, stri
 	return raiblocklists.ValidateRaiBlocklistID
 }
 
+func (c CognitiveRaiBlocklistResource) Identity() resourceids.ResourceId {
+	return &raiblocklists.RaiBlocklistId{}
+}
+
 func (c CognitiveRaiBlocklistResource) ModelObject() interface{} {
 	return &cognitiveRaiBlocklistModel{}
 }
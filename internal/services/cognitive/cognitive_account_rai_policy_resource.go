@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2025-06-01/raipolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -20,6 +21,9 @@ import (
 
 var _ sdk.ResourceWithUpdate = &CognitiveAccountRaiPolicyResource{}
 
+var _ sdk.ResourceWithIdentity = CognitiveAccountRaiPolicyResource{}
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name cognitive_account_rai_policy -service-package-name cognitive -properties "name" -compare-values "subscription_id:id,resource_group_name:id,account_name:id" -test-resource-type RaiPolicyTestResource
 type CognitiveAccountRaiPolicyResource struct{}
 
 type AccountRaiPolicyContentFilter struct {
@@ -304,6 +308,10 @@ func (r CognitiveAccountRaiPolicyResource) IDValidationFunc() pluginsdk.SchemaVa
 	return raipolicies.ValidateRaiPolicyID
 }
 
+func (r CognitiveAccountRaiPolicyResource) Identity() resourceids.ResourceId {
+	return &raipolicies.RaiPolicyId{}
+}
+
 func expandRaiPolicyContentFilters(filters []AccountRaiPolicyContentFilter) *[]raipolicies.RaiPolicyContentFilter {
 	if filters == nil {
 		return nil
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2023-03-31/communicationservices"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/migration"
@@ -23,8 +24,11 @@ import (
 var (
 	_ sdk.ResourceWithUpdate         = CommunicationServiceResource{}
 	_ sdk.ResourceWithStateMigration = CommunicationServiceResource{}
+	_ sdk.ResourceWithIdentity       = CommunicationServiceResource{}
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name communication_service -service-package-name communication -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type CommunicationServiceTestResource
+
 type CommunicationServiceResource struct{}
 
 type CommunicationServiceResourceModel struct {
@@ -265,6 +269,10 @@ func (CommunicationServiceResource) Read() sdk.ResourceFunc {
 				state.SecondaryKey = pointer.From(model.SecondaryKey)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -293,3 +301,7 @@ func (CommunicationServiceResource) Delete() sdk.ResourceFunc {
 func (CommunicationServiceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return communicationservices.ValidateCommunicationServiceID
 }
+
+func (CommunicationServiceResource) Identity() resourceids.ResourceId {
+	return &communicationservices.CommunicationServiceId{}
+}
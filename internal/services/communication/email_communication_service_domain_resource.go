@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2023-03-31/domains"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2023-03-31/emailservices"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -21,6 +22,9 @@ import (
 )
 
 var _ sdk.ResourceWithUpdate = EmailCommunicationServiceDomainResource{}
+var _ sdk.ResourceWithIdentity = EmailCommunicationServiceDomainResource{}
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name email_communication_service_domain -service-package-name communication -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,email_service_name:id" -test-resource-type EmailServiceDomainTestResource
 
 type EmailCommunicationServiceDomainResource struct{}
 
@@ -282,6 +286,10 @@ func (EmailCommunicationServiceDomainResource) Read() sdk.ResourceFunc {
 				state.Tags = pointer.From(model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -310,3 +318,7 @@ func (EmailCommunicationServiceDomainResource) Delete() sdk.ResourceFunc {
 func (EmailCommunicationServiceDomainResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return domains.ValidateDomainID
 }
+
+func (EmailCommunicationServiceDomainResource) Identity() resourceids.ResourceId {
+	return &domains.DomainId{}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2023-03-31/senderusernames"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -18,6 +19,9 @@ import (
 )
 
 var _ sdk.ResourceWithUpdate = EmailCommunicationServiceDomainSenderUsernameResource{}
+var _ sdk.ResourceWithIdentity = EmailCommunicationServiceDomainSenderUsernameResource{}
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name email_communication_service_domain_sender_username -service-package-name communication -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,email_service_name:id,domain_name:id" -test-resource-type EmailServiceDomainSenderUsernameTestResource
 
 type EmailCommunicationServiceDomainSenderUsernameResource struct{}
 
@@ -50,6 +54,10 @@ func (EmailCommunicationServiceDomainSenderUsernameResource) IDValidationFunc()
 	return senderusernames.ValidateSenderUsernameID
 }
 
+func (EmailCommunicationServiceDomainSenderUsernameResource) Identity() resourceids.ResourceId {
+	return &senderusernames.SenderUsernameId{}
+}
+
 func (EmailCommunicationServiceDomainSenderUsernameResource) Attributes() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{}
 }
@@ -189,6 +197,10 @@ func (EmailCommunicationServiceDomainSenderUsernameResource) Read() sdk.Resource
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2023-03-31/emailservices"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/validate"
@@ -20,6 +21,9 @@ import (
 )
 
 var _ sdk.ResourceWithUpdate = EmailCommunicationServiceResource{}
+var _ sdk.ResourceWithIdentity = EmailCommunicationServiceResource{}
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name email_communication_service -service-package-name communication -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type EmailServiceTestResource
 
 type EmailCommunicationServiceResource struct{}
 
@@ -202,6 +206,10 @@ func (EmailCommunicationServiceResource) Read() sdk.ResourceFunc {
 				state.Tags = pointer.From(model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -230,3 +238,7 @@ func (EmailCommunicationServiceResource) Delete() sdk.ResourceFunc {
 func (EmailCommunicationServiceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return emailservices.ValidateEmailServiceID
 }
+
+func (EmailCommunicationServiceResource) Identity() resourceids.ResourceId {
+	return &emailservices.EmailServiceId{}
+}
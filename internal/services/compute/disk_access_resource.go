@@ -13,12 +13,15 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-02/diskaccesses"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name disk_access -service-package-name compute -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name empty
+
 func resourceDiskAccess() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceDiskAccessCreateUpdate,
@@ -26,10 +29,11 @@ func resourceDiskAccess() *pluginsdk.Resource {
 		Update: resourceDiskAccessCreateUpdate,
 		Delete: resourceDiskAccessDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := diskaccesses.ParseDiskAccessID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&diskaccesses.DiskAccessId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&diskaccesses.DiskAccessId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -118,7 +122,7 @@ func resourceDiskAccessRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceDiskAccessDelete(d *pluginsdk.ResourceData, meta interface{}) error {
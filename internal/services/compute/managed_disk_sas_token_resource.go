@@ -10,12 +10,15 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-04-02/disks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name managed_disk_sas_token -service-package-name compute -compare-values "subscription_id:id,resource_group_name:id,name:id" -test-resource-type ManagedDiskSASTokenResource
+
 type Result struct {
 	Properties Properties `tfschema:"properties"`
 }
@@ -40,10 +43,11 @@ func resourceManagedDiskSasToken() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := commonids.ParseManagedDiskID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&commonids.ManagedDiskId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&commonids.ManagedDiskId{}),
+		},
 
 		Schema: map[string]*pluginsdk.Schema{
 			"managed_disk_id": {
@@ -165,7 +169,7 @@ func resourceManagedDiskSasTokenRead(d *pluginsdk.ResourceData, meta interface{}
 
 	d.SetId(diskId.ID())
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, diskId)
 }
 
 func resourceManagedDiskSasTokenDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -61,8 +61,8 @@ type Disk struct {
 
 type EncryptionSetting struct {
 	Enabled            bool                `tfschema:"enabled"`
-	DiskEncryptionKeys []DiskEncryptionKey `tfschema:"disk_encryption_keys"`
-	KeyEncryptionKeys  []KeyEncryptionKey  `tfschema:"key_encryption_keys"`
+	DiskEncryptionKeys []DiskEncryptionKey `tfschema:"disk_encryption_key"`
+	KeyEncryptionKeys  []KeyEncryptionKey  `tfschema:"key_encryption_key"`
 }
 
 type DiskEncryptionKey struct {
@@ -26,7 +26,7 @@ func resourceMarketplaceAgreement() *pluginsdk.Resource {
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := agreements.ParsePlanID(id)
 			return err
-		}),
+		}, &agreements.PlanId{}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
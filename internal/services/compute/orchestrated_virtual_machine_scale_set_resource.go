@@ -13,7 +13,6 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
@@ -39,6 +38,8 @@ const (
 	SkuNameMix = "Mix"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name orchestrated_virtual_machine_scale_set -service-package-name compute -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceOrchestratedVirtualMachineScaleSet() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceOrchestratedVirtualMachineScaleSetCreate,
@@ -46,10 +47,11 @@ func resourceOrchestratedVirtualMachineScaleSet() *pluginsdk.Resource {
 		Update: resourceOrchestratedVirtualMachineScaleSetUpdate,
 		Delete: resourceOrchestratedVirtualMachineScaleSetDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := commonids.ParseVirtualMachineScaleSetID(id)
-			return err
-		}, importOrchestratedVirtualMachineScaleSet),
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&virtualmachinescalesets.VirtualMachineScaleSetId{}, importOrchestratedVirtualMachineScaleSet),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&virtualmachinescalesets.VirtualMachineScaleSetId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
@@ -1498,9 +1500,11 @@ func resourceOrchestratedVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, m
 			d.Set("extension_operations_enabled", extensionOperationsEnabled)
 			d.Set("upgrade_mode", upgradeMode)
 		}
-		return tags.FlattenAndSet(d, model.Tags)
+		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+			return err
+		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceOrchestratedVirtualMachineScaleSetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,6 +21,24 @@ func TestDiskEncryptionSetIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDiskEncryptionSetIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&DiskEncryptionSetId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/diskEncryptionSets/set1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual DiskEncryptionSetId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/diskEncryptionSets/set1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestDiskEncryptionSetID(t *testing.T) {
 	testData := []struct {
 		Input    string
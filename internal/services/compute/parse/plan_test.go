@@ -21,6 +21,24 @@ func TestPlanIDFormatter(t *testing.T) {
 	}
 }
 
+func TestPlanIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&PlanId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.MarketplaceOrdering/agreements/agreement1/offers/offer1/plans/hourly", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual PlanId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.MarketplaceOrdering/agreements/agreement1/offers/offer1/plans/hourly"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestPlanID(t *testing.T) {
 	testData := []struct {
 		Input    string
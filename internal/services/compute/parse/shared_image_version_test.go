@@ -21,6 +21,24 @@ func TestSharedImageVersionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSharedImageVersionIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&SharedImageVersionId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/galleries/gallery1/images/image1/versions/version1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual SharedImageVersionId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/galleries/gallery1/images/image1/versions/version1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestSharedImageVersionID(t *testing.T) {
 	testData := []struct {
 		Input    string
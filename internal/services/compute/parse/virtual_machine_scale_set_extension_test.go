@@ -21,6 +21,24 @@ func TestVirtualMachineScaleSetExtensionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestVirtualMachineScaleSetExtensionIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&VirtualMachineScaleSetExtensionId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachineScaleSets/scaleSet1/extensions/extension1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual VirtualMachineScaleSetExtensionId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachineScaleSets/scaleSet1/extensions/extension1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestVirtualMachineScaleSetExtensionID(t *testing.T) {
 	testData := []struct {
 		Input    string
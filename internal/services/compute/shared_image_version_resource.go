@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/images"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-07-03/galleryimageversions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
@@ -29,6 +30,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name shared_image_version -service-package-name compute -properties "resource_group_name,gallery_name,image_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name setup
+
 func resourceSharedImageVersion() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceSharedImageVersionCreate,
@@ -36,10 +39,11 @@ func resourceSharedImageVersion() *pluginsdk.Resource {
 		Update: resourceSharedImageVersionUpdate,
 		Delete: resourceSharedImageVersionDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := galleryimageversions.ParseImageVersionID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&galleryimageversions.ImageVersionId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&galleryimageversions.ImageVersionId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -440,9 +444,11 @@ func resourceSharedImageVersionRead(d *pluginsdk.ResourceData, meta interface{})
 				d.Set("deletion_of_replicated_locations_enabled", pointer.From(safetyProfile.AllowDeletionOfReplicatedLocations))
 			}
 		}
-		return tags.FlattenAndSet(d, model.Tags)
+		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+			return err
+		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceSharedImageVersionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
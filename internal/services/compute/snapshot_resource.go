@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-02/diskaccesses"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-02/snapshots"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -27,6 +28,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name snapshot -service-package-name compute -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name fromManagedDisk
+
 func resourceSnapshot() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceSnapshotCreateUpdate,
@@ -34,10 +37,11 @@ func resourceSnapshot() *pluginsdk.Resource {
 		Update: resourceSnapshotCreateUpdate,
 		Delete: resourceSnapshotDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := snapshots.ParseSnapshotID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&snapshots.SnapshotId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&snapshots.SnapshotId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -294,7 +298,7 @@ func resourceSnapshotRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceSnapshotDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -33,7 +33,7 @@ func resourceSshPublicKey() *pluginsdk.Resource {
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := sshpublickeys.ParseSshPublicKeyID(id)
 			return err
-		}),
+		}, &sshpublickeys.SshPublicKeyId{}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(45 * time.Minute),
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-02/snapshots"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-04-02/disks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
@@ -27,8 +28,11 @@ var (
 	_ sdk.ResourceWithUpdate         = VirtualMachineImplicitDataDiskFromSourceResource{}
 	_ sdk.ResourceWithCustomImporter = VirtualMachineImplicitDataDiskFromSourceResource{}
 	_ sdk.ResourceWithCustomizeDiff  = VirtualMachineImplicitDataDiskFromSourceResource{}
+	_ sdk.ResourceWithIdentity       = VirtualMachineImplicitDataDiskFromSourceResource{}
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name virtual_machine_implicit_data_disk_from_source -service-package-name compute -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,virtual_machine_name:id"
+
 type VirtualMachineImplicitDataDiskFromSourceResource struct{}
 
 func (r VirtualMachineImplicitDataDiskFromSourceResource) ModelObject() interface{} {
@@ -50,6 +54,10 @@ func (r VirtualMachineImplicitDataDiskFromSourceResource) IDValidationFunc() plu
 	return validate.DataDiskID
 }
 
+func (r VirtualMachineImplicitDataDiskFromSourceResource) Identity() resourceids.ResourceId {
+	return &parse.DataDiskId{}
+}
+
 func (r VirtualMachineImplicitDataDiskFromSourceResource) ResourceType() string {
 	return "azurerm_virtual_machine_implicit_data_disk_from_source"
 }
@@ -279,6 +287,10 @@ func (r VirtualMachineImplicitDataDiskFromSourceResource) Read() sdk.ResourceFun
 
 			schema.WriteAcceleratorEnabled = pointer.From(disk.WriteAcceleratorEnabled)
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -13,20 +13,28 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/restorepointcollections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name virtual_machine_restore_point_collection -service-package-name compute -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 // VirtualMachineRestorePointCollectionResource remove this in 4.0, the resource is renamed
 type VirtualMachineRestorePointCollectionResource struct{}
 
 var (
 	_ sdk.ResourceWithUpdate                = VirtualMachineRestorePointCollectionResource{}
 	_ sdk.ResourceWithDeprecationReplacedBy = VirtualMachineRestorePointCollectionResource{}
+	_ sdk.ResourceWithIdentity              = VirtualMachineRestorePointCollectionResource{}
 )
 
+func (r VirtualMachineRestorePointCollectionResource) Identity() resourceids.ResourceId {
+	return &restorepointcollections.RestorePointCollectionId{}
+}
+
 func (r VirtualMachineRestorePointCollectionResource) DeprecatedInFavourOfResource() string {
 	return "azurerm_virtual_machine_restore_point_collection"
 }
@@ -157,6 +165,10 @@ func (r VirtualMachineRestorePointCollectionResource) Read() sdk.ResourceFunc {
 				schema.Tags = tags.Flatten(model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
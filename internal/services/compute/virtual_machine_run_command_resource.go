@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-03-01/virtualmachineruncommands"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -22,11 +23,18 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name virtual_machine_run_command -service-package-name compute -properties "name" -compare-values "subscription_id:id,resource_group_name:id,virtual_machine_name:id" -test-resource-type VirtualMachineRunCommandTestResource
+
 var (
-	_ sdk.Resource           = VirtualMachineRunCommandResource{}
-	_ sdk.ResourceWithUpdate = VirtualMachineRunCommandResource{}
+	_ sdk.Resource             = VirtualMachineRunCommandResource{}
+	_ sdk.ResourceWithUpdate   = VirtualMachineRunCommandResource{}
+	_ sdk.ResourceWithIdentity = VirtualMachineRunCommandResource{}
 )
 
+func (r VirtualMachineRunCommandResource) Identity() resourceids.ResourceId {
+	return &virtualmachineruncommands.VirtualMachineRunCommandId{}
+}
+
 type VirtualMachineRunCommandResource struct{}
 
 func (r VirtualMachineRunCommandResource) ModelObject() interface{} {
@@ -480,6 +488,10 @@ func (r VirtualMachineRunCommandResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
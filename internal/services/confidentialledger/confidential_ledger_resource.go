@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/confidentialledger/2022-05-13/confidentialledger"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/confidentialledger/validate"
@@ -22,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name confidential_ledger -service-package-name confidentialledger -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name public -test-resource-type ConfidentialLedgerResource -test-sequential
 func resourceConfidentialLedger() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceConfidentialLedgerCreate,
@@ -36,10 +38,11 @@ func resourceConfidentialLedger() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := confidentialledger.ParseLedgerID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&confidentialledger.LedgerId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&confidentialledger.LedgerId{}),
+		},
 
 		Schema: map[string]*pluginsdk.Schema{
 			// Required
@@ -219,7 +222,7 @@ func resourceConfidentialLedgerRead(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceConfidentialLedgerUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
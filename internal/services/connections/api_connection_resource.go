@@ -23,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_connection -service-package-name connections -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type ApiConnectionTestResource
+
 func resourceApiConnection() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceApiConnectionCreate,
@@ -37,10 +39,11 @@ func resourceApiConnection() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := connections.ParseConnectionID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&connections.ConnectionId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&connections.ConnectionId{}),
+		},
 
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
@@ -174,7 +177,7 @@ func resourceApiConnectionRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceApiConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
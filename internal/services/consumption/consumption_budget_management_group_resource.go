@@ -4,6 +4,7 @@
 package consumption
 
 import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/consumption/2019-10-01/budgets"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	validateManagementGroup "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/validate"
@@ -11,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name consumption_budget_management_group -service-package-name consumption -compare-values "scope:id,name:id"
+
 type ManagementGroupConsumptionBudget struct {
 	base consumptionBudgetBaseResource
 }
@@ -18,6 +21,7 @@ type ManagementGroupConsumptionBudget struct {
 var (
 	_ sdk.Resource                   = ManagementGroupConsumptionBudget{}
 	_ sdk.ResourceWithCustomImporter = ManagementGroupConsumptionBudget{}
+	_ sdk.ResourceWithIdentity       = ManagementGroupConsumptionBudget{}
 )
 
 func (r ManagementGroupConsumptionBudget) Arguments() map[string]*pluginsdk.Schema {
@@ -104,6 +108,10 @@ func (r ManagementGroupConsumptionBudget) IDValidationFunc() pluginsdk.SchemaVal
 	return budgets.ValidateScopedBudgetID
 }
 
+func (r ManagementGroupConsumptionBudget) Identity() resourceids.ResourceId {
+	return &budgets.ScopedBudgetId{}
+}
+
 func (r ManagementGroupConsumptionBudget) Create() sdk.ResourceFunc {
 	return r.base.createFunc(r.ResourceType(), "management_group_id")
 }
@@ -4,6 +4,7 @@
 package consumption
 
 import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/consumption/2019-10-01/budgets"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	validateResourceGroup "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
@@ -11,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name consumption_budget_resource_group -service-package-name consumption -compare-values "scope:id,name:id"
+
 type ResourceGroupConsumptionBudget struct {
 	base consumptionBudgetBaseResource
 }
@@ -18,6 +21,7 @@ type ResourceGroupConsumptionBudget struct {
 var (
 	_ sdk.Resource                   = ResourceGroupConsumptionBudget{}
 	_ sdk.ResourceWithCustomImporter = ResourceGroupConsumptionBudget{}
+	_ sdk.ResourceWithIdentity       = ResourceGroupConsumptionBudget{}
 )
 
 func (r ResourceGroupConsumptionBudget) Arguments() map[string]*pluginsdk.Schema {
@@ -54,6 +58,10 @@ func (r ResourceGroupConsumptionBudget) IDValidationFunc() pluginsdk.SchemaValid
 	return budgets.ValidateScopedBudgetID
 }
 
+func (r ResourceGroupConsumptionBudget) Identity() resourceids.ResourceId {
+	return &budgets.ScopedBudgetId{}
+}
+
 func (r ResourceGroupConsumptionBudget) Create() sdk.ResourceFunc {
 	return r.base.createFunc(r.ResourceType(), "resource_group_id")
 }
@@ -5,6 +5,7 @@ package consumption
 
 import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/consumption/2019-10-01/budgets"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/consumption/migration"
@@ -12,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name consumption_budget_subscription -service-package-name consumption -compare-values "scope:id,name:id"
+
 type SubscriptionConsumptionBudget struct {
 	base consumptionBudgetBaseResource
 }
@@ -20,6 +23,7 @@ var (
 	_ sdk.Resource                   = SubscriptionConsumptionBudget{}
 	_ sdk.ResourceWithCustomImporter = SubscriptionConsumptionBudget{}
 	_ sdk.ResourceWithStateMigration = SubscriptionConsumptionBudget{}
+	_ sdk.ResourceWithIdentity       = SubscriptionConsumptionBudget{}
 )
 
 func (r SubscriptionConsumptionBudget) Arguments() map[string]*pluginsdk.Schema {
@@ -56,6 +60,10 @@ func (r SubscriptionConsumptionBudget) IDValidationFunc() pluginsdk.SchemaValida
 	return budgets.ValidateScopedBudgetID
 }
 
+func (r SubscriptionConsumptionBudget) Identity() resourceids.ResourceId {
+	return &budgets.ScopedBudgetId{}
+}
+
 func (r SubscriptionConsumptionBudget) Create() sdk.ResourceFunc {
 	return r.base.createFunc(r.ResourceType(), "subscription_id")
 }
@@ -8,7 +8,9 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/certificates"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/containerapps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/containerappsauthconfigs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/containerappsrevisions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/containerappssessionpools"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/daprcomponents"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/jobs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/managedenvironments"
@@ -17,11 +19,13 @@ import (
 )
 
 type Client struct {
+	AuthConfigsClient          *containerappsauthconfigs.ContainerAppsAuthConfigsClient
 	CertificatesClient         *certificates.CertificatesClient
 	ContainerAppClient         *containerapps.ContainerAppsClient
 	ContainerAppRevisionClient *containerappsrevisions.ContainerAppsRevisionsClient
 	DaprComponentsClient       *daprcomponents.DaprComponentsClient
 	ManagedEnvironmentClient   *managedenvironments.ManagedEnvironmentsClient
+	SessionPoolsClient         *containerappssessionpools.ContainerAppsSessionPoolsClient
 	StorageClient              *managedenvironmentsstorages.ManagedEnvironmentsStoragesClient
 	JobClient                  *jobs.JobsClient
 }
@@ -39,6 +43,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(containerAppsClient.Client, o.Authorizers.ResourceManager)
 
+	authConfigsClient, err := containerappsauthconfigs.NewContainerAppsAuthConfigsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Auth Configs client : %+v", err)
+	}
+	o.Configure(authConfigsClient.Client, o.Authorizers.ResourceManager)
+
 	containerAppsRevisionsClient, err := containerappsrevisions.NewContainerAppsRevisionsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Container Apps Revisions client : %+v", err)
@@ -69,12 +79,20 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(jobsClient.Client, o.Authorizers.ResourceManager)
 
+	sessionPoolsClient, err := containerappssessionpools.NewContainerAppsSessionPoolsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Session Pools client : %+v", err)
+	}
+	o.Configure(sessionPoolsClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
+		AuthConfigsClient:          authConfigsClient,
 		CertificatesClient:         certificatesClient,
 		ContainerAppClient:         containerAppsClient,
 		ContainerAppRevisionClient: containerAppsRevisionsClient,
 		DaprComponentsClient:       daprComponentClient,
 		ManagedEnvironmentClient:   managedEnvironmentClient,
+		SessionPoolsClient:         sessionPoolsClient,
 		StorageClient:              managedEnvironmentStoragesClient,
 		JobClient:                  jobsClient,
 	}, nil
@@ -0,0 +1,425 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/containerappsauthconfigs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name container_app_auth_config -service-package-name containerapps -known-values "subscription_id:data.Subscriptions.Primary,auth_config_name:current" -compare-values "resource_group_name:container_app_id,container_app_name:container_app_id"
+
+// the Container Apps API only supports a single Auth Config per Container App, which must be named `current`
+const containerAppAuthConfigName = "current"
+
+type ContainerAppAuthConfigResource struct{}
+
+type ContainerAppAuthConfigModel struct {
+	ContainerAppId string `tfschema:"container_app_id"`
+
+	AuthEnabled           bool     `tfschema:"auth_enabled"`
+	RuntimeVersion        string   `tfschema:"runtime_version"`
+	UnauthenticatedAction string   `tfschema:"unauthenticated_action"`
+	DefaultAuthProvider   string   `tfschema:"default_provider"`
+	ExcludedPaths         []string `tfschema:"excluded_paths"`
+
+	ActiveDirectory    []helpers.AuthConfigActiveDirectory   `tfschema:"active_directory"`
+	Apple              []helpers.AuthConfigApple             `tfschema:"apple"`
+	AzureStaticWebApp  []helpers.AuthConfigAzureStaticWebApp `tfschema:"azure_static_web_app"`
+	CustomOIDC         []helpers.AuthConfigCustomOIDC        `tfschema:"custom_oidc"`
+	Facebook           []helpers.AuthConfigFacebook          `tfschema:"facebook"`
+	GitHub             []helpers.AuthConfigGitHub            `tfschema:"github"`
+	Google             []helpers.AuthConfigGoogle            `tfschema:"google"`
+	Twitter            []helpers.AuthConfigTwitter           `tfschema:"twitter"`
+	Login              []helpers.AuthConfigLogin             `tfschema:"login"`
+	EncryptionSecret   string                                `tfschema:"encryption_secret_name"`
+	SigningSecret      string                                `tfschema:"signing_secret_name"`
+	RequireHTTPS       bool                                  `tfschema:"require_https"`
+	HttpRouteAPIPrefix string                                `tfschema:"http_route_api_prefix"`
+
+	ForwardProxyConvention             string `tfschema:"forward_proxy_convention"`
+	ForwardProxyCustomHostHeaderName   string `tfschema:"forward_proxy_custom_host_header_name"`
+	ForwardProxyCustomSchemeHeaderName string `tfschema:"forward_proxy_custom_scheme_header_name"`
+}
+
+var (
+	_ sdk.ResourceWithUpdate   = ContainerAppAuthConfigResource{}
+	_ sdk.ResourceWithIdentity = ContainerAppAuthConfigResource{}
+)
+
+func (r ContainerAppAuthConfigResource) Identity() resourceids.ResourceId {
+	return &containerappsauthconfigs.AuthConfigId{}
+}
+
+func (r ContainerAppAuthConfigResource) ModelObject() interface{} {
+	return &ContainerAppAuthConfigModel{}
+}
+
+func (r ContainerAppAuthConfigResource) ResourceType() string {
+	return "azurerm_container_app_auth_config"
+}
+
+func (r ContainerAppAuthConfigResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return containerappsauthconfigs.ValidateAuthConfigID
+}
+
+func (r ContainerAppAuthConfigResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"container_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: containerappsauthconfigs.ValidateContainerAppID,
+			Description:  "The ID of the Container App to which this Auth Config applies.",
+		},
+
+		"auth_enabled": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Should the Authentication and Authorisation feature be enabled for the Container App? Defaults to `true`.",
+		},
+
+		"runtime_version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "~1",
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The Runtime Version of the Authentication and Authorisation feature of this Container App. Defaults to `~1`.",
+		},
+
+		"unauthenticated_action": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(containerappsauthconfigs.UnauthenticatedClientActionV2RedirectToLoginPage),
+			ValidateFunc: validation.StringInSlice(containerappsauthconfigs.PossibleValuesForUnauthenticatedClientActionV2(), false),
+			Description:  "The action to take for requests made without authentication. Possible values include `RedirectToLoginPage`, `AllowAnonymous`, `Return401`, and `Return403`. Defaults to `RedirectToLoginPage`.",
+		},
+
+		"default_provider": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The Default Authentication Provider to use when the `unauthenticated_action` is set to `RedirectToLoginPage`. Possible values include: `apple`, `azureactivedirectory`, `azurestaticwebapps`, `facebook`, `github`, `google`, `twitter` and the `name` of a `custom_oidc` provider.",
+		},
+
+		"excluded_paths": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			Description: "The paths which should be excluded from the `unauthenticated_action` when it is set to `RedirectToLoginPage`.",
+		},
+
+		"active_directory": helpers.AuthConfigActiveDirectorySchema(),
+
+		"apple": helpers.AuthConfigAppleSchema(),
+
+		"azure_static_web_app": helpers.AuthConfigAzureStaticWebAppSchema(),
+
+		"custom_oidc": helpers.AuthConfigCustomOIDCSchema(),
+
+		"facebook": helpers.AuthConfigFacebookSchema(),
+
+		"github": helpers.AuthConfigGitHubSchema(),
+
+		"google": helpers.AuthConfigGoogleSchema(),
+
+		"twitter": helpers.AuthConfigTwitterSchema(),
+
+		"login": helpers.AuthConfigLoginSchema(),
+
+		"encryption_secret_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			RequiredWith: []string{"signing_secret_name"},
+			Description:  "The name of the Container App secret containing the key used to encrypt the session cookie.",
+		},
+
+		"signing_secret_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			RequiredWith: []string{"encryption_secret_name"},
+			Description:  "The name of the Container App secret containing the key used to sign the session cookie.",
+		},
+
+		"require_https": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Should HTTPS be required on connections? Defaults to `true`.",
+		},
+
+		"http_route_api_prefix": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "/.auth",
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The prefix that should precede all the authentication and authorisation paths. Defaults to `/.auth`.",
+		},
+
+		"forward_proxy_convention": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(containerappsauthconfigs.ForwardProxyConventionNoProxy),
+			ValidateFunc: validation.StringInSlice(containerappsauthconfigs.PossibleValuesForForwardProxyConvention(), false),
+			Description:  "The convention used to determine the URL of the request made. Possible values include `NoProxy`, `Standard` and `Custom`. Defaults to `NoProxy`.",
+		},
+
+		"forward_proxy_custom_host_header_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The name of the header containing the host of the request.",
+		},
+
+		"forward_proxy_custom_scheme_header_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The name of the header containing the scheme of the request.",
+		},
+	}
+}
+
+func (r ContainerAppAuthConfigResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerAppAuthConfigResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.AuthConfigsClient
+
+			var config ContainerAppAuthConfigModel
+			if err := metadata.Decode(&config); err != nil {
+				return err
+			}
+
+			containerAppId, err := containerappsauthconfigs.ParseContainerAppID(config.ContainerAppId)
+			if err != nil {
+				return err
+			}
+
+			id := containerappsauthconfigs.NewAuthConfigID(containerAppId.SubscriptionId, containerAppId.ResourceGroupName, containerAppId.ContainerAppName, containerAppAuthConfigName)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := containerappsauthconfigs.AuthConfig{
+				Properties: expandContainerAppAuthConfigProperties(config),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppAuthConfigResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.AuthConfigsClient
+
+			id, err := containerappsauthconfigs.ParseAuthConfigID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerAppAuthConfigModel{
+				ContainerAppId: containerappsauthconfigs.NewContainerAppID(id.SubscriptionId, id.ResourceGroupName, id.ContainerAppName).ID(),
+			}
+
+			if model := existing.Model; model != nil {
+				if props := model.Properties; props != nil {
+					if platform := props.Platform; platform != nil {
+						state.AuthEnabled = pointer.From(platform.Enabled)
+						state.RuntimeVersion = pointer.From(platform.RuntimeVersion)
+					}
+
+					if global := props.GlobalValidation; global != nil {
+						state.UnauthenticatedAction = string(pointer.From(global.UnauthenticatedClientAction))
+						state.DefaultAuthProvider = pointer.From(global.RedirectToProvider)
+						state.ExcludedPaths = pointer.From(global.ExcludedPaths)
+					}
+
+					if providers := props.IdentityProviders; providers != nil {
+						state.ActiveDirectory = helpers.FlattenAuthConfigActiveDirectory(providers.AzureActiveDirectory)
+						state.Apple = helpers.FlattenAuthConfigApple(providers.Apple)
+						state.AzureStaticWebApp = helpers.FlattenAuthConfigAzureStaticWebApp(providers.AzureStaticWebApps)
+						state.CustomOIDC = helpers.FlattenAuthConfigCustomOIDC(providers.CustomOpenIdConnectProviders)
+						state.Facebook = helpers.FlattenAuthConfigFacebook(providers.Facebook)
+						state.GitHub = helpers.FlattenAuthConfigGitHub(providers.GitHub)
+						state.Google = helpers.FlattenAuthConfigGoogle(providers.Google)
+						state.Twitter = helpers.FlattenAuthConfigTwitter(providers.Twitter)
+					}
+
+					state.Login = helpers.FlattenAuthConfigLogin(props.Login)
+
+					if encryption := props.EncryptionSettings; encryption != nil {
+						state.EncryptionSecret = pointer.From(encryption.ContainerAppAuthEncryptionSecretName)
+						state.SigningSecret = pointer.From(encryption.ContainerAppAuthSigningSecretName)
+					}
+
+					if http := props.HTTPSettings; http != nil {
+						state.RequireHTTPS = pointer.From(http.RequireHTTPS)
+						if routes := http.Routes; routes != nil {
+							state.HttpRouteAPIPrefix = pointer.From(routes.ApiPrefix)
+						}
+						if proxy := http.ForwardProxy; proxy != nil {
+							state.ForwardProxyConvention = string(pointer.From(proxy.Convention))
+							state.ForwardProxyCustomHostHeaderName = pointer.From(proxy.CustomHostHeaderName)
+							state.ForwardProxyCustomSchemeHeaderName = pointer.From(proxy.CustomProtoHeaderName)
+						}
+					}
+				}
+			}
+
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppAuthConfigResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.AuthConfigsClient
+
+			id, err := containerappsauthconfigs.ParseAuthConfigID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ContainerAppAuthConfigModel
+			if err := metadata.Decode(&config); err != nil {
+				return err
+			}
+
+			// the API replaces the whole configuration on each PUT, so the payload is always built from the full config
+			payload := containerappsauthconfigs.AuthConfig{
+				Properties: expandContainerAppAuthConfigProperties(config),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppAuthConfigResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.AuthConfigsClient
+
+			id, err := containerappsauthconfigs.ParseAuthConfigID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandContainerAppAuthConfigProperties(input ContainerAppAuthConfigModel) *containerappsauthconfigs.AuthConfigProperties {
+	props := &containerappsauthconfigs.AuthConfigProperties{
+		Platform: &containerappsauthconfigs.AuthPlatform{
+			Enabled:        pointer.To(input.AuthEnabled),
+			RuntimeVersion: pointer.To(input.RuntimeVersion),
+		},
+		GlobalValidation: &containerappsauthconfigs.GlobalValidation{
+			UnauthenticatedClientAction: pointer.To(containerappsauthconfigs.UnauthenticatedClientActionV2(input.UnauthenticatedAction)),
+			ExcludedPaths:               pointer.To(input.ExcludedPaths),
+		},
+		IdentityProviders: &containerappsauthconfigs.IdentityProviders{
+			Apple:                        helpers.ExpandAuthConfigApple(input.Apple),
+			AzureActiveDirectory:         helpers.ExpandAuthConfigActiveDirectory(input.ActiveDirectory),
+			AzureStaticWebApps:           helpers.ExpandAuthConfigAzureStaticWebApp(input.AzureStaticWebApp),
+			CustomOpenIdConnectProviders: helpers.ExpandAuthConfigCustomOIDC(input.CustomOIDC),
+			Facebook:                     helpers.ExpandAuthConfigFacebook(input.Facebook),
+			GitHub:                       helpers.ExpandAuthConfigGitHub(input.GitHub),
+			Google:                       helpers.ExpandAuthConfigGoogle(input.Google),
+			Twitter:                      helpers.ExpandAuthConfigTwitter(input.Twitter),
+		},
+		Login: helpers.ExpandAuthConfigLogin(input.Login),
+		HTTPSettings: &containerappsauthconfigs.HTTPSettings{
+			RequireHTTPS: pointer.To(input.RequireHTTPS),
+			Routes: &containerappsauthconfigs.HTTPSettingsRoutes{
+				ApiPrefix: pointer.To(input.HttpRouteAPIPrefix),
+			},
+			ForwardProxy: &containerappsauthconfigs.ForwardProxy{
+				Convention: pointer.To(containerappsauthconfigs.ForwardProxyConvention(input.ForwardProxyConvention)),
+			},
+		},
+	}
+
+	if input.DefaultAuthProvider != "" {
+		props.GlobalValidation.RedirectToProvider = pointer.To(input.DefaultAuthProvider)
+	}
+
+	if input.EncryptionSecret != "" || input.SigningSecret != "" {
+		props.EncryptionSettings = &containerappsauthconfigs.EncryptionSettings{
+			ContainerAppAuthEncryptionSecretName: pointer.To(input.EncryptionSecret),
+			ContainerAppAuthSigningSecretName:    pointer.To(input.SigningSecret),
+		}
+	}
+
+	if input.ForwardProxyCustomHostHeaderName != "" {
+		props.HTTPSettings.ForwardProxy.CustomHostHeaderName = pointer.To(input.ForwardProxyCustomHostHeaderName)
+	}
+
+	if input.ForwardProxyCustomSchemeHeaderName != "" {
+		props.HTTPSettings.ForwardProxy.CustomProtoHeaderName = pointer.To(input.ForwardProxyCustomSchemeHeaderName)
+	}
+
+	return props
+}
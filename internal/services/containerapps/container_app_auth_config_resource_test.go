@@ -0,0 +1,259 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/containerappsauthconfigs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerAppAuthConfigResource struct{}
+
+func TestAccContainerAppAuthConfigResource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_auth_config", "test")
+	r := ContainerAppAuthConfigResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppAuthConfigResource_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_auth_config", "test")
+	r := ContainerAppAuthConfigResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerAppAuthConfigResource_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_auth_config", "test")
+	r := ContainerAppAuthConfigResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppAuthConfigResource_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_auth_config", "test")
+	r := ContainerAppAuthConfigResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ContainerAppAuthConfigResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := containerappsauthconfigs.ParseAuthConfigID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ContainerApps.AuthConfigsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ContainerAppAuthConfigResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_auth_config" "test" {
+  container_app_id = azurerm_container_app.test.id
+
+  github {
+    client_id                  = "acctest-github-client"
+    client_secret_setting_name = "github-secret"
+  }
+
+  login {}
+}
+`, r.template(data))
+}
+
+func (r ContainerAppAuthConfigResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_auth_config" "import" {
+  container_app_id = azurerm_container_app_auth_config.test.container_app_id
+
+  github {
+    client_id                  = "acctest-github-client"
+    client_secret_setting_name = "github-secret"
+  }
+
+  login {}
+}
+`, r.basic(data))
+}
+
+func (r ContainerAppAuthConfigResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_container_app_auth_config" "test" {
+  container_app_id       = azurerm_container_app.test.id
+  unauthenticated_action = "Return401"
+  default_provider       = "azureactivedirectory"
+  excluded_paths         = ["/health"]
+
+  active_directory {
+    client_id                  = "d87a1a2b-4c5c-4b2e-9d4e-0b3c8e2f1a6d"
+    tenant_auth_endpoint       = "https://login.microsoftonline.com/${data.azurerm_client_config.current.tenant_id}/v2.0"
+    client_secret_setting_name = "aad-secret"
+    allowed_audiences          = ["api://acctest-%[2]d"]
+    login_parameters = {
+      response_type = "code id_token"
+    }
+  }
+
+  github {
+    client_id                  = "acctest-github-client"
+    client_secret_setting_name = "github-secret"
+    login_scopes               = ["read:user"]
+  }
+
+  custom_oidc {
+    name                          = "acctestoidc"
+    client_id                     = "acctest-oidc-client"
+    client_secret_setting_name    = "oidc-secret"
+    openid_configuration_endpoint = "https://accounts.google.com/.well-known/openid-configuration"
+    scopes                        = ["openid", "profile"]
+  }
+
+  login {
+    logout_endpoint                = "/logout"
+    token_store_enabled            = true
+    token_refresh_extension_time   = 24
+    allowed_external_redirect_urls = ["https://example.com"]
+    cookie_expiration_convention   = "IdentityProviderDerived"
+    nonce_expiration_time          = "00:10:00"
+  }
+
+  encryption_secret_name = "auth-encryption"
+  signing_secret_name    = "auth-signing"
+
+  require_https                           = true
+  http_route_api_prefix                   = "/.authentication"
+  forward_proxy_convention                = "Custom"
+  forward_proxy_custom_host_header_name   = "X-Original-Host"
+  forward_proxy_custom_scheme_header_name = "X-Original-Proto"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (ContainerAppAuthConfigResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app" "test" {
+  name                         = "acctest-capp-%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  revision_mode                = "Single"
+
+  secret {
+    name  = "github-secret"
+    value = "VGhpcyBJcyBOb3QgQSBHb29kIFBhc3N3b3JkCg=="
+  }
+
+  secret {
+    name  = "aad-secret"
+    value = "VGhpcyBJcyBOb3QgQSBHb29kIFBhc3N3b3JkCg=="
+  }
+
+  secret {
+    name  = "oidc-secret"
+    value = "VGhpcyBJcyBOb3QgQSBHb29kIFBhc3N3b3JkCg=="
+  }
+
+  secret {
+    name  = "auth-encryption"
+    value = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+  }
+
+  secret {
+    name  = "auth-signing"
+    value = "ZmVkY2JhOTg3NjU0MzIxMGZlZGNiYTk4NzY1NDMyMTA="
+  }
+
+  ingress {
+    external_enabled = true
+    target_port      = 5000
+
+    traffic_weight {
+      latest_revision = true
+      percentage      = 100
+    }
+  }
+
+  template {
+    container {
+      name   = "acctest-cont-%[2]d"
+      image  = "jackofallops/azure-containerapps-python-acctest:v0.0.1"
+      cpu    = 0.25
+      memory = "0.5Gi"
+    }
+  }
+}
+`, ContainerAppEnvironmentResource{}.basic(data), data.RandomInteger)
+}
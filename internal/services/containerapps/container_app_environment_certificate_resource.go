@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/certificates"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/managedenvironments"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name container_app_environment_certificate -service-package-name containerapps -properties "name" -compare-values "subscription_id:id,resource_group_name:id,managed_environment_name:id"
+
 type ContainerAppEnvironmentCertificateResource struct{}
 
 type ContainerAppCertificateModel struct {
@@ -40,6 +43,11 @@ type ContainerAppCertificateModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentCertificateResource{}
+var _ sdk.ResourceWithIdentity = ContainerAppEnvironmentCertificateResource{}
+
+func (r ContainerAppEnvironmentCertificateResource) Identity() resourceids.ResourceId {
+	return &certificates.CertificateId{}
+}
 
 func (r ContainerAppEnvironmentCertificateResource) ModelObject() interface{} {
 	return &ContainerAppCertificateModel{}
@@ -215,6 +223,10 @@ func (r ContainerAppEnvironmentCertificateResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/daprcomponents"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/helpers"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name container_app_environment_dapr_component -service-package-name containerapps -properties "name" -compare-values "subscription_id:id,resource_group_name:id,managed_environment_name:id"
+
 type ContainerAppEnvironmentDaprComponentResource struct{}
 
 type ContainerAppEnvironmentDaprComponentModel struct {
@@ -33,6 +36,11 @@ type ContainerAppEnvironmentDaprComponentModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentDaprComponentResource{}
+var _ sdk.ResourceWithIdentity = ContainerAppEnvironmentDaprComponentResource{}
+
+func (r ContainerAppEnvironmentDaprComponentResource) Identity() resourceids.ResourceId {
+	return &daprcomponents.DaprComponentId{}
+}
 
 func (r ContainerAppEnvironmentDaprComponentResource) ModelObject() interface{} {
 	return &ContainerAppEnvironmentDaprComponentModel{}
@@ -212,6 +220,10 @@ func (r ContainerAppEnvironmentDaprComponentResource) Read() sdk.ResourceFunc {
 
 			state.Secrets = helpers.FlattenContainerAppDaprSecrets(secretsResp.Model)
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
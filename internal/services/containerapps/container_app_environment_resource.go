@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/managedenvironments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
@@ -35,6 +36,8 @@ const (
 	LogsDestinationNone         string = ""
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name container_app_environment -service-package-name containerapps -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type ContainerAppEnvironmentResource struct{}
 
 type ContainerAppEnvironmentModel struct {
@@ -67,6 +70,8 @@ var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentResource{}
 
 var _ sdk.ResourceWithCustomizeDiff = ContainerAppEnvironmentResource{}
 
+var _ sdk.ResourceWithIdentity = ContainerAppEnvironmentResource{}
+
 func (r ContainerAppEnvironmentResource) ModelObject() interface{} {
 	return &ContainerAppEnvironmentModel{}
 }
@@ -79,6 +84,10 @@ func (r ContainerAppEnvironmentResource) IDValidationFunc() pluginsdk.SchemaVali
 	return managedenvironments.ValidateManagedEnvironmentID
 }
 
+func (r ContainerAppEnvironmentResource) Identity() resourceids.ResourceId {
+	return &managedenvironments.ManagedEnvironmentId{}
+}
+
 func (r ContainerAppEnvironmentResource) Arguments() map[string]*pluginsdk.Schema {
 	schema := map[string]*pluginsdk.Schema{
 		"name": {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/managedenvironmentsstorages"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name container_app_environment_storage -service-package-name containerapps -properties "name" -compare-values "subscription_id:id,resource_group_name:id,managed_environment_name:id"
+
 type ContainerAppEnvironmentStorageResource struct{}
 
 type ContainerAppEnvironmentStorageModel struct {
@@ -31,6 +34,11 @@ type ContainerAppEnvironmentStorageModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentStorageResource{}
+var _ sdk.ResourceWithIdentity = ContainerAppEnvironmentStorageResource{}
+
+func (r ContainerAppEnvironmentStorageResource) Identity() resourceids.ResourceId {
+	return &managedenvironmentsstorages.StorageId{}
+}
 
 func (r ContainerAppEnvironmentStorageResource) ModelObject() interface{} {
 	return &ContainerAppEnvironmentStorageModel{}
@@ -222,6 +230,10 @@ func (r ContainerAppEnvironmentStorageResource) Read() sdk.ResourceFunc {
 				state.AccessKey = keyFromConfig.(string)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/containerapps"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/managedenvironments"
@@ -24,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name container_app -service-package-name containerapps -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type ContainerAppResource struct{}
 
 type ContainerAppModel struct {
@@ -53,6 +56,11 @@ type ContainerAppModel struct {
 var _ sdk.ResourceWithUpdate = ContainerAppResource{}
 
 var _ sdk.ResourceWithCustomizeDiff = ContainerAppResource{}
+var _ sdk.ResourceWithIdentity = ContainerAppResource{}
+
+func (r ContainerAppResource) Identity() resourceids.ResourceId {
+	return &containerapps.ContainerAppId{}
+}
 
 func (r ContainerAppResource) ModelObject() interface{} {
 	return &ContainerAppModel{}
@@ -304,6 +312,10 @@ func (r ContainerAppResource) Read() sdk.ResourceFunc {
 
 			state.Secrets = helpers.FlattenContainerAppSecrets(secretsResp.Model)
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/containerappssessionpools"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerAppSessionPoolDataSource struct{}
+
+type ContainerAppSessionPoolDataSourceModel struct {
+	Name          string `tfschema:"name"`
+	ResourceGroup string `tfschema:"resource_group_name"`
+
+	Location                  string                                     `tfschema:"location"`
+	ContainerType             string                                     `tfschema:"container_type"`
+	ContainerAppEnvironmentId string                                     `tfschema:"container_app_environment_id"`
+	MaxConcurrentSessions     int64                                      `tfschema:"max_concurrent_sessions"`
+	ReadySessionInstances     int64                                      `tfschema:"ready_session_instances"`
+	NetworkEgressEnabled      bool                                       `tfschema:"network_egress_enabled"`
+	PoolManagementEndpoint    string                                     `tfschema:"pool_management_endpoint"`
+	Identity                  []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	Tags                      map[string]interface{}                     `tfschema:"tags"`
+}
+
+var _ sdk.DataSource = ContainerAppSessionPoolDataSource{}
+
+func (r ContainerAppSessionPoolDataSource) ModelObject() interface{} {
+	return &ContainerAppSessionPoolDataSourceModel{}
+}
+
+func (r ContainerAppSessionPoolDataSource) ResourceType() string {
+	return "azurerm_container_app_session_pool"
+}
+
+func (r ContainerAppSessionPoolDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.ContainerAppName,
+			Description:  "The name of the Container App Session Pool.",
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+	}
+}
+
+func (r ContainerAppSessionPoolDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.LocationComputed(),
+
+		"container_type": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The type of container used by the sessions in this pool.",
+		},
+
+		"container_app_environment_id": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The ID of the Container App Environment in which the pool's sessions run.",
+		},
+
+		"max_concurrent_sessions": {
+			Type:        pluginsdk.TypeInt,
+			Computed:    true,
+			Description: "The maximum number of sessions that can run concurrently in this pool.",
+		},
+
+		"ready_session_instances": {
+			Type:        pluginsdk.TypeInt,
+			Computed:    true,
+			Description: "The number of sessions that are kept ready in the pool.",
+		},
+
+		"network_egress_enabled": {
+			Type:        pluginsdk.TypeBool,
+			Computed:    true,
+			Description: "Are sessions in this pool allowed outbound network access?",
+		},
+
+		"pool_management_endpoint": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The endpoint used to manage the sessions in this pool.",
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityComputed(),
+
+		"tags": commonschema.TagsDataSource(),
+	}
+}
+
+func (r ContainerAppSessionPoolDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.SessionPoolsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var pool ContainerAppSessionPoolDataSourceModel
+			if err := metadata.Decode(&pool); err != nil {
+				return err
+			}
+
+			id := containerappssessionpools.NewSessionPoolID(subscriptionId, pool.ResourceGroup, pool.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("reading %s: %+v", id, err)
+			}
+
+			if model := existing.Model; model != nil {
+				pool.Location = location.Normalize(model.Location)
+				pool.Tags = tags.Flatten(model.Tags)
+
+				if model.Identity != nil {
+					ident, err := identity.FlattenSystemAndUserAssignedMapToModel(pointer.To((identity.SystemAndUserAssignedMap)(*model.Identity)))
+					if err != nil {
+						return err
+					}
+					pool.Identity = pointer.From(ident)
+				}
+
+				if props := model.Properties; props != nil {
+					pool.ContainerType = string(pointer.From(props.ContainerType))
+					pool.PoolManagementEndpoint = pointer.From(props.PoolManagementEndpoint)
+
+					if props.EnvironmentId != nil {
+						environmentId, err := managedenvironments.ParseManagedEnvironmentIDInsensitively(*props.EnvironmentId)
+						if err != nil {
+							return err
+						}
+						pool.ContainerAppEnvironmentId = environmentId.ID()
+					}
+
+					if scale := props.ScaleConfiguration; scale != nil {
+						pool.MaxConcurrentSessions = pointer.From(scale.MaxConcurrentSessions)
+						pool.ReadySessionInstances = pointer.From(scale.ReadySessionInstances)
+					}
+
+					if network := props.SessionNetworkConfiguration; network != nil {
+						pool.NetworkEgressEnabled = pointer.From(network.Status) == containerappssessionpools.SessionNetworkStatusEgressEnabled
+					}
+				}
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&pool)
+		},
+	}
+}
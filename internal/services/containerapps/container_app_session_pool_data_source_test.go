@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ContainerAppSessionPoolDataSource struct{}
+
+func TestAccContainerAppSessionPoolDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_container_app_session_pool", "test")
+	r := ContainerAppSessionPoolDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("location").IsSet(),
+				check.That(data.ResourceName).Key("container_type").HasValue("PythonLTS"),
+				check.That(data.ResourceName).Key("max_concurrent_sessions").HasValue("5"),
+				check.That(data.ResourceName).Key("pool_management_endpoint").IsSet(),
+			),
+		},
+	})
+}
+
+func (d ContainerAppSessionPoolDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_container_app_session_pool" "test" {
+  name                = azurerm_container_app_session_pool.test.name
+  resource_group_name = azurerm_container_app_session_pool.test.resource_group_name
+}
+`, ContainerAppSessionPoolResource{}.basic(data))
+}
@@ -0,0 +1,851 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/containerappssessionpools"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name container_app_session_pool -service-package-name containerapps -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
+type ContainerAppSessionPoolResource struct{}
+
+type ContainerAppSessionPoolModel struct {
+	Name                      string                                     `tfschema:"name"`
+	ResourceGroup             string                                     `tfschema:"resource_group_name"`
+	Location                  string                                     `tfschema:"location"`
+	ContainerType             string                                     `tfschema:"container_type"`
+	ContainerAppEnvironmentId string                                     `tfschema:"container_app_environment_id"`
+	MaxConcurrentSessions     int64                                      `tfschema:"max_concurrent_sessions"`
+	ReadySessionInstances     int64                                      `tfschema:"ready_session_instances"`
+	LifecycleType             string                                     `tfschema:"lifecycle_type"`
+	CooldownPeriodInSeconds   int64                                      `tfschema:"cooldown_period_in_seconds"`
+	MaxAlivePeriodInSeconds   int64                                      `tfschema:"max_alive_period_in_seconds"`
+	NetworkEgressEnabled      bool                                       `tfschema:"network_egress_enabled"`
+	CustomContainerTemplate   []SessionPoolCustomContainerTemplateModel  `tfschema:"custom_container_template"`
+	Secrets                   []SessionPoolSecretModel                   `tfschema:"secret"`
+	Identity                  []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	ManagedIdentitySettings   []SessionPoolManagedIdentitySettingModel   `tfschema:"managed_identity_setting"`
+	Tags                      map[string]interface{}                     `tfschema:"tags"`
+
+	PoolManagementEndpoint string `tfschema:"pool_management_endpoint"`
+}
+
+type SessionPoolCustomContainerTemplateModel struct {
+	Containers []SessionPoolContainerModel           `tfschema:"container"`
+	TargetPort int64                                 `tfschema:"target_port"`
+	Registry   []SessionPoolRegistryCredentialsModel `tfschema:"registry"`
+}
+
+type SessionPoolContainerModel struct {
+	Name    string                    `tfschema:"name"`
+	Image   string                    `tfschema:"image"`
+	CPU     float64                   `tfschema:"cpu"`
+	Memory  string                    `tfschema:"memory"`
+	Args    []string                  `tfschema:"args"`
+	Command []string                  `tfschema:"command"`
+	Env     []helpers.ContainerEnvVar `tfschema:"env"`
+}
+
+type SessionPoolRegistryCredentialsModel struct {
+	Server             string `tfschema:"server"`
+	Identity           string `tfschema:"identity"`
+	Username           string `tfschema:"username"`
+	PasswordSecretName string `tfschema:"password_secret_name"`
+}
+
+type SessionPoolSecretModel struct {
+	Name  string `tfschema:"name"`
+	Value string `tfschema:"value"`
+}
+
+type SessionPoolManagedIdentitySettingModel struct {
+	Identity  string `tfschema:"identity"`
+	Lifecycle string `tfschema:"lifecycle"`
+}
+
+var (
+	_ sdk.ResourceWithUpdate   = ContainerAppSessionPoolResource{}
+	_ sdk.ResourceWithIdentity = ContainerAppSessionPoolResource{}
+)
+
+func (r ContainerAppSessionPoolResource) Identity() resourceids.ResourceId {
+	return &containerappssessionpools.SessionPoolId{}
+}
+
+func (r ContainerAppSessionPoolResource) ModelObject() interface{} {
+	return &ContainerAppSessionPoolModel{}
+}
+
+func (r ContainerAppSessionPoolResource) ResourceType() string {
+	return "azurerm_container_app_session_pool"
+}
+
+func (r ContainerAppSessionPoolResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return containerappssessionpools.ValidateSessionPoolID
+}
+
+func (r ContainerAppSessionPoolResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerAppName,
+			Description:  "The name of the Container App Session Pool.",
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"container_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(containerappssessionpools.PossibleValuesForContainerType(), false),
+			Description:  "The type of container used by the sessions in this pool.",
+		},
+
+		"max_concurrent_sessions": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "The maximum number of sessions that can run concurrently in this pool.",
+		},
+
+		"container_app_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: managedenvironments.ValidateManagedEnvironmentID,
+			Description:  "The ID of the Container App Environment in which the pool's sessions run. Required when `container_type` is `CustomContainer`.",
+		},
+
+		"ready_session_instances": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "The number of sessions that are kept ready in the pool.",
+		},
+
+		"lifecycle_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(containerappssessionpools.LifecycleTypeTimed),
+			ValidateFunc: validation.StringInSlice(containerappssessionpools.PossibleValuesForLifecycleType(), false),
+			Description:  "How the lifetime of a session is managed.",
+		},
+
+		"cooldown_period_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(300, 3600),
+			Description:  "The number of seconds a session can be idle before it is terminated. Only applicable when `lifecycle_type` is `Timed`.",
+		},
+
+		"max_alive_period_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(300, 21600),
+			Description:  "The maximum number of seconds a session can stay alive. Only applicable when `lifecycle_type` is `OnContainerExit`.",
+		},
+
+		"network_egress_enabled": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Should sessions in this pool be allowed outbound network access?",
+		},
+
+		"custom_container_template": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"container": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validate.ContainerAppContainerName,
+									Description:  "The name of the container.",
+								},
+
+								"image": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+									Description:  "The image to use to create the container.",
+								},
+
+								"cpu": {
+									Type:         pluginsdk.TypeFloat,
+									Required:     true,
+									ValidateFunc: validation.FloatAtLeast(0.1),
+									Description:  "The amount of vCPU to allocate to the container.",
+								},
+
+								"memory": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+									Description:  "The amount of memory to allocate to the container.",
+								},
+
+								"args": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									Description: "A list of extra arguments to pass to the container.",
+								},
+
+								"command": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									Description: "A command to pass to the container to override the default.",
+								},
+
+								"env": helpers.ContainerEnvVarSchema(),
+							},
+						},
+					},
+
+					"target_port": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IsPortNumber,
+						Description:  "The port on which the containers listen for session requests.",
+					},
+
+					"registry": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"server": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+									Description:  "The hostname for the Container Registry.",
+								},
+
+								"identity": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+									Description:  "The ID of the User Assigned Identity used to pull images from the Container Registry.",
+								},
+
+								"username": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+									RequiredWith: []string{"custom_container_template.0.registry.0.password_secret_name"},
+									Description:  "The username to use for this Container Registry.",
+								},
+
+								"password_secret_name": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validate.SecretName,
+									RequiredWith: []string{"custom_container_template.0.registry.0.username"},
+									Description:  "The name of the Secret that contains the password for this Container Registry.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"secret": {
+			Type:      pluginsdk.TypeSet,
+			Optional:  true,
+			Sensitive: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validate.SecretName,
+						Description:  "The secret name.",
+					},
+
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "The value for this secret.",
+					},
+				},
+			},
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
+
+		"managed_identity_setting": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"identity": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.Any(
+							commonids.ValidateUserAssignedIdentityID,
+							validation.StringInSlice([]string{"system"}, false),
+						),
+						Description: "The identity to configure, either `system` or the ID of a User Assigned Identity assigned to this pool.",
+					},
+
+					"lifecycle": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(containerappssessionpools.IdentitySettingsLifeCycleNone),
+						ValidateFunc: validation.StringInSlice(containerappssessionpools.PossibleValuesForIdentitySettingsLifeCycle(), false),
+						Description:  "Whether the identity is made available to the session containers.",
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ContainerAppSessionPoolResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"pool_management_endpoint": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The endpoint used to manage the sessions in this pool.",
+		},
+	}
+}
+
+func (r ContainerAppSessionPoolResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.SessionPoolsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ContainerAppSessionPoolModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			id := containerappssessionpools.NewSessionPoolID(subscriptionId, model.ResourceGroup, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if model.ContainerType == string(containerappssessionpools.ContainerTypeCustomContainer) {
+				if model.ContainerAppEnvironmentId == "" {
+					return fmt.Errorf("`container_app_environment_id` must be specified when `container_type` is `%s`", model.ContainerType)
+				}
+				if len(model.CustomContainerTemplate) == 0 {
+					return fmt.Errorf("`custom_container_template` must be specified when `container_type` is `%s`", model.ContainerType)
+				}
+			}
+
+			ident, err := identity.ExpandLegacySystemAndUserAssignedMapFromModel(model.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			pool := containerappssessionpools.SessionPool{
+				Location: location.Normalize(model.Location),
+				Identity: ident,
+				Properties: &containerappssessionpools.SessionPoolProperties{
+					ContainerType:               pointer.To(containerappssessionpools.ContainerType(model.ContainerType)),
+					CustomContainerTemplate:     expandSessionPoolCustomContainerTemplate(model.CustomContainerTemplate),
+					DynamicPoolConfiguration:    expandSessionPoolDynamicPoolConfiguration(model),
+					ManagedIdentitySettings:     expandSessionPoolManagedIdentitySettings(model.ManagedIdentitySettings),
+					PoolManagementType:          pointer.To(containerappssessionpools.PoolManagementTypeDynamic),
+					ScaleConfiguration:          expandSessionPoolScaleConfiguration(model),
+					Secrets:                     expandSessionPoolSecrets(model.Secrets),
+					SessionNetworkConfiguration: expandSessionPoolNetworkConfiguration(model.NetworkEgressEnabled),
+				},
+				Tags: tags.Expand(model.Tags),
+			}
+
+			if model.ContainerAppEnvironmentId != "" {
+				pool.Properties.EnvironmentId = pointer.To(model.ContainerAppEnvironmentId)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, pool); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppSessionPoolResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.SessionPoolsClient
+
+			id, err := containerappssessionpools.ParseSessionPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("reading %s: %+v", *id, err)
+			}
+
+			// the API does not return the values of secrets, so these are carried over from the config/state
+			var config ContainerAppSessionPoolModel
+			if err := metadata.Decode(&config); err != nil {
+				return err
+			}
+
+			state := ContainerAppSessionPoolModel{
+				Name:          id.SessionPoolName,
+				ResourceGroup: id.ResourceGroupName,
+			}
+
+			if model := existing.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = tags.Flatten(model.Tags)
+
+				if model.Identity != nil {
+					ident, err := identity.FlattenSystemAndUserAssignedMapToModel(pointer.To((identity.SystemAndUserAssignedMap)(*model.Identity)))
+					if err != nil {
+						return err
+					}
+					state.Identity = pointer.From(ident)
+				}
+
+				if props := model.Properties; props != nil {
+					state.ContainerType = string(pointer.From(props.ContainerType))
+					state.CustomContainerTemplate = flattenSessionPoolCustomContainerTemplate(props.CustomContainerTemplate)
+					state.ManagedIdentitySettings = flattenSessionPoolManagedIdentitySettings(props.ManagedIdentitySettings)
+					state.PoolManagementEndpoint = pointer.From(props.PoolManagementEndpoint)
+					state.Secrets = flattenSessionPoolSecrets(props.Secrets, config.Secrets)
+
+					if props.EnvironmentId != nil {
+						environmentId, err := managedenvironments.ParseManagedEnvironmentIDInsensitively(*props.EnvironmentId)
+						if err != nil {
+							return err
+						}
+						state.ContainerAppEnvironmentId = environmentId.ID()
+					}
+
+					if scale := props.ScaleConfiguration; scale != nil {
+						state.MaxConcurrentSessions = pointer.From(scale.MaxConcurrentSessions)
+						state.ReadySessionInstances = pointer.From(scale.ReadySessionInstances)
+					}
+
+					if dynamic := props.DynamicPoolConfiguration; dynamic != nil && dynamic.LifecycleConfiguration != nil {
+						state.LifecycleType = string(pointer.From(dynamic.LifecycleConfiguration.LifecycleType))
+						state.CooldownPeriodInSeconds = pointer.From(dynamic.LifecycleConfiguration.CooldownPeriodInSeconds)
+						state.MaxAlivePeriodInSeconds = pointer.From(dynamic.LifecycleConfiguration.MaxAlivePeriodInSeconds)
+					}
+
+					if network := props.SessionNetworkConfiguration; network != nil {
+						state.NetworkEgressEnabled = pointer.From(network.Status) == containerappssessionpools.SessionNetworkStatusEgressEnabled
+					}
+				}
+			}
+
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppSessionPoolResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.SessionPoolsClient
+
+			id, err := containerappssessionpools.ParseSessionPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ContainerAppSessionPoolModel
+			if err := metadata.Decode(&config); err != nil {
+				return err
+			}
+
+			d := metadata.ResourceData
+			payload := containerappssessionpools.SessionPoolUpdatableProperties{
+				Properties: &containerappssessionpools.SessionPoolUpdatablePropertiesProperties{},
+			}
+
+			if d.HasChange("custom_container_template") {
+				payload.Properties.CustomContainerTemplate = expandSessionPoolCustomContainerTemplate(config.CustomContainerTemplate)
+			}
+
+			if d.HasChanges("lifecycle_type", "cooldown_period_in_seconds", "max_alive_period_in_seconds") {
+				payload.Properties.DynamicPoolConfiguration = expandSessionPoolDynamicPoolConfiguration(config)
+			}
+
+			if d.HasChanges("max_concurrent_sessions", "ready_session_instances") {
+				payload.Properties.ScaleConfiguration = expandSessionPoolScaleConfiguration(config)
+			}
+
+			if d.HasChange("secret") {
+				payload.Properties.Secrets = expandSessionPoolSecrets(config.Secrets)
+			}
+
+			if d.HasChange("network_egress_enabled") {
+				payload.Properties.SessionNetworkConfiguration = expandSessionPoolNetworkConfiguration(config.NetworkEgressEnabled)
+			}
+
+			if d.HasChange("identity") {
+				ident, err := identity.ExpandLegacySystemAndUserAssignedMapFromModel(config.Identity)
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				payload.Identity = ident
+			}
+
+			if d.HasChange("tags") {
+				payload.Tags = tags.Expand(config.Tags)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			// `managedIdentitySettings` isn't part of the PATCH payload, so changes to it require a full PUT
+			if d.HasChange("managed_identity_setting") {
+				existing, err := client.Get(ctx, *id)
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", *id, err)
+				}
+				if existing.Model == nil || existing.Model.Properties == nil {
+					return fmt.Errorf("retrieving %s: `model` or `properties` was nil", *id)
+				}
+
+				existing.Model.Properties.ManagedIdentitySettings = expandSessionPoolManagedIdentitySettings(config.ManagedIdentitySettings)
+				// secret values are not returned by the API, and the read-only properties are rejected
+				existing.Model.Properties.Secrets = expandSessionPoolSecrets(config.Secrets)
+				existing.Model.Properties.NodeCount = nil
+				existing.Model.Properties.PoolManagementEndpoint = nil
+				existing.Model.Properties.ProvisioningState = nil
+
+				if err := client.CreateOrUpdateThenPoll(ctx, *id, *existing.Model); err != nil {
+					return fmt.Errorf("updating `managed_identity_setting` for %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppSessionPoolResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.SessionPoolsClient
+
+			id, err := containerappssessionpools.ParseSessionPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandSessionPoolScaleConfiguration(input ContainerAppSessionPoolModel) *containerappssessionpools.ScaleConfiguration {
+	result := &containerappssessionpools.ScaleConfiguration{
+		MaxConcurrentSessions: pointer.To(input.MaxConcurrentSessions),
+	}
+
+	if input.ReadySessionInstances != 0 {
+		result.ReadySessionInstances = pointer.To(input.ReadySessionInstances)
+	}
+
+	return result
+}
+
+func expandSessionPoolDynamicPoolConfiguration(input ContainerAppSessionPoolModel) *containerappssessionpools.DynamicPoolConfiguration {
+	lifecycle := &containerappssessionpools.LifecycleConfiguration{
+		LifecycleType: pointer.To(containerappssessionpools.LifecycleType(input.LifecycleType)),
+	}
+
+	switch containerappssessionpools.LifecycleType(input.LifecycleType) {
+	case containerappssessionpools.LifecycleTypeTimed:
+		if input.CooldownPeriodInSeconds != 0 {
+			lifecycle.CooldownPeriodInSeconds = pointer.To(input.CooldownPeriodInSeconds)
+		}
+	case containerappssessionpools.LifecycleTypeOnContainerExit:
+		if input.MaxAlivePeriodInSeconds != 0 {
+			lifecycle.MaxAlivePeriodInSeconds = pointer.To(input.MaxAlivePeriodInSeconds)
+		}
+	}
+
+	return &containerappssessionpools.DynamicPoolConfiguration{
+		LifecycleConfiguration: lifecycle,
+	}
+}
+
+func expandSessionPoolNetworkConfiguration(egressEnabled bool) *containerappssessionpools.SessionNetworkConfiguration {
+	status := containerappssessionpools.SessionNetworkStatusEgressDisabled
+	if egressEnabled {
+		status = containerappssessionpools.SessionNetworkStatusEgressEnabled
+	}
+
+	return &containerappssessionpools.SessionNetworkConfiguration{
+		Status: pointer.To(status),
+	}
+}
+
+func expandSessionPoolSecrets(input []SessionPoolSecretModel) *[]containerappssessionpools.SessionPoolSecret {
+	result := make([]containerappssessionpools.SessionPoolSecret, 0)
+	for _, v := range input {
+		result = append(result, containerappssessionpools.SessionPoolSecret{
+			Name:  pointer.To(v.Name),
+			Value: pointer.To(v.Value),
+		})
+	}
+
+	return &result
+}
+
+func flattenSessionPoolSecrets(input *[]containerappssessionpools.SessionPoolSecret, config []SessionPoolSecretModel) []SessionPoolSecretModel {
+	result := make([]SessionPoolSecretModel, 0)
+	if input == nil {
+		return result
+	}
+
+	values := make(map[string]string)
+	for _, v := range config {
+		values[v.Name] = v.Value
+	}
+
+	for _, v := range *input {
+		name := pointer.From(v.Name)
+		result = append(result, SessionPoolSecretModel{
+			Name:  name,
+			Value: values[name],
+		})
+	}
+
+	return result
+}
+
+func expandSessionPoolManagedIdentitySettings(input []SessionPoolManagedIdentitySettingModel) *[]containerappssessionpools.ManagedIdentitySetting {
+	if len(input) == 0 {
+		return nil
+	}
+
+	result := make([]containerappssessionpools.ManagedIdentitySetting, 0)
+	for _, v := range input {
+		result = append(result, containerappssessionpools.ManagedIdentitySetting{
+			Identity:  v.Identity,
+			Lifecycle: pointer.To(containerappssessionpools.IdentitySettingsLifeCycle(v.Lifecycle)),
+		})
+	}
+
+	return &result
+}
+
+func flattenSessionPoolManagedIdentitySettings(input *[]containerappssessionpools.ManagedIdentitySetting) []SessionPoolManagedIdentitySettingModel {
+	result := make([]SessionPoolManagedIdentitySettingModel, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		result = append(result, SessionPoolManagedIdentitySettingModel{
+			Identity:  v.Identity,
+			Lifecycle: string(pointer.From(v.Lifecycle)),
+		})
+	}
+
+	return result
+}
+
+func expandSessionPoolCustomContainerTemplate(input []SessionPoolCustomContainerTemplateModel) *containerappssessionpools.CustomContainerTemplate {
+	if len(input) == 0 {
+		return nil
+	}
+
+	template := input[0]
+	result := &containerappssessionpools.CustomContainerTemplate{
+		Ingress: &containerappssessionpools.SessionIngress{
+			TargetPort: pointer.To(template.TargetPort),
+		},
+	}
+
+	containers := make([]containerappssessionpools.SessionContainer, 0)
+	for _, v := range template.Containers {
+		container := containerappssessionpools.SessionContainer{
+			Name:  pointer.To(v.Name),
+			Image: pointer.To(v.Image),
+			Resources: &containerappssessionpools.SessionContainerResources{
+				Cpu:    pointer.To(v.CPU),
+				Memory: pointer.To(v.Memory),
+			},
+		}
+
+		if len(v.Args) > 0 {
+			container.Args = pointer.To(v.Args)
+		}
+
+		if len(v.Command) > 0 {
+			container.Command = pointer.To(v.Command)
+		}
+
+		if len(v.Env) > 0 {
+			env := make([]containerappssessionpools.EnvironmentVar, 0)
+			for _, e := range v.Env {
+				envVar := containerappssessionpools.EnvironmentVar{
+					Name: pointer.To(e.Name),
+				}
+				if e.SecretReference != "" {
+					envVar.SecretRef = pointer.To(e.SecretReference)
+				} else {
+					envVar.Value = pointer.To(e.Value)
+				}
+				env = append(env, envVar)
+			}
+			container.Env = &env
+		}
+
+		containers = append(containers, container)
+	}
+	result.Containers = &containers
+
+	if len(template.Registry) > 0 {
+		registry := template.Registry[0]
+		result.RegistryCredentials = &containerappssessionpools.SessionRegistryCredentials{
+			Server: pointer.To(registry.Server),
+		}
+
+		if registry.Identity != "" {
+			result.RegistryCredentials.Identity = pointer.To(registry.Identity)
+		}
+
+		if registry.Username != "" {
+			result.RegistryCredentials.Username = pointer.To(registry.Username)
+			result.RegistryCredentials.PasswordSecretRef = pointer.To(registry.PasswordSecretName)
+		}
+	}
+
+	return result
+}
+
+func flattenSessionPoolCustomContainerTemplate(input *containerappssessionpools.CustomContainerTemplate) []SessionPoolCustomContainerTemplateModel {
+	if input == nil {
+		return []SessionPoolCustomContainerTemplateModel{}
+	}
+
+	template := SessionPoolCustomContainerTemplateModel{}
+
+	if input.Ingress != nil {
+		template.TargetPort = pointer.From(input.Ingress.TargetPort)
+	}
+
+	if input.Containers != nil {
+		for _, v := range *input.Containers {
+			container := SessionPoolContainerModel{
+				Name:    pointer.From(v.Name),
+				Image:   pointer.From(v.Image),
+				Args:    pointer.From(v.Args),
+				Command: pointer.From(v.Command),
+			}
+
+			if resources := v.Resources; resources != nil {
+				container.CPU = pointer.From(resources.Cpu)
+				container.Memory = pointer.From(resources.Memory)
+			}
+
+			if v.Env != nil {
+				for _, e := range *v.Env {
+					container.Env = append(container.Env, helpers.ContainerEnvVar{
+						Name:            pointer.From(e.Name),
+						Value:           pointer.From(e.Value),
+						SecretReference: pointer.From(e.SecretRef),
+					})
+				}
+			}
+
+			template.Containers = append(template.Containers, container)
+		}
+	}
+
+	if registry := input.RegistryCredentials; registry != nil {
+		template.Registry = []SessionPoolRegistryCredentialsModel{
+			{
+				Server:             pointer.From(registry.Server),
+				Identity:           pointer.From(registry.Identity),
+				Username:           pointer.From(registry.Username),
+				PasswordSecretName: pointer.From(registry.PasswordSecretRef),
+			},
+		}
+	}
+
+	return []SessionPoolCustomContainerTemplateModel{template}
+}
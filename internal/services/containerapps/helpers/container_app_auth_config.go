@@ -0,0 +1,1057 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/containerappsauthconfigs"
+	appServiceValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type AuthConfigLogin struct {
+	LogoutEndpoint                string   `tfschema:"logout_endpoint"`
+	TokenStoreEnabled             bool     `tfschema:"token_store_enabled"`
+	TokenRefreshExtension         float64  `tfschema:"token_refresh_extension_time"`
+	TokenBlobStorageSAS           string   `tfschema:"token_store_sas_setting_name"`
+	PreserveURLFragmentsForLogins bool     `tfschema:"preserve_url_fragments_for_logins"`
+	AllowedExternalRedirectURLs   []string `tfschema:"allowed_external_redirect_urls"`
+	CookieExpirationConvention    string   `tfschema:"cookie_expiration_convention"`
+	CookieExpirationTime          string   `tfschema:"cookie_expiration_time"`
+	ValidateNonce                 bool     `tfschema:"validate_nonce"`
+	NonceExpirationTime           string   `tfschema:"nonce_expiration_time"`
+}
+
+func AuthConfigLoginSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"logout_endpoint": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The endpoint to which logout requests should be made.",
+				},
+
+				"token_store_enabled": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Should the Token Store be enabled? Defaults to `false`.",
+				},
+
+				"token_refresh_extension_time": {
+					Type:         pluginsdk.TypeFloat,
+					Optional:     true,
+					Default:      72,
+					ValidateFunc: validation.FloatAtLeast(0),
+					Description:  "The number of hours after session token expiration that a session token can be used to call the token refresh API. Defaults to `72` hours.",
+				},
+
+				"token_store_sas_setting_name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the Container App secret which contains the SAS URL of the blob storage containing the tokens.",
+				},
+
+				"preserve_url_fragments_for_logins": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Should the fragments from the request be preserved after the login request is made? Defaults to `false`.",
+				},
+
+				"allowed_external_redirect_urls": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "External URLs that can be redirected to as part of logging in or logging out of the Container App. **Note:** URLs within the current domain are always implicitly allowed.",
+				},
+
+				"cookie_expiration_convention": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      string(containerappsauthconfigs.CookieExpirationConventionFixedTime),
+					ValidateFunc: validation.StringInSlice(containerappsauthconfigs.PossibleValuesForCookieExpirationConvention(), false),
+					Description:  "The method by which cookies expire. Possible values include: `FixedTime`, and `IdentityProviderDerived`. Defaults to `FixedTime`.",
+				},
+
+				"cookie_expiration_time": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "08:00:00",
+					ValidateFunc: appServiceValidate.TimeInterval,
+					Description:  "The time after the request is made when the session cookie should expire. Defaults to `08:00:00`.",
+				},
+
+				"validate_nonce": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Should the nonce be validated while completing the login flow? Defaults to `true`.",
+				},
+
+				"nonce_expiration_time": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "00:05:00",
+					ValidateFunc: appServiceValidate.TimeInterval,
+					Description:  "The time after the request is made when the nonce should expire. Defaults to `00:05:00`.",
+				},
+			},
+		},
+	}
+}
+
+func ExpandAuthConfigLogin(input []AuthConfigLogin) *containerappsauthconfigs.Login {
+	if len(input) == 0 {
+		return nil
+	}
+
+	login := input[0]
+	result := &containerappsauthconfigs.Login{
+		AllowedExternalRedirectURLs:   pointer.To(login.AllowedExternalRedirectURLs),
+		PreserveURLFragmentsForLogins: pointer.To(login.PreserveURLFragmentsForLogins),
+		CookieExpiration: &containerappsauthconfigs.CookieExpiration{
+			Convention:       pointer.To(containerappsauthconfigs.CookieExpirationConvention(login.CookieExpirationConvention)),
+			TimeToExpiration: pointer.To(login.CookieExpirationTime),
+		},
+		Nonce: &containerappsauthconfigs.Nonce{
+			ValidateNonce:           pointer.To(login.ValidateNonce),
+			NonceExpirationInterval: pointer.To(login.NonceExpirationTime),
+		},
+		TokenStore: &containerappsauthconfigs.TokenStore{
+			Enabled:                    pointer.To(login.TokenStoreEnabled),
+			TokenRefreshExtensionHours: pointer.To(login.TokenRefreshExtension),
+		},
+	}
+
+	if login.LogoutEndpoint != "" {
+		result.Routes = &containerappsauthconfigs.LoginRoutes{
+			LogoutEndpoint: pointer.To(login.LogoutEndpoint),
+		}
+	}
+
+	if login.TokenBlobStorageSAS != "" {
+		result.TokenStore.AzureBlobStorage = &containerappsauthconfigs.BlobStorageTokenStore{
+			SasURLSettingName: login.TokenBlobStorageSAS,
+		}
+	}
+
+	return result
+}
+
+func FlattenAuthConfigLogin(input *containerappsauthconfigs.Login) []AuthConfigLogin {
+	if input == nil {
+		return []AuthConfigLogin{}
+	}
+
+	result := AuthConfigLogin{
+		AllowedExternalRedirectURLs:   pointer.From(input.AllowedExternalRedirectURLs),
+		PreserveURLFragmentsForLogins: pointer.From(input.PreserveURLFragmentsForLogins),
+	}
+
+	if routes := input.Routes; routes != nil {
+		result.LogoutEndpoint = pointer.From(routes.LogoutEndpoint)
+	}
+
+	if tokenStore := input.TokenStore; tokenStore != nil {
+		result.TokenStoreEnabled = pointer.From(tokenStore.Enabled)
+		result.TokenRefreshExtension = pointer.From(tokenStore.TokenRefreshExtensionHours)
+		if blob := tokenStore.AzureBlobStorage; blob != nil {
+			result.TokenBlobStorageSAS = blob.SasURLSettingName
+		}
+	}
+
+	if nonce := input.Nonce; nonce != nil {
+		result.ValidateNonce = pointer.From(nonce.ValidateNonce)
+		result.NonceExpirationTime = pointer.From(nonce.NonceExpirationInterval)
+	}
+
+	if cookie := input.CookieExpiration; cookie != nil {
+		result.CookieExpirationConvention = string(pointer.From(cookie.Convention))
+		result.CookieExpirationTime = pointer.From(cookie.TimeToExpiration)
+	}
+
+	return []AuthConfigLogin{result}
+}
+
+type AuthConfigActiveDirectory struct {
+	ClientId                          string            `tfschema:"client_id"`
+	TenantAuthURI                     string            `tfschema:"tenant_auth_endpoint"`
+	ClientSecretSettingName           string            `tfschema:"client_secret_setting_name"`
+	ClientSecretCertificateThumbprint string            `tfschema:"client_secret_certificate_thumbprint"`
+	LoginParameters                   map[string]string `tfschema:"login_parameters"`
+	DisableWWWAuth                    bool              `tfschema:"www_authentication_disabled"`
+	JWTAllowedGroups                  []string          `tfschema:"jwt_allowed_groups"`
+	JWTAllowedClientApps              []string          `tfschema:"jwt_allowed_client_applications"`
+	AllowedApplications               []string          `tfschema:"allowed_applications"`
+	AllowedAudiences                  []string          `tfschema:"allowed_audiences"`
+	AllowedGroups                     []string          `tfschema:"allowed_groups"`
+	AllowedIdentities                 []string          `tfschema:"allowed_identities"`
+}
+
+func AuthConfigActiveDirectorySchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"client_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The ID of the Client to use to authenticate with Azure Active Directory.",
+				},
+
+				"tenant_auth_endpoint": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					Description:  "The Azure Tenant Endpoint for the Authenticating Tenant. e.g. `https://login.microsoftonline.com/{tenant-guid}/v2.0/`.",
+				},
+
+				"client_secret_setting_name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					ConflictsWith: []string{
+						"active_directory.0.client_secret_certificate_thumbprint",
+					},
+					Description: "The name of the Container App secret that contains the client secret of the Client.",
+				},
+
+				"client_secret_certificate_thumbprint": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					ConflictsWith: []string{
+						"active_directory.0.client_secret_setting_name",
+					},
+					Description: "The thumbprint of the certificate used for signing purposes.",
+				},
+
+				"login_parameters": {
+					Type:     pluginsdk.TypeMap,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+					Description: "A map of key-value pairs to send to the Authorisation Endpoint when a user logs in.",
+				},
+
+				"www_authentication_disabled": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Description: "Should the www-authenticate provider be omitted from the request? Defaults to `false`.",
+				},
+
+				"jwt_allowed_groups": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "A list of Allowed Groups in the JWT Claim.",
+				},
+
+				"jwt_allowed_client_applications": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "A list of Allowed Client Applications in the JWT Claim.",
+				},
+
+				"allowed_applications": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "The list of allowed Applications for the Default Authorisation Policy.",
+				},
+
+				"allowed_audiences": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "Specifies a list of Allowed audience values to consider when validating JWTs issued by Azure Active Directory.",
+				},
+
+				"allowed_groups": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "The list of allowed Group Names for the Default Authorisation Policy.",
+				},
+
+				"allowed_identities": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "The list of allowed Identities for the Default Authorisation Policy.",
+				},
+			},
+		},
+	}
+}
+
+func ExpandAuthConfigActiveDirectory(input []AuthConfigActiveDirectory) *containerappsauthconfigs.AzureActiveDirectory {
+	if len(input) == 0 {
+		return &containerappsauthconfigs.AzureActiveDirectory{
+			Enabled: pointer.To(false),
+		}
+	}
+
+	aad := input[0]
+	result := &containerappsauthconfigs.AzureActiveDirectory{
+		Enabled: pointer.To(true),
+		Registration: &containerappsauthconfigs.AzureActiveDirectoryRegistration{
+			ClientId:     pointer.To(aad.ClientId),
+			OpenIdIssuer: pointer.To(aad.TenantAuthURI),
+		},
+		Login: &containerappsauthconfigs.AzureActiveDirectoryLogin{
+			DisableWWWAuthenticate: pointer.To(aad.DisableWWWAuth),
+		},
+		Validation: &containerappsauthconfigs.AzureActiveDirectoryValidation{
+			AllowedAudiences: pointer.To(aad.AllowedAudiences),
+			DefaultAuthorizationPolicy: &containerappsauthconfigs.DefaultAuthorizationPolicy{
+				AllowedApplications: pointer.To(aad.AllowedApplications),
+				AllowedPrincipals: &containerappsauthconfigs.AllowedPrincipals{
+					Groups:     pointer.To(aad.AllowedGroups),
+					Identities: pointer.To(aad.AllowedIdentities),
+				},
+			},
+			JwtClaimChecks: &containerappsauthconfigs.JwtClaimChecks{
+				AllowedGroups:             pointer.To(aad.JWTAllowedGroups),
+				AllowedClientApplications: pointer.To(aad.JWTAllowedClientApps),
+			},
+		},
+	}
+
+	if aad.ClientSecretSettingName != "" {
+		result.Registration.ClientSecretSettingName = pointer.To(aad.ClientSecretSettingName)
+	}
+
+	if aad.ClientSecretCertificateThumbprint != "" {
+		result.Registration.ClientSecretCertificateThumbprint = pointer.To(aad.ClientSecretCertificateThumbprint)
+	}
+
+	if len(aad.LoginParameters) > 0 {
+		params := make([]string, 0)
+		for k, v := range aad.LoginParameters {
+			params = append(params, fmt.Sprintf("%s=%s", k, v))
+		}
+		result.Login.LoginParameters = &params
+	}
+
+	return result
+}
+
+func FlattenAuthConfigActiveDirectory(input *containerappsauthconfigs.AzureActiveDirectory) []AuthConfigActiveDirectory {
+	if input == nil || !pointer.From(input.Enabled) {
+		return []AuthConfigActiveDirectory{}
+	}
+
+	result := AuthConfigActiveDirectory{}
+
+	if reg := input.Registration; reg != nil {
+		result.ClientId = pointer.From(reg.ClientId)
+		result.TenantAuthURI = pointer.From(reg.OpenIdIssuer)
+		result.ClientSecretSettingName = pointer.From(reg.ClientSecretSettingName)
+		result.ClientSecretCertificateThumbprint = pointer.From(reg.ClientSecretCertificateThumbprint)
+	}
+
+	if login := input.Login; login != nil {
+		result.DisableWWWAuth = pointer.From(login.DisableWWWAuthenticate)
+		if login.LoginParameters != nil {
+			loginParams := make(map[string]string)
+			for _, v := range *login.LoginParameters {
+				parts := strings.SplitN(v, "=", 2)
+				if len(parts) == 2 && parts[0] != "" {
+					loginParams[parts[0]] = parts[1]
+				}
+			}
+			result.LoginParameters = loginParams
+		}
+	}
+
+	if v := input.Validation; v != nil {
+		result.AllowedAudiences = pointer.From(v.AllowedAudiences)
+		if jwt := v.JwtClaimChecks; jwt != nil {
+			result.JWTAllowedGroups = pointer.From(jwt.AllowedGroups)
+			result.JWTAllowedClientApps = pointer.From(jwt.AllowedClientApplications)
+		}
+		if policy := v.DefaultAuthorizationPolicy; policy != nil {
+			result.AllowedApplications = pointer.From(policy.AllowedApplications)
+			if principals := policy.AllowedPrincipals; principals != nil {
+				result.AllowedGroups = pointer.From(principals.Groups)
+				result.AllowedIdentities = pointer.From(principals.Identities)
+			}
+		}
+	}
+
+	return []AuthConfigActiveDirectory{result}
+}
+
+type AuthConfigApple struct {
+	ClientId                string   `tfschema:"client_id"`
+	ClientSecretSettingName string   `tfschema:"client_secret_setting_name"`
+	LoginScopes             []string `tfschema:"login_scopes"`
+}
+
+func AuthConfigAppleSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"client_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The OpenID Connect Client ID for the Apple web application.",
+				},
+
+				"client_secret_setting_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the Container App secret that contains the `client_secret` value used for Apple Login.",
+				},
+
+				"login_scopes": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func ExpandAuthConfigApple(input []AuthConfigApple) *containerappsauthconfigs.Apple {
+	if len(input) == 0 {
+		return &containerappsauthconfigs.Apple{
+			Enabled: pointer.To(false),
+		}
+	}
+
+	apple := input[0]
+	return &containerappsauthconfigs.Apple{
+		Enabled: pointer.To(true),
+		Registration: &containerappsauthconfigs.AppleRegistration{
+			ClientId:                pointer.To(apple.ClientId),
+			ClientSecretSettingName: pointer.To(apple.ClientSecretSettingName),
+		},
+	}
+}
+
+func FlattenAuthConfigApple(input *containerappsauthconfigs.Apple) []AuthConfigApple {
+	if input == nil || !pointer.From(input.Enabled) {
+		return []AuthConfigApple{}
+	}
+
+	result := AuthConfigApple{}
+
+	if reg := input.Registration; reg != nil {
+		result.ClientId = pointer.From(reg.ClientId)
+		result.ClientSecretSettingName = pointer.From(reg.ClientSecretSettingName)
+	}
+	if login := input.Login; login != nil {
+		result.LoginScopes = pointer.From(login.Scopes)
+	}
+
+	return []AuthConfigApple{result}
+}
+
+type AuthConfigAzureStaticWebApp struct {
+	ClientId string `tfschema:"client_id"`
+}
+
+func AuthConfigAzureStaticWebAppSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"client_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The ID of the Client to use to authenticate with Azure Static Web App Authentication.",
+				},
+			},
+		},
+	}
+}
+
+func ExpandAuthConfigAzureStaticWebApp(input []AuthConfigAzureStaticWebApp) *containerappsauthconfigs.AzureStaticWebApps {
+	if len(input) == 0 {
+		return &containerappsauthconfigs.AzureStaticWebApps{
+			Enabled: pointer.To(false),
+		}
+	}
+
+	return &containerappsauthconfigs.AzureStaticWebApps{
+		Enabled: pointer.To(true),
+		Registration: &containerappsauthconfigs.AzureStaticWebAppsRegistration{
+			ClientId: pointer.To(input[0].ClientId),
+		},
+	}
+}
+
+func FlattenAuthConfigAzureStaticWebApp(input *containerappsauthconfigs.AzureStaticWebApps) []AuthConfigAzureStaticWebApp {
+	if input == nil || !pointer.From(input.Enabled) {
+		return []AuthConfigAzureStaticWebApp{}
+	}
+
+	result := AuthConfigAzureStaticWebApp{}
+
+	if reg := input.Registration; reg != nil {
+		result.ClientId = pointer.From(reg.ClientId)
+	}
+
+	return []AuthConfigAzureStaticWebApp{result}
+}
+
+type AuthConfigCustomOIDC struct {
+	Name                        string   `tfschema:"name"`
+	ClientId                    string   `tfschema:"client_id"`
+	ClientSecretSettingName     string   `tfschema:"client_secret_setting_name"`
+	OpenIDConfigurationEndpoint string   `tfschema:"openid_configuration_endpoint"`
+	NameClaimType               string   `tfschema:"name_claim_type"`
+	Scopes                      []string `tfschema:"scopes"`
+	ClientCredentialMethod      string   `tfschema:"client_credential_method"`
+	AuthorizationEndpoint       string   `tfschema:"authorisation_endpoint"`
+	TokenEndpoint               string   `tfschema:"token_endpoint"`
+	IssuerEndpoint              string   `tfschema:"issuer_endpoint"`
+	CertificationURI            string   `tfschema:"certification_uri"`
+}
+
+func AuthConfigCustomOIDCSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the Custom OIDC Authentication Provider.",
+				},
+
+				"client_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The ID of the Client to use to authenticate with this Custom OIDC.",
+				},
+
+				"client_secret_setting_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the Container App secret that contains the secret for this Custom OIDC Client.",
+				},
+
+				"openid_configuration_endpoint": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.IsURLWithHTTPS,
+					Description:  "The endpoint that contains all the configuration endpoints for this Custom OIDC provider.",
+				},
+
+				"name_claim_type": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the claim that contains the users name.",
+				},
+
+				"scopes": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "The list of the scopes that should be requested while authenticating.",
+				},
+
+				"client_credential_method": {
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+					Description: "The Client Credential Method used.",
+				},
+
+				"authorisation_endpoint": {
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+					Description: "The endpoint to make the Authorisation Request.",
+				},
+
+				"token_endpoint": {
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+					Description: "The endpoint used to request a Token.",
+				},
+
+				"issuer_endpoint": {
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+					Description: "The endpoint that issued the Token.",
+				},
+
+				"certification_uri": {
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+					Description: "The endpoint that provides the keys necessary to validate the token.",
+				},
+			},
+		},
+	}
+}
+
+func ExpandAuthConfigCustomOIDC(input []AuthConfigCustomOIDC) *map[string]containerappsauthconfigs.CustomOpenIdConnectProvider {
+	result := make(map[string]containerappsauthconfigs.CustomOpenIdConnectProvider)
+
+	for _, v := range input {
+		provider := containerappsauthconfigs.CustomOpenIdConnectProvider{
+			Enabled: pointer.To(true),
+			Registration: &containerappsauthconfigs.OpenIdConnectRegistration{
+				ClientId: pointer.To(v.ClientId),
+				ClientCredential: &containerappsauthconfigs.OpenIdConnectClientCredential{
+					Method:                  pointer.To(containerappsauthconfigs.ClientCredentialMethodClientSecretPost),
+					ClientSecretSettingName: pointer.To(v.ClientSecretSettingName),
+				},
+				OpenIdConnectConfiguration: &containerappsauthconfigs.OpenIdConnectConfig{
+					WellKnownOpenIdConfiguration: pointer.To(v.OpenIDConfigurationEndpoint),
+				},
+			},
+			Login: &containerappsauthconfigs.OpenIdConnectLogin{
+				Scopes: pointer.To(v.Scopes),
+			},
+		}
+
+		if v.NameClaimType != "" {
+			provider.Login.NameClaimType = pointer.To(v.NameClaimType)
+		}
+
+		result[v.Name] = provider
+	}
+
+	return &result
+}
+
+func FlattenAuthConfigCustomOIDC(input *map[string]containerappsauthconfigs.CustomOpenIdConnectProvider) []AuthConfigCustomOIDC {
+	if input == nil {
+		return []AuthConfigCustomOIDC{}
+	}
+
+	result := make([]AuthConfigCustomOIDC, 0)
+	for name, v := range *input {
+		if !pointer.From(v.Enabled) {
+			continue
+		}
+
+		provider := AuthConfigCustomOIDC{
+			Name: name,
+		}
+
+		if reg := v.Registration; reg != nil {
+			provider.ClientId = pointer.From(reg.ClientId)
+			if credential := reg.ClientCredential; credential != nil {
+				provider.ClientSecretSettingName = pointer.From(credential.ClientSecretSettingName)
+				provider.ClientCredentialMethod = string(pointer.From(credential.Method))
+			}
+			if config := reg.OpenIdConnectConfiguration; config != nil {
+				provider.OpenIDConfigurationEndpoint = pointer.From(config.WellKnownOpenIdConfiguration)
+				provider.AuthorizationEndpoint = pointer.From(config.AuthorizationEndpoint)
+				provider.TokenEndpoint = pointer.From(config.TokenEndpoint)
+				provider.IssuerEndpoint = pointer.From(config.Issuer)
+				provider.CertificationURI = pointer.From(config.CertificationUri)
+			}
+		}
+
+		if login := v.Login; login != nil {
+			provider.NameClaimType = pointer.From(login.NameClaimType)
+			provider.Scopes = pointer.From(login.Scopes)
+		}
+
+		result = append(result, provider)
+	}
+
+	// the API returns a map, so sort by name to keep the ordering stable
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
+
+type AuthConfigFacebook struct {
+	AppId                string   `tfschema:"app_id"`
+	AppSecretSettingName string   `tfschema:"app_secret_setting_name"`
+	GraphAPIVersion      string   `tfschema:"graph_api_version"`
+	LoginScopes          []string `tfschema:"login_scopes"`
+}
+
+func AuthConfigFacebookSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"app_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The App ID of the Facebook app used for login.",
+				},
+
+				"app_secret_setting_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the Container App secret that contains the `app_secret` value used for Facebook Login.",
+				},
+
+				"graph_api_version": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The version of the Facebook API to be used while logging in.",
+				},
+
+				"login_scopes": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "Specifies a list of scopes to be requested as part of Facebook Login authentication.",
+				},
+			},
+		},
+	}
+}
+
+func ExpandAuthConfigFacebook(input []AuthConfigFacebook) *containerappsauthconfigs.Facebook {
+	if len(input) == 0 {
+		return &containerappsauthconfigs.Facebook{
+			Enabled: pointer.To(false),
+		}
+	}
+
+	facebook := input[0]
+	result := &containerappsauthconfigs.Facebook{
+		Enabled: pointer.To(true),
+		Registration: &containerappsauthconfigs.AppRegistration{
+			AppId:                pointer.To(facebook.AppId),
+			AppSecretSettingName: pointer.To(facebook.AppSecretSettingName),
+		},
+		Login: &containerappsauthconfigs.LoginScopes{
+			Scopes: pointer.To(facebook.LoginScopes),
+		},
+	}
+
+	if facebook.GraphAPIVersion != "" {
+		result.GraphApiVersion = pointer.To(facebook.GraphAPIVersion)
+	}
+
+	return result
+}
+
+func FlattenAuthConfigFacebook(input *containerappsauthconfigs.Facebook) []AuthConfigFacebook {
+	if input == nil || !pointer.From(input.Enabled) {
+		return []AuthConfigFacebook{}
+	}
+
+	result := AuthConfigFacebook{
+		GraphAPIVersion: pointer.From(input.GraphApiVersion),
+	}
+
+	if reg := input.Registration; reg != nil {
+		result.AppId = pointer.From(reg.AppId)
+		result.AppSecretSettingName = pointer.From(reg.AppSecretSettingName)
+	}
+	if login := input.Login; login != nil {
+		result.LoginScopes = pointer.From(login.Scopes)
+	}
+
+	return []AuthConfigFacebook{result}
+}
+
+type AuthConfigGitHub struct {
+	ClientId                string   `tfschema:"client_id"`
+	ClientSecretSettingName string   `tfschema:"client_secret_setting_name"`
+	LoginScopes             []string `tfschema:"login_scopes"`
+}
+
+func AuthConfigGitHubSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"client_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The ID of the GitHub app used for login.",
+				},
+
+				"client_secret_setting_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the Container App secret that contains the `client_secret` value used for GitHub Login.",
+				},
+
+				"login_scopes": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "Specifies a list of OAuth 2.0 scopes that will be requested as part of GitHub Login authentication.",
+				},
+			},
+		},
+	}
+}
+
+func ExpandAuthConfigGitHub(input []AuthConfigGitHub) *containerappsauthconfigs.GitHub {
+	if len(input) == 0 {
+		return &containerappsauthconfigs.GitHub{
+			Enabled: pointer.To(false),
+		}
+	}
+
+	github := input[0]
+	return &containerappsauthconfigs.GitHub{
+		Enabled: pointer.To(true),
+		Registration: &containerappsauthconfigs.ClientRegistration{
+			ClientId:                pointer.To(github.ClientId),
+			ClientSecretSettingName: pointer.To(github.ClientSecretSettingName),
+		},
+		Login: &containerappsauthconfigs.LoginScopes{
+			Scopes: pointer.To(github.LoginScopes),
+		},
+	}
+}
+
+func FlattenAuthConfigGitHub(input *containerappsauthconfigs.GitHub) []AuthConfigGitHub {
+	if input == nil || !pointer.From(input.Enabled) {
+		return []AuthConfigGitHub{}
+	}
+
+	result := AuthConfigGitHub{}
+
+	if reg := input.Registration; reg != nil {
+		result.ClientId = pointer.From(reg.ClientId)
+		result.ClientSecretSettingName = pointer.From(reg.ClientSecretSettingName)
+	}
+	if login := input.Login; login != nil {
+		result.LoginScopes = pointer.From(login.Scopes)
+	}
+
+	return []AuthConfigGitHub{result}
+}
+
+type AuthConfigGoogle struct {
+	ClientId                string   `tfschema:"client_id"`
+	ClientSecretSettingName string   `tfschema:"client_secret_setting_name"`
+	AllowedAudiences        []string `tfschema:"allowed_audiences"`
+	LoginScopes             []string `tfschema:"login_scopes"`
+}
+
+func AuthConfigGoogleSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"client_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The OpenID Connect Client ID for the Google web application.",
+				},
+
+				"client_secret_setting_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the Container App secret that contains the `client_secret` value used for Google Login.",
+				},
+
+				"allowed_audiences": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "Specifies a list of Allowed Audiences that will be requested as part of Google Sign-In authentication.",
+				},
+
+				"login_scopes": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "Specifies a list of Login scopes that will be requested as part of Google Sign-In authentication.",
+				},
+			},
+		},
+	}
+}
+
+func ExpandAuthConfigGoogle(input []AuthConfigGoogle) *containerappsauthconfigs.Google {
+	if len(input) == 0 {
+		return &containerappsauthconfigs.Google{
+			Enabled: pointer.To(false),
+		}
+	}
+
+	google := input[0]
+	return &containerappsauthconfigs.Google{
+		Enabled: pointer.To(true),
+		Registration: &containerappsauthconfigs.ClientRegistration{
+			ClientId:                pointer.To(google.ClientId),
+			ClientSecretSettingName: pointer.To(google.ClientSecretSettingName),
+		},
+		Validation: &containerappsauthconfigs.AllowedAudiencesValidation{
+			AllowedAudiences: pointer.To(google.AllowedAudiences),
+		},
+		Login: &containerappsauthconfigs.LoginScopes{
+			Scopes: pointer.To(google.LoginScopes),
+		},
+	}
+}
+
+func FlattenAuthConfigGoogle(input *containerappsauthconfigs.Google) []AuthConfigGoogle {
+	if input == nil || !pointer.From(input.Enabled) {
+		return []AuthConfigGoogle{}
+	}
+
+	result := AuthConfigGoogle{}
+
+	if reg := input.Registration; reg != nil {
+		result.ClientId = pointer.From(reg.ClientId)
+		result.ClientSecretSettingName = pointer.From(reg.ClientSecretSettingName)
+	}
+	if login := input.Login; login != nil {
+		result.LoginScopes = pointer.From(login.Scopes)
+	}
+	if v := input.Validation; v != nil {
+		result.AllowedAudiences = pointer.From(v.AllowedAudiences)
+	}
+
+	return []AuthConfigGoogle{result}
+}
+
+type AuthConfigTwitter struct {
+	ConsumerKey               string `tfschema:"consumer_key"`
+	ConsumerSecretSettingName string `tfschema:"consumer_secret_setting_name"`
+}
+
+func AuthConfigTwitterSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"consumer_key": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The OAuth 1.0a consumer key of the Twitter application used for sign-in.",
+				},
+
+				"consumer_secret_setting_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the Container App secret that contains the OAuth 1.0a consumer secret of the Twitter application used for sign-in.",
+				},
+			},
+		},
+	}
+}
+
+func ExpandAuthConfigTwitter(input []AuthConfigTwitter) *containerappsauthconfigs.Twitter {
+	if len(input) == 0 {
+		return &containerappsauthconfigs.Twitter{
+			Enabled: pointer.To(false),
+		}
+	}
+
+	twitter := input[0]
+	return &containerappsauthconfigs.Twitter{
+		Enabled: pointer.To(true),
+		Registration: &containerappsauthconfigs.TwitterRegistration{
+			ConsumerKey:               pointer.To(twitter.ConsumerKey),
+			ConsumerSecretSettingName: pointer.To(twitter.ConsumerSecretSettingName),
+		},
+	}
+}
+
+func FlattenAuthConfigTwitter(input *containerappsauthconfigs.Twitter) []AuthConfigTwitter {
+	if input == nil || !pointer.From(input.Enabled) {
+		return []AuthConfigTwitter{}
+	}
+
+	result := AuthConfigTwitter{}
+
+	if reg := input.Registration; reg != nil {
+		result.ConsumerKey = pointer.From(reg.ConsumerKey)
+		result.ConsumerSecretSettingName = pointer.From(reg.ConsumerSecretSettingName)
+	}
+
+	return []AuthConfigTwitter{result}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/connectedregistries"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/registries"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/tokens"
@@ -22,9 +23,16 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name container_connected_registry -service-package-name containers -properties "name" -compare-values "subscription_id:id,resource_group_name:id,registry_name:id"
+
 type ContainerConnectedRegistryResource struct{}
 
 var _ sdk.ResourceWithUpdate = ContainerConnectedRegistryResource{}
+var _ sdk.ResourceWithIdentity = ContainerConnectedRegistryResource{}
+
+func (r ContainerConnectedRegistryResource) Identity() resourceids.ResourceId {
+	return &connectedregistries.ConnectedRegistryId{}
+}
 
 type ContainerConnectedRegistryModel struct {
 	Name                string                   `tfschema:"name"`
@@ -365,6 +373,10 @@ func (r ContainerConnectedRegistryResource) Read() sdk.ResourceFunc {
 				AuditLogEnabled:     auditLogEnabled,
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&model)
 		},
 	}
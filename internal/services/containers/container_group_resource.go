@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerinstance/2023-05-01/containerinstance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -32,16 +33,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name container_group -service-package-name containers -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name linuxBasic
+
 func resourceContainerGroup() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
-		Create: resourceContainerGroupCreate,
-		Read:   resourceContainerGroupRead,
-		Delete: resourceContainerGroupDelete,
-		Update: resourceContainerGroupUpdate,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := containerinstance.ParseContainerGroupID(id)
-			return err
-		}),
+		Create:   resourceContainerGroupCreate,
+		Read:     resourceContainerGroupRead,
+		Delete:   resourceContainerGroupDelete,
+		Update:   resourceContainerGroupUpdate,
+		Importer: pluginsdk.ImporterValidatingIdentity(&containerinstance.ContainerGroupId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&containerinstance.ContainerGroupId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
@@ -969,7 +973,7 @@ func resourceContainerGroupRead(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func flattenPorts(ports []interface{}) *pluginsdk.Set {
@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-07-01/cacherules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-07-01/credentialsets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/registries"
@@ -21,6 +22,9 @@ import (
 )
 
 var _ sdk.Resource = ContainerRegistryCacheRule{}
+var _ sdk.ResourceWithIdentity = ContainerRegistryCacheRule{}
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name container_registry_cache_rule -service-package-name containers -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,registry_name:id"
 
 type ContainerRegistryCacheRule struct{}
 
@@ -180,6 +184,10 @@ func (ContainerRegistryCacheRule) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&config)
 		},
 	}
@@ -244,6 +252,10 @@ func (ContainerRegistryCacheRule) IDValidationFunc() pluginsdk.SchemaValidateFun
 	return cacherules.ValidateCacheRuleID
 }
 
+func (ContainerRegistryCacheRule) Identity() resourceids.ResourceId {
+	return &cacherules.CacheRuleId{}
+}
+
 func (ContainerRegistryCacheRule) CustomizeDiff() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
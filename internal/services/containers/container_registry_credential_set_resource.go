@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-07-01/credentialsets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/registries"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,6 +23,9 @@ import (
 )
 
 var _ sdk.Resource = ContainerRegistryCredentialSetResource{}
+var _ sdk.ResourceWithIdentity = ContainerRegistryCredentialSetResource{}
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name container_registry_credential_set -service-package-name containers -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,registry_name:id"
 
 type ContainerRegistryCredentialSetResource struct{}
 
@@ -227,6 +231,10 @@ func (ContainerRegistryCredentialSetResource) Read() sdk.ResourceFunc {
 					config.AuthenticationCredential = flattenAuthCredentials(props.AuthCredentials)
 				}
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&config)
 		},
 	}
@@ -254,6 +262,10 @@ func (ContainerRegistryCredentialSetResource) IDValidationFunc() pluginsdk.Schem
 	return credentialsets.ValidateCredentialSetID
 }
 
+func (ContainerRegistryCredentialSetResource) Identity() resourceids.ResourceId {
+	return &credentialsets.CredentialSetId{}
+}
+
 func expandAuthCredentials(input []AuthenticationCredential) *[]credentialsets.AuthCredential {
 	output := make([]credentialsets.AuthCredential, 0)
 	if len(input) == 0 {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2019-06-01-preview/tasks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/registries"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,11 +25,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name container_registry_task -service-package-name containers -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,registry_name:id" -test-name systemTask
+
 type ContainerRegistryTaskResource struct{}
 
 var (
 	_ sdk.ResourceWithUpdate        = ContainerRegistryTaskResource{}
 	_ sdk.ResourceWithCustomizeDiff = ContainerRegistryTaskResource{}
+	_ sdk.ResourceWithIdentity      = ContainerRegistryTaskResource{}
 )
 
 type AgentConfig struct {
@@ -661,6 +665,10 @@ func (r ContainerRegistryTaskResource) IDValidationFunc() pluginsdk.SchemaValida
 	return tasks.ValidateTaskID
 }
 
+func (r ContainerRegistryTaskResource) Identity() resourceids.ResourceId {
+	return &tasks.TaskId{}
+}
+
 func (r ContainerRegistryTaskResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -14,12 +14,15 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kubernetesconfiguration/2024-11-01/extensions"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name kubernetes_cluster_extension -service-package-name containers -properties "name,scope:cluster_id"
+
 type KubernetesClusterExtensionModel struct {
 	Name                           string            `tfschema:"name"`
 	ClusterID                      string            `tfschema:"cluster_id"`
@@ -46,6 +49,8 @@ type KubernetesClusterExtensionResource struct{}
 
 var _ sdk.ResourceWithUpdate = KubernetesClusterExtensionResource{}
 
+var _ sdk.ResourceWithIdentity = KubernetesClusterExtensionResource{}
+
 func (r KubernetesClusterExtensionResource) ResourceType() string {
 	return "azurerm_kubernetes_cluster_extension"
 }
@@ -78,6 +83,10 @@ func (r KubernetesClusterExtensionResource) IDValidationFunc() pluginsdk.SchemaV
 	}
 }
 
+func (r KubernetesClusterExtensionResource) Identity() resourceids.ResourceId {
+	return &extensions.ScopedExtensionId{}
+}
+
 func (r KubernetesClusterExtensionResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
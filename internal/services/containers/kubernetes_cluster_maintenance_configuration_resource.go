@@ -0,0 +1,588 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2025-07-01/maintenanceconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name kubernetes_cluster_maintenance_configuration -service-package-name containers -properties "maintenance_configuration_name:name" -compare-values "subscription_id:kubernetes_cluster_id,resource_group_name:kubernetes_cluster_id,managed_cluster_name:kubernetes_cluster_id"
+
+const (
+	kubernetesClusterMaintenanceConfigurationDefault          = "default"
+	kubernetesClusterMaintenanceConfigurationAutoUpgrade      = "aksManagedAutoUpgradeSchedule"
+	kubernetesClusterMaintenanceConfigurationNodeOSUpgrade    = "aksManagedNodeOSUpgradeSchedule"
+	kubernetesClusterMaintenanceConfigurationDateFormat       = "2006-01-02"
+	kubernetesClusterMaintenanceConfigurationDateSpanTimeZero = "T00:00:00Z"
+)
+
+type KubernetesClusterMaintenanceConfigurationModel struct {
+	Name                string                                              `tfschema:"name"`
+	KubernetesClusterId string                                              `tfschema:"kubernetes_cluster_id"`
+	Allowed             []KubernetesClusterMaintenanceConfigurationAllowed  `tfschema:"allowed"`
+	NotAllowed          []KubernetesClusterMaintenanceConfigurationTimeSpan `tfschema:"not_allowed"`
+	MaintenanceWindow   []KubernetesClusterMaintenanceConfigurationWindow   `tfschema:"maintenance_window"`
+}
+
+type KubernetesClusterMaintenanceConfigurationAllowed struct {
+	Day   string  `tfschema:"day"`
+	Hours []int64 `tfschema:"hours"`
+}
+
+type KubernetesClusterMaintenanceConfigurationTimeSpan struct {
+	Start string `tfschema:"start"`
+	End   string `tfschema:"end"`
+}
+
+type KubernetesClusterMaintenanceConfigurationWindow struct {
+	Frequency  string                                              `tfschema:"frequency"`
+	Interval   int64                                               `tfschema:"interval"`
+	DayOfWeek  string                                              `tfschema:"day_of_week"`
+	Duration   int64                                               `tfschema:"duration"`
+	WeekIndex  string                                              `tfschema:"week_index"`
+	DayOfMonth int64                                               `tfschema:"day_of_month"`
+	StartDate  string                                              `tfschema:"start_date"`
+	StartTime  string                                              `tfschema:"start_time"`
+	UtcOffset  string                                              `tfschema:"utc_offset"`
+	NotAllowed []KubernetesClusterMaintenanceConfigurationTimeSpan `tfschema:"not_allowed"`
+}
+
+type KubernetesClusterMaintenanceConfigurationResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = KubernetesClusterMaintenanceConfigurationResource{}
+	_ sdk.ResourceWithCustomizeDiff = KubernetesClusterMaintenanceConfigurationResource{}
+	_ sdk.ResourceWithIdentity      = KubernetesClusterMaintenanceConfigurationResource{}
+)
+
+func (r KubernetesClusterMaintenanceConfigurationResource) Identity() resourceids.ResourceId {
+	return &maintenanceconfigurations.MaintenanceConfigurationId{}
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) ResourceType() string {
+	return "azurerm_kubernetes_cluster_maintenance_configuration"
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) ModelObject() interface{} {
+	return &KubernetesClusterMaintenanceConfigurationModel{}
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return maintenanceconfigurations.ValidateMaintenanceConfigurationID
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				kubernetesClusterMaintenanceConfigurationDefault,
+				kubernetesClusterMaintenanceConfigurationAutoUpgrade,
+				kubernetesClusterMaintenanceConfigurationNodeOSUpgrade,
+			}, false),
+		},
+
+		"kubernetes_cluster_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateKubernetesClusterID,
+		},
+
+		"allowed": {
+			Type:          pluginsdk.TypeSet,
+			Optional:      true,
+			ConflictsWith: []string{"maintenance_window"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"day": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(maintenanceconfigurations.PossibleValuesForWeekDay(), false),
+					},
+
+					"hours": {
+						Type:     pluginsdk.TypeSet,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeInt,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+					},
+				},
+			},
+		},
+
+		"not_allowed": {
+			Type:          pluginsdk.TypeSet,
+			Optional:      true,
+			ConflictsWith: []string{"maintenance_window"},
+			Elem:          kubernetesClusterMaintenanceConfigurationTimeSpanSchema(),
+		},
+
+		"maintenance_window": {
+			Type:          pluginsdk.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"allowed", "not_allowed"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"frequency": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"Daily",
+							"Weekly",
+							"RelativeMonthly",
+							"AbsoluteMonthly",
+						}, false),
+					},
+
+					"interval": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"duration": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(4, 24),
+					},
+
+					"day_of_week": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(maintenanceconfigurations.PossibleValuesForWeekDay(), false),
+					},
+
+					"week_index": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(maintenanceconfigurations.PossibleValuesForType(), false),
+					},
+
+					"day_of_month": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(0, 31),
+					},
+
+					"start_date": {
+						Type:             pluginsdk.TypeString,
+						Optional:         true,
+						Computed:         true,
+						DiffSuppressFunc: suppress.RFC3339Time,
+						ValidateFunc:     validation.IsRFC3339Time,
+					},
+
+					"start_time": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+					},
+
+					"utc_offset": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+					},
+
+					"not_allowed": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						Elem:     kubernetesClusterMaintenanceConfigurationTimeSpanSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config KubernetesClusterMaintenanceConfigurationModel
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if config.Name == kubernetesClusterMaintenanceConfigurationDefault {
+				if len(config.MaintenanceWindow) > 0 {
+					return fmt.Errorf("`maintenance_window` cannot be specified when `name` is `%s`, use `allowed` and `not_allowed` instead", kubernetesClusterMaintenanceConfigurationDefault)
+				}
+				if len(config.Allowed) == 0 && len(config.NotAllowed) == 0 {
+					return fmt.Errorf("at least one of `allowed` or `not_allowed` must be specified when `name` is `%s`", kubernetesClusterMaintenanceConfigurationDefault)
+				}
+				return nil
+			}
+
+			if config.Name != "" && len(config.MaintenanceWindow) == 0 {
+				return fmt.Errorf("`maintenance_window` must be specified when `name` is `%s`", config.Name)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.MaintenanceConfigurationsClient
+
+			var model KubernetesClusterMaintenanceConfigurationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			kubernetesClusterId, err := commonids.ParseKubernetesClusterID(model.KubernetesClusterId)
+			if err != nil {
+				return err
+			}
+
+			id := maintenanceconfigurations.NewMaintenanceConfigurationID(kubernetesClusterId.SubscriptionId, kubernetesClusterId.ResourceGroupName, kubernetesClusterId.ManagedClusterName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := maintenanceconfigurations.MaintenanceConfiguration{
+				Properties: &maintenanceconfigurations.MaintenanceConfigurationProperties{
+					NotAllowedTime:    expandKubernetesClusterMaintenanceConfigurationNotAllowedTime(model.NotAllowed),
+					TimeInWeek:        expandKubernetesClusterMaintenanceConfigurationAllowed(model.Allowed),
+					MaintenanceWindow: expandKubernetesClusterMaintenanceConfigurationWindow(model.MaintenanceWindow, nil),
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.MaintenanceConfigurationsClient
+
+			id, err := maintenanceconfigurations.ParseMaintenanceConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := KubernetesClusterMaintenanceConfigurationModel{
+				Name:                id.MaintenanceConfigurationName,
+				KubernetesClusterId: commonids.NewKubernetesClusterID(id.SubscriptionId, id.ResourceGroupName, id.ManagedClusterName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Allowed = flattenKubernetesClusterMaintenanceConfigurationAllowed(props.TimeInWeek)
+					state.NotAllowed = flattenKubernetesClusterMaintenanceConfigurationNotAllowedTime(props.NotAllowedTime)
+					state.MaintenanceWindow = flattenKubernetesClusterMaintenanceConfigurationWindow(props.MaintenanceWindow)
+				}
+			}
+
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.MaintenanceConfigurationsClient
+
+			id, err := maintenanceconfigurations.ParseMaintenanceConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KubernetesClusterMaintenanceConfigurationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChange("allowed") {
+				payload.Properties.TimeInWeek = expandKubernetesClusterMaintenanceConfigurationAllowed(model.Allowed)
+			}
+
+			if metadata.ResourceData.HasChange("not_allowed") {
+				payload.Properties.NotAllowedTime = expandKubernetesClusterMaintenanceConfigurationNotAllowedTime(model.NotAllowed)
+			}
+
+			if metadata.ResourceData.HasChange("maintenance_window") {
+				payload.Properties.MaintenanceWindow = expandKubernetesClusterMaintenanceConfigurationWindow(model.MaintenanceWindow, existing.Model.Properties.MaintenanceWindow)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.MaintenanceConfigurationsClient
+
+			id, err := maintenanceconfigurations.ParseMaintenanceConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func kubernetesClusterMaintenanceConfigurationTimeSpanSchema() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"start": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppress.RFC3339Time,
+				ValidateFunc:     validation.IsRFC3339Time,
+			},
+
+			"end": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppress.RFC3339Time,
+				ValidateFunc:     validation.IsRFC3339Time,
+			},
+		},
+	}
+}
+
+func expandKubernetesClusterMaintenanceConfigurationAllowed(input []KubernetesClusterMaintenanceConfigurationAllowed) *[]maintenanceconfigurations.TimeInWeek {
+	if len(input) == 0 {
+		return nil
+	}
+
+	results := make([]maintenanceconfigurations.TimeInWeek, 0)
+	for _, item := range input {
+		results = append(results, maintenanceconfigurations.TimeInWeek{
+			Day:       pointer.To(maintenanceconfigurations.WeekDay(item.Day)),
+			HourSlots: pointer.To(item.Hours),
+		})
+	}
+	return &results
+}
+
+func expandKubernetesClusterMaintenanceConfigurationNotAllowedTime(input []KubernetesClusterMaintenanceConfigurationTimeSpan) *[]maintenanceconfigurations.TimeSpan {
+	if len(input) == 0 {
+		return nil
+	}
+
+	results := make([]maintenanceconfigurations.TimeSpan, 0)
+	for _, item := range input {
+		start, _ := time.Parse(time.RFC3339, item.Start)
+		end, _ := time.Parse(time.RFC3339, item.End)
+		results = append(results, maintenanceconfigurations.TimeSpan{
+			Start: pointer.To(start.Format(time.RFC3339)),
+			End:   pointer.To(end.Format(time.RFC3339)),
+		})
+	}
+	return &results
+}
+
+func expandKubernetesClusterMaintenanceConfigurationWindow(input []KubernetesClusterMaintenanceConfigurationWindow, existing *maintenanceconfigurations.MaintenanceWindow) *maintenanceconfigurations.MaintenanceWindow {
+	if len(input) == 0 {
+		return nil
+	}
+	window := input[0]
+
+	var schedule maintenanceconfigurations.Schedule
+	switch window.Frequency {
+	case "Daily":
+		schedule.Daily = &maintenanceconfigurations.DailySchedule{
+			IntervalDays: window.Interval,
+		}
+	case "Weekly":
+		schedule.Weekly = &maintenanceconfigurations.WeeklySchedule{
+			IntervalWeeks: window.Interval,
+			DayOfWeek:     maintenanceconfigurations.WeekDay(window.DayOfWeek),
+		}
+	case "AbsoluteMonthly":
+		schedule.AbsoluteMonthly = &maintenanceconfigurations.AbsoluteMonthlySchedule{
+			IntervalMonths: window.Interval,
+			DayOfMonth:     window.DayOfMonth,
+		}
+	case "RelativeMonthly":
+		schedule.RelativeMonthly = &maintenanceconfigurations.RelativeMonthlySchedule{
+			IntervalMonths: window.Interval,
+			DayOfWeek:      maintenanceconfigurations.WeekDay(window.DayOfWeek),
+			WeekIndex:      maintenanceconfigurations.Type(window.WeekIndex),
+		}
+	}
+
+	notAllowedDates := make([]maintenanceconfigurations.DateSpan, 0)
+	for _, item := range window.NotAllowed {
+		start, _ := time.Parse(time.RFC3339, item.Start)
+		end, _ := time.Parse(time.RFC3339, item.End)
+		notAllowedDates = append(notAllowedDates, maintenanceconfigurations.DateSpan{
+			Start: start.Format(kubernetesClusterMaintenanceConfigurationDateFormat),
+			End:   end.Format(kubernetesClusterMaintenanceConfigurationDateFormat),
+		})
+	}
+
+	output := &maintenanceconfigurations.MaintenanceWindow{
+		DurationHours:   window.Duration,
+		NotAllowedDates: &notAllowedDates,
+		Schedule:        schedule,
+		StartTime:       window.StartTime,
+		UtcOffset:       pointer.To(window.UtcOffset),
+	}
+
+	if window.StartDate != "" {
+		startDate, _ := time.Parse(time.RFC3339, window.StartDate)
+		startDateStr := startDate.Format(kubernetesClusterMaintenanceConfigurationDateFormat)
+		// `start_date` is Optional & Computed and the value defaulted by the API may be in the past by the time it's updated,
+		// so it's only sent when it differs from the existing value
+		if existing == nil || pointer.From(existing.StartDate) != startDateStr {
+			output.StartDate = pointer.To(startDateStr)
+		}
+	}
+
+	return output
+}
+
+func flattenKubernetesClusterMaintenanceConfigurationAllowed(input *[]maintenanceconfigurations.TimeInWeek) []KubernetesClusterMaintenanceConfigurationAllowed {
+	results := make([]KubernetesClusterMaintenanceConfigurationAllowed, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, KubernetesClusterMaintenanceConfigurationAllowed{
+			Day:   string(pointer.From(item.Day)),
+			Hours: pointer.From(item.HourSlots),
+		})
+	}
+	return results
+}
+
+func flattenKubernetesClusterMaintenanceConfigurationNotAllowedTime(input *[]maintenanceconfigurations.TimeSpan) []KubernetesClusterMaintenanceConfigurationTimeSpan {
+	results := make([]KubernetesClusterMaintenanceConfigurationTimeSpan, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, KubernetesClusterMaintenanceConfigurationTimeSpan{
+			Start: pointer.From(item.Start),
+			End:   pointer.From(item.End),
+		})
+	}
+	return results
+}
+
+func flattenKubernetesClusterMaintenanceConfigurationWindow(input *maintenanceconfigurations.MaintenanceWindow) []KubernetesClusterMaintenanceConfigurationWindow {
+	if input == nil {
+		return []KubernetesClusterMaintenanceConfigurationWindow{}
+	}
+
+	window := KubernetesClusterMaintenanceConfigurationWindow{
+		Duration:   input.DurationHours,
+		StartTime:  input.StartTime,
+		UtcOffset:  pointer.From(input.UtcOffset),
+		NotAllowed: make([]KubernetesClusterMaintenanceConfigurationTimeSpan, 0),
+	}
+
+	if input.StartDate != nil {
+		window.StartDate = *input.StartDate + kubernetesClusterMaintenanceConfigurationDateSpanTimeZero
+	}
+
+	if input.NotAllowedDates != nil {
+		for _, item := range *input.NotAllowedDates {
+			window.NotAllowed = append(window.NotAllowed, KubernetesClusterMaintenanceConfigurationTimeSpan{
+				Start: item.Start + kubernetesClusterMaintenanceConfigurationDateSpanTimeZero,
+				End:   item.End + kubernetesClusterMaintenanceConfigurationDateSpanTimeZero,
+			})
+		}
+	}
+
+	schedule := input.Schedule
+	switch {
+	case schedule.Daily != nil:
+		window.Frequency = "Daily"
+		window.Interval = schedule.Daily.IntervalDays
+	case schedule.Weekly != nil:
+		window.Frequency = "Weekly"
+		window.Interval = schedule.Weekly.IntervalWeeks
+		window.DayOfWeek = string(schedule.Weekly.DayOfWeek)
+	case schedule.AbsoluteMonthly != nil:
+		window.Frequency = "AbsoluteMonthly"
+		window.Interval = schedule.AbsoluteMonthly.IntervalMonths
+		window.DayOfMonth = schedule.AbsoluteMonthly.DayOfMonth
+	case schedule.RelativeMonthly != nil:
+		window.Frequency = "RelativeMonthly"
+		window.Interval = schedule.RelativeMonthly.IntervalMonths
+		window.DayOfWeek = string(schedule.RelativeMonthly.DayOfWeek)
+		window.WeekIndex = string(schedule.RelativeMonthly.WeekIndex)
+	}
+
+	return []KubernetesClusterMaintenanceConfigurationWindow{window}
+}
@@ -0,0 +1,275 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2025-07-01/maintenanceconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type KubernetesClusterMaintenanceConfigurationResource struct{}
+
+func TestAccKubernetesClusterMaintenanceConfiguration_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_maintenance_configuration", "test")
+	r := KubernetesClusterMaintenanceConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesClusterMaintenanceConfiguration_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_maintenance_configuration", "test")
+	r := KubernetesClusterMaintenanceConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccKubernetesClusterMaintenanceConfiguration_default(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_maintenance_configuration", "test")
+	r := KubernetesClusterMaintenanceConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.defaultConfiguration(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesClusterMaintenanceConfiguration_nodeOS(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_maintenance_configuration", "test")
+	r := KubernetesClusterMaintenanceConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.nodeOS(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesClusterMaintenanceConfiguration_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_maintenance_configuration", "test")
+	r := KubernetesClusterMaintenanceConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (KubernetesClusterMaintenanceConfigurationResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := maintenanceconfigurations.ParseMaintenanceConfigurationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Containers.MaintenanceConfigurationsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_maintenance_configuration" "test" {
+  name                  = "aksManagedAutoUpgradeSchedule"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+
+  maintenance_window {
+    frequency   = "Weekly"
+    interval    = 1
+    day_of_week = "Monday"
+    start_time  = "07:00"
+    utc_offset  = "+01:00"
+    duration    = 8
+  }
+}
+`, r.template(data))
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_maintenance_configuration" "import" {
+  name                  = azurerm_kubernetes_cluster_maintenance_configuration.test.name
+  kubernetes_cluster_id = azurerm_kubernetes_cluster_maintenance_configuration.test.kubernetes_cluster_id
+
+  maintenance_window {
+    frequency   = "Weekly"
+    interval    = 1
+    day_of_week = "Monday"
+    start_time  = "07:00"
+    utc_offset  = "+01:00"
+    duration    = 8
+  }
+}
+`, r.basic(data))
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_maintenance_configuration" "test" {
+  name                  = "aksManagedAutoUpgradeSchedule"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+
+  maintenance_window {
+    frequency   = "RelativeMonthly"
+    interval    = 2
+    day_of_week = "Tuesday"
+    week_index  = "First"
+    start_date  = "2035-01-01T00:00:00Z"
+    start_time  = "07:00"
+    utc_offset  = "+01:00"
+    duration    = 9
+
+    not_allowed {
+      start = "2035-12-20T00:00:00Z"
+      end   = "2036-01-05T00:00:00Z"
+    }
+  }
+}
+`, r.template(data))
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) defaultConfiguration(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_maintenance_configuration" "test" {
+  name                  = "default"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+
+  allowed {
+    day   = "Monday"
+    hours = [1, 2]
+  }
+
+  allowed {
+    day   = "Sunday"
+    hours = [0]
+  }
+
+  not_allowed {
+    start = "2035-12-24T00:00:00Z"
+    end   = "2035-12-26T00:00:00Z"
+  }
+}
+`, r.template(data))
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) nodeOS(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_maintenance_configuration" "test" {
+  name                  = "aksManagedNodeOSUpgradeSchedule"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+
+  maintenance_window {
+    frequency  = "Daily"
+    interval   = 1
+    start_time = "02:00"
+    utc_offset = "+00:00"
+    duration   = 4
+  }
+}
+`, r.template(data))
+}
+
+func (KubernetesClusterMaintenanceConfigurationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%[1]d"
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+    upgrade_settings {
+      max_surge = "10%%"
+    }
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  lifecycle {
+    ignore_changes = [maintenance_window, maintenance_window_auto_upgrade, maintenance_window_node_os]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
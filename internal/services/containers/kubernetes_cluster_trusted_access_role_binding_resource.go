@@ -10,16 +10,24 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2025-07-01/trustedaccess"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name kubernetes_cluster_trusted_access_role_binding -service-package-name containers -properties "name" -compare-values "subscription_id:id,resource_group_name:id,managed_cluster_name:id" -test-resource-type KubernetesClusterTrustedAccessRoleBindingTestResource
+
 var (
-	_ sdk.Resource           = KubernetesClusterTrustedAccessRoleBindingResource{}
-	_ sdk.ResourceWithUpdate = KubernetesClusterTrustedAccessRoleBindingResource{}
+	_ sdk.Resource             = KubernetesClusterTrustedAccessRoleBindingResource{}
+	_ sdk.ResourceWithUpdate   = KubernetesClusterTrustedAccessRoleBindingResource{}
+	_ sdk.ResourceWithIdentity = KubernetesClusterTrustedAccessRoleBindingResource{}
 )
 
+func (r KubernetesClusterTrustedAccessRoleBindingResource) Identity() resourceids.ResourceId {
+	return &trustedaccess.TrustedAccessRoleBindingId{}
+}
+
 type KubernetesClusterTrustedAccessRoleBindingResource struct{}
 
 func (r KubernetesClusterTrustedAccessRoleBindingResource) ModelObject() interface{} {
@@ -143,6 +151,10 @@ func (r KubernetesClusterTrustedAccessRoleBindingResource) Read() sdk.ResourceFu
 				r.mapTrustedAccessRoleBindingToKubernetesClusterTrustedAccessRoleBindingResourceSchema(*model, &schema)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -11,17 +11,25 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2024-04-01/fleets"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name kubernetes_fleet_manager -service-package-name containers -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type KubernetesFleetManagerTestResource
+
 var (
-	_ sdk.Resource           = KubernetesFleetManagerResource{}
-	_ sdk.ResourceWithUpdate = KubernetesFleetManagerResource{}
+	_ sdk.Resource             = KubernetesFleetManagerResource{}
+	_ sdk.ResourceWithUpdate   = KubernetesFleetManagerResource{}
+	_ sdk.ResourceWithIdentity = KubernetesFleetManagerResource{}
 )
 
+func (r KubernetesFleetManagerResource) Identity() resourceids.ResourceId {
+	return &fleets.FleetId{}
+}
+
 type KubernetesFleetManagerResource struct{}
 
 func (r KubernetesFleetManagerResource) ModelObject() interface{} {
@@ -147,6 +155,10 @@ func (r KubernetesFleetManagerResource) Read() sdk.ResourceFunc {
 				r.mapFleetToKubernetesFleetManagerResourceSchema(*model, &schema)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
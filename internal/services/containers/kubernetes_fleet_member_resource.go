@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2024-04-01/fleetmembers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.ResourceWithIdentity = KubernetesFleetMemberResource{}
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name kubernetes_fleet_member -service-package-name containers -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,fleet_name:id" -test-resource-type KubernetesFleetMemberTestResource
+
+// Identity is defined here rather than in the generated resource, the Resource Identity data is then set from the
+// ID of the resource once the generated Read function completes
+func (r KubernetesFleetMemberResource) Identity() resourceids.ResourceId {
+	return &fleetmembers.MemberId{}
+}
@@ -12,17 +12,25 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2024-04-01/updateruns"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name kubernetes_fleet_update_run -service-package-name containers -properties "name" -compare-values "subscription_id:id,resource_group_name:id,fleet_name:id" -test-resource-type KubernetesFleetUpdateRunTestResource
+
 var (
-	_ sdk.Resource           = KubernetesFleetUpdateRunResource{}
-	_ sdk.ResourceWithUpdate = KubernetesFleetUpdateRunResource{}
+	_ sdk.Resource             = KubernetesFleetUpdateRunResource{}
+	_ sdk.ResourceWithUpdate   = KubernetesFleetUpdateRunResource{}
+	_ sdk.ResourceWithIdentity = KubernetesFleetUpdateRunResource{}
 )
 
+func (r KubernetesFleetUpdateRunResource) Identity() resourceids.ResourceId {
+	return &updateruns.UpdateRunId{}
+}
+
 type KubernetesFleetUpdateRunResource struct{}
 
 func (r KubernetesFleetUpdateRunResource) ModelObject() interface{} {
@@ -299,6 +307,10 @@ func (r KubernetesFleetUpdateRunResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -12,17 +12,25 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2024-04-01/fleetupdatestrategies"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name kubernetes_fleet_update_strategy -service-package-name containers -properties "name" -compare-values "subscription_id:id,resource_group_name:id,fleet_name:id" -test-resource-type KubernetesFleetUpdateStrategyTestResource
+
 var (
-	_ sdk.Resource           = KubernetesFleetUpdateStrategyResource{}
-	_ sdk.ResourceWithUpdate = KubernetesFleetUpdateStrategyResource{}
+	_ sdk.Resource             = KubernetesFleetUpdateStrategyResource{}
+	_ sdk.ResourceWithUpdate   = KubernetesFleetUpdateStrategyResource{}
+	_ sdk.ResourceWithIdentity = KubernetesFleetUpdateStrategyResource{}
 )
 
+func (r KubernetesFleetUpdateStrategyResource) Identity() resourceids.ResourceId {
+	return &fleetupdatestrategies.UpdateStrategyId{}
+}
+
 type KubernetesFleetUpdateStrategyResource struct{}
 
 func (r KubernetesFleetUpdateStrategyResource) ModelObject() interface{} {
@@ -215,6 +223,10 @@ func (r KubernetesFleetUpdateStrategyResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kubernetesconfiguration/2024-11-01/fluxconfiguration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
@@ -23,6 +24,8 @@ import (
 	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/containers"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name kubernetes_flux_configuration -service-package-name containers -properties "name,scope:cluster_id"
+
 const (
 	FluxGitBranch       string = "branch"
 	FluxGitCommit       string = "commit"
@@ -125,6 +128,8 @@ type KubernetesFluxConfigurationResource struct{}
 
 var _ sdk.ResourceWithUpdate = KubernetesFluxConfigurationResource{}
 
+var _ sdk.ResourceWithIdentity = KubernetesFluxConfigurationResource{}
+
 func (r KubernetesFluxConfigurationResource) ResourceType() string {
 	return "azurerm_kubernetes_flux_configuration"
 }
@@ -157,6 +162,10 @@ func (r KubernetesFluxConfigurationResource) IDValidationFunc() pluginsdk.Schema
 	}
 }
 
+func (r KubernetesFluxConfigurationResource) Identity() resourceids.ResourceId {
+	return &fluxconfiguration.ScopedFluxConfigurationId{}
+}
+
 func (r KubernetesFluxConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
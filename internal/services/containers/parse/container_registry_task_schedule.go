@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &ContainerRegistryTaskScheduleId{}
+
 type ContainerRegistryTaskScheduleId struct {
 	SubscriptionId string
 	ResourceGroup  string
@@ -47,6 +49,51 @@ func (id ContainerRegistryTaskScheduleId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.RegistryName, id.TaskName, id.ScheduleName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ContainerRegistryTaskSchedule ID
+func (id ContainerRegistryTaskScheduleId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "group1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftContainerRegistry", "Microsoft.ContainerRegistry", "Microsoft.ContainerRegistry"),
+		resourceids.StaticSegment("staticRegistries", "registries", "registries"),
+		resourceids.UserSpecifiedSegment("registryName", "registry1"),
+		resourceids.StaticSegment("staticTasks", "tasks", "tasks"),
+		resourceids.UserSpecifiedSegment("taskName", "task1"),
+		resourceids.StaticSegment("staticSchedule", "schedule", "schedule"),
+		resourceids.UserSpecifiedSegment("scheduleName", "schedule1"),
+	}
+}
+
+// FromParseResult populates the fields of this ContainerRegistryTaskSchedule ID from the ParseResult, for use with resourceids.Parser
+func (id *ContainerRegistryTaskScheduleId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.RegistryName, ok = input.Parsed["registryName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "registryName", input)
+	}
+
+	if id.TaskName, ok = input.Parsed["taskName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "taskName", input)
+	}
+
+	if id.ScheduleName, ok = input.Parsed["scheduleName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "scheduleName", input)
+	}
+
+	return nil
+}
+
 // ContainerRegistryTaskScheduleID parses a ContainerRegistryTaskSchedule ID into an ContainerRegistryTaskScheduleId struct
 func ContainerRegistryTaskScheduleID(input string) (*ContainerRegistryTaskScheduleId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -21,6 +21,24 @@ func TestContainerRegistryTaskScheduleIDFormatter(t *testing.T) {
 	}
 }
 
+func TestContainerRegistryTaskScheduleIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&ContainerRegistryTaskScheduleId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ContainerRegistry/registries/registry1/tasks/task1/schedule/schedule1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual ContainerRegistryTaskScheduleId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ContainerRegistry/registries/registry1/tasks/task1/schedule/schedule1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestContainerRegistryTaskScheduleID(t *testing.T) {
 	testData := []struct {
 		Input    string
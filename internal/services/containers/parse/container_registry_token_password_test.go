@@ -21,6 +21,24 @@ func TestContainerRegistryTokenPasswordIDFormatter(t *testing.T) {
 	}
 }
 
+func TestContainerRegistryTokenPasswordIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&ContainerRegistryTokenPasswordId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerRegistry/registries/registry1/tokens/token1/passwords/password", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual ContainerRegistryTokenPasswordId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerRegistry/registries/registry1/tokens/token1/passwords/password"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestContainerRegistryTokenPasswordID(t *testing.T) {
 	testData := []struct {
 		Input    string
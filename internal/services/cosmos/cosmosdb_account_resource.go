@@ -30,7 +30,6 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/migration"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
 	keyVaultSuppress "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/suppress"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
@@ -133,6 +132,8 @@ func suppressConsistencyPolicyStalenessConfiguration(_, _, _ string, d *pluginsd
 	return consistencyPolicy["consistency_level"].(string) != string(cosmosdb.DefaultConsistencyLevelBoundedStaleness)
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name cosmosdb_account -service-package-name cosmos -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name failover_boundedStaleness -test-resource-type CosmosDBAccountResource
+
 func resourceCosmosDbAccount() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceCosmosDbAccountCreate,
@@ -182,10 +183,11 @@ func resourceCosmosDbAccount() *pluginsdk.Resource {
 			}),
 		),
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.DatabaseAccountID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&cosmosdb.DatabaseAccountId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&cosmosdb.DatabaseAccountId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(180 * time.Minute),
@@ -1569,7 +1571,11 @@ func resourceCosmosDbAccountRead(d *pluginsdk.ResourceData, meta interface{}) er
 		}
 	}
 
-	return tags.FlattenAndSet(d, existing.Model.Tags)
+	if err := tags.FlattenAndSet(d, existing.Model.Tags); err != nil {
+		return err
+	}
+
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceCosmosDbAccountDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -11,6 +11,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/common"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name cosmosdb_cassandra_keyspace -service-package-name cosmos -properties "resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "database_account_name:id,name:id" -test-resource-type CosmosDbCassandraKeyspaceResource
+
 func resourceCosmosDbCassandraKeyspace() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceCosmosDbCassandraKeyspaceCreate,
@@ -29,10 +32,11 @@ func resourceCosmosDbCassandraKeyspace() *pluginsdk.Resource {
 		Update: resourceCosmosDbCassandraKeyspaceUpdate,
 		Delete: resourceCosmosDbCassandraKeyspaceDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.CassandraKeyspaceID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.CassandraKeyspaceId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.CassandraKeyspaceId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -231,7 +235,7 @@ func resourceCosmosDbCassandraKeyspaceRead(d *pluginsdk.ResourceData, meta inter
 			common.SetResourceDataThroughputFromResponseLegacy(throughputResp, d)
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceCosmosDbCassandraKeyspaceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
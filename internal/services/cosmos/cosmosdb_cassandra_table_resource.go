@@ -11,6 +11,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/common"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name cosmosdb_cassandra_table -service-package-name cosmos -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,database_account_name:id,cassandra_keyspace_name:id,name:id" -test-resource-type CosmosDBCassandraTableResource
+
 func resourceCosmosDbCassandraTable() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceCosmosDbCassandraTableCreate,
@@ -29,10 +32,11 @@ func resourceCosmosDbCassandraTable() *pluginsdk.Resource {
 		Update: resourceCosmosDbCassandraTableUpdate,
 		Delete: resourceCosmosDbCassandraTableDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.CassandraTableID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.CassandraTableId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.CassandraTableId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -279,7 +283,7 @@ func resourceCosmosDbCassandraTableRead(d *pluginsdk.ResourceData, meta interfac
 			common.SetResourceDataThroughputFromResponseLegacy(throughputResp, d)
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceCosmosDbCassandraTableDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -11,16 +11,18 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-08-15/cosmosdb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/migration"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name cosmosdb_gremlin_database -service-package-name cosmos -properties "resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "database_account_name:id,name:id" -test-resource-type CosmosGremlinDatabaseResource
+
 func resourceCosmosGremlinDatabase() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceCosmosGremlinDatabaseCreate,
@@ -28,10 +30,11 @@ func resourceCosmosGremlinDatabase() *pluginsdk.Resource {
 		Read:   resourceCosmosGremlinDatabaseRead,
 		Delete: resourceCosmosGremlinDatabaseDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.GremlinDatabaseID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&cosmosdb.GremlinDatabaseId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&cosmosdb.GremlinDatabaseId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -223,7 +226,7 @@ func resourceCosmosGremlinDatabaseRead(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceCosmosGremlinDatabaseDelete(d *pluginsdk.ResourceData, meta interface{}) error {
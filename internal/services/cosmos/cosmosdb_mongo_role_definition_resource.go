@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2022-11-15/mongorbacs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name cosmosdb_mongo_role_definition -service-package-name cosmos -compare-values "subscription_id:id,resource_group_name:id,database_account_name:id,mongo_role_definition_id:id" -test-resource-type CosmosMongoRoleDefinitionResource
+
 type CosmosDbMongoRoleDefinitionResourceModel struct {
 	CosmosMongoDatabaseId string      `tfschema:"cosmos_mongo_database_id"`
 	RoleName              string      `tfschema:"role_name"`
@@ -39,6 +42,11 @@ type Resource struct {
 type CosmosDbMongoRoleDefinitionResource struct{}
 
 var _ sdk.ResourceWithUpdate = CosmosDbMongoRoleDefinitionResource{}
+var _ sdk.ResourceWithIdentity = CosmosDbMongoRoleDefinitionResource{}
+
+func (r CosmosDbMongoRoleDefinitionResource) Identity() resourceids.ResourceId {
+	return &mongorbacs.MongodbRoleDefinitionId{}
+}
 
 func (r CosmosDbMongoRoleDefinitionResource) ResourceType() string {
 	return "azurerm_cosmosdb_mongo_role_definition"
@@ -259,6 +267,10 @@ func (r CosmosDbMongoRoleDefinitionResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2022-11-15/mongorbacs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name cosmosdb_mongo_user_definition -service-package-name cosmos -compare-values "subscription_id:id,resource_group_name:id,database_account_name:id,mongo_user_definition_id:id" -test-resource-type CosmosMongoUserDefinitionResource
+
 type CosmosDbMongoUserDefinitionResourceModel struct {
 	CosmosMongoDatabaseId string   `tfschema:"cosmos_mongo_database_id"`
 	Username              string   `tfschema:"username"`
@@ -29,6 +32,11 @@ type CosmosDbMongoUserDefinitionResourceModel struct {
 type CosmosDbMongoUserDefinitionResource struct{}
 
 var _ sdk.ResourceWithUpdate = CosmosDbMongoUserDefinitionResource{}
+var _ sdk.ResourceWithIdentity = CosmosDbMongoUserDefinitionResource{}
+
+func (r CosmosDbMongoUserDefinitionResource) Identity() resourceids.ResourceId {
+	return &mongorbacs.MongodbUserDefinitionId{}
+}
 
 func (r CosmosDbMongoUserDefinitionResource) ResourceType() string {
 	return "azurerm_cosmosdb_mongo_user_definition"
@@ -218,6 +226,10 @@ func (r CosmosDbMongoUserDefinitionResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
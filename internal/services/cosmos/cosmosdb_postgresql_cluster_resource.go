@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresqlhsc/2022-11-08/clusters"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name cosmosdb_postgresql_cluster -service-package-name cosmos -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type CosmosDbPostgreSQLClusterResource
+
 var CosmosDbPostgreSQLClusterResourceName = "azurerm_cosmosdb_postgresql_cluster"
 
 type CosmosDbPostgreSQLClusterModel struct {
@@ -63,6 +66,11 @@ type MaintenanceWindow struct {
 type CosmosDbPostgreSQLClusterResource struct{}
 
 var _ sdk.ResourceWithUpdate = CosmosDbPostgreSQLClusterResource{}
+var _ sdk.ResourceWithIdentity = CosmosDbPostgreSQLClusterResource{}
+
+func (r CosmosDbPostgreSQLClusterResource) Identity() resourceids.ResourceId {
+	return &clusters.ServerGroupsv2Id{}
+}
 
 func (r CosmosDbPostgreSQLClusterResource) ResourceType() string {
 	return CosmosDbPostgreSQLClusterResourceName
@@ -602,6 +610,10 @@ func (r CosmosDbPostgreSQLClusterResource) Read() sdk.ResourceFunc {
 				state.Tags = *model.Tags
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
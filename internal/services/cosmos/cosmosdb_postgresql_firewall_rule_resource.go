@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresqlhsc/2022-11-08/firewallrules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
@@ -23,10 +24,13 @@ type CosmosDbPostgreSQLFirewallRuleResourceModel struct {
 	StartIPAddress string `tfschema:"start_ip_address"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name cosmosdb_postgresql_firewall_rule -service-package-name cosmos -properties "name" -compare-values "subscription_id:cluster_id,resource_group_name:cluster_id,server_groupsv_2_name:cluster_id" -test-params "10.0.17.62,10.0.17.64" -test-resource-type CosmosDbPostgreSQLFirewallRuleResource
 type CosmosDbPostgreSQLFirewallRuleResource struct{}
 
 var _ sdk.ResourceWithUpdate = CosmosDbPostgreSQLFirewallRuleResource{}
 
+var _ sdk.ResourceWithIdentity = CosmosDbPostgreSQLFirewallRuleResource{}
+
 func (r CosmosDbPostgreSQLFirewallRuleResource) ResourceType() string {
 	return "azurerm_cosmosdb_postgresql_firewall_rule"
 }
@@ -39,6 +43,10 @@ func (r CosmosDbPostgreSQLFirewallRuleResource) IDValidationFunc() pluginsdk.Sch
 	return firewallrules.ValidateFirewallRuleID
 }
 
+func (r CosmosDbPostgreSQLFirewallRuleResource) Identity() resourceids.ResourceId {
+	return &firewallrules.FirewallRuleId{}
+}
+
 func (r CosmosDbPostgreSQLFirewallRuleResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
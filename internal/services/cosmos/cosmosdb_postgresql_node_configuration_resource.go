@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresqlhsc/2022-11-08/configurations"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -22,10 +23,13 @@ type CosmosDbPostgreSQLNodeConfigurationModel struct {
 	Value     string `tfschema:"value"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name cosmosdb_postgresql_node_configuration -service-package-name cosmos -properties "name" -compare-values "subscription_id:cluster_id,resource_group_name:cluster_id,server_groupsv_2_name:cluster_id" -test-params "array_nulls,on" -test-resource-type CosmosDbPostgreSQLNodeConfigurationResource
 type CosmosDbPostgreSQLNodeConfigurationResource struct{}
 
 var _ sdk.ResourceWithUpdate = CosmosDbPostgreSQLNodeConfigurationResource{}
 
+var _ sdk.ResourceWithIdentity = CosmosDbPostgreSQLNodeConfigurationResource{}
+
 func (r CosmosDbPostgreSQLNodeConfigurationResource) ResourceType() string {
 	return "azurerm_cosmosdb_postgresql_node_configuration"
 }
@@ -38,6 +42,10 @@ func (r CosmosDbPostgreSQLNodeConfigurationResource) IDValidationFunc() pluginsd
 	return configurations.ValidateNodeConfigurationID
 }
 
+func (r CosmosDbPostgreSQLNodeConfigurationResource) Identity() resourceids.ResourceId {
+	return &configurations.NodeConfigurationId{}
+}
+
 func (r CosmosDbPostgreSQLNodeConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresqlhsc/2022-11-08/roles"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
@@ -21,8 +22,11 @@ type CosmosDbPostgreSQLRoleResourceModel struct {
 	Password  string `tfschema:"password"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name cosmosdb_postgresql_role -service-package-name cosmos -properties "name" -compare-values "subscription_id:id,resource_group_name:id,server_groupsv_2_name:id" -test-resource-type CosmosDbPostgreSQLRoleResource
 type CosmosDbPostgreSQLRoleResource struct{}
 
+var _ sdk.ResourceWithIdentity = CosmosDbPostgreSQLRoleResource{}
+
 var _ sdk.Resource = CosmosDbPostgreSQLClusterResource{}
 
 func (r CosmosDbPostgreSQLRoleResource) ResourceType() string {
@@ -37,6 +41,10 @@ func (r CosmosDbPostgreSQLRoleResource) IDValidationFunc() pluginsdk.SchemaValid
 	return roles.ValidateRoleID
 }
 
+func (r CosmosDbPostgreSQLRoleResource) Identity() resourceids.ResourceId {
+	return &roles.RoleId{}
+}
+
 func (r CosmosDbPostgreSQLRoleResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2022-05-15/cosmosdb"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2022-05-15/sqldedicatedgateway"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -16,6 +17,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name cosmosdb_sql_dedicated_gateway -service-package-name cosmos -compare-values "subscription_id:id,resource_group_name:id,database_account_name:id,name:id" -test-resource-type CosmosDbSqlDedicatedGatewayResource
+
 type CosmosDbSqlDedicatedGatewayModel struct {
 	CosmosDbAccountId string                          `tfschema:"cosmosdb_account_id"`
 	InstanceCount     int64                           `tfschema:"instance_count"`
@@ -25,6 +28,11 @@ type CosmosDbSqlDedicatedGatewayModel struct {
 type CosmosDbSqlDedicatedGatewayResource struct{}
 
 var _ sdk.ResourceWithUpdate = CosmosDbSqlDedicatedGatewayResource{}
+var _ sdk.ResourceWithIdentity = CosmosDbSqlDedicatedGatewayResource{}
+
+func (r CosmosDbSqlDedicatedGatewayResource) Identity() resourceids.ResourceId {
+	return &sqldedicatedgateway.ServiceId{}
+}
 
 func (r CosmosDbSqlDedicatedGatewayResource) ResourceType() string {
 	return "azurerm_cosmosdb_sql_dedicated_gateway"
@@ -201,6 +209,10 @@ func (r CosmosDbSqlDedicatedGatewayResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
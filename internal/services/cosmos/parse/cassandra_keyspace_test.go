@@ -21,6 +21,24 @@ func TestCassandraKeyspaceIDFormatter(t *testing.T) {
 	}
 }
 
+func TestCassandraKeyspaceIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&CassandraKeyspaceId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/cassandraKeyspaces/keyspace1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual CassandraKeyspaceId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/cassandraKeyspaces/keyspace1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestCassandraKeyspaceID(t *testing.T) {
 	testData := []struct {
 		Input    string
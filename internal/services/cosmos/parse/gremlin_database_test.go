@@ -21,6 +21,24 @@ func TestGremlinDatabaseIDFormatter(t *testing.T) {
 	}
 }
 
+func TestGremlinDatabaseIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&GremlinDatabaseId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/gremlinDatabases/database1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual GremlinDatabaseId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/gremlinDatabases/database1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestGremlinDatabaseID(t *testing.T) {
 	testData := []struct {
 		Input    string
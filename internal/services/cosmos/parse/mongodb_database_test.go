@@ -21,6 +21,24 @@ func TestMongodbDatabaseIDFormatter(t *testing.T) {
 	}
 }
 
+func TestMongodbDatabaseIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&MongodbDatabaseId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/mongodbDatabases/db1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual MongodbDatabaseId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/mongodbDatabases/db1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestMongodbDatabaseID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &RestorableDatabaseAccountId{}
+
 type RestorableDatabaseAccountId struct {
 	SubscriptionId string
 	LocationName   string
@@ -41,6 +43,39 @@ func (id RestorableDatabaseAccountId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.LocationName, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this RestorableDatabaseAccount ID
+func (id RestorableDatabaseAccountId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDocumentDB", "Microsoft.DocumentDB", "Microsoft.DocumentDB"),
+		resourceids.StaticSegment("staticLocations", "locations", "locations"),
+		resourceids.UserSpecifiedSegment("locationName", "location1"),
+		resourceids.StaticSegment("staticRestorableDatabaseAccounts", "restorableDatabaseAccounts", "restorableDatabaseAccounts"),
+		resourceids.UserSpecifiedSegment("name", "account1"),
+	}
+}
+
+// FromParseResult populates the fields of this RestorableDatabaseAccount ID from the ParseResult, for use with resourceids.Parser
+func (id *RestorableDatabaseAccountId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.LocationName, ok = input.Parsed["locationName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "locationName", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
+
 // RestorableDatabaseAccountID parses a RestorableDatabaseAccount ID into an RestorableDatabaseAccountId struct
 func RestorableDatabaseAccountID(input string) (*RestorableDatabaseAccountId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -21,6 +21,24 @@ func TestRestorableDatabaseAccountIDFormatter(t *testing.T) {
 	}
 }
 
+func TestRestorableDatabaseAccountIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&RestorableDatabaseAccountId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.DocumentDB/locations/location1/restorableDatabaseAccounts/account1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual RestorableDatabaseAccountId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.DocumentDB/locations/location1/restorableDatabaseAccounts/account1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestRestorableDatabaseAccountID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,24 @@ func TestSqlRoleAssignmentIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSqlRoleAssignmentIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&SqlRoleAssignmentId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.DocumentDB/databaseAccounts/account1/sqlRoleAssignments/roleAssignment1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual SqlRoleAssignmentId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.DocumentDB/databaseAccounts/account1/sqlRoleAssignments/roleAssignment1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestSqlRoleAssignmentID(t *testing.T) {
 	testData := []struct {
 		Input    string
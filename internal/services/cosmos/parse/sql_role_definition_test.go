@@ -21,6 +21,24 @@ func TestSqlRoleDefinitionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSqlRoleDefinitionIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&SqlRoleDefinitionId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.DocumentDB/databaseAccounts/account1/sqlRoleDefinitions/def1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual SqlRoleDefinitionId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.DocumentDB/databaseAccounts/account1/sqlRoleDefinitions/def1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestSqlRoleDefinitionID(t *testing.T) {
 	testData := []struct {
 		Input    string
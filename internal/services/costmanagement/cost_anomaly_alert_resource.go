@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/costmanagement/2023-08-01/scheduledactions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/costmanagement/2023-08-01/views"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -21,6 +22,9 @@ import (
 )
 
 var _ sdk.Resource = AnomalyAlertResource{}
+var _ sdk.ResourceWithIdentity = AnomalyAlertResource{}
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name cost_anomaly_alert -service-package-name costmanagement -compare-values "scope:id,name:id" -test-name basicConfig -test-resource-type AnomalyAlertResource
 
 type AnomalyAlertResource struct{}
 
@@ -286,3 +290,7 @@ func (AnomalyAlertResource) Delete() sdk.ResourceFunc {
 func (AnomalyAlertResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return scheduledactions.ValidateScopedScheduledActionID
 }
+
+func (AnomalyAlertResource) Identity() resourceids.ResourceId {
+	return &scheduledactions.ScopedScheduledActionId{}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/costmanagement/2023-08-01/scheduledactions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/costmanagement/2023-08-01/views"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -19,9 +20,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name cost_management_scheduled_action -service-package-name costmanagement -compare-values "scope:id,name:id" -test-name daily -test-resource-type CostManagementScheduledAction
+
 type CostManagementScheduledActionResource struct{}
 
 var _ sdk.Resource = CostManagementScheduledActionResource{}
+var _ sdk.ResourceWithIdentity = CostManagementScheduledActionResource{}
 
 func (r CostManagementScheduledActionResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -142,6 +146,10 @@ func (r CostManagementScheduledActionResource) IDValidationFunc() pluginsdk.Sche
 	return scheduledactions.ValidateScopedScheduledActionID
 }
 
+func (r CostManagementScheduledActionResource) Identity() resourceids.ResourceId {
+	return &scheduledactions.ScopedScheduledActionId{}
+}
+
 func (r CostManagementScheduledActionResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
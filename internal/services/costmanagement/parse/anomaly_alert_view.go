@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &AnomalyAlertViewId{}
+
 type AnomalyAlertViewId struct {
 	SubscriptionId string
 	ViewName       string
@@ -38,6 +40,33 @@ func (id AnomalyAlertViewId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ViewName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this AnomalyAlertView ID
+func (id AnomalyAlertViewId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCostManagement", "Microsoft.CostManagement", "Microsoft.CostManagement"),
+		resourceids.StaticSegment("staticViews", "views", "views"),
+		resourceids.UserSpecifiedSegment("viewName", "ms:DailyAnomalyByResourceGroup"),
+	}
+}
+
+// FromParseResult populates the fields of this AnomalyAlertView ID from the ParseResult, for use with resourceids.Parser
+func (id *AnomalyAlertViewId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ViewName, ok = input.Parsed["viewName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "viewName", input)
+	}
+
+	return nil
+}
+
 // AnomalyAlertViewID parses a AnomalyAlertView ID into an AnomalyAlertViewId struct
 func AnomalyAlertViewID(input string) (*AnomalyAlertViewId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
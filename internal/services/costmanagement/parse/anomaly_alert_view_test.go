@@ -21,6 +21,24 @@ func TestAnomalyAlertViewIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAnomalyAlertViewIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&AnomalyAlertViewId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.CostManagement/views/ms:DailyAnomalyByResourceGroup", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual AnomalyAlertViewId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.CostManagement/views/ms:DailyAnomalyByResourceGroup"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestAnomalyAlertViewID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &ResourceGroupCostManagementExportId{}
+
 type ResourceGroupCostManagementExportId struct {
 	SubscriptionId string
 	ResourceGroup  string
@@ -41,6 +43,39 @@ func (id ResourceGroupCostManagementExportId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ExportName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ResourceGroupCostManagementExport ID
+func (id ResourceGroupCostManagementExportId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCostManagement", "Microsoft.CostManagement", "Microsoft.CostManagement"),
+		resourceids.StaticSegment("staticExports", "exports", "exports"),
+		resourceids.UserSpecifiedSegment("exportName", "export1"),
+	}
+}
+
+// FromParseResult populates the fields of this ResourceGroupCostManagementExport ID from the ParseResult, for use with resourceids.Parser
+func (id *ResourceGroupCostManagementExportId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ExportName, ok = input.Parsed["exportName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "exportName", input)
+	}
+
+	return nil
+}
+
 // ResourceGroupCostManagementExportID parses a ResourceGroupCostManagementExport ID into an ResourceGroupCostManagementExportId struct
 func ResourceGroupCostManagementExportID(input string) (*ResourceGroupCostManagementExportId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -21,6 +21,24 @@ func TestResourceGroupCostManagementViewIDFormatter(t *testing.T) {
 	}
 }
 
+func TestResourceGroupCostManagementViewIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&ResourceGroupCostManagementViewId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.CostManagement/views/view1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual ResourceGroupCostManagementViewId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.CostManagement/views/view1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestResourceGroupCostManagementViewID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &SubscriptionCostManagementExportId{}
+
 type SubscriptionCostManagementExportId struct {
 	SubscriptionId string
 	ExportName     string
@@ -38,6 +40,33 @@ func (id SubscriptionCostManagementExportId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ExportName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SubscriptionCostManagementExport ID
+func (id SubscriptionCostManagementExportId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCostManagement", "Microsoft.CostManagement", "Microsoft.CostManagement"),
+		resourceids.StaticSegment("staticExports", "exports", "exports"),
+		resourceids.UserSpecifiedSegment("exportName", "export1"),
+	}
+}
+
+// FromParseResult populates the fields of this SubscriptionCostManagementExport ID from the ParseResult, for use with resourceids.Parser
+func (id *SubscriptionCostManagementExportId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ExportName, ok = input.Parsed["exportName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "exportName", input)
+	}
+
+	return nil
+}
+
 // SubscriptionCostManagementExportID parses a SubscriptionCostManagementExport ID into an SubscriptionCostManagementExportId struct
 func SubscriptionCostManagementExportID(input string) (*SubscriptionCostManagementExportId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -21,6 +21,24 @@ func TestSubscriptionCostManagementExportIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSubscriptionCostManagementExportIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&SubscriptionCostManagementExportId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.CostManagement/exports/export1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual SubscriptionCostManagementExportId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.CostManagement/exports/export1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestSubscriptionCostManagementExportID(t *testing.T) {
 	testData := []struct {
 		Input    string
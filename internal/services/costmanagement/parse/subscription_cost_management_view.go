@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &SubscriptionCostManagementViewId{}
+
 type SubscriptionCostManagementViewId struct {
 	SubscriptionId string
 	ViewName       string
@@ -38,6 +40,33 @@ func (id SubscriptionCostManagementViewId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ViewName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SubscriptionCostManagementView ID
+func (id SubscriptionCostManagementViewId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCostManagement", "Microsoft.CostManagement", "Microsoft.CostManagement"),
+		resourceids.StaticSegment("staticViews", "views", "views"),
+		resourceids.UserSpecifiedSegment("viewName", "view1"),
+	}
+}
+
+// FromParseResult populates the fields of this SubscriptionCostManagementView ID from the ParseResult, for use with resourceids.Parser
+func (id *SubscriptionCostManagementViewId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ViewName, ok = input.Parsed["viewName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "viewName", input)
+	}
+
+	return nil
+}
+
 // SubscriptionCostManagementViewID parses a SubscriptionCostManagementView ID into an SubscriptionCostManagementViewId struct
 func SubscriptionCostManagementViewID(input string) (*SubscriptionCostManagementViewId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
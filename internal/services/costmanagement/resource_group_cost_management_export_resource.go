@@ -4,18 +4,23 @@
 package costmanagement
 
 import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/validate"
 	resourceValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name resource_group_cost_management_export -service-package-name costmanagement -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,name:id" -test-resource-type ResourceGroupCostManagementExport
+
 type ResourceGroupCostManagementExportResource struct {
 	base costManagementExportBaseResource
 }
 
 var _ sdk.Resource = ResourceGroupCostManagementExportResource{}
+var _ sdk.ResourceWithIdentity = ResourceGroupCostManagementExportResource{}
 
 func (r ResourceGroupCostManagementExportResource) Arguments() map[string]*pluginsdk.Schema {
 	schema := map[string]*pluginsdk.Schema{
@@ -51,6 +56,10 @@ func (r ResourceGroupCostManagementExportResource) IDValidationFunc() pluginsdk.
 	return validate.ResourceGroupCostManagementExportID
 }
 
+func (r ResourceGroupCostManagementExportResource) Identity() resourceids.ResourceId {
+	return &parse.ResourceGroupCostManagementExportId{}
+}
+
 func (r ResourceGroupCostManagementExportResource) Create() sdk.ResourceFunc {
 	return r.base.createFunc(r.ResourceType(), "resource_group_id")
 }
@@ -4,18 +4,23 @@
 package costmanagement
 
 import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/validate"
 	resourceValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name resource_group_cost_management_view -service-package-name costmanagement -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,name:id" -test-resource-type ResourceGroupCostManagementView
+
 type ResourceGroupCostManagementViewResource struct {
 	base costManagementViewBaseResource
 }
 
 var _ sdk.Resource = ResourceGroupCostManagementViewResource{}
+var _ sdk.ResourceWithIdentity = ResourceGroupCostManagementViewResource{}
 
 func (r ResourceGroupCostManagementViewResource) Arguments() map[string]*pluginsdk.Schema {
 	schema := map[string]*pluginsdk.Schema{
@@ -51,6 +56,10 @@ func (r ResourceGroupCostManagementViewResource) IDValidationFunc() pluginsdk.Sc
 	return validate.ResourceGroupCostManagementViewID
 }
 
+func (r ResourceGroupCostManagementViewResource) Identity() resourceids.ResourceId {
+	return &parse.ResourceGroupCostManagementViewId{}
+}
+
 func (r ResourceGroupCostManagementViewResource) Create() sdk.ResourceFunc {
 	return r.base.createFunc(r.ResourceType(), "resource_group_id")
 }
@@ -5,17 +5,22 @@ package costmanagement
 
 import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name subscription_cost_management_export -service-package-name costmanagement -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "name:id" -test-resource-type SubscriptionCostManagementExport
+
 type SubscriptionCostManagementExportResource struct {
 	base costManagementExportBaseResource
 }
 
 var _ sdk.Resource = SubscriptionCostManagementExportResource{}
+var _ sdk.ResourceWithIdentity = SubscriptionCostManagementExportResource{}
 
 func (r SubscriptionCostManagementExportResource) Arguments() map[string]*pluginsdk.Schema {
 	schema := map[string]*pluginsdk.Schema{
@@ -51,6 +56,10 @@ func (r SubscriptionCostManagementExportResource) IDValidationFunc() pluginsdk.S
 	return validate.SubscriptionCostManagementExportID
 }
 
+func (r SubscriptionCostManagementExportResource) Identity() resourceids.ResourceId {
+	return &parse.SubscriptionCostManagementExportId{}
+}
+
 func (r SubscriptionCostManagementExportResource) Create() sdk.ResourceFunc {
 	return r.base.createFunc(r.ResourceType(), "subscription_id")
 }
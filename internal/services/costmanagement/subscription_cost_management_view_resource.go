@@ -5,17 +5,22 @@ package costmanagement
 
 import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name subscription_cost_management_view -service-package-name costmanagement -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "name:id" -test-resource-type SubscriptionCostManagementView
+
 type SubscriptionCostManagementViewResource struct {
 	base costManagementViewBaseResource
 }
 
 var _ sdk.Resource = SubscriptionCostManagementViewResource{}
+var _ sdk.ResourceWithIdentity = SubscriptionCostManagementViewResource{}
 
 func (r SubscriptionCostManagementViewResource) Arguments() map[string]*pluginsdk.Schema {
 	schema := map[string]*pluginsdk.Schema{
@@ -51,6 +56,10 @@ func (r SubscriptionCostManagementViewResource) IDValidationFunc() pluginsdk.Sch
 	return validate.SubscriptionCostManagementViewID
 }
 
+func (r SubscriptionCostManagementViewResource) Identity() resourceids.ResourceId {
+	return &parse.SubscriptionCostManagementViewId{}
+}
+
 func (r SubscriptionCostManagementViewResource) Create() sdk.ResourceFunc {
 	return r.base.createFunc(r.ResourceType(), "subscription_id")
 }
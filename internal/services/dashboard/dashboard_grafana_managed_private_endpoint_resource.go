@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dashboard/2025-08-01/managedgrafanas"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dashboard/2025-08-01/managedprivateendpointmodels"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -21,8 +22,11 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dashboard_grafana_managed_private_endpoint -service-package-name dashboard -properties "name" -compare-values "subscription_id:id,resource_group_name:id,grafana_name:id" -test-resource-type ManagedPrivateEndpointResource -test-sequential
 type ManagedPrivateEndpointResource struct{}
 
+var _ sdk.ResourceWithIdentity = ManagedPrivateEndpointResource{}
+
 type ManagedPrivateEndpointModel struct {
 	Name                      string            `tfschema:"name"`
 	Location                  string            `tfschema:"location"`
@@ -54,6 +58,10 @@ func (r ManagedPrivateEndpointResource) IDValidationFunc() pluginsdk.SchemaValid
 	return managedprivateendpointmodels.ValidateManagedPrivateEndpointID
 }
 
+func (r ManagedPrivateEndpointResource) Identity() resourceids.ResourceId {
+	return &managedprivateendpointmodels.ManagedPrivateEndpointId{}
+}
+
 func (r ManagedPrivateEndpointResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dashboard/2025-08-01/managedgrafanas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dashboard_grafana -service-package-name dashboard -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-sequential
+
 type DashboardGrafanaModel struct {
 	Name                              string                                            `tfschema:"name"`
 	ResourceGroupName                 string                                            `tfschema:"resource_group_name"`
@@ -59,6 +62,11 @@ type SMTPConfigurationModel struct {
 type DashboardGrafanaResource struct{}
 
 var _ sdk.ResourceWithUpdate = DashboardGrafanaResource{}
+var _ sdk.ResourceWithIdentity = DashboardGrafanaResource{}
+
+func (r DashboardGrafanaResource) Identity() resourceids.ResourceId {
+	return &managedgrafanas.GrafanaId{}
+}
 
 func (r DashboardGrafanaResource) ResourceType() string {
 	return "azurerm_dashboard_grafana"
@@ -501,6 +509,10 @@ func (r DashboardGrafanaResource) Read() sdk.ResourceFunc {
 				state.Tags = *model.Tags
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/databoxedge/2022-03-01/devices"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -44,9 +45,12 @@ type EdgeDeviceModel struct {
 	Tags              map[string]string       `tfschema:"tags"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name databox_edge_device -service-package-name databoxedge -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type EdgeDeviceResource struct{}
 
 var _ sdk.ResourceWithUpdate = EdgeDeviceResource{}
+var _ sdk.ResourceWithIdentity = EdgeDeviceResource{}
 
 func (r EdgeDeviceResource) ModelObject() interface{} {
 	return &EdgeDeviceModel{}
@@ -60,6 +64,10 @@ func (r EdgeDeviceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return devices.ValidateDataBoxEdgeDeviceID
 }
 
+func (r EdgeDeviceResource) Identity() resourceids.ResourceId {
+	return &devices.DataBoxEdgeDeviceId{}
+}
+
 func (r EdgeDeviceResource) Arguments() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"name": {
@@ -229,6 +237,10 @@ func (r EdgeDeviceResource) Read() sdk.ResourceFunc {
 
 			metadata.SetID(id)
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/databricks/2022-10-01-preview/accessconnector"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/validate"
@@ -19,9 +20,16 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name databricks_access_connector -service-package-name databricks -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type AccessConnectorResource struct{}
 
 var _ sdk.ResourceWithUpdate = AccessConnectorResource{}
+var _ sdk.ResourceWithIdentity = AccessConnectorResource{}
+
+func (r AccessConnectorResource) Identity() resourceids.ResourceId {
+	return &accessconnector.AccessConnectorId{}
+}
 
 type AccessConnectorResourceModel struct {
 	Name          string            `tfschema:"name"`
@@ -193,6 +201,10 @@ func (r AccessConnectorResource) Read() sdk.ResourceFunc {
 					}
 				}
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
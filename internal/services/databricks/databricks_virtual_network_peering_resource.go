@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/databricks/2024-05-01/vnetpeering"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/databricks/2024-05-01/workspaces"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -23,18 +24,21 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name databricks_virtual_network_peering -service-package-name databricks -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:workspace_id,workspace_name:workspace_id"
+
 const databricksVnetPeeringsResourceType string = "azurerm_databricks_virtual_network_peering"
 
 func resourceDatabricksVirtualNetworkPeering() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceDatabricksVirtualNetworkPeeringCreate,
-		Read:   resourceDatabricksVirtualNetworkPeeringRead,
-		Update: resourceDatabricksVirtualNetworkPeeringUpdate,
-		Delete: resourceDatabricksVirtualNetworkPeeringDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := vnetpeering.ParseVirtualNetworkPeeringID(id)
-			return err
-		}),
+		Create:   resourceDatabricksVirtualNetworkPeeringCreate,
+		Read:     resourceDatabricksVirtualNetworkPeeringRead,
+		Update:   resourceDatabricksVirtualNetworkPeeringUpdate,
+		Delete:   resourceDatabricksVirtualNetworkPeeringDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&vnetpeering.VirtualNetworkPeeringId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&vnetpeering.VirtualNetworkPeeringId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -249,7 +253,7 @@ func resourceDatabricksVirtualNetworkPeeringRead(d *pluginsdk.ResourceData, meta
 		d.Set("remote_virtual_network_id", remoteVirtualNetworkId)
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceDatabricksVirtualNetworkPeeringUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
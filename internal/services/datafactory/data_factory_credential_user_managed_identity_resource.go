@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/credentials"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/factories"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -18,11 +19,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name data_factory_credential_user_managed_identity -service-package-name datafactory -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,factory_name:id" -test-resource-type CredentialUserAssignedManagedIdentityResource
+
 type DataFactoryCredentialUserAssignedManagedIdentityResource struct{}
 
 var (
-	_ sdk.Resource           = DataFactoryCredentialUserAssignedManagedIdentityResource{}
-	_ sdk.ResourceWithUpdate = DataFactoryCredentialUserAssignedManagedIdentityResource{}
+	_ sdk.Resource             = DataFactoryCredentialUserAssignedManagedIdentityResource{}
+	_ sdk.ResourceWithUpdate   = DataFactoryCredentialUserAssignedManagedIdentityResource{}
+	_ sdk.ResourceWithIdentity = DataFactoryCredentialUserAssignedManagedIdentityResource{}
 )
 
 func (DataFactoryCredentialUserAssignedManagedIdentityResource) ResourceType() string {
@@ -89,6 +93,10 @@ func (DataFactoryCredentialUserAssignedManagedIdentityResource) IDValidationFunc
 	return credentials.ValidateCredentialID
 }
 
+func (DataFactoryCredentialUserAssignedManagedIdentityResource) Identity() resourceids.ResourceId {
+	return &credentials.CredentialId{}
+}
+
 func (DataFactoryCredentialUserAssignedManagedIdentityResource) Read() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/factories"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
@@ -20,6 +21,8 @@ import (
 	"github.com/jackofallops/kermit/sdk/datafactory/2018-06-01/datafactory" // nolint: staticcheck
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name data_factory_custom_dataset -service-package-name datafactory -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,factory_name:id" -test-resource-type CustomDatasetResource
+
 func resourceDataFactoryCustomDataset() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceDataFactoryCustomDatasetCreateUpdate,
@@ -27,10 +30,11 @@ func resourceDataFactoryCustomDataset() *pluginsdk.Resource {
 		Update: resourceDataFactoryCustomDatasetCreateUpdate,
 		Delete: resourceDataFactoryCustomDatasetDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.DataSetID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.DataSetId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.DataSetId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -355,7 +359,7 @@ func resourceDataFactoryCustomDatasetRead(d *pluginsdk.ResourceData, meta interf
 	}
 	d.Set("additional_properties", additionalProperties)
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceDataFactoryCustomDatasetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/factories"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name data_factory_customer_managed_key -service-package-name datafactory -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,name:id" -test-name systemAssignedKey -test-resource-type DataFactoryCustomerManagedKeyTestResource
+
 type DataFactoryCustomerManagedKeyResource struct{}
 
 type DataFactoryCustomerManagedKeyModel struct {
@@ -29,6 +32,7 @@ type DataFactoryCustomerManagedKeyModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = DataFactoryCustomerManagedKeyResource{}
+var _ sdk.ResourceWithIdentity = DataFactoryCustomerManagedKeyResource{}
 
 func (r DataFactoryCustomerManagedKeyResource) ModelObject() interface{} {
 	return &DataFactoryCustomerManagedKeyModel{}
@@ -42,6 +46,10 @@ func (r DataFactoryCustomerManagedKeyResource) IDValidationFunc() pluginsdk.Sche
 	return factories.ValidateFactoryID
 }
 
+func (r DataFactoryCustomerManagedKeyResource) Identity() resourceids.ResourceId {
+	return &factories.FactoryId{}
+}
+
 func (r DataFactoryCustomerManagedKeyResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"data_factory_id": {
@@ -182,6 +190,10 @@ func (r DataFactoryCustomerManagedKeyResource) Read() sdk.ResourceFunc {
 				UserAssignedIdentityID: customerManagedKeyIdentityId,
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
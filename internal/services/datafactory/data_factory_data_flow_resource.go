@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/dataflows"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/factories"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/helper"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name data_factory_data_flow -service-package-name datafactory -properties "name" -compare-values "subscription_id:id,resource_group_name:id,factory_name:id" -test-resource-type DataFlowResource
+
 func resourceDataFactoryDataFlow() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceDataFactoryDataFlowCreateUpdate,
@@ -27,10 +30,11 @@ func resourceDataFactoryDataFlow() *pluginsdk.Resource {
 		Update: resourceDataFactoryDataFlowCreateUpdate,
 		Delete: resourceDataFactoryDataFlowDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := dataflows.ParseDataflowID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&dataflows.DataflowId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&dataflows.DataflowId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -218,7 +222,7 @@ func resourceDataFactoryDataFlowRead(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceDataFactoryDataFlowDelete(d *pluginsdk.ResourceData, meta interface{}) error {
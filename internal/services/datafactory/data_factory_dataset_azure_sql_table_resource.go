@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/factories"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
@@ -21,6 +22,9 @@ import (
 )
 
 var _ sdk.Resource = DataFactoryDatasetAzureSQLTableResource{}
+var _ sdk.ResourceWithIdentity = DataFactoryDatasetAzureSQLTableResource{}
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name data_factory_dataset_azure_sql_table -service-package-name datafactory -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,factory_name:id" -test-resource-type DatasetAzureSQLTableResource
 
 type DataFactoryDatasetAzureSQLTableResource struct{}
 
@@ -457,3 +461,7 @@ func (DataFactoryDatasetAzureSQLTableResource) Delete() sdk.ResourceFunc {
 func (DataFactoryDatasetAzureSQLTableResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return validate.DataSetID
 }
+
+func (DataFactoryDatasetAzureSQLTableResource) Identity() resourceids.ResourceId {
+	return &parse.DataSetId{}
+}
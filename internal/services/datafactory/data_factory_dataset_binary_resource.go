@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/factories"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
@@ -19,16 +20,20 @@ import (
 	"github.com/jackofallops/kermit/sdk/datafactory/2018-06-01/datafactory" // nolint: staticcheck
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name data_factory_dataset_binary -service-package-name datafactory -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,factory_name:id" -test-name blob -test-resource-type DatasetBinaryResource
+
 func resourceDataFactoryDatasetBinary() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceDataFactoryDatasetBinaryCreateUpdate,
 		Read:   resourceDataFactoryDatasetBinaryRead,
 		Update: resourceDataFactoryDatasetBinaryCreateUpdate,
 		Delete: resourceDataFactoryDatasetBinaryDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.DataSetID(id)
-			return err
-		}),
+
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.DataSetId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.DataSetId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -399,7 +404,7 @@ func resourceDataFactoryDatasetBinaryRead(d *pluginsdk.ResourceData, meta interf
 		d.Set("folder", folder.Name)
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceDataFactoryDatasetBinaryDelete(d *pluginsdk.ResourceData, meta interface{}) error {
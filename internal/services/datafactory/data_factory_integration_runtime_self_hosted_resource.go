@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/factories"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/integrationruntimes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -24,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name data_factory_integration_runtime_self_hosted -service-package-name datafactory -properties "name" -compare-values "subscription_id:id,resource_group_name:id,factory_name:id" -test-resource-type IntegrationRuntimeSelfHostedResource
+
 func resourceDataFactoryIntegrationRuntimeSelfHosted() *pluginsdk.Resource {
 	r := &pluginsdk.Resource{
 		Create: resourceDataFactoryIntegrationRuntimeSelfHostedCreateUpdate,
@@ -36,10 +39,11 @@ func resourceDataFactoryIntegrationRuntimeSelfHosted() *pluginsdk.Resource {
 			0: migration.DataFactoryIntegrationRuntimeSelfHostedV0ToV1{},
 		}),
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := integrationruntimes.ParseIntegrationRuntimeID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&integrationruntimes.IntegrationRuntimeId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&integrationruntimes.IntegrationRuntimeId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -213,7 +217,7 @@ func resourceDataFactoryIntegrationRuntimeSelfHostedRead(d *pluginsdk.ResourceDa
 			// The ListAuthenticationKeys on integration runtime type Linked is not supported.
 			// Only skip the call to ListAuthKeys if the linkedInfo is valid.
 			if _, ok := props.LinkedInfo.(integrationruntimes.RawLinkedIntegrationRuntimeTypeImpl); !ok {
-				return nil
+				return pluginsdk.SetResourceIdentityData(d, id)
 			}
 		}
 	}
@@ -236,7 +240,7 @@ func resourceDataFactoryIntegrationRuntimeSelfHostedRead(d *pluginsdk.ResourceDa
 	d.Set("primary_authorization_key", keyModel.AuthKey1)
 	d.Set("secondary_authorization_key", keyModel.AuthKey2)
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceDataFactoryIntegrationRuntimeSelfHostedDelete(d *pluginsdk.ResourceData, meta interface{}) error {
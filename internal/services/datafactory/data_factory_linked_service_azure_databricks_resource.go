@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/databricks/2022-04-01-preview/workspaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/factories"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
@@ -22,6 +23,8 @@ import (
 	"github.com/jackofallops/kermit/sdk/datafactory/2018-06-01/datafactory" // nolint: staticcheck
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name data_factory_linked_service_azure_databricks -service-package-name datafactory -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,factory_name:id,name:id" -test-name msi -test-resource-type LinkedServiceDatabricksResource
+
 func resourceDataFactoryLinkedServiceAzureDatabricks() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceDataFactoryLinkedServiceDatabricksCreateUpdate,
@@ -29,10 +32,11 @@ func resourceDataFactoryLinkedServiceAzureDatabricks() *pluginsdk.Resource {
 		Update: resourceDataFactoryLinkedServiceDatabricksCreateUpdate,
 		Delete: resourceDataFactoryLinkedServiceDatabricksDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := parse.LinkedServiceID(id)
-			return err
-		}, importDataFactoryLinkedService(datafactory.TypeBasicLinkedServiceTypeAzureDatabricks)),
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&parse.LinkedServiceId{}, importDataFactoryLinkedService(datafactory.TypeBasicLinkedServiceTypeAzureDatabricks)),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.LinkedServiceId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -569,7 +573,7 @@ func resourceDataFactoryLinkedServiceDatabricksRead(d *pluginsdk.ResourceData, m
 		d.Set("integration_runtime_name", connectVia.ReferenceName)
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceDataFactoryLinkedServiceDatabricksDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/factories"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
@@ -19,6 +20,8 @@ import (
 	"github.com/jackofallops/kermit/sdk/datafactory/2018-06-01/datafactory" // nolint: staticcheck
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name data_factory_linked_service_azure_file_storage -service-package-name datafactory -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,factory_name:id,name:id" -test-resource-type LinkedServiceAzureFileStorageResource
+
 func resourceDataFactoryLinkedServiceAzureFileStorage() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceDataFactoryLinkedServiceAzureFileStorageCreateUpdate,
@@ -26,10 +29,11 @@ func resourceDataFactoryLinkedServiceAzureFileStorage() *pluginsdk.Resource {
 		Update: resourceDataFactoryLinkedServiceAzureFileStorageCreateUpdate,
 		Delete: resourceDataFactoryLinkedServiceAzureFileStorageDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := parse.LinkedServiceID(id)
-			return err
-		}, importDataFactoryLinkedService(datafactory.TypeBasicLinkedServiceTypeAzureFileStorage)),
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&parse.LinkedServiceId{}, importDataFactoryLinkedService(datafactory.TypeBasicLinkedServiceTypeAzureFileStorage)),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.LinkedServiceId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -303,7 +307,7 @@ func resourceDataFactoryLinkedServiceAzureFileStorageRead(d *pluginsdk.ResourceD
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceDataFactoryLinkedServiceAzureFileStorageDelete(d *pluginsdk.ResourceData, meta interface{}) error {
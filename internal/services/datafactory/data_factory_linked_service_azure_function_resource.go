@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/factories"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
@@ -20,6 +21,8 @@ import (
 	"github.com/jackofallops/kermit/sdk/datafactory/2018-06-01/datafactory" // nolint: staticcheck
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name data_factory_linked_service_azure_function -service-package-name datafactory -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,factory_name:id" -test-resource-type LinkedServiceAzureFunctionResource
+
 func resourceDataFactoryLinkedServiceAzureFunction() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceDataFactoryLinkedServiceAzureFunctionCreateUpdate,
@@ -27,10 +30,11 @@ func resourceDataFactoryLinkedServiceAzureFunction() *pluginsdk.Resource {
 		Update: resourceDataFactoryLinkedServiceAzureFunctionCreateUpdate,
 		Delete: resourceDataFactoryLinkedServiceAzureFunctionDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := parse.LinkedServiceID(id)
-			return err
-		}, importDataFactoryLinkedService(datafactory.TypeBasicLinkedServiceTypeAzureFunction)),
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&parse.LinkedServiceId{}, importDataFactoryLinkedService(datafactory.TypeBasicLinkedServiceTypeAzureFunction)),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.LinkedServiceId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -259,7 +263,7 @@ func resourceDataFactoryLinkedServiceAzureFunctionRead(d *pluginsdk.ResourceData
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceDataFactoryLinkedServiceAzureFunctionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
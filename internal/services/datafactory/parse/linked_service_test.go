@@ -21,6 +21,24 @@ func TestLinkedServiceIDFormatter(t *testing.T) {
 	}
 }
 
+func TestLinkedServiceIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&LinkedServiceId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataFactory/factories/factory1/linkedservices/linkedService1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual LinkedServiceId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataFactory/factories/factory1/linkedservices/linkedService1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestLinkedServiceID(t *testing.T) {
 	testData := []struct {
 		Input    string
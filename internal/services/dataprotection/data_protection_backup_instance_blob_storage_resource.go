@@ -20,11 +20,11 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
-	azSchema "github.com/hashicorp/terraform-provider-azurerm/internal/tf/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name data_protection_backup_instance_blob_storage -service-package-name dataprotection -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:vault_id,backup_vault_name:vault_id" -test-resource-type DataProtectionBackupInstanceBlobStorageResource
 func resourceDataProtectionBackupInstanceBlobStorage() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDataProtectionBackupInstanceBlobStorageCreateUpdate,
@@ -39,10 +39,11 @@ func resourceDataProtectionBackupInstanceBlobStorage() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
-			_, err := backupinstances.ParseBackupInstanceID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&backupinstances.BackupInstanceId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&backupinstances.BackupInstanceId{}),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -213,7 +214,7 @@ func resourceDataProtectionBackupInstanceBlobStorageRead(d *schema.ResourceData,
 			}
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceDataProtectionBackupInstanceBlobStorageDelete(d *schema.ResourceData, meta interface{}) error {
@@ -20,12 +20,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	resourceParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
-	azSchema "github.com/hashicorp/terraform-provider-azurerm/internal/tf/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name data_protection_backup_instance_disk -service-package-name dataprotection -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:vault_id,backup_vault_name:vault_id" -test-resource-type DataProtectionBackupInstanceDiskResource
 func resourceDataProtectionBackupInstanceDisk() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDataProtectionBackupInstanceDiskCreateUpdate,
@@ -40,10 +40,11 @@ func resourceDataProtectionBackupInstanceDisk() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
-			_, err := backupinstances.ParseBackupInstanceID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&backupinstances.BackupInstanceId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&backupinstances.BackupInstanceId{}),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -234,7 +235,7 @@ func resourceDataProtectionBackupInstanceDiskRead(d *schema.ResourceData, meta i
 			}
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceDataProtectionBackupInstanceDiskDelete(d *schema.ResourceData, meta interface{}) error {
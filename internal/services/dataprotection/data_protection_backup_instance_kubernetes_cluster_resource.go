@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backupinstances"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backuppolicies"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name data_protection_backup_instance_kubernetes_cluster -service-package-name dataprotection -properties "name" -compare-values "subscription_id:id,resource_group_name:id,backup_vault_name:id" -test-resource-type DataProtectionBackupInstanceKubernatesClusterTestResource
+
 type BackupInstanceKubernatesClusterModel struct {
 	Name                       string                       `tfschema:"name"`
 	Location                   string                       `tfschema:"location"`
@@ -44,6 +47,11 @@ type BackupDatasourceParameters struct {
 type DataProtectionBackupInstanceKubernatesClusterResource struct{}
 
 var _ sdk.Resource = DataProtectionBackupInstanceKubernatesClusterResource{}
+var _ sdk.ResourceWithIdentity = DataProtectionBackupInstanceKubernatesClusterResource{}
+
+func (r DataProtectionBackupInstanceKubernatesClusterResource) Identity() resourceids.ResourceId {
+	return &backupinstances.BackupInstanceId{}
+}
 
 func (r DataProtectionBackupInstanceKubernatesClusterResource) ResourceType() string {
 	return "azurerm_data_protection_backup_instance_kubernetes_cluster"
@@ -299,6 +307,10 @@ func (r DataProtectionBackupInstanceKubernatesClusterResource) Read() sdk.Resour
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
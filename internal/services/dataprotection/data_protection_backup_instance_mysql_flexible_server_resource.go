@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backupinstances"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backuppolicies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/servers"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name data_protection_backup_instance_mysql_flexible_server -service-package-name dataprotection -properties "name" -compare-values "subscription_id:id,resource_group_name:id,backup_vault_name:id" -test-resource-type DataProtectionBackupInstanceMySQLFlexibleServerResource
+
 type BackupInstanceMySQLFlexibleServerModel struct {
 	Name           string `tfschema:"name"`
 	Location       string `tfschema:"location"`
@@ -31,6 +34,11 @@ type BackupInstanceMySQLFlexibleServerModel struct {
 type DataProtectionBackupInstanceMySQLFlexibleServerResource struct{}
 
 var _ sdk.Resource = DataProtectionBackupInstanceMySQLFlexibleServerResource{}
+var _ sdk.ResourceWithIdentity = DataProtectionBackupInstanceMySQLFlexibleServerResource{}
+
+func (r DataProtectionBackupInstanceMySQLFlexibleServerResource) Identity() resourceids.ResourceId {
+	return &backupinstances.BackupInstanceId{}
+}
 
 func (r DataProtectionBackupInstanceMySQLFlexibleServerResource) ResourceType() string {
 	return "azurerm_data_protection_backup_instance_mysql_flexible_server"
@@ -207,6 +215,10 @@ func (r DataProtectionBackupInstanceMySQLFlexibleServerResource) Read() sdk.Reso
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
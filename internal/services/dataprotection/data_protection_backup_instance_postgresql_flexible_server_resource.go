@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backupinstances"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backuppolicies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2024-08-01/servers"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name data_protection_backup_instance_postgresql_flexible_server -service-package-name dataprotection -properties "name" -compare-values "subscription_id:id,resource_group_name:id,backup_vault_name:id" -test-resource-type DataProtectionBackupInstancePostgreSQLFlexibleServerResource
+
 type BackupInstancePostgreSQLFlexibleServerModel struct {
 	Name           string `tfschema:"name"`
 	Location       string `tfschema:"location"`
@@ -31,6 +34,11 @@ type BackupInstancePostgreSQLFlexibleServerModel struct {
 type DataProtectionBackupInstancePostgreSQLFlexibleServerResource struct{}
 
 var _ sdk.Resource = DataProtectionBackupInstancePostgreSQLFlexibleServerResource{}
+var _ sdk.ResourceWithIdentity = DataProtectionBackupInstancePostgreSQLFlexibleServerResource{}
+
+func (r DataProtectionBackupInstancePostgreSQLFlexibleServerResource) Identity() resourceids.ResourceId {
+	return &backupinstances.BackupInstanceId{}
+}
 
 func (r DataProtectionBackupInstancePostgreSQLFlexibleServerResource) ResourceType() string {
 	return "azurerm_data_protection_backup_instance_postgresql_flexible_server"
@@ -207,6 +215,10 @@ func (r DataProtectionBackupInstancePostgreSQLFlexibleServerResource) Read() sdk
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -18,12 +18,12 @@ import (
 	helperValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
-	azSchema "github.com/hashicorp/terraform-provider-azurerm/internal/tf/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name data_protection_backup_policy_blob_storage -service-package-name dataprotection -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:vault_id,backup_vault_name:vault_id" -test-resource-type DataProtectionBackupPolicyBlobStorageResource
 func resourceDataProtectionBackupPolicyBlobStorage() *schema.Resource {
 	resource := &schema.Resource{
 		Create: resourceDataProtectionBackupPolicyBlobStorageCreate,
@@ -36,10 +36,11 @@ func resourceDataProtectionBackupPolicyBlobStorage() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
-			_, err := backuppolicies.ParseBackupPolicyID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&backuppolicies.BackupPolicyId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&backuppolicies.BackupPolicyId{}),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -321,7 +322,7 @@ func resourceDataProtectionBackupPolicyBlobStorageRead(d *schema.ResourceData, m
 			}
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceDataProtectionBackupPolicyBlobStorageDelete(d *schema.ResourceData, meta interface{}) error {
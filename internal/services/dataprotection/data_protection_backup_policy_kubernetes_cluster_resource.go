@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backuppolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name data_protection_backup_policy_kubernetes_cluster -service-package-name dataprotection -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "backup_vault_name:id" -test-resource-type DataProtectionBackupPolicyKubernatesClusterTestResource
+
 type BackupPolicyKubernatesClusterModel struct {
 	Name                         string                 `tfschema:"name"`
 	ResourceGroupName            string                 `tfschema:"resource_group_name"`
@@ -58,6 +61,11 @@ type Criteria struct {
 type DataProtectionBackupPolicyKubernatesClusterResource struct{}
 
 var _ sdk.Resource = DataProtectionBackupPolicyKubernatesClusterResource{}
+var _ sdk.ResourceWithIdentity = DataProtectionBackupPolicyKubernatesClusterResource{}
+
+func (r DataProtectionBackupPolicyKubernatesClusterResource) Identity() resourceids.ResourceId {
+	return &backuppolicies.BackupPolicyId{}
+}
 
 func (r DataProtectionBackupPolicyKubernatesClusterResource) ResourceType() string {
 	return "azurerm_data_protection_backup_policy_kubernetes_cluster"
@@ -351,6 +359,10 @@ func (r DataProtectionBackupPolicyKubernatesClusterResource) Read() sdk.Resource
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backuppolicies"
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name data_protection_backup_policy_mysql_flexible_server -service-package-name dataprotection -properties "name" -compare-values "subscription_id:id,resource_group_name:id,backup_vault_name:id" -test-resource-type DataProtectionBackupPolicyMySQLFlexibleServerResource
+
 type BackupPolicyMySQLFlexibleServerModel struct {
 	Name                         string                                                `tfschema:"name"`
 	VaultId                      string                                                `tfschema:"vault_id"`
@@ -56,6 +59,11 @@ type BackupPolicyMySQLFlexibleServerCriteria struct {
 type DataProtectionBackupPolicyMySQLFlexibleServerResource struct{}
 
 var _ sdk.Resource = DataProtectionBackupPolicyMySQLFlexibleServerResource{}
+var _ sdk.ResourceWithIdentity = DataProtectionBackupPolicyMySQLFlexibleServerResource{}
+
+func (r DataProtectionBackupPolicyMySQLFlexibleServerResource) Identity() resourceids.ResourceId {
+	return &backuppolicies.BackupPolicyId{}
+}
 
 func (r DataProtectionBackupPolicyMySQLFlexibleServerResource) ResourceType() string {
 	return "azurerm_data_protection_backup_policy_mysql_flexible_server"
@@ -336,6 +344,10 @@ func (r DataProtectionBackupPolicyMySQLFlexibleServerResource) Read() sdk.Resour
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
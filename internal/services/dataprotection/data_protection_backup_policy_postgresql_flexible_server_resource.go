@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backuppolicies"
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name data_protection_backup_policy_postgresql_flexible_server -service-package-name dataprotection -properties "name" -compare-values "subscription_id:id,resource_group_name:id,backup_vault_name:id" -test-resource-type DataProtectionBackupPolicyPostgreSQLFlexibleServerResource
+
 type BackupPolicyPostgreSQLFlexibleServerModel struct {
 	Name                         string                                                     `tfschema:"name"`
 	VaultId                      string                                                     `tfschema:"vault_id"`
@@ -57,6 +60,11 @@ type BackupPolicyPostgreSQLFlexibleServerCriteria struct {
 type DataProtectionBackupPolicyPostgreSQLFlexibleServerResource struct{}
 
 var _ sdk.Resource = DataProtectionBackupPolicyPostgreSQLFlexibleServerResource{}
+var _ sdk.ResourceWithIdentity = DataProtectionBackupPolicyPostgreSQLFlexibleServerResource{}
+
+func (r DataProtectionBackupPolicyPostgreSQLFlexibleServerResource) Identity() resourceids.ResourceId {
+	return &backuppolicies.BackupPolicyId{}
+}
 
 func (r DataProtectionBackupPolicyPostgreSQLFlexibleServerResource) ResourceType() string {
 	return "azurerm_data_protection_backup_policy_postgresql_flexible_server"
@@ -337,6 +345,10 @@ func (r DataProtectionBackupPolicyPostgreSQLFlexibleServerResource) Read() sdk.R
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
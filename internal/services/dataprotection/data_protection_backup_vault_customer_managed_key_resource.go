@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backupvaults"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name data_protection_backup_vault_customer_managed_key -service-package-name dataprotection -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,name:id" -test-name complete
+
 type DataProtectionBackupVaultCustomerManagedKeyResource struct{}
 
 type DataProtectionBackupVaultCustomerManagedKeyModel struct {
@@ -27,6 +30,7 @@ type DataProtectionBackupVaultCustomerManagedKeyModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = DataProtectionBackupVaultCustomerManagedKeyResource{}
+var _ sdk.ResourceWithIdentity = DataProtectionBackupVaultCustomerManagedKeyResource{}
 
 func (r DataProtectionBackupVaultCustomerManagedKeyResource) ModelObject() interface{} {
 	return &DataProtectionBackupVaultCustomerManagedKeyResource{}
@@ -40,6 +44,10 @@ func (r DataProtectionBackupVaultCustomerManagedKeyResource) IDValidationFunc()
 	return backupvaults.ValidateBackupVaultID
 }
 
+func (r DataProtectionBackupVaultCustomerManagedKeyResource) Identity() resourceids.ResourceId {
+	return &backupvaults.BackupVaultId{}
+}
+
 func (r DataProtectionBackupVaultCustomerManagedKeyResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"data_protection_backup_vault_id": {
@@ -156,6 +164,10 @@ func (r DataProtectionBackupVaultCustomerManagedKeyResource) Read() sdk.Resource
 					}
 				}
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -21,6 +21,24 @@ func TestHostPoolRegistrationInfoIDFormatter(t *testing.T) {
 	}
 }
 
+func TestHostPoolRegistrationInfoIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&HostPoolRegistrationInfoId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DesktopVirtualization/hostPools/pool1/registrationInfo/default", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual HostPoolRegistrationInfoId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DesktopVirtualization/hostPools/pool1/registrationInfo/default"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestHostPoolRegistrationInfoID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -44,7 +44,7 @@ func resourceVirtualDesktopScalingPlan() *pluginsdk.Resource {
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := scalingplan.ParseScalingPlanID(id)
 			return err
-		}),
+		}, &scalingplan.ScalingPlanId{}),
 
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/attachednetworkconnections"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/devcenters"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/networkconnections"
@@ -18,7 +19,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dev_center_attached_network -service-package-name devcenter -properties "name" -compare-values "subscription_id:id,resource_group_name:id,dev_center_name:id" -test-resource-type DevCenterAttachedNetworkTestResource
+
 var _ sdk.Resource = DevCenterAttachedNetworkResource{}
+var _ sdk.ResourceWithIdentity = DevCenterAttachedNetworkResource{}
+
+func (r DevCenterAttachedNetworkResource) Identity() resourceids.ResourceId {
+	return &attachednetworkconnections.DevCenterAttachedNetworkId{}
+}
 
 type DevCenterAttachedNetworkResource struct{}
 
@@ -135,6 +143,10 @@ func (r DevCenterAttachedNetworkResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/catalogs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dev_center_catalog -service-package-name devcenter -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "dev_center_name:id" -test-resource-type DevCenterCatalogsResource
+
 type DevCenterCatalogsResourceModel struct {
 	Name              string                   `tfschema:"name"`
 	ResourceGroupName string                   `tfschema:"resource_group_name"`
@@ -36,6 +39,11 @@ type CatalogPropertiesModel struct {
 type DevCenterCatalogsResource struct{}
 
 var _ sdk.Resource = DevCenterCatalogsResource{}
+var _ sdk.ResourceWithIdentity = DevCenterCatalogsResource{}
+
+func (r DevCenterCatalogsResource) Identity() resourceids.ResourceId {
+	return &catalogs.DevCenterCatalogId{}
+}
 
 func (r DevCenterCatalogsResource) ModelObject() interface{} {
 	return &DevCenterCatalogsResourceModel{}
@@ -219,6 +227,10 @@ func (r DevCenterCatalogsResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
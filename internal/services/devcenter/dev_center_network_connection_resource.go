@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/networkconnections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/validate"
@@ -20,11 +21,18 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dev_center_network_connection -service-package-name devcenter -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type DevCenterNetworkConnectionTestResource
+
 var (
-	_ sdk.Resource           = DevCenterNetworkConnectionResource{}
-	_ sdk.ResourceWithUpdate = DevCenterNetworkConnectionResource{}
+	_ sdk.Resource             = DevCenterNetworkConnectionResource{}
+	_ sdk.ResourceWithUpdate   = DevCenterNetworkConnectionResource{}
+	_ sdk.ResourceWithIdentity = DevCenterNetworkConnectionResource{}
 )
 
+func (r DevCenterNetworkConnectionResource) Identity() resourceids.ResourceId {
+	return &networkconnections.NetworkConnectionId{}
+}
+
 type DevCenterNetworkConnectionResource struct{}
 
 func (r DevCenterNetworkConnectionResource) ModelObject() interface{} {
@@ -208,6 +216,10 @@ func (r DevCenterNetworkConnectionResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
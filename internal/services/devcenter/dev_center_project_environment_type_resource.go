@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/environmenttypes"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/projects"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -22,11 +23,18 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dev_center_project_environment_type -service-package-name devcenter -properties "name" -compare-values "subscription_id:id,resource_group_name:id,project_name:id" -test-resource-type DevCenterProjectEnvironmentTypeTestResource
+
 var (
-	_ sdk.Resource           = DevCenterProjectEnvironmentTypeResource{}
-	_ sdk.ResourceWithUpdate = DevCenterProjectEnvironmentTypeResource{}
+	_ sdk.Resource             = DevCenterProjectEnvironmentTypeResource{}
+	_ sdk.ResourceWithUpdate   = DevCenterProjectEnvironmentTypeResource{}
+	_ sdk.ResourceWithIdentity = DevCenterProjectEnvironmentTypeResource{}
 )
 
+func (r DevCenterProjectEnvironmentTypeResource) Identity() resourceids.ResourceId {
+	return &environmenttypes.EnvironmentTypeId{}
+}
+
 type DevCenterProjectEnvironmentTypeResource struct{}
 
 func (r DevCenterProjectEnvironmentTypeResource) ModelObject() interface{} {
@@ -225,6 +233,10 @@ func (r DevCenterProjectEnvironmentTypeResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/pools"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/projects"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -20,11 +21,18 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dev_center_project_pool -service-package-name devcenter -properties "name" -compare-values "subscription_id:id,resource_group_name:id,project_name:id" -test-resource-type DevCenterProjectPoolTestResource
+
 var (
-	_ sdk.Resource           = DevCenterProjectPoolResource{}
-	_ sdk.ResourceWithUpdate = DevCenterProjectPoolResource{}
+	_ sdk.Resource             = DevCenterProjectPoolResource{}
+	_ sdk.ResourceWithUpdate   = DevCenterProjectPoolResource{}
+	_ sdk.ResourceWithIdentity = DevCenterProjectPoolResource{}
 )
 
+func (r DevCenterProjectPoolResource) Identity() resourceids.ResourceId {
+	return &pools.PoolId{}
+}
+
 type DevCenterProjectPoolResource struct{}
 
 func (r DevCenterProjectPoolResource) ModelObject() interface{} {
@@ -219,6 +227,10 @@ func (r DevCenterProjectPoolResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -13,17 +13,25 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/projects"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dev_center_project -service-package-name devcenter -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type DevCenterProjectTestResource
+
 var (
-	_ sdk.Resource           = DevCenterProjectResource{}
-	_ sdk.ResourceWithUpdate = DevCenterProjectResource{}
+	_ sdk.Resource             = DevCenterProjectResource{}
+	_ sdk.ResourceWithUpdate   = DevCenterProjectResource{}
+	_ sdk.ResourceWithIdentity = DevCenterProjectResource{}
 )
 
+func (r DevCenterProjectResource) Identity() resourceids.ResourceId {
+	return &projects.ProjectId{}
+}
+
 type DevCenterProjectResource struct{}
 
 func (r DevCenterProjectResource) ModelObject() interface{} {
@@ -181,6 +189,10 @@ func (r DevCenterProjectResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
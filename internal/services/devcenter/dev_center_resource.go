@@ -13,17 +13,25 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/devcenters"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dev_center -service-package-name devcenter -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type DevCenterTestResource
+
 var (
-	_ sdk.Resource           = DevCenterResource{}
-	_ sdk.ResourceWithUpdate = DevCenterResource{}
+	_ sdk.Resource             = DevCenterResource{}
+	_ sdk.ResourceWithUpdate   = DevCenterResource{}
+	_ sdk.ResourceWithIdentity = DevCenterResource{}
 )
 
+func (r DevCenterResource) Identity() resourceids.ResourceId {
+	return &devcenters.DevCenterId{}
+}
+
 type DevCenterResource struct{}
 
 func (r DevCenterResource) ModelObject() interface{} {
@@ -144,6 +152,10 @@ func (r DevCenterResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/globalschedules"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -22,16 +23,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dev_test_global_vm_shutdown_schedule -service-package-name devtestlabs -compare-values "subscription_id:id,resource_group_name:id,name:id" -test-name autoShutdownBasic -test-resource-type DevTestGlobalVMShutdownScheduleResource
+
 func resourceDevTestGlobalVMShutdownSchedule() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceDevTestGlobalVMShutdownScheduleCreateUpdate,
-		Read:   resourceDevTestGlobalVMShutdownScheduleRead,
-		Update: resourceDevTestGlobalVMShutdownScheduleCreateUpdate,
-		Delete: resourceDevTestGlobalVMShutdownScheduleDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := globalschedules.ParseScheduleID(id)
-			return err
-		}),
+		Create:   resourceDevTestGlobalVMShutdownScheduleCreateUpdate,
+		Read:     resourceDevTestGlobalVMShutdownScheduleRead,
+		Update:   resourceDevTestGlobalVMShutdownScheduleCreateUpdate,
+		Delete:   resourceDevTestGlobalVMShutdownScheduleDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&globalschedules.ScheduleId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&globalschedules.ScheduleId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -214,7 +218,7 @@ func resourceDevTestGlobalVMShutdownScheduleRead(d *pluginsdk.ResourceData, meta
 			return err
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceDevTestGlobalVMShutdownScheduleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/labs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -23,16 +24,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dev_test_lab -service-package-name devtestlabs -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceDevTestLab() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
-		Create: resourceDevTestLabCreateUpdate,
-		Read:   resourceDevTestLabRead,
-		Update: resourceDevTestLabCreateUpdate,
-		Delete: resourceDevTestLabDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := labs.ParseLabID(id)
-			return err
-		}),
+		Create:   resourceDevTestLabCreateUpdate,
+		Read:     resourceDevTestLabRead,
+		Update:   resourceDevTestLabCreateUpdate,
+		Delete:   resourceDevTestLabDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&labs.LabId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&labs.LabId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -188,7 +192,7 @@ func resourceDevTestLabRead(d *pluginsdk.ResourceData, meta interface{}) error {
 			return err
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceDevTestLabDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/virtualmachines"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -23,16 +24,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dev_test_linux_virtual_machine -service-package-name devtestlabs -properties "resource_group_name,lab_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceArmDevTestLinuxVirtualMachine() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceArmDevTestLinuxVirtualMachineCreateUpdate,
-		Read:   resourceArmDevTestLinuxVirtualMachineRead,
-		Update: resourceArmDevTestLinuxVirtualMachineCreateUpdate,
-		Delete: resourceArmDevTestLinuxVirtualMachineDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := virtualmachines.ParseVirtualMachineID(id)
-			return err
-		}),
+		Create:   resourceArmDevTestLinuxVirtualMachineCreateUpdate,
+		Read:     resourceArmDevTestLinuxVirtualMachineRead,
+		Update:   resourceArmDevTestLinuxVirtualMachineCreateUpdate,
+		Delete:   resourceArmDevTestLinuxVirtualMachineDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&virtualmachines.VirtualMachineId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&virtualmachines.VirtualMachineId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -290,7 +294,7 @@ func resourceArmDevTestLinuxVirtualMachineRead(d *pluginsdk.ResourceData, meta i
 			return err
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceArmDevTestLinuxVirtualMachineDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/policies"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -24,16 +25,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dev_test_policy -service-package-name devtestlabs -properties "resource_group_name,lab_name,policy_set_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceArmDevTestPolicy() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceArmDevTestPolicyCreateUpdate,
-		Read:   resourceArmDevTestPolicyRead,
-		Update: resourceArmDevTestPolicyCreateUpdate,
-		Delete: resourceArmDevTestPolicyDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := policies.ParsePolicyID(id)
-			return err
-		}),
+		Create:   resourceArmDevTestPolicyCreateUpdate,
+		Read:     resourceArmDevTestPolicyRead,
+		Update:   resourceArmDevTestPolicyCreateUpdate,
+		Delete:   resourceArmDevTestPolicyDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&policies.PolicyId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&policies.PolicyId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -199,7 +203,7 @@ func resourceArmDevTestPolicyRead(d *pluginsdk.ResourceData, meta interface{}) e
 			return err
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceArmDevTestPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/schedules"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -24,16 +25,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dev_test_schedule -service-package-name devtestlabs -properties "resource_group_name,lab_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name autoShutdownBasic -test-resource-type DevTestLabScheduleResource
+
 func resourceDevTestLabSchedules() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceDevTestLabSchedulesCreateUpdate,
-		Read:   resourceDevTestLabSchedulesRead,
-		Update: resourceDevTestLabSchedulesCreateUpdate,
-		Delete: resourceDevTestLabSchedulesDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := schedules.ParseLabScheduleID(id)
-			return err
-		}),
+		Create:   resourceDevTestLabSchedulesCreateUpdate,
+		Read:     resourceDevTestLabSchedulesRead,
+		Update:   resourceDevTestLabSchedulesCreateUpdate,
+		Delete:   resourceDevTestLabSchedulesDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&schedules.LabScheduleId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&schedules.LabScheduleId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -316,7 +320,7 @@ func resourceDevTestLabSchedulesRead(d *pluginsdk.ResourceData, meta interface{}
 			return err
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceDevTestLabSchedulesDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/digitaltwins/2023-01-31/digitaltwinsinstance"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/digitaltwins/2023-01-31/endpoints"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/digitaltwins/validate"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name digital_twins_endpoint_eventgrid -service-package-name digitaltwins -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,digital_twins_instance_name:id" -test-resource-type DigitalTwinsEndpointEventGridResource
+
 func resourceDigitalTwinsEndpointEventGrid() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceDigitalTwinsEndpointEventGridCreateUpdate,
@@ -34,16 +37,17 @@ func resourceDigitalTwinsEndpointEventGrid() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := endpoints.ParseEndpointID(id)
-			return err
-		}, validateEndpointType(func(input endpoints.DigitalTwinsEndpointResourceProperties) error {
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&endpoints.EndpointId{}, validateEndpointType(func(input endpoints.DigitalTwinsEndpointResourceProperties) error {
 			if _, ok := input.(endpoints.EventGrid); !ok {
 				return fmt.Errorf("expected an EventGrid type but got: %+v", input)
 			}
 			return nil
 		})),
 
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&endpoints.EndpointId{}),
+		},
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -160,7 +164,7 @@ func resourceDigitalTwinsEndpointEventGridRead(d *pluginsdk.ResourceData, meta i
 		d.Set("eventgrid_topic_endpoint", props.TopicEndpoint)
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceDigitalTwinsEndpointEventGridDelete(d *pluginsdk.ResourceData, meta interface{}) error {
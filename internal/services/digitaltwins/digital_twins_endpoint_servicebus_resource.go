@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/digitaltwins/2023-01-31/digitaltwinsinstance"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/digitaltwins/2023-01-31/endpoints"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/digitaltwins/validate"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name digital_twins_endpoint_servicebus -service-package-name digitaltwins -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,digital_twins_instance_name:id" -test-resource-type DigitalTwinsEndpointServiceBusResource
+
 func resourceDigitalTwinsEndpointServiceBus() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceDigitalTwinsEndpointServiceBusCreateUpdate,
@@ -35,16 +38,17 @@ func resourceDigitalTwinsEndpointServiceBus() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := endpoints.ParseEndpointID(id)
-			return err
-		}, validateEndpointType(func(input endpoints.DigitalTwinsEndpointResourceProperties) error {
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&endpoints.EndpointId{}, validateEndpointType(func(input endpoints.DigitalTwinsEndpointResourceProperties) error {
 			if _, ok := input.(endpoints.ServiceBus); !ok {
 				return fmt.Errorf("expected an ServiceBus type but got: %+v", input)
 			}
 			return nil
 		})),
 
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&endpoints.EndpointId{}),
+		},
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -154,7 +158,7 @@ func resourceDigitalTwinsEndpointServiceBusRead(d *pluginsdk.ResourceData, meta
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceDigitalTwinsEndpointServiceBusDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/digitaltwins/2023-01-31/timeseriesdatabaseconnections"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -34,8 +35,12 @@ type TimeSeriesDatabaseConnectionModel struct {
 	KustoTableName               string `tfschema:"kusto_table_name"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name digital_twins_time_series_database_connection -service-package-name digitaltwins -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,digital_twins_instance_name:id" -test-resource-type TimeSeriesDatabaseConnectionResource
+
 type TimeSeriesDatabaseConnectionResource struct{}
 
+var _ sdk.ResourceWithIdentity = TimeSeriesDatabaseConnectionResource{}
+
 func (m TimeSeriesDatabaseConnectionResource) Arguments() map[string]*pluginsdk.Schema {
 	resource := map[string]*pluginsdk.Schema{
 		"name": {
@@ -130,6 +135,10 @@ func (m TimeSeriesDatabaseConnectionResource) IDValidationFunc() pluginsdk.Schem
 	return timeseriesdatabaseconnections.ValidateTimeSeriesDatabaseConnectionID
 }
 
+func (m TimeSeriesDatabaseConnectionResource) Identity() resourceids.ResourceId {
+	return &timeseriesdatabaseconnections.TimeSeriesDatabaseConnectionId{}
+}
+
 func (m TimeSeriesDatabaseConnectionResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
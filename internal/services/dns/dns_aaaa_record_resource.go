@@ -4,6 +4,7 @@
 package dns
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -23,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dns_aaaa_record -service-package-name dns -properties "resource_group_name,dns_zone_name:zone_name,name" -known-values "subscription_id:data.Subscriptions.Primary,record_type:AAAA" -test-resource-type DnsAAAARecordResource
+
 func resourceDnsAAAARecord() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceDnsAaaaRecordCreateUpdate,
@@ -37,17 +41,21 @@ func resourceDnsAAAARecord() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			parsed, err := recordsets.ParseRecordTypeID(id)
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&recordsets.RecordTypeId{}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+			parsed, err := recordsets.ParseRecordTypeID(d.Id())
 			if err != nil {
-				return err
+				return nil, err
 			}
 			if parsed.RecordType != recordsets.RecordTypeAAAA {
-				return fmt.Errorf("this resource only supports 'AAAA' records")
+				return nil, fmt.Errorf("this resource only supports 'AAAA' records")
 			}
-			return nil
+			return []*pluginsdk.ResourceData{d}, nil
 		}),
 
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&recordsets.RecordTypeId{}),
+		},
+
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
 			0: migration.AAAARecordV0ToV1{},
@@ -201,7 +209,7 @@ func resourceDnsAaaaRecordRead(d *pluginsdk.ResourceData, meta interface{}) erro
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceDnsAaaaRecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -5,6 +5,7 @@ package dns
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/migration"
@@ -20,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dns_caa_record -service-package-name dns -properties "resource_group_name,dns_zone_name:zone_name,name" -known-values "subscription_id:data.Subscriptions.Primary,record_type:CAA"
+
 func resourceDnsCaaRecord() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceDnsCaaRecordCreateUpdate,
@@ -34,17 +38,21 @@ func resourceDnsCaaRecord() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			parsed, err := recordsets.ParseRecordTypeID(id)
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&recordsets.RecordTypeId{}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+			parsed, err := recordsets.ParseRecordTypeID(d.Id())
 			if err != nil {
-				return err
+				return nil, err
 			}
 			if parsed.RecordType != recordsets.RecordTypeCAA {
-				return fmt.Errorf("this resource only supports 'CAA' records")
+				return nil, fmt.Errorf("this resource only supports 'CAA' records")
 			}
-			return nil
+			return []*pluginsdk.ResourceData{d}, nil
 		}),
 
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&recordsets.RecordTypeId{}),
+		},
+
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
 			0: migration.CAARecordV0ToV1{},
@@ -192,7 +200,7 @@ func resourceDnsCaaRecordRead(d *pluginsdk.ResourceData, meta interface{}) error
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceDnsCaaRecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -5,6 +5,7 @@ package dns
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"strconv"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/migration"
@@ -20,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dns_mx_record -service-package-name dns -properties "resource_group_name,dns_zone_name:zone_name,name" -known-values "subscription_id:data.Subscriptions.Primary,record_type:MX"
+
 func resourceDnsMxRecord() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceDnsMxRecordCreateUpdate,
@@ -34,17 +38,21 @@ func resourceDnsMxRecord() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			parsed, err := recordsets.ParseRecordTypeID(id)
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&recordsets.RecordTypeId{}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+			parsed, err := recordsets.ParseRecordTypeID(d.Id())
 			if err != nil {
-				return err
+				return nil, err
 			}
 			if parsed.RecordType != recordsets.RecordTypeMX {
-				return fmt.Errorf("this resource only supports 'MX' records")
+				return nil, fmt.Errorf("this resource only supports 'MX' records")
 			}
-			return nil
+			return []*pluginsdk.ResourceData{d}, nil
 		}),
 
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&recordsets.RecordTypeId{}),
+		},
+
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
 			0: migration.MXRecordV0ToV1{},
@@ -182,7 +190,7 @@ func resourceDnsMxRecordRead(d *pluginsdk.ResourceData, meta interface{}) error
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceDnsMxRecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -26,8 +27,11 @@ import (
 var (
 	_ sdk.ResourceWithUpdate         = DnsZoneResource{}
 	_ sdk.ResourceWithStateMigration = DnsZoneResource{}
+	_ sdk.ResourceWithIdentity       = DnsZoneResource{}
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dns_zone -service-package-name dns -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type DnsZoneResource struct{}
 
 func (DnsZoneResource) ModelObject() interface{} {
@@ -38,6 +42,10 @@ func (DnsZoneResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return zones.ValidateDnsZoneID
 }
 
+func (DnsZoneResource) Identity() resourceids.ResourceId {
+	return &zones.DnsZoneId{}
+}
+
 func (DnsZoneResource) ResourceType() string {
 	return "azurerm_dns_zone"
 }
@@ -297,6 +305,10 @@ func (DnsZoneResource) Read() sdk.ResourceFunc {
 				state.Tags = pointer.From(model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
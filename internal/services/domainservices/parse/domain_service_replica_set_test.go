@@ -21,6 +21,24 @@ func TestDomainServiceReplicaSetIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDomainServiceReplicaSetIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&DomainServiceReplicaSetId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AAD/domainServices/DomainService1/replicaSets/replicaSetID", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual DomainServiceReplicaSetId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AAD/domainServices/DomainService1/replicaSets/replicaSetID"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestDomainServiceReplicaSetID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,24 @@ func TestDomainServiceTrustIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDomainServiceTrustIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&DomainServiceTrustId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AAD/domainServices/DomainService1/trusts/trust1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual DomainServiceTrustId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AAD/domainServices/DomainService1/trusts/trust1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestDomainServiceTrustID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/elasticsan/2023-01-01/elasticsans"
@@ -21,12 +22,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name elastic_san -service-package-name elasticsan -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type ElasticSANTestResource
+
 var (
 	_ sdk.Resource                  = ElasticSANResource{}
 	_ sdk.ResourceWithUpdate        = ElasticSANResource{}
 	_ sdk.ResourceWithCustomizeDiff = ElasticSANResource{}
+	_ sdk.ResourceWithIdentity      = ElasticSANResource{}
 )
 
+func (r ElasticSANResource) Identity() resourceids.ResourceId {
+	return &elasticsans.ElasticSanId{}
+}
+
 type ElasticSANResource struct{}
 
 func (r ElasticSANResource) ModelObject() interface{} {
@@ -267,6 +275,10 @@ func (r ElasticSANResource) Read() sdk.ResourceFunc {
 				state.VolumeGroupCount = pointer.From(prop.VolumeGroupCount)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/elasticsan/2023-01-01/volumegroups"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elasticsan/validate"
@@ -22,12 +23,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name elastic_san_volume_group -service-package-name elasticsan -properties "name" -compare-values "subscription_id:id,resource_group_name:id,elastic_san_name:id" -test-resource-type ElasticSANVolumeGroupTestResource
+
 var (
 	_ sdk.Resource                  = ElasticSANVolumeGroupResource{}
 	_ sdk.ResourceWithUpdate        = ElasticSANVolumeGroupResource{}
 	_ sdk.ResourceWithCustomizeDiff = ElasticSANVolumeGroupResource{}
+	_ sdk.ResourceWithIdentity      = ElasticSANVolumeGroupResource{}
 )
 
+func (r ElasticSANVolumeGroupResource) Identity() resourceids.ResourceId {
+	return &volumegroups.VolumeGroupId{}
+}
+
 type ElasticSANVolumeGroupResource struct{}
 
 func (r ElasticSANVolumeGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
@@ -283,6 +291,10 @@ func (r ElasticSANVolumeGroupResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
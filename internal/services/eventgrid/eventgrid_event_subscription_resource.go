@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2025-02-15/eventsubscriptions"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -34,6 +35,8 @@ func possibleEventSubscriptionEndpointTypes() []string {
 	}
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name eventgrid_event_subscription -service-package-name eventgrid -properties "scope,name" -test-resource-type EventGridEventSubscriptionResource
+
 func resourceEventGridEventSubscription() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceEventGridEventSubscriptionCreateUpdate,
@@ -69,10 +72,11 @@ func resourceEventGridEventSubscription() *pluginsdk.Resource {
 			return nil
 		}),
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := eventsubscriptions.ParseScopedEventSubscriptionID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&eventsubscriptions.ScopedEventSubscriptionId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&eventsubscriptions.ScopedEventSubscriptionId{}),
+		},
 
 		Schema: map[string]*pluginsdk.Schema{
 			"name": eventSubscriptionSchemaEventSubscriptionName(),
@@ -368,7 +372,7 @@ func resourceEventGridEventSubscriptionRead(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceEventGridEventSubscriptionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
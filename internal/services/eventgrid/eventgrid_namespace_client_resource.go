@@ -0,0 +1,356 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/namespaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2025-02-15/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name eventgrid_namespace_client -service-package-name eventgrid -properties "name" -compare-values "subscription_id:namespace_id,resource_group_name:namespace_id,namespace_name:namespace_id" -test-resource-type EventGridNamespaceClientResource
+
+var (
+	_ sdk.ResourceWithUpdate        = EventGridNamespaceClientResource{}
+	_ sdk.ResourceWithIdentity      = EventGridNamespaceClientResource{}
+	_ sdk.ResourceWithCustomizeDiff = EventGridNamespaceClientResource{}
+)
+
+type EventGridNamespaceClientResource struct{}
+
+type EventGridNamespaceClientResourceModel struct {
+	Name                      string                                `tfschema:"name"`
+	NamespaceId               string                                `tfschema:"namespace_id"`
+	Attributes                map[string]string                     `tfschema:"attributes"`
+	AuthenticationName        string                                `tfschema:"authentication_name"`
+	CertificateAuthentication []NamespaceClientCertificateAuthModel `tfschema:"certificate_authentication"`
+	Description               string                                `tfschema:"description"`
+	Enabled                   bool                                  `tfschema:"enabled"`
+}
+
+type NamespaceClientCertificateAuthModel struct {
+	AllowedThumbprints []string `tfschema:"allowed_thumbprints"`
+	ValidationScheme   string   `tfschema:"validation_scheme"`
+}
+
+func (r EventGridNamespaceClientResource) Identity() resourceids.ResourceId {
+	return &clients.ClientId{}
+}
+
+func (r EventGridNamespaceClientResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[-a-zA-Z0-9:._]{1,128}$`),
+				"`name` must be 1 - 128 characters long, contain only letters, numbers, hyphens, colons, periods and underscores.",
+			),
+		},
+
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: namespaces.ValidateNamespaceID,
+		},
+
+		"attributes": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		// defaults to the `name` of the Client when omitted
+		"authentication_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringLenBetween(1, 128),
+		},
+
+		"certificate_authentication": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"validation_scheme": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(clients.PossibleValuesForClientCertificateValidationScheme(), false),
+					},
+
+					"allowed_thumbprints": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 2,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 128),
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EventGridNamespaceClientResource) ModelObject() interface{} {
+	return &EventGridNamespaceClientResourceModel{}
+}
+
+func (r EventGridNamespaceClientResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace_client"
+}
+
+func (r EventGridNamespaceClientResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return clients.ValidateClientID
+}
+
+func (r EventGridNamespaceClientResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model EventGridNamespaceClientResourceModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			for _, v := range model.CertificateAuthentication {
+				isThumbprintMatch := v.ValidationScheme == string(clients.ClientCertificateValidationSchemeThumbprintMatch)
+				if isThumbprintMatch && len(v.AllowedThumbprints) == 0 {
+					return fmt.Errorf("`allowed_thumbprints` must be specified when `validation_scheme` is `%s`", clients.ClientCertificateValidationSchemeThumbprintMatch)
+				}
+				if !isThumbprintMatch && len(v.AllowedThumbprints) > 0 {
+					return fmt.Errorf("`allowed_thumbprints` can only be specified when `validation_scheme` is `%s`", clients.ClientCertificateValidationSchemeThumbprintMatch)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.Clients
+
+			var model EventGridNamespaceClientResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			namespaceId, err := namespaces.ParseNamespaceID(model.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := clients.NewClientID(namespaceId.SubscriptionId, namespaceId.ResourceGroupName, namespaceId.NamespaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandNamespaceClient(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.Clients
+
+			id, err := clients.ParseClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EventGridNamespaceClientResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, expandNamespaceClient(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.Clients
+
+			id, err := clients.ParseClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespaceClientResourceModel{
+				Name:        id.ClientName,
+				NamespaceId: namespaces.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Attributes = flattenNamespaceClientAttributes(props.Attributes)
+					state.AuthenticationName = pointer.From(props.AuthenticationName)
+					state.CertificateAuthentication = flattenNamespaceClientCertificateAuthentication(props.ClientCertificateAuthentication)
+					state.Description = pointer.From(props.Description)
+					state.Enabled = pointer.From(props.State) == clients.ClientStateEnabled
+				}
+			}
+
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.Clients
+
+			id, err := clients.ParseClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandNamespaceClient(input EventGridNamespaceClientResourceModel) clients.Client {
+	state := clients.ClientStateDisabled
+	if input.Enabled {
+		state = clients.ClientStateEnabled
+	}
+
+	props := clients.ClientProperties{
+		State: pointer.To(state),
+	}
+
+	if len(input.Attributes) > 0 {
+		attributes := make(map[string]interface{}, len(input.Attributes))
+		for k, v := range input.Attributes {
+			attributes[k] = v
+		}
+		props.Attributes = pointer.To(attributes)
+	}
+
+	if input.AuthenticationName != "" {
+		props.AuthenticationName = pointer.To(input.AuthenticationName)
+	}
+
+	if len(input.CertificateAuthentication) > 0 {
+		v := input.CertificateAuthentication[0]
+		props.ClientCertificateAuthentication = &clients.ClientCertificateAuthentication{
+			ValidationScheme: pointer.To(clients.ClientCertificateValidationScheme(v.ValidationScheme)),
+		}
+		if len(v.AllowedThumbprints) > 0 {
+			props.ClientCertificateAuthentication.AllowedThumbprints = pointer.To(v.AllowedThumbprints)
+		}
+	}
+
+	if input.Description != "" {
+		props.Description = pointer.To(input.Description)
+	}
+
+	return clients.Client{
+		Properties: &props,
+	}
+}
+
+func flattenNamespaceClientAttributes(input *map[string]interface{}) map[string]string {
+	output := make(map[string]string)
+	if input == nil {
+		return output
+	}
+
+	// only string attributes can be managed by this resource, other values (e.g. arrays) are ignored
+	for k, v := range *input {
+		if s, ok := v.(string); ok {
+			output[k] = s
+		}
+	}
+	return output
+}
+
+func flattenNamespaceClientCertificateAuthentication(input *clients.ClientCertificateAuthentication) []NamespaceClientCertificateAuthModel {
+	output := make([]NamespaceClientCertificateAuthModel, 0)
+	if input == nil || input.ValidationScheme == nil {
+		return output
+	}
+
+	return append(output, NamespaceClientCertificateAuthModel{
+		AllowedThumbprints: pointer.From(input.AllowedThumbprints),
+		ValidationScheme:   string(pointer.From(input.ValidationScheme)),
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/namespaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2025-02-15/topics"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -21,7 +22,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name eventgrid_namespace -service-package-name eventgrid -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type EventGridNamespaceResource
+
 var _ sdk.ResourceWithUpdate = EventGridNamespaceResource{}
+var _ sdk.ResourceWithIdentity = EventGridNamespaceResource{}
+
+func (r EventGridNamespaceResource) Identity() resourceids.ResourceId {
+	return &namespaces.NamespaceId{}
+}
 
 type EventGridNamespaceResource struct{}
 
@@ -383,6 +391,10 @@ func (r EventGridNamespaceResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -0,0 +1,602 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2025-02-15/eventsubscriptions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2025-02-15/namespacetopics"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
+	serviceBusQueues "github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/queues"
+	serviceBusTopics "github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/topics"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name eventgrid_namespace_topic_event_subscription -service-package-name eventgrid -properties "name" -compare-values "subscription_id:namespace_topic_id,resource_group_name:namespace_topic_id,namespace_name:namespace_topic_id,topic_name:namespace_topic_id" -test-resource-type EventGridNamespaceTopicEventSubscriptionResource
+
+var (
+	_ sdk.ResourceWithUpdate   = EventGridNamespaceTopicEventSubscriptionResource{}
+	_ sdk.ResourceWithIdentity = EventGridNamespaceTopicEventSubscriptionResource{}
+)
+
+type EventGridNamespaceTopicEventSubscriptionResource struct{}
+
+type EventGridNamespaceTopicEventSubscriptionResourceModel struct {
+	Name                string                                 `tfschema:"name"`
+	NamespaceTopicId    string                                 `tfschema:"namespace_topic_id"`
+	EventDeliverySchema string                                 `tfschema:"event_delivery_schema"`
+	IncludedEventTypes  []string                               `tfschema:"included_event_types"`
+	Push                []NamespaceTopicEventSubscriptionPush  `tfschema:"push"`
+	Queue               []NamespaceTopicEventSubscriptionQueue `tfschema:"queue"`
+}
+
+type NamespaceTopicEventSubscriptionPush struct {
+	EventHubId             string                                   `tfschema:"eventhub_id"`
+	ServiceBusQueueId      string                                   `tfschema:"service_bus_queue_id"`
+	ServiceBusTopicId      string                                   `tfschema:"service_bus_topic_id"`
+	Webhook                []NamespaceTopicEventSubscriptionWebhook `tfschema:"webhook"`
+	EventTimeToLive        string                                   `tfschema:"event_time_to_live"`
+	MaxDeliveryCount       int64                                    `tfschema:"max_delivery_count"`
+	UserAssignedIdentityId string                                   `tfschema:"user_assigned_identity_id"`
+}
+
+type NamespaceTopicEventSubscriptionWebhook struct {
+	Url                           string `tfschema:"url"`
+	BaseUrl                       string `tfschema:"base_url"`
+	MaxEventsPerBatch             int64  `tfschema:"max_events_per_batch"`
+	PreferredBatchSizeInKilobytes int64  `tfschema:"preferred_batch_size_in_kilobytes"`
+	ActiveDirectoryTenantId       string `tfschema:"active_directory_tenant_id"`
+	ActiveDirectoryAppIdOrUri     string `tfschema:"active_directory_app_id_or_uri"`
+}
+
+type NamespaceTopicEventSubscriptionQueue struct {
+	EventTimeToLive              string `tfschema:"event_time_to_live"`
+	MaxDeliveryCount             int64  `tfschema:"max_delivery_count"`
+	ReceiveLockDurationInSeconds int64  `tfschema:"receive_lock_duration_in_seconds"`
+}
+
+var namespaceTopicEventSubscriptionPushDestinations = []string{
+	"push.0.eventhub_id",
+	"push.0.service_bus_queue_id",
+	"push.0.service_bus_topic_id",
+	"push.0.webhook",
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Identity() resourceids.ResourceId {
+	return &eventsubscriptions.NamespaceTopicEventSubscriptionId{}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[-a-zA-Z0-9]{3,50}$"),
+				"`name` must be 3 - 50 characters long, contain only letters, numbers and hyphens.",
+			),
+		},
+
+		"namespace_topic_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: namespacetopics.ValidateNamespaceTopicID,
+		},
+
+		"event_delivery_schema": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(eventsubscriptions.DeliverySchemaCloudEventSchemaVOneZero),
+			ValidateFunc: validation.StringInSlice(eventsubscriptions.PossibleValuesForDeliverySchema(), false),
+		},
+
+		"included_event_types": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"push": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"push", "queue"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"eventhub_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: eventhubs.ValidateEventhubID,
+						ExactlyOneOf: namespaceTopicEventSubscriptionPushDestinations,
+					},
+
+					"service_bus_queue_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: serviceBusQueues.ValidateQueueID,
+						ExactlyOneOf: namespaceTopicEventSubscriptionPushDestinations,
+					},
+
+					"service_bus_topic_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: serviceBusTopics.ValidateTopicID,
+						ExactlyOneOf: namespaceTopicEventSubscriptionPushDestinations,
+					},
+
+					"webhook": {
+						Type:         pluginsdk.TypeList,
+						Optional:     true,
+						MaxItems:     1,
+						ExactlyOneOf: namespaceTopicEventSubscriptionPushDestinations,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"url": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.IsURLWithHTTPS,
+								},
+
+								"base_url": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"max_events_per_batch": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      1,
+									ValidateFunc: validation.IntBetween(1, 5000),
+								},
+
+								"preferred_batch_size_in_kilobytes": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      64,
+									ValidateFunc: validation.IntBetween(1, 1024),
+								},
+
+								"active_directory_tenant_id": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.IsUUID,
+								},
+
+								"active_directory_app_id_or_uri": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"event_time_to_live": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      "P7D",
+						ValidateFunc: validate.ISO8601DurationBetween("PT1M", "P7D"),
+					},
+
+					"max_delivery_count": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      10,
+						ValidateFunc: validation.IntBetween(1, 10),
+					},
+
+					// delivery to Event Hubs and Service Bus uses the System Assigned Identity of the Namespace when this is omitted
+					"user_assigned_identity_id": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						ValidateFunc:  commonids.ValidateUserAssignedIdentityID,
+						ConflictsWith: []string{"push.0.webhook"},
+					},
+				},
+			},
+		},
+
+		"queue": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"push", "queue"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"event_time_to_live": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      "P7D",
+						ValidateFunc: validate.ISO8601DurationBetween("PT1M", "P7D"),
+					},
+
+					"max_delivery_count": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      10,
+						ValidateFunc: validation.IntBetween(1, 10),
+					},
+
+					"receive_lock_duration_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      60,
+						ValidateFunc: validation.IntBetween(60, 300),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) ModelObject() interface{} {
+	return &EventGridNamespaceTopicEventSubscriptionResourceModel{}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace_topic_event_subscription"
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return eventsubscriptions.ValidateNamespaceTopicEventSubscriptionID
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.EventSubscriptions
+
+			var model EventGridNamespaceTopicEventSubscriptionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			topicId, err := namespacetopics.ParseNamespaceTopicID(model.NamespaceTopicId)
+			if err != nil {
+				return err
+			}
+
+			id := eventsubscriptions.NewNamespaceTopicEventSubscriptionID(topicId.SubscriptionId, topicId.ResourceGroupName, topicId.NamespaceName, topicId.TopicName, model.Name)
+
+			existing, err := client.NamespaceTopicEventSubscriptionsGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := eventsubscriptions.Subscription{
+				Properties: &eventsubscriptions.SubscriptionProperties{
+					DeliveryConfiguration: expandNamespaceTopicEventSubscriptionDeliveryConfiguration(model),
+					EventDeliverySchema:   pointer.To(eventsubscriptions.DeliverySchema(model.EventDeliverySchema)),
+					FiltersConfiguration: &eventsubscriptions.FiltersConfiguration{
+						IncludedEventTypes: pointer.To(model.IncludedEventTypes),
+					},
+				},
+			}
+
+			if err := client.NamespaceTopicEventSubscriptionsCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.EventSubscriptions
+
+			id, err := eventsubscriptions.ParseNamespaceTopicEventSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EventGridNamespaceTopicEventSubscriptionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := eventsubscriptions.SubscriptionUpdateParameters{
+				Properties: &eventsubscriptions.SubscriptionUpdateParametersProperties{},
+			}
+
+			if metadata.ResourceData.HasChanges("push", "queue") {
+				payload.Properties.DeliveryConfiguration = expandNamespaceTopicEventSubscriptionDeliveryConfiguration(model)
+			}
+
+			if metadata.ResourceData.HasChange("event_delivery_schema") {
+				payload.Properties.EventDeliverySchema = pointer.To(eventsubscriptions.DeliverySchema(model.EventDeliverySchema))
+			}
+
+			if metadata.ResourceData.HasChange("included_event_types") {
+				payload.Properties.FiltersConfiguration = &eventsubscriptions.FiltersConfiguration{
+					IncludedEventTypes: pointer.To(model.IncludedEventTypes),
+				}
+			}
+
+			if err := client.NamespaceTopicEventSubscriptionsUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.EventSubscriptions
+
+			id, err := eventsubscriptions.ParseNamespaceTopicEventSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.NamespaceTopicEventSubscriptionsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespaceTopicEventSubscriptionResourceModel{
+				Name:             id.EventSubscriptionName,
+				NamespaceTopicId: namespacetopics.NewNamespaceTopicID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName, id.TopicName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.EventDeliverySchema = string(pointer.From(props.EventDeliverySchema))
+
+					if filters := props.FiltersConfiguration; filters != nil {
+						state.IncludedEventTypes = pointer.From(filters.IncludedEventTypes)
+					}
+
+					if delivery := props.DeliveryConfiguration; delivery != nil {
+						// the full URL of a webhook (which can contain secrets) is only returned by a separate API
+						var fullUrl *eventsubscriptions.SubscriptionFullURL
+						if push := delivery.Push; push != nil {
+							if _, ok := push.Destination.(eventsubscriptions.WebHookEventSubscriptionDestination); ok {
+								fullUrlResp, err := client.NamespaceTopicEventSubscriptionsGetFullURL(ctx, *id)
+								if err != nil {
+									return fmt.Errorf("retrieving the full URL of %s: %+v", *id, err)
+								}
+								fullUrl = fullUrlResp.Model
+							}
+						}
+
+						push, err := flattenNamespaceTopicEventSubscriptionPush(delivery.Push, fullUrl)
+						if err != nil {
+							return fmt.Errorf("flattening `push`: %+v", err)
+						}
+						state.Push = push
+						state.Queue = flattenNamespaceTopicEventSubscriptionQueue(delivery.Queue)
+					}
+				}
+			}
+
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.EventSubscriptions
+
+			id, err := eventsubscriptions.ParseNamespaceTopicEventSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.NamespaceTopicEventSubscriptionsDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandNamespaceTopicEventSubscriptionDeliveryConfiguration(input EventGridNamespaceTopicEventSubscriptionResourceModel) *eventsubscriptions.DeliveryConfiguration {
+	if len(input.Push) > 0 {
+		push := input.Push[0]
+
+		pushInfo := &eventsubscriptions.PushInfo{
+			EventTimeToLive:  pointer.To(push.EventTimeToLive),
+			MaxDeliveryCount: pointer.To(push.MaxDeliveryCount),
+		}
+
+		if len(push.Webhook) > 0 {
+			webhook := push.Webhook[0]
+			properties := &eventsubscriptions.WebHookEventSubscriptionDestinationProperties{
+				EndpointURL:                   pointer.To(webhook.Url),
+				MaxEventsPerBatch:             pointer.To(webhook.MaxEventsPerBatch),
+				PreferredBatchSizeInKilobytes: pointer.To(webhook.PreferredBatchSizeInKilobytes),
+			}
+			if webhook.ActiveDirectoryTenantId != "" {
+				properties.AzureActiveDirectoryTenantId = pointer.To(webhook.ActiveDirectoryTenantId)
+			}
+			if webhook.ActiveDirectoryAppIdOrUri != "" {
+				properties.AzureActiveDirectoryApplicationIdOrUri = pointer.To(webhook.ActiveDirectoryAppIdOrUri)
+			}
+
+			pushInfo.Destination = eventsubscriptions.WebHookEventSubscriptionDestination{
+				Properties: properties,
+			}
+		} else {
+			identity := &eventsubscriptions.EventSubscriptionIdentity{
+				Type: pointer.To(eventsubscriptions.EventSubscriptionIdentityTypeSystemAssigned),
+			}
+			if push.UserAssignedIdentityId != "" {
+				identity = &eventsubscriptions.EventSubscriptionIdentity{
+					Type:                 pointer.To(eventsubscriptions.EventSubscriptionIdentityTypeUserAssigned),
+					UserAssignedIdentity: pointer.To(push.UserAssignedIdentityId),
+				}
+			}
+
+			// delivery to Event Hubs and Service Bus from a Namespace Topic is only possible using a Managed Identity
+			var destination eventsubscriptions.EventSubscriptionDestination
+			switch {
+			case push.ServiceBusQueueId != "":
+				destination = eventsubscriptions.ServiceBusQueueEventSubscriptionDestination{
+					Properties: &eventsubscriptions.ServiceBusQueueEventSubscriptionDestinationProperties{
+						ResourceId: pointer.To(push.ServiceBusQueueId),
+					},
+				}
+			case push.ServiceBusTopicId != "":
+				destination = eventsubscriptions.ServiceBusTopicEventSubscriptionDestination{
+					Properties: &eventsubscriptions.ServiceBusTopicEventSubscriptionDestinationProperties{
+						ResourceId: pointer.To(push.ServiceBusTopicId),
+					},
+				}
+			default:
+				destination = eventsubscriptions.EventHubEventSubscriptionDestination{
+					Properties: &eventsubscriptions.EventHubEventSubscriptionDestinationProperties{
+						ResourceId: pointer.To(push.EventHubId),
+					},
+				}
+			}
+
+			pushInfo.DeliveryWithResourceIdentity = &eventsubscriptions.DeliveryWithResourceIdentity{
+				Destination: destination,
+				Identity:    identity,
+			}
+		}
+
+		return &eventsubscriptions.DeliveryConfiguration{
+			DeliveryMode: pointer.To(eventsubscriptions.DeliveryModePush),
+			Push:         pushInfo,
+		}
+	}
+
+	if len(input.Queue) > 0 {
+		queue := input.Queue[0]
+		return &eventsubscriptions.DeliveryConfiguration{
+			DeliveryMode: pointer.To(eventsubscriptions.DeliveryModeQueue),
+			Queue: &eventsubscriptions.QueueInfo{
+				EventTimeToLive:              pointer.To(queue.EventTimeToLive),
+				MaxDeliveryCount:             pointer.To(queue.MaxDeliveryCount),
+				ReceiveLockDurationInSeconds: pointer.To(queue.ReceiveLockDurationInSeconds),
+			},
+		}
+	}
+
+	return nil
+}
+
+func flattenNamespaceTopicEventSubscriptionPush(input *eventsubscriptions.PushInfo, fullUrl *eventsubscriptions.SubscriptionFullURL) ([]NamespaceTopicEventSubscriptionPush, error) {
+	output := make([]NamespaceTopicEventSubscriptionPush, 0)
+	if input == nil {
+		return output, nil
+	}
+
+	push := NamespaceTopicEventSubscriptionPush{
+		EventTimeToLive:  pointer.From(input.EventTimeToLive),
+		MaxDeliveryCount: pointer.From(input.MaxDeliveryCount),
+	}
+
+	if destination, ok := input.Destination.(eventsubscriptions.WebHookEventSubscriptionDestination); ok && destination.Properties != nil {
+		webhook := NamespaceTopicEventSubscriptionWebhook{
+			BaseUrl:                       pointer.From(destination.Properties.EndpointBaseURL),
+			MaxEventsPerBatch:             pointer.From(destination.Properties.MaxEventsPerBatch),
+			PreferredBatchSizeInKilobytes: pointer.From(destination.Properties.PreferredBatchSizeInKilobytes),
+			ActiveDirectoryTenantId:       pointer.From(destination.Properties.AzureActiveDirectoryTenantId),
+			ActiveDirectoryAppIdOrUri:     pointer.From(destination.Properties.AzureActiveDirectoryApplicationIdOrUri),
+		}
+		if fullUrl != nil {
+			webhook.Url = pointer.From(fullUrl.EndpointURL)
+		}
+		push.Webhook = []NamespaceTopicEventSubscriptionWebhook{webhook}
+	}
+
+	if delivery := input.DeliveryWithResourceIdentity; delivery != nil {
+		switch destination := delivery.Destination.(type) {
+		case eventsubscriptions.EventHubEventSubscriptionDestination:
+			if destination.Properties != nil {
+				eventHubId, err := eventhubs.ParseEventhubIDInsensitively(pointer.From(destination.Properties.ResourceId))
+				if err != nil {
+					return nil, err
+				}
+				push.EventHubId = eventHubId.ID()
+			}
+		case eventsubscriptions.ServiceBusQueueEventSubscriptionDestination:
+			if destination.Properties != nil {
+				queueId, err := serviceBusQueues.ParseQueueIDInsensitively(pointer.From(destination.Properties.ResourceId))
+				if err != nil {
+					return nil, err
+				}
+				push.ServiceBusQueueId = queueId.ID()
+			}
+		case eventsubscriptions.ServiceBusTopicEventSubscriptionDestination:
+			if destination.Properties != nil {
+				topicId, err := serviceBusTopics.ParseTopicIDInsensitively(pointer.From(destination.Properties.ResourceId))
+				if err != nil {
+					return nil, err
+				}
+				push.ServiceBusTopicId = topicId.ID()
+			}
+		}
+
+		if identity := delivery.Identity; identity != nil && pointer.From(identity.Type) == eventsubscriptions.EventSubscriptionIdentityTypeUserAssigned {
+			identityId, err := commonids.ParseUserAssignedIdentityIDInsensitively(pointer.From(identity.UserAssignedIdentity))
+			if err != nil {
+				return nil, err
+			}
+			push.UserAssignedIdentityId = identityId.ID()
+		}
+	}
+
+	return append(output, push), nil
+}
+
+func flattenNamespaceTopicEventSubscriptionQueue(input *eventsubscriptions.QueueInfo) []NamespaceTopicEventSubscriptionQueue {
+	output := make([]NamespaceTopicEventSubscriptionQueue, 0)
+	if input == nil {
+		return output
+	}
+
+	return append(output, NamespaceTopicEventSubscriptionQueue{
+		EventTimeToLive:              pointer.From(input.EventTimeToLive),
+		MaxDeliveryCount:             pointer.From(input.MaxDeliveryCount),
+		ReceiveLockDurationInSeconds: pointer.From(input.ReceiveLockDurationInSeconds),
+	})
+}
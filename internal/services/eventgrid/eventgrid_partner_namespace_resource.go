@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2025-02-15/partnernamespaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2025-02-15/partnerregistrations"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -21,6 +22,9 @@ import (
 )
 
 var _ sdk.ResourceWithUpdate = EventGridPartnerNamespaceResource{}
+var _ sdk.ResourceWithIdentity = EventGridPartnerNamespaceResource{}
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name eventgrid_partner_namespace -service-package-name eventgrid -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type EventGridPartnerNamespaceTestResource
 
 type EventGridPartnerNamespaceResource struct{}
 
@@ -262,6 +266,10 @@ func (r EventGridPartnerNamespaceResource) Read() sdk.ResourceFunc {
 					state.Tags = pointer.From(model.Tags)
 				}
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -290,6 +298,10 @@ func (EventGridPartnerNamespaceResource) IDValidationFunc() pluginsdk.SchemaVali
 	return partnernamespaces.ValidatePartnerNamespaceID
 }
 
+func (EventGridPartnerNamespaceResource) Identity() resourceids.ResourceId {
+	return &partnernamespaces.PartnerNamespaceId{}
+}
+
 func expandPartnerInboundIPRules(input []PartnerInboundIpRuleModel) *[]partnernamespaces.InboundIPRule {
 	if len(input) == 0 {
 		return nil
@@ -38,6 +38,13 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		EventGridNamespaceResource{},
+		EventGridNamespaceCaCertificateResource{},
+		EventGridNamespaceClientGroupResource{},
+		EventGridNamespaceClientResource{},
+		EventGridNamespacePermissionBindingResource{},
+		EventGridNamespaceTopicEventSubscriptionResource{},
+		EventGridNamespaceTopicResource{},
+		EventGridNamespaceTopicSpaceResource{},
 		EventGridPartnerConfigurationResource{},
 		EventGridPartnerNamespaceResource{},
 		EventGridPartnerRegistrationResource{},
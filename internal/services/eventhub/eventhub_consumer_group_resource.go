@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/consumergroups"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/migration"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name eventhub_consumer_group -service-package-name eventhub -properties "resource_group_name,namespace_name,eventhub_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type EventHubConsumerGroupResource
+
 type ConsumerGroupObject struct {
 	Name              string `tfschema:"name"`
 	NamespaceName     string `tfschema:"namespace_name"`
@@ -32,8 +35,13 @@ var (
 	_ sdk.Resource                   = ConsumerGroupResource{}
 	_ sdk.ResourceWithUpdate         = ConsumerGroupResource{}
 	_ sdk.ResourceWithStateMigration = ConsumerGroupResource{}
+	_ sdk.ResourceWithIdentity       = ConsumerGroupResource{}
 )
 
+func (r ConsumerGroupResource) Identity() resourceids.ResourceId {
+	return &consumergroups.ConsumerGroupId{}
+}
+
 type ConsumerGroupResource struct{}
 
 func (r ConsumerGroupResource) ResourceType() string {
@@ -180,6 +188,10 @@ func (r ConsumerGroupResource) Read() sdk.ResourceFunc {
 				state.UserMetadata = pointer.From(model.Properties.UserMetadata)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 		Timeout: 5 * time.Minute,
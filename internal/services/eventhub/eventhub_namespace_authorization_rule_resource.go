@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/authorizationrulesnamespaces"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name eventhub_namespace_authorization_rule -service-package-name eventhub -properties "resource_group_name,namespace_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name withAliasConnectionString -test-resource-type EventHubNamespaceAuthorizationRuleResource
+
 func resourceEventHubNamespaceAuthorizationRule() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceEventHubNamespaceAuthorizationRuleCreateUpdate,
@@ -27,10 +30,11 @@ func resourceEventHubNamespaceAuthorizationRule() *pluginsdk.Resource {
 		Update: resourceEventHubNamespaceAuthorizationRuleCreateUpdate,
 		Delete: resourceEventHubNamespaceAuthorizationRuleDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := authorizationrulesnamespaces.ParseAuthorizationRuleID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&authorizationrulesnamespaces.AuthorizationRuleId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&authorizationrulesnamespaces.AuthorizationRuleId{}),
+		},
 
 		SchemaVersion: 2,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -153,7 +157,7 @@ func resourceEventHubNamespaceAuthorizationRuleRead(d *pluginsdk.ResourceData, m
 		d.Set("secondary_connection_string_alias", model.AliasSecondaryConnectionString)
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceEventHubNamespaceAuthorizationRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -27,6 +27,8 @@ import (
 
 var eventHubResourceName = "azurerm_eventhub"
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name eventhub -service-package-name eventhub -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,namespace_name:id" -test-name requiresImport -test-resource-type EventHubResource
+
 func resourceEventHub() *pluginsdk.Resource {
 	r := &pluginsdk.Resource{
 		Create: resourceEventHubCreate,
@@ -34,10 +36,11 @@ func resourceEventHub() *pluginsdk.Resource {
 		Update: resourceEventHubUpdate,
 		Delete: resourceEventHubDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := eventhubs.ParseEventhubID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&eventhubs.EventhubId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&eventhubs.EventhubId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -408,7 +411,7 @@ func resourceEventHubRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceEventHubDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -13,15 +13,23 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/fabric/2023-11-01/fabriccapacities"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name fabric_capacity -service-package-name fabric -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type FabricCapacityResource struct{}
 
 var _ sdk.ResourceWithUpdate = FabricCapacityResource{}
+var _ sdk.ResourceWithIdentity = FabricCapacityResource{}
+
+func (r FabricCapacityResource) Identity() resourceids.ResourceId {
+	return &fabriccapacities.CapacityId{}
+}
 
 type FabricCapacityResourceModel struct {
 	Name                  string            `tfschema:"name"`
@@ -247,6 +255,10 @@ func (r FabricCapacityResource) Read() sdk.ResourceFunc {
 				state.Tags = pointer.From(model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/azurefirewalls"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -24,16 +25,20 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name firewall_application_rule_collection -service-package-name firewall -properties "resource_group_name,azure_firewall_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceFirewallApplicationRuleCollection() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceFirewallApplicationRuleCollectionCreateUpdate,
 		Read:   resourceFirewallApplicationRuleCollectionRead,
 		Update: resourceFirewallApplicationRuleCollectionCreateUpdate,
 		Delete: resourceFirewallApplicationRuleCollectionDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.FirewallApplicationRuleCollectionID(id)
-			return err
-		}),
+
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.FirewallApplicationRuleCollectionId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.FirewallApplicationRuleCollectionId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -328,7 +333,7 @@ func resourceFirewallApplicationRuleCollectionRead(d *pluginsdk.ResourceData, me
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceFirewallApplicationRuleCollectionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
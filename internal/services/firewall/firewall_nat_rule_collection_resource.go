@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/azurefirewalls"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -23,16 +24,20 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name firewall_nat_rule_collection -service-package-name firewall -properties "resource_group_name,azure_firewall_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceFirewallNatRuleCollection() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceFirewallNatRuleCollectionCreateUpdate,
 		Read:   resourceFirewallNatRuleCollectionRead,
 		Update: resourceFirewallNatRuleCollectionCreateUpdate,
 		Delete: resourceFirewallNatRuleCollectionDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.FirewallNatRuleCollectionID(id)
-			return err
-		}),
+
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.FirewallNatRuleCollectionId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.FirewallNatRuleCollectionId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -327,7 +332,7 @@ func resourceFirewallNatRuleCollectionRead(d *pluginsdk.ResourceData, meta inter
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceFirewallNatRuleCollectionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/azurefirewalls"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -23,16 +24,20 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name firewall_network_rule_collection -service-package-name firewall -properties "resource_group_name,azure_firewall_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceFirewallNetworkRuleCollection() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceFirewallNetworkRuleCollectionCreateUpdate,
 		Read:   resourceFirewallNetworkRuleCollectionRead,
 		Update: resourceFirewallNetworkRuleCollectionCreateUpdate,
 		Delete: resourceFirewallNetworkRuleCollectionDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.FirewallNetworkRuleCollectionID(id)
-			return err
-		}),
+
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.FirewallNetworkRuleCollectionId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.FirewallNetworkRuleCollectionId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -329,7 +334,7 @@ func resourceFirewallNetworkRuleCollectionRead(d *pluginsdk.ResourceData, meta i
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceFirewallNetworkRuleCollectionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
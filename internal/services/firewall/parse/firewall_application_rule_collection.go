@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &FirewallApplicationRuleCollectionId{}
+
 type FirewallApplicationRuleCollectionId struct {
 	SubscriptionId                string
 	ResourceGroup                 string
@@ -44,6 +46,45 @@ func (id FirewallApplicationRuleCollectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AzureFirewallName, id.ApplicationRuleCollectionName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this FirewallApplicationRuleCollection ID
+func (id FirewallApplicationRuleCollectionId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "00000000-0000-0000-0000-000000000000"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "mygroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticAzureFirewalls", "azureFirewalls", "azureFirewalls"),
+		resourceids.UserSpecifiedSegment("azureFirewallName", "myfirewall"),
+		resourceids.StaticSegment("staticApplicationRuleCollections", "applicationRuleCollections", "applicationRuleCollections"),
+		resourceids.UserSpecifiedSegment("applicationRuleCollectionName", "applicationRuleCollection1"),
+	}
+}
+
+// FromParseResult populates the fields of this FirewallApplicationRuleCollection ID from the ParseResult, for use with resourceids.Parser
+func (id *FirewallApplicationRuleCollectionId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.AzureFirewallName, ok = input.Parsed["azureFirewallName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "azureFirewallName", input)
+	}
+
+	if id.ApplicationRuleCollectionName, ok = input.Parsed["applicationRuleCollectionName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "applicationRuleCollectionName", input)
+	}
+
+	return nil
+}
+
 // FirewallApplicationRuleCollectionID parses a FirewallApplicationRuleCollection ID into an FirewallApplicationRuleCollectionId struct
 func FirewallApplicationRuleCollectionID(input string) (*FirewallApplicationRuleCollectionId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
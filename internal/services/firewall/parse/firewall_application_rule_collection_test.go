@@ -21,6 +21,24 @@ func TestFirewallApplicationRuleCollectionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFirewallApplicationRuleCollectionIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&FirewallApplicationRuleCollectionId{})
+	parsed, err := parser.Parse("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/azureFirewalls/myfirewall/applicationRuleCollections/applicationRuleCollection1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual FirewallApplicationRuleCollectionId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/azureFirewalls/myfirewall/applicationRuleCollections/applicationRuleCollection1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestFirewallApplicationRuleCollectionID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,24 @@ func TestFirewallNatRuleCollectionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFirewallNatRuleCollectionIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&FirewallNatRuleCollectionId{})
+	parsed, err := parser.Parse("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/azureFirewalls/myfirewall/natRuleCollections/natRuleCollection1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual FirewallNatRuleCollectionId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/azureFirewalls/myfirewall/natRuleCollections/natRuleCollection1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestFirewallNatRuleCollectionID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &FirewallNetworkRuleCollectionId{}
+
 type FirewallNetworkRuleCollectionId struct {
 	SubscriptionId            string
 	ResourceGroup             string
@@ -44,6 +46,45 @@ func (id FirewallNetworkRuleCollectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AzureFirewallName, id.NetworkRuleCollectionName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this FirewallNetworkRuleCollection ID
+func (id FirewallNetworkRuleCollectionId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "00000000-0000-0000-0000-000000000000"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "mygroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticAzureFirewalls", "azureFirewalls", "azureFirewalls"),
+		resourceids.UserSpecifiedSegment("azureFirewallName", "myfirewall"),
+		resourceids.StaticSegment("staticNetworkRuleCollections", "networkRuleCollections", "networkRuleCollections"),
+		resourceids.UserSpecifiedSegment("networkRuleCollectionName", "networkRuleCollection1"),
+	}
+}
+
+// FromParseResult populates the fields of this FirewallNetworkRuleCollection ID from the ParseResult, for use with resourceids.Parser
+func (id *FirewallNetworkRuleCollectionId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.AzureFirewallName, ok = input.Parsed["azureFirewallName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "azureFirewallName", input)
+	}
+
+	if id.NetworkRuleCollectionName, ok = input.Parsed["networkRuleCollectionName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "networkRuleCollectionName", input)
+	}
+
+	return nil
+}
+
 // FirewallNetworkRuleCollectionID parses a FirewallNetworkRuleCollection ID into an FirewallNetworkRuleCollectionId struct
 func FirewallNetworkRuleCollectionID(input string) (*FirewallNetworkRuleCollectionId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/fluidrelay/2022-05-26/fluidrelayservers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/fluidrelay/validate"
@@ -68,10 +69,14 @@ func (s *ServerModel) flattenIdentity(input *identity.SystemAndUserAssignedMap)
 	return nil
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name fluid_relay_server -service-package-name fluidrelay -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group:id" -test-resource-type FluidRelayResource
+
 type Server struct{}
 
 var _ sdk.ResourceWithUpdate = (*Server)(nil)
 
+var _ sdk.ResourceWithIdentity = Server{}
+
 func (s Server) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -357,6 +362,10 @@ func (s Server) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return fluidrelayservers.ValidateFluidRelayServerID
 }
 
+func (s Server) Identity() resourceids.ResourceId {
+	return &fluidrelayservers.FluidRelayServerId{}
+}
+
 func expandFluidRelayServerCustomerManagedKey(input []CustomerManagedKey) *fluidrelayservers.EncryptionProperties {
 	if len(input) == 0 {
 		return nil
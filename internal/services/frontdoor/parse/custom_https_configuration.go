@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &CustomHttpsConfigurationId{}
+
 type CustomHttpsConfigurationId struct {
 	SubscriptionId               string
 	ResourceGroup                string
@@ -44,6 +46,45 @@ func (id CustomHttpsConfigurationId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FrontDoorName, id.CustomHttpsConfigurationName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this CustomHttpsConfiguration ID
+func (id CustomHttpsConfigurationId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticFrontDoors", "frontDoors", "frontDoors"),
+		resourceids.UserSpecifiedSegment("frontDoorName", "frontdoor1"),
+		resourceids.StaticSegment("staticCustomHttpsConfiguration", "customHttpsConfiguration", "customHttpsConfiguration"),
+		resourceids.UserSpecifiedSegment("customHttpsConfigurationName", "endpoint1"),
+	}
+}
+
+// FromParseResult populates the fields of this CustomHttpsConfiguration ID from the ParseResult, for use with resourceids.Parser
+func (id *CustomHttpsConfigurationId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.FrontDoorName, ok = input.Parsed["frontDoorName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "frontDoorName", input)
+	}
+
+	if id.CustomHttpsConfigurationName, ok = input.Parsed["customHttpsConfigurationName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "customHttpsConfigurationName", input)
+	}
+
+	return nil
+}
+
 // CustomHttpsConfigurationID parses a CustomHttpsConfiguration ID into an CustomHttpsConfigurationId struct
 func CustomHttpsConfigurationID(input string) (*CustomHttpsConfigurationId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
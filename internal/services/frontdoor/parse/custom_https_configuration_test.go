@@ -21,6 +21,24 @@ func TestCustomHttpsConfigurationIDFormatter(t *testing.T) {
 	}
 }
 
+func TestCustomHttpsConfigurationIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&CustomHttpsConfigurationId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/frontDoors/frontdoor1/customHttpsConfiguration/endpoint1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual CustomHttpsConfigurationId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/frontDoors/frontdoor1/customHttpsConfiguration/endpoint1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestCustomHttpsConfigurationID(t *testing.T) {
 	testData := []struct {
 		Input    string
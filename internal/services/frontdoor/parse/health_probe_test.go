@@ -21,6 +21,24 @@ func TestHealthProbeIDFormatter(t *testing.T) {
 	}
 }
 
+func TestHealthProbeIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&HealthProbeId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/frontDoors/frontdoor1/healthProbeSettings/probe1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual HealthProbeId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/frontDoors/frontdoor1/healthProbeSettings/probe1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestHealthProbeID(t *testing.T) {
 	testData := []struct {
 		Input    string
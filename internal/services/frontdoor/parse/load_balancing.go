@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &LoadBalancingId{}
+
 type LoadBalancingId struct {
 	SubscriptionId           string
 	ResourceGroup            string
@@ -44,6 +46,45 @@ func (id LoadBalancingId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FrontDoorName, id.LoadBalancingSettingName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this LoadBalancing ID
+func (id LoadBalancingId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticFrontDoors", "frontDoors", "frontDoors"),
+		resourceids.UserSpecifiedSegment("frontDoorName", "frontdoor1"),
+		resourceids.StaticSegment("staticLoadBalancingSettings", "loadBalancingSettings", "loadBalancingSettings"),
+		resourceids.UserSpecifiedSegment("loadBalancingSettingName", "setting1"),
+	}
+}
+
+// FromParseResult populates the fields of this LoadBalancing ID from the ParseResult, for use with resourceids.Parser
+func (id *LoadBalancingId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.FrontDoorName, ok = input.Parsed["frontDoorName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "frontDoorName", input)
+	}
+
+	if id.LoadBalancingSettingName, ok = input.Parsed["loadBalancingSettingName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "loadBalancingSettingName", input)
+	}
+
+	return nil
+}
+
 // LoadBalancingID parses a LoadBalancing ID into an LoadBalancingId struct
 func LoadBalancingID(input string) (*LoadBalancingId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
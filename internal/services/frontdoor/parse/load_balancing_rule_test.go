@@ -21,6 +21,24 @@ func TestLoadBalancingRuleIDFormatter(t *testing.T) {
 	}
 }
 
+func TestLoadBalancingRuleIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&LoadBalancingRuleId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1/loadBalancingRules/rule1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual LoadBalancingRuleId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1/loadBalancingRules/rule1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestLoadBalancingRuleID(t *testing.T) {
 	testData := []struct {
 		Input    string
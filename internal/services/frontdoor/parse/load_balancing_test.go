@@ -21,6 +21,24 @@ func TestLoadBalancingIDFormatter(t *testing.T) {
 	}
 }
 
+func TestLoadBalancingIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&LoadBalancingId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/frontDoors/frontdoor1/loadBalancingSettings/setting1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual LoadBalancingId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/frontDoors/frontdoor1/loadBalancingSettings/setting1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestLoadBalancingID(t *testing.T) {
 	testData := []struct {
 		Input    string
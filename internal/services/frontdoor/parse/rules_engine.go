@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &RulesEngineId{}
+
 type RulesEngineId struct {
 	SubscriptionId string
 	ResourceGroup  string
@@ -44,6 +46,45 @@ func (id RulesEngineId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FrontdoorName, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this RulesEngine ID
+func (id RulesEngineId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticFrontdoors", "frontdoors", "frontdoors"),
+		resourceids.UserSpecifiedSegment("frontdoorName", "frontdoor1"),
+		resourceids.StaticSegment("staticRulesEngines", "rulesEngines", "rulesEngines"),
+		resourceids.UserSpecifiedSegment("name", "rule1"),
+	}
+}
+
+// FromParseResult populates the fields of this RulesEngine ID from the ParseResult, for use with resourceids.Parser
+func (id *RulesEngineId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.FrontdoorName, ok = input.Parsed["frontdoorName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "frontdoorName", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
+
 // RulesEngineID parses a RulesEngine ID into an RulesEngineId struct
 func RulesEngineID(input string) (*RulesEngineId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -21,6 +21,24 @@ func TestRulesEngineIDFormatter(t *testing.T) {
 	}
 }
 
+func TestRulesEngineIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&RulesEngineId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/frontdoors/frontdoor1/rulesEngines/rule1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual RulesEngineId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/frontdoors/frontdoor1/rulesEngines/rule1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestRulesEngineID(t *testing.T) {
 	testData := []struct {
 		Input    string
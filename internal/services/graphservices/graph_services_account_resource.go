@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/graphservices/2023-04-13/graphservicesprods"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,11 +20,18 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name graph_services_account -service-package-name graphservices -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type AccountTestResource -test-sequential
+
 var (
-	_ sdk.Resource           = AccountResource{}
-	_ sdk.ResourceWithUpdate = AccountResource{}
+	_ sdk.Resource             = AccountResource{}
+	_ sdk.ResourceWithUpdate   = AccountResource{}
+	_ sdk.ResourceWithIdentity = AccountResource{}
 )
 
+func (r AccountResource) Identity() resourceids.ResourceId {
+	return &graphservicesprods.AccountId{}
+}
+
 type AccountResource struct{}
 
 func (r AccountResource) ModelObject() interface{} {
@@ -150,6 +158,10 @@ func (r AccountResource) Read() sdk.ResourceFunc {
 				schema.BillingPlanId = pointer.From(model.Properties.BillingPlanId)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
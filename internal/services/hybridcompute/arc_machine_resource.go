@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-07-10/machines"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -28,8 +29,11 @@ type ArcMachineResourceModel struct {
 	Tags              map[string]string              `tfschema:"tags"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name arc_machine -service-package-name hybridcompute -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-sequential
 type ArcMachineResource struct{}
 
+var _ sdk.ResourceWithIdentity = ArcMachineResource{}
+
 func (r ArcMachineResource) ResourceType() string {
 	return "azurerm_arc_machine"
 }
@@ -42,6 +46,10 @@ func (r ArcMachineResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return machines.ValidateMachineID
 }
 
+func (r ArcMachineResource) Identity() resourceids.ResourceId {
+	return &machines.MachineId{}
+}
+
 func (r ArcMachineResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/privatelinkscopes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -28,8 +29,11 @@ type PrivateLinkScopeModel struct {
 }
 
 var _ sdk.Resource = ArcPrivateLinkScopeResource{}
+var _ sdk.ResourceWithIdentity = ArcPrivateLinkScopeResource{}
 
 // ArcPrivateLinkScopeResource is a Resource implementation for the Azure Arc Private Link Scope resource
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name arc_private_link_scope -service-package-name hybridcompute -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type ArcPrivateLinkScopeResource struct{}
 
 func (a ArcPrivateLinkScopeResource) Arguments() map[string]*schema.Schema {
@@ -192,6 +196,10 @@ func (a ArcPrivateLinkScopeResource) Read() sdk.ResourceFunc {
 				state.PublicNetworkAccessEnabled = publicNetworkAccess
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -220,3 +228,7 @@ func (a ArcPrivateLinkScopeResource) Delete() sdk.ResourceFunc {
 func (a ArcPrivateLinkScopeResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return privatelinkscopes.ValidateProviderPrivateLinkScopeID
 }
+
+func (a ArcPrivateLinkScopeResource) Identity() resourceids.ResourceId {
+	return &privatelinkscopes.ProviderPrivateLinkScopeId{}
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/iotcentral/2021-11-01-preview/apps"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -20,9 +21,16 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name iotcentral_application_network_rule_set -service-package-name iotcentral -compare-values "subscription_id:id,resource_group_name:id,name:id" -test-resource-type IoTCentralApplicationNetworkRuleSetResource
+
 type IotCentralApplicationNetworkRuleSetResource struct{}
 
 var _ sdk.ResourceWithUpdate = IotCentralApplicationNetworkRuleSetResource{}
+var _ sdk.ResourceWithIdentity = IotCentralApplicationNetworkRuleSetResource{}
+
+func (r IotCentralApplicationNetworkRuleSetResource) Identity() resourceids.ResourceId {
+	return &apps.IotAppId{}
+}
 
 type IotCentralApplicationNetworkRuleSetModel struct {
 	IotCentralApplicationId string             `tfschema:"iotcentral_application_id"`
@@ -195,6 +203,10 @@ func (r IotCentralApplicationNetworkRuleSetResource) Read() sdk.ResourceFunc {
 			}
 			state.DefaultAction = defaultAction
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 		Timeout: 5 * time.Minute,
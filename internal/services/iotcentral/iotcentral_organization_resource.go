@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/iotcentral/2021-11-01-preview/apps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iotcentral/parse"
@@ -16,9 +17,12 @@ import (
 	dataplane "github.com/jackofallops/kermit/sdk/iotcentral/2022-10-31-preview/iotcentral"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name iotcentral_organization -service-package-name iotcentral -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,iot_app_name:id,name:id" -test-resource-type IoTCentralOrganizationResource
+
 type IotCentralOrganizationResource struct{}
 
 var _ sdk.ResourceWithUpdate = IotCentralOrganizationResource{}
+var _ sdk.ResourceWithIdentity = IotCentralOrganizationResource{}
 
 type IotCentralOrganizationModel struct {
 	IotCentralApplicationId string `tfschema:"iotcentral_application_id"`
@@ -70,6 +74,10 @@ func (r IotCentralOrganizationResource) IDValidationFunc() pluginsdk.SchemaValid
 	return validate.OrganizationID
 }
 
+func (r IotCentralOrganizationResource) Identity() resourceids.ResourceId {
+	return &parse.OrganizationId{}
+}
+
 func (r IotCentralOrganizationResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
@@ -159,6 +167,10 @@ func (r IotCentralOrganizationResource) Read() sdk.ResourceFunc {
 				state.ParentOrganizationId = *org.Parent
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 		Timeout: 5 * time.Minute,
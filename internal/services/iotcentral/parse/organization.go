@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &OrganizationId{}
+
 type OrganizationId struct {
 	SubscriptionId string
 	ResourceGroup  string
@@ -44,6 +46,45 @@ func (id OrganizationId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.IotAppName, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this Organization ID
+func (id OrganizationId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftIoTCentral", "Microsoft.IoTCentral", "Microsoft.IoTCentral"),
+		resourceids.StaticSegment("staticIotApps", "iotApps", "iotApps"),
+		resourceids.UserSpecifiedSegment("iotAppName", "application1"),
+		resourceids.StaticSegment("staticOrganizations", "organizations", "organizations"),
+		resourceids.UserSpecifiedSegment("name", "organization1"),
+	}
+}
+
+// FromParseResult populates the fields of this Organization ID from the ParseResult, for use with resourceids.Parser
+func (id *OrganizationId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.IotAppName, ok = input.Parsed["iotAppName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "iotAppName", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
+
 // OrganizationID parses a Organization ID into an OrganizationId struct
 func OrganizationID(input string) (*OrganizationId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -21,6 +21,24 @@ func TestOrganizationIDFormatter(t *testing.T) {
 	}
 }
 
+func TestOrganizationIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&OrganizationId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.IoTCentral/iotApps/application1/organizations/organization1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual OrganizationId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.IoTCentral/iotApps/application1/organizations/organization1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestOrganizationID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/migration"
@@ -20,6 +21,8 @@ import (
 	devices "github.com/jackofallops/kermit/sdk/iothub/2022-04-30-preview/iothub"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name iothub_certificate -service-package-name iothub -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "iot_hub_name:id" -test-resource-type IotHubCertificateResource
+
 func resourceIotHubCertificate() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceIotHubCertificateCreate,
@@ -32,10 +35,11 @@ func resourceIotHubCertificate() *pluginsdk.Resource {
 			0: migration.IoTHubCertificateV0ToV1{},
 		}),
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.IotHubCertificateID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.IotHubCertificateId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.IotHubCertificateId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -140,7 +144,7 @@ func resourceIotHubCertificateRead(d *pluginsdk.ResourceData, meta interface{})
 		d.Set("certificate_content", props.Certificate)
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceIotHubCertificateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
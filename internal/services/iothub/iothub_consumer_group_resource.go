@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -21,6 +22,8 @@ import (
 	devices "github.com/jackofallops/kermit/sdk/iothub/2022-04-30-preview/iothub"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name iothub_consumer_group -service-package-name iothub -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "iot_hub_name:id,event_hub_endpoint_name:id" -test-params "events" -test-resource-type IotHubConsumerGroupResource
+
 func resourceIotHubConsumerGroup() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceIotHubConsumerGroupCreate,
@@ -32,10 +35,11 @@ func resourceIotHubConsumerGroup() *pluginsdk.Resource {
 			0: migration.IoTHubConsumerGroupV0ToV1{},
 		}),
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.ConsumerGroupID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.ConsumerGroupId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.ConsumerGroupId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -149,7 +153,7 @@ func resourceIotHubConsumerGroupRead(d *pluginsdk.ResourceData, meta interface{}
 	d.Set("eventhub_endpoint_name", id.EventHubEndpointName)
 	d.Set("resource_group_name", id.ResourceGroup)
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceIotHubConsumerGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
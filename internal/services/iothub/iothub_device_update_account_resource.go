@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceupdate/2022-10-01/deviceupdates"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/validate"
@@ -19,9 +20,16 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name iothub_device_update_account -service-package-name iothub -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type IotHubDeviceUpdateAccountResource
+
 type IotHubDeviceUpdateAccountResource struct{}
 
 var _ sdk.ResourceWithUpdate = IotHubDeviceUpdateAccountResource{}
+var _ sdk.ResourceWithIdentity = IotHubDeviceUpdateAccountResource{}
+
+func (r IotHubDeviceUpdateAccountResource) Identity() resourceids.ResourceId {
+	return &deviceupdates.AccountId{}
+}
 
 type IotHubDeviceUpdateAccountModel struct {
 	Name                       string            `tfschema:"name"`
@@ -203,6 +211,10 @@ func (r IotHubDeviceUpdateAccountResource) Read() sdk.ResourceFunc {
 				state.Tags = *model.Tags
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceupdate/2022-10-01/deviceupdates"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/validate"
@@ -19,9 +20,16 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name iothub_device_update_instance -service-package-name iothub -properties "name" -compare-values "subscription_id:id,resource_group_name:id,account_name:id" -test-resource-type IotHubDeviceUpdateInstanceResource
+
 type IotHubDeviceUpdateInstanceResource struct{}
 
 var _ sdk.ResourceWithUpdate = IotHubDeviceUpdateInstanceResource{}
+var _ sdk.ResourceWithIdentity = IotHubDeviceUpdateInstanceResource{}
+
+func (r IotHubDeviceUpdateInstanceResource) Identity() resourceids.ResourceId {
+	return &deviceupdates.InstanceId{}
+}
 
 type IotHubDeviceUpdateInstanceModel struct {
 	Name                     string                          `tfschema:"name"`
@@ -215,6 +223,10 @@ func (r IotHubDeviceUpdateInstanceResource) Read() sdk.ResourceFunc {
 				state.Tags = *model.Tags
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
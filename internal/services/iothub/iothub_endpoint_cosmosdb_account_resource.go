@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -23,9 +24,12 @@ import (
 	devices "github.com/jackofallops/kermit/sdk/iothub/2022-04-30-preview/iothub"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name iothub_endpoint_cosmosdb_account -service-package-name iothub -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,iot_hub_name:id" -test-resource-type IotHubEndpointCosmosDBAccountResource
+
 type IotHubEndpointCosmosDBAccountResource struct{}
 
 var _ sdk.ResourceWithUpdate = IotHubEndpointCosmosDBAccountResource{}
+var _ sdk.ResourceWithIdentity = IotHubEndpointCosmosDBAccountResource{}
 
 type IotHubEndpointCosmosDBAccountModel struct {
 	Name                 string `tfschema:"name"`
@@ -157,6 +161,10 @@ func (r IotHubEndpointCosmosDBAccountResource) IDValidationFunc() pluginsdk.Sche
 	return validate.EndpointCosmosDBAccountID
 }
 
+func (r IotHubEndpointCosmosDBAccountResource) Identity() resourceids.ResourceId {
+	return &parse.EndpointCosmosDBAccountId{}
+}
+
 func (r IotHubEndpointCosmosDBAccountResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
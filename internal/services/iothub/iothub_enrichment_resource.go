@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -24,6 +25,8 @@ import (
 	devices "github.com/jackofallops/kermit/sdk/iothub/2022-04-30-preview/iothub"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name iothub_enrichment -service-package-name iothub -properties "resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "iot_hub_name:id,name:id" -test-resource-type IotHubEnrichmentResource
+
 func resourceIotHubEnrichment() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceArmIotHubEnrichmentCreateUpdate,
@@ -36,10 +39,11 @@ func resourceIotHubEnrichment() *pluginsdk.Resource {
 			0: migration.IoTHubEnrichmentV0ToV1{},
 		}),
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.EnrichmentID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.EnrichmentId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.EnrichmentId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -211,7 +215,7 @@ func resourceArmIotHubEnrichmentRead(d *pluginsdk.ResourceData, meta interface{}
 	d.Set("value", props.Value)
 	d.Set("endpoint_names", props.EndpointNames)
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceArmIotHubEnrichmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/migration"
@@ -20,6 +21,8 @@ import (
 	devices "github.com/jackofallops/kermit/sdk/iothub/2022-04-30-preview/iothub"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name iothub_fallback_route -service-package-name iothub -properties "resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "iot_hub_name:id,name:id" -test-resource-type IotHubFallbackRouteResource
+
 func resourceIotHubFallbackRoute() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceIotHubFallbackRouteCreateUpdate,
@@ -32,10 +35,11 @@ func resourceIotHubFallbackRoute() *pluginsdk.Resource {
 			0: migration.IoTHubFallbackRouteV0ToV1{},
 		}),
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.FallbackRouteID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.FallbackRouteId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.FallbackRouteId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -179,7 +183,7 @@ func resourceIotHubFallbackRouteRead(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceIotHubFallbackRouteDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -21,9 +22,12 @@ import (
 	devices "github.com/jackofallops/kermit/sdk/iothub/2022-04-30-preview/iothub"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name iothub_file_upload -service-package-name iothub -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,name:id" -test-resource-type IotHubFileUploadResource
+
 type IotHubFileUploadResource struct{}
 
 var _ sdk.ResourceWithUpdate = IotHubFileUploadResource{}
+var _ sdk.ResourceWithIdentity = IotHubFileUploadResource{}
 
 type IotHubFileUploadResourceModel struct {
 	AuthenticationType   string `tfschema:"authentication_type"`
@@ -129,6 +133,10 @@ func (r IotHubFileUploadResource) IDValidationFunc() pluginsdk.SchemaValidateFun
 	return validate.IotHubID
 }
 
+func (r IotHubFileUploadResource) Identity() resourceids.ResourceId {
+	return &parse.IotHubId{}
+}
+
 func (r IotHubFileUploadResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
@@ -279,6 +287,10 @@ func (r IotHubFileUploadResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 		Timeout: 5 * time.Minute,
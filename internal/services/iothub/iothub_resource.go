@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -69,6 +70,8 @@ func suppressWhenAny(fs ...pluginsdk.SchemaDiffSuppressFunc) pluginsdk.SchemaDif
 	}
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name iothub -service-package-name iothub -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type IotHubResource
+
 func resourceIotHub() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceIotHubCreate,
@@ -81,10 +84,11 @@ func resourceIotHub() *pluginsdk.Resource {
 			0: migration.IoTHubV0ToV1{},
 		}),
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.IotHubID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.IotHubId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.IotHubId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -1106,7 +1110,11 @@ func resourceIotHubRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("setting `sku`: %+v", err)
 	}
 	d.Set("type", hub.Type)
-	return tags.FlattenAndSet(d, hub.Tags)
+	if err := tags.FlattenAndSet(d, hub.Tags); err != nil {
+		return err
+	}
+
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceIotHubDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &ConsumerGroupId{}
+
 type ConsumerGroupId struct {
 	SubscriptionId       string
 	ResourceGroup        string
@@ -47,6 +49,51 @@ func (id ConsumerGroupId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.IotHubName, id.EventHubEndpointName, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ConsumerGroup ID
+func (id ConsumerGroupId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDevices", "Microsoft.Devices", "Microsoft.Devices"),
+		resourceids.StaticSegment("staticIotHubs", "iotHubs", "iotHubs"),
+		resourceids.UserSpecifiedSegment("iotHubName", "hub1"),
+		resourceids.StaticSegment("staticEventHubEndpoints", "eventHubEndpoints", "eventHubEndpoints"),
+		resourceids.UserSpecifiedSegment("eventHubEndpointName", "events"),
+		resourceids.StaticSegment("staticConsumerGroups", "consumerGroups", "consumerGroups"),
+		resourceids.UserSpecifiedSegment("name", "group1"),
+	}
+}
+
+// FromParseResult populates the fields of this ConsumerGroup ID from the ParseResult, for use with resourceids.Parser
+func (id *ConsumerGroupId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.IotHubName, ok = input.Parsed["iotHubName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "iotHubName", input)
+	}
+
+	if id.EventHubEndpointName, ok = input.Parsed["eventHubEndpointName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "eventHubEndpointName", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
+
 // ConsumerGroupID parses a ConsumerGroup ID into an ConsumerGroupId struct
 func ConsumerGroupID(input string) (*ConsumerGroupId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
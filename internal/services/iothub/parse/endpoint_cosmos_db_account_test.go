@@ -21,6 +21,24 @@ func TestEndpointCosmosDBAccountIDFormatter(t *testing.T) {
 	}
 }
 
+func TestEndpointCosmosDBAccountIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&EndpointCosmosDBAccountId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/iotHubs/hub1/endpoints/cosmosDBAccountEndpoint1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual EndpointCosmosDBAccountId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/iotHubs/hub1/endpoints/cosmosDBAccountEndpoint1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestEndpointCosmosDBAccountID(t *testing.T) {
 	testData := []struct {
 		Input    string
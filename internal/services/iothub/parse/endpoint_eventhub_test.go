@@ -21,6 +21,24 @@ func TestEndpointEventhubIDFormatter(t *testing.T) {
 	}
 }
 
+func TestEndpointEventhubIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&EndpointEventhubId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/iotHubs/hub1/endpoints/eventHubEndpoint1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual EndpointEventhubId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/iotHubs/hub1/endpoints/eventHubEndpoint1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestEndpointEventhubID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,24 @@ func TestEnrichmentIDFormatter(t *testing.T) {
 	}
 }
 
+func TestEnrichmentIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&EnrichmentId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/iotHubs/hub1/enrichments/enrichment1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual EnrichmentId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/iotHubs/hub1/enrichments/enrichment1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestEnrichmentID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &FallbackRouteId{}
+
 type FallbackRouteId struct {
 	SubscriptionId    string
 	ResourceGroup     string
@@ -44,6 +46,45 @@ func (id FallbackRouteId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.IotHubName, id.FallbackRouteName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this FallbackRoute ID
+func (id FallbackRouteId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDevices", "Microsoft.Devices", "Microsoft.Devices"),
+		resourceids.StaticSegment("staticIotHubs", "iotHubs", "iotHubs"),
+		resourceids.UserSpecifiedSegment("iotHubName", "hub1"),
+		resourceids.StaticSegment("staticFallbackRoute", "fallbackRoute", "fallbackRoute"),
+		resourceids.UserSpecifiedSegment("fallbackRouteName", "default"),
+	}
+}
+
+// FromParseResult populates the fields of this FallbackRoute ID from the ParseResult, for use with resourceids.Parser
+func (id *FallbackRouteId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.IotHubName, ok = input.Parsed["iotHubName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "iotHubName", input)
+	}
+
+	if id.FallbackRouteName, ok = input.Parsed["fallbackRouteName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "fallbackRouteName", input)
+	}
+
+	return nil
+}
+
 // FallbackRouteID parses a FallbackRoute ID into an FallbackRouteId struct
 func FallbackRouteID(input string) (*FallbackRouteId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
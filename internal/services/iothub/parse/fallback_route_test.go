@@ -21,6 +21,24 @@ func TestFallbackRouteIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFallbackRouteIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&FallbackRouteId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/iotHubs/hub1/fallbackRoute/default", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual FallbackRouteId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/iotHubs/hub1/fallbackRoute/default"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestFallbackRouteID(t *testing.T) {
 	testData := []struct {
 		Input    string
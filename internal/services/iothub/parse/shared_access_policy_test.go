@@ -21,6 +21,24 @@ func TestSharedAccessPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSharedAccessPolicyIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&SharedAccessPolicyId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/iotHubs/hub1/iotHubKeys/sharedAccessPolicy1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual SharedAccessPolicyId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/iotHubs/hub1/iotHubKeys/sharedAccessPolicy1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestSharedAccessPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,24 @@ func TestAccessPolicyApplicationIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAccessPolicyApplicationIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&AccessPolicyApplicationId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1/objectId/object1/applicationId/application1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual AccessPolicyApplicationId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1/objectId/object1/applicationId/application1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestAccessPolicyApplicationID(t *testing.T) {
 	testData := []struct {
 		Input    string
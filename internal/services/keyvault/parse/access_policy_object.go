@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &AccessPolicyObjectId{}
+
 type AccessPolicyObjectId struct {
 	SubscriptionId string
 	ResourceGroup  string
@@ -44,6 +46,45 @@ func (id AccessPolicyObjectId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VaultName, id.ObjectIdName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this AccessPolicyObject ID
+func (id AccessPolicyObjectId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftKeyVault", "Microsoft.KeyVault", "Microsoft.KeyVault"),
+		resourceids.StaticSegment("staticVaults", "vaults", "vaults"),
+		resourceids.UserSpecifiedSegment("vaultName", "vault1"),
+		resourceids.StaticSegment("staticObjectId", "objectId", "objectId"),
+		resourceids.UserSpecifiedSegment("objectIdName", "object1"),
+	}
+}
+
+// FromParseResult populates the fields of this AccessPolicyObject ID from the ParseResult, for use with resourceids.Parser
+func (id *AccessPolicyObjectId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.VaultName, ok = input.Parsed["vaultName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "vaultName", input)
+	}
+
+	if id.ObjectIdName, ok = input.Parsed["objectIdName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "objectIdName", input)
+	}
+
+	return nil
+}
+
 // AccessPolicyObjectID parses a AccessPolicyObject ID into an AccessPolicyObjectId struct
 func AccessPolicyObjectID(input string) (*AccessPolicyObjectId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
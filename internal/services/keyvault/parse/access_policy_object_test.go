@@ -21,6 +21,24 @@ func TestAccessPolicyObjectIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAccessPolicyObjectIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&AccessPolicyObjectId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1/objectId/object1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual AccessPolicyObjectId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1/objectId/object1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestAccessPolicyObjectID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,24 @@ func TestCertificateVersionlessIDFormatter(t *testing.T) {
 	}
 }
 
+func TestCertificateVersionlessIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&CertificateVersionlessId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1/certificates/cert1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual CertificateVersionlessId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1/certificates/cert1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestCertificateVersionlessID(t *testing.T) {
 	testData := []struct {
 		Input    string
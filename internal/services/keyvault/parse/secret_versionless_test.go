@@ -21,6 +21,24 @@ func TestSecretVersionlessIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSecretVersionlessIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&SecretVersionlessId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1/secrets/secret1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual SecretVersionlessId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1/secrets/secret1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestSecretVersionlessID(t *testing.T) {
 	testData := []struct {
 		Input    string
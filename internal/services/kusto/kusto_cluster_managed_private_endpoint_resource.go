@@ -22,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name kusto_cluster_managed_private_endpoint -service-package-name kusto -properties "resource_group_name,cluster_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceKustoClusterManagedPrivateEndpoint() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceKustoClusterManagedPrivateEndpointCreateUpdate,
@@ -35,10 +37,11 @@ func resourceKustoClusterManagedPrivateEndpoint() *pluginsdk.Resource {
 			1: migration.KustoManagedPrivateEndpointV1ToV2{},
 		}),
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := managedprivateendpoints.ParseManagedPrivateEndpointID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&managedprivateendpoints.ManagedPrivateEndpointId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&managedprivateendpoints.ManagedPrivateEndpointId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
@@ -177,7 +180,7 @@ func resourceKustoClusterManagedPrivateEndpointRead(d *schema.ResourceData, meta
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceKustoClusterManagedPrivateEndpointDelete(d *schema.ResourceData, meta interface{}) error {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-08-15/cosmosdb"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2024-04-13/dataconnections"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -35,6 +36,9 @@ type CosmosDBDataConnectionModel struct {
 }
 
 var _ sdk.Resource = CosmosDBDataConnectionResource{}
+var _ sdk.ResourceWithIdentity = CosmosDBDataConnectionResource{}
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name kusto_cosmosdb_data_connection -service-package-name kusto -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,cluster_name:id,database_name:id" -test-resource-type KustoCosmosDBDataConnectionResource
 
 type CosmosDBDataConnectionResource struct{}
 
@@ -211,6 +215,10 @@ func (r CosmosDBDataConnectionResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -239,3 +247,7 @@ func (r CosmosDBDataConnectionResource) Delete() sdk.ResourceFunc {
 func (r CosmosDBDataConnectionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return dataconnections.ValidateDataConnectionID
 }
+
+func (r CosmosDBDataConnectionResource) Identity() resourceids.ResourceId {
+	return &dataconnections.DataConnectionId{}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2025-02-15/eventsubscriptions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2024-04-13/dataconnections"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -29,6 +30,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name kusto_eventgrid_data_connection -service-package-name kusto -properties "resource_group_name,cluster_name,database_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type KustoEventGridDataConnectionResource
+
 func resourceKustoEventGridDataConnection() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceKustoEventGridDataConnectionCreateUpdate,
@@ -41,10 +44,11 @@ func resourceKustoEventGridDataConnection() *pluginsdk.Resource {
 			0: migration.KustoDatabaseDataConnectionEventGridV0ToV1{},
 		}),
 
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := dataconnections.ParseDataConnectionID(id)
-			return err
-		}, importDataConnection("EventGrid")),
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&dataconnections.DataConnectionId{}, importDataConnection("EventGrid")),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&dataconnections.DataConnectionId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
@@ -333,7 +337,7 @@ func resourceKustoEventGridDataConnectionRead(d *pluginsdk.ResourceData, meta in
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceKustoEventGridDataConnectionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
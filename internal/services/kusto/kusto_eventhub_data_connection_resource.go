@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2024-04-13/dataconnections"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -26,6 +27,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name kusto_eventhub_data_connection -service-package-name kusto -properties "resource_group_name,cluster_name,database_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type KustoEventHubDataConnectionResource
+
 func resourceKustoEventHubDataConnection() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceKustoEventHubDataConnectionCreateUpdate,
@@ -38,10 +41,11 @@ func resourceKustoEventHubDataConnection() *pluginsdk.Resource {
 			0: migration.KustoDatabaseDataConnectionEventHubV0ToV1{},
 		}),
 
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := dataconnections.ParseDataConnectionID(id)
-			return err
-		}, importDataConnection("EventHub")),
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&dataconnections.DataConnectionId{}, importDataConnection("EventHub")),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&dataconnections.DataConnectionId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
@@ -256,7 +260,7 @@ func resourceKustoEventHubDataConnectionRead(d *pluginsdk.ResourceData, meta int
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceKustoEventHubDataConnectionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
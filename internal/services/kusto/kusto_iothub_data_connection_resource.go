@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2024-04-13/dataconnections"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -26,6 +27,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name kusto_iothub_data_connection -service-package-name kusto -properties "resource_group_name,cluster_name,database_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type KustoIotHubDataConnectionResource
+
 func resourceKustoIotHubDataConnection() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceKustoIotHubDataConnectionCreate,
@@ -37,10 +40,11 @@ func resourceKustoIotHubDataConnection() *pluginsdk.Resource {
 			0: migration.KustoDatabaseDataConnectionIotHub0ToV1{},
 		}),
 
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := dataconnections.ParseDataConnectionID(id)
-			return err
-		}, importDataConnection("IotHub")),
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&dataconnections.DataConnectionId{}, importDataConnection("IotHub")),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&dataconnections.DataConnectionId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
@@ -222,7 +226,7 @@ func resourceKustoIotHubDataConnectionRead(d *pluginsdk.ResourceData, meta inter
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceKustoIotHubDataConnectionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2024-04-13/scripts"
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name kusto_script -service-package-name kusto -properties "name" -compare-values "subscription_id:id,resource_group_name:id,cluster_name:id,database_name:id"
+
 func resourceKustoDatabaseScript() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceKustoDatabaseScriptCreateUpdate,
@@ -41,10 +44,11 @@ func resourceKustoDatabaseScript() *pluginsdk.Resource {
 			0: migration.KustoDatabaseScriptV0ToV1{},
 		}),
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := scripts.ParseScriptID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&scripts.ScriptId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&scripts.ScriptId{}),
+		},
 
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
@@ -189,7 +193,7 @@ func resourceKustoDatabaseScriptRead(d *pluginsdk.ResourceData, meta interface{}
 			d.Set("url", props.ScriptURL)
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceKustoDatabaseScriptDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &DatabasePrincipalId{}
+
 type DatabasePrincipalId struct {
 	SubscriptionId string
 	ResourceGroup  string
@@ -50,6 +52,57 @@ func (id DatabasePrincipalId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ClusterName, id.DatabaseName, id.RoleName, id.FQNName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this DatabasePrincipal ID
+func (id DatabasePrincipalId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftKusto", "Microsoft.Kusto", "Microsoft.Kusto"),
+		resourceids.StaticSegment("staticClusters", "Clusters", "Clusters"),
+		resourceids.UserSpecifiedSegment("clusterName", "cluster1"),
+		resourceids.StaticSegment("staticDatabases", "Databases", "Databases"),
+		resourceids.UserSpecifiedSegment("databaseName", "database1"),
+		resourceids.StaticSegment("staticRole", "Role", "Role"),
+		resourceids.UserSpecifiedSegment("roleName", "Viewer"),
+		resourceids.StaticSegment("staticFQN", "FQN", "FQN"),
+		resourceids.UserSpecifiedSegment("fQNName", "aaduser=11111111-1111-1111-1111-111111111111;22222222-2222-2222-2222-222222222222"),
+	}
+}
+
+// FromParseResult populates the fields of this DatabasePrincipal ID from the ParseResult, for use with resourceids.Parser
+func (id *DatabasePrincipalId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ClusterName, ok = input.Parsed["clusterName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "clusterName", input)
+	}
+
+	if id.DatabaseName, ok = input.Parsed["databaseName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "databaseName", input)
+	}
+
+	if id.RoleName, ok = input.Parsed["roleName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "roleName", input)
+	}
+
+	if id.FQNName, ok = input.Parsed["fQNName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "fQNName", input)
+	}
+
+	return nil
+}
+
 // DatabasePrincipalID parses a DatabasePrincipal ID into an DatabasePrincipalId struct
 func DatabasePrincipalID(input string) (*DatabasePrincipalId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/loadbalancers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -21,10 +22,13 @@ import (
 )
 
 var (
-	_ sdk.Resource           = BackendAddressPoolAddressResource{}
-	_ sdk.ResourceWithUpdate = BackendAddressPoolAddressResource{}
+	_ sdk.Resource             = BackendAddressPoolAddressResource{}
+	_ sdk.ResourceWithUpdate   = BackendAddressPoolAddressResource{}
+	_ sdk.ResourceWithIdentity = BackendAddressPoolAddressResource{}
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name lb_backend_address_pool_address -service-package-name loadbalancer -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,load_balancer_name:id,backend_address_pool_name:id" -test-resource-type BackendAddressPoolAddressResourceTests
+
 type BackendAddressPoolAddressResource struct{}
 
 type BackendAddressPoolAddressModel struct {
@@ -325,6 +329,10 @@ func (r BackendAddressPoolAddressResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&model)
 		},
 		Timeout: 5 * time.Minute,
@@ -404,6 +412,10 @@ func (r BackendAddressPoolAddressResource) IDValidationFunc() pluginsdk.SchemaVa
 	return validate.BackendAddressPoolAddressID
 }
 
+func (r BackendAddressPoolAddressResource) Identity() resourceids.ResourceId {
+	return &parse.BackendAddressPoolAddressId{}
+}
+
 func (r BackendAddressPoolAddressResource) Update() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
@@ -32,6 +32,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name lb -service-package-name loadbalancer -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type LoadBalancer
+
 func resourceArmLoadBalancer() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceArmLoadBalancerCreateUpdate,
@@ -39,10 +41,11 @@ func resourceArmLoadBalancer() *pluginsdk.Resource {
 		Update: resourceArmLoadBalancerCreateUpdate,
 		Delete: resourceArmLoadBalancerDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := loadbalancers.ParseLoadBalancerID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&loadbalancers.LoadBalancerId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&loadbalancers.LoadBalancerId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -196,9 +199,11 @@ func resourceArmLoadBalancerRead(d *pluginsdk.ResourceData, meta interface{}) er
 			}
 		}
 
-		return tags.FlattenAndSet(d, model.Tags)
+		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+			return err
+		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceArmLoadBalancerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &BackendAddressPoolAddressId{}
+
 type BackendAddressPoolAddressId struct {
 	SubscriptionId         string
 	ResourceGroup          string
@@ -47,6 +49,51 @@ func (id BackendAddressPoolAddressId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName, id.AddressName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this BackendAddressPoolAddress ID
+func (id BackendAddressPoolAddressId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticLoadBalancers", "loadBalancers", "loadBalancers"),
+		resourceids.UserSpecifiedSegment("loadBalancerName", "loadBalancer1"),
+		resourceids.StaticSegment("staticBackendAddressPools", "backendAddressPools", "backendAddressPools"),
+		resourceids.UserSpecifiedSegment("backendAddressPoolName", "backendAddressPool1"),
+		resourceids.StaticSegment("staticAddresses", "addresses", "addresses"),
+		resourceids.UserSpecifiedSegment("addressName", "address1"),
+	}
+}
+
+// FromParseResult populates the fields of this BackendAddressPoolAddress ID from the ParseResult, for use with resourceids.Parser
+func (id *BackendAddressPoolAddressId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.LoadBalancerName, ok = input.Parsed["loadBalancerName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "loadBalancerName", input)
+	}
+
+	if id.BackendAddressPoolName, ok = input.Parsed["backendAddressPoolName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "backendAddressPoolName", input)
+	}
+
+	if id.AddressName, ok = input.Parsed["addressName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "addressName", input)
+	}
+
+	return nil
+}
+
 // BackendAddressPoolAddressID parses a BackendAddressPoolAddress ID into an BackendAddressPoolAddressId struct
 func BackendAddressPoolAddressID(input string) (*BackendAddressPoolAddressId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -21,6 +21,24 @@ func TestBackendAddressPoolAddressIDFormatter(t *testing.T) {
 	}
 }
 
+func TestBackendAddressPoolAddressIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&BackendAddressPoolAddressId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1/backendAddressPools/backendAddressPool1/addresses/address1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual BackendAddressPoolAddressId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1/backendAddressPools/backendAddressPool1/addresses/address1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestBackendAddressPoolAddressID(t *testing.T) {
 	testData := []struct {
 		Input    string
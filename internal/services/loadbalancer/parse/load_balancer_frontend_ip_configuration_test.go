@@ -21,6 +21,24 @@ func TestLoadBalancerFrontendIpConfigurationIDFormatter(t *testing.T) {
 	}
 }
 
+func TestLoadBalancerFrontendIpConfigurationIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&LoadBalancerFrontendIpConfigurationId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1/frontendIPConfigurations/frontendIPConfig1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual LoadBalancerFrontendIpConfigurationId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1/frontendIPConfigurations/frontendIPConfig1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestLoadBalancerFrontendIpConfigurationID(t *testing.T) {
 	testData := []struct {
 		Input    string
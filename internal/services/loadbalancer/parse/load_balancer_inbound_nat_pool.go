@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &LoadBalancerInboundNatPoolId{}
+
 type LoadBalancerInboundNatPoolId struct {
 	SubscriptionId     string
 	ResourceGroup      string
@@ -44,6 +46,45 @@ func (id LoadBalancerInboundNatPoolId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.InboundNatPoolName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this LoadBalancerInboundNatPool ID
+func (id LoadBalancerInboundNatPoolId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticLoadBalancers", "loadBalancers", "loadBalancers"),
+		resourceids.UserSpecifiedSegment("loadBalancerName", "loadBalancer1"),
+		resourceids.StaticSegment("staticInboundNatPools", "inboundNatPools", "inboundNatPools"),
+		resourceids.UserSpecifiedSegment("inboundNatPoolName", "pool1"),
+	}
+}
+
+// FromParseResult populates the fields of this LoadBalancerInboundNatPool ID from the ParseResult, for use with resourceids.Parser
+func (id *LoadBalancerInboundNatPoolId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.LoadBalancerName, ok = input.Parsed["loadBalancerName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "loadBalancerName", input)
+	}
+
+	if id.InboundNatPoolName, ok = input.Parsed["inboundNatPoolName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "inboundNatPoolName", input)
+	}
+
+	return nil
+}
+
 // LoadBalancerInboundNatPoolID parses a LoadBalancerInboundNatPool ID into an LoadBalancerInboundNatPoolId struct
 func LoadBalancerInboundNatPoolID(input string) (*LoadBalancerInboundNatPoolId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -21,6 +21,24 @@ func TestLoadBalancerInboundNatPoolIDFormatter(t *testing.T) {
 	}
 }
 
+func TestLoadBalancerInboundNatPoolIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&LoadBalancerInboundNatPoolId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1/inboundNatPools/pool1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual LoadBalancerInboundNatPoolId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1/inboundNatPools/pool1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestLoadBalancerInboundNatPoolID(t *testing.T) {
 	testData := []struct {
 		Input    string
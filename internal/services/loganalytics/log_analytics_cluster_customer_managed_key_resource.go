@@ -38,7 +38,7 @@ func resourceLogAnalyticsClusterCustomerManagedKey() *pluginsdk.Resource {
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := clusters.ParseClusterID(id)
 			return err
-		}),
+		}, &clusters.ClusterId{}),
 
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
 			0: migration.ClusterCustomerManagedKeyV0ToV1{},
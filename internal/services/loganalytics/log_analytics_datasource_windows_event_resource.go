@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/datasources"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/migration"
@@ -23,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name log_analytics_datasource_windows_event -service-package-name loganalytics -properties "resource_group_name,workspace_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type LogAnalyticsDataSourceWindowsEventResource
+
 func resourceLogAnalyticsDataSourceWindowsEvent() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceLogAnalyticsDataSourceWindowsEventCreateUpdate,
@@ -30,10 +33,11 @@ func resourceLogAnalyticsDataSourceWindowsEvent() *pluginsdk.Resource {
 		Update: resourceLogAnalyticsDataSourceWindowsEventCreateUpdate,
 		Delete: resourceLogAnalyticsDataSourceWindowsEventDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := datasources.ParseDataSourceID(id)
-			return err
-		}, importLogAnalyticsDataSource(datasources.DataSourceKindWindowsEvent)),
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&datasources.DataSourceId{}, importLogAnalyticsDataSource(datasources.DataSourceKindWindowsEvent)),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&datasources.DataSourceId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -175,7 +179,7 @@ func resourceLogAnalyticsDataSourceWindowsEventRead(d *pluginsdk.ResourceData, m
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceLogAnalyticsDataSourceWindowsEventDelete(d *pluginsdk.ResourceData, meta interface{}) error {
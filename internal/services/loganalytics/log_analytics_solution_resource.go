@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationsmanagement/2015-11-01-preview/solution"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -26,6 +27,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name log_analytics_solution -service-package-name loganalytics -properties "resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "name:id" -test-name containerMonitoring
+
 type LogAnalyticsSolutionResource struct{}
 
 func (s LogAnalyticsSolutionResource) StateUpgraders() sdk.StateUpgradeData {
@@ -57,6 +60,7 @@ type SolutionPlanModel struct {
 var (
 	_ sdk.ResourceWithUpdate         = LogAnalyticsSolutionResource{}
 	_ sdk.ResourceWithStateMigration = LogAnalyticsSolutionResource{}
+	_ sdk.ResourceWithIdentity       = LogAnalyticsSolutionResource{}
 )
 
 func (s LogAnalyticsSolutionResource) ModelObject() interface{} {
@@ -71,6 +75,10 @@ func (s LogAnalyticsSolutionResource) IDValidationFunc() pluginsdk.SchemaValidat
 	return solution.ValidateSolutionID
 }
 
+func (s LogAnalyticsSolutionResource) Identity() resourceids.ResourceId {
+	return &solution.SolutionId{}
+}
+
 func (s LogAnalyticsSolutionResource) Arguments() map[string]*schema.Schema {
 	return map[string]*pluginsdk.Schema{
 		"solution_name": {
@@ -256,6 +264,10 @@ func (s LogAnalyticsSolutionResource) Read() sdk.ResourceFunc {
 				state.Tags = pointer.From(model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
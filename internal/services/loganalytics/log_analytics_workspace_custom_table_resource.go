@@ -0,0 +1,440 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loganalytics
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/tables"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name log_analytics_workspace_custom_table -service-package-name loganalytics -properties "table_name:name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:workspace_id,workspace_name:workspace_id"
+
+// tablePlanEnumAuxiliary is accepted by the service but is not yet exposed as a constant by the SDK
+const tablePlanEnumAuxiliary = tables.TablePlanEnum("Auxiliary")
+
+type LogAnalyticsWorkspaceCustomTableResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = LogAnalyticsWorkspaceCustomTableResource{}
+	_ sdk.ResourceWithIdentity      = LogAnalyticsWorkspaceCustomTableResource{}
+	_ sdk.ResourceWithCustomizeDiff = LogAnalyticsWorkspaceCustomTableResource{}
+)
+
+type LogAnalyticsWorkspaceCustomTableResourceModel struct {
+	Name                 string                                   `tfschema:"name"`
+	WorkspaceId          string                                   `tfschema:"workspace_id"`
+	Column               []LogAnalyticsWorkspaceCustomTableColumn `tfschema:"column"`
+	Description          string                                   `tfschema:"description"`
+	DisplayName          string                                   `tfschema:"display_name"`
+	Plan                 string                                   `tfschema:"plan"`
+	RetentionInDays      int64                                    `tfschema:"retention_in_days"`
+	TotalRetentionInDays int64                                    `tfschema:"total_retention_in_days"`
+}
+
+type LogAnalyticsWorkspaceCustomTableColumn struct {
+	Name        string `tfschema:"name"`
+	Type        string `tfschema:"type"`
+	Description string `tfschema:"description"`
+	DisplayName string `tfschema:"display_name"`
+}
+
+func (r LogAnalyticsWorkspaceCustomTableResource) Identity() resourceids.ResourceId {
+	return &tables.TableId{}
+}
+
+func (r LogAnalyticsWorkspaceCustomTableResource) ResourceType() string {
+	return "azurerm_log_analytics_workspace_custom_table"
+}
+
+func (r LogAnalyticsWorkspaceCustomTableResource) ModelObject() interface{} {
+	return &LogAnalyticsWorkspaceCustomTableResourceModel{}
+}
+
+func (r LogAnalyticsWorkspaceCustomTableResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return tables.ValidateTableID
+}
+
+func (r LogAnalyticsWorkspaceCustomTableResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]{0,59}_CL$`),
+				"`name` must start with a letter, may only contain letters, numbers and underscores, and must end with `_CL`",
+			),
+		},
+
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"column": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(tables.PossibleValuesForColumnTypeEnum(), false),
+					},
+
+					"description": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"display_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"plan": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(tables.TablePlanEnumAnalytics),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(tables.TablePlanEnumAnalytics),
+				string(tables.TablePlanEnumBasic),
+				string(tablePlanEnumAuxiliary),
+			}, false),
+		},
+
+		"retention_in_days": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(4, 730),
+		},
+
+		"total_retention_in_days": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.Any(validation.IntBetween(4, 730), validation.IntInSlice([]int{1095, 1460, 1826, 2191, 2556, 2922, 3288, 3653, 4018, 4383})),
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceCustomTableResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r LogAnalyticsWorkspaceCustomTableResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			if plan := rd.Get("plan").(string); plan != string(tables.TablePlanEnumAnalytics) {
+				if v := rd.GetRawConfig().AsValueMap()["retention_in_days"]; !v.IsNull() {
+					return fmt.Errorf("`retention_in_days` cannot be set when `plan` is `%s` because the interactive retention is fixed by the service", plan)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceCustomTableResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.TablesClient
+
+			var model LogAnalyticsWorkspaceCustomTableResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := tables.NewTableID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := tables.Table{
+				Properties: &tables.TableProperties{
+					Plan: pointer.To(tables.TablePlanEnum(model.Plan)),
+					Schema: &tables.Schema{
+						Name:    pointer.To(model.Name),
+						Columns: expandLogAnalyticsWorkspaceCustomTableColumns(model.Column),
+					},
+				},
+			}
+
+			if model.Description != "" {
+				payload.Properties.Schema.Description = pointer.To(model.Description)
+			}
+
+			if model.DisplayName != "" {
+				payload.Properties.Schema.DisplayName = pointer.To(model.DisplayName)
+			}
+
+			if model.RetentionInDays != 0 {
+				payload.Properties.RetentionInDays = pointer.To(model.RetentionInDays)
+			}
+
+			if model.TotalRetentionInDays != 0 {
+				payload.Properties.TotalRetentionInDays = pointer.To(model.TotalRetentionInDays)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceCustomTableResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.TablesClient
+
+			id, err := tables.ParseTableID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config LogAnalyticsWorkspaceCustomTableResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := existing.Model
+			props := payload.Properties
+
+			if props.Schema == nil {
+				props.Schema = &tables.Schema{
+					Name: pointer.To(id.TableName),
+				}
+			}
+
+			if metadata.ResourceData.HasChange("column") {
+				props.Schema.Columns = expandLogAnalyticsWorkspaceCustomTableColumns(config.Column)
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				props.Schema.Description = pointer.To(config.Description)
+			}
+
+			if metadata.ResourceData.HasChange("display_name") {
+				props.Schema.DisplayName = pointer.To(config.DisplayName)
+			}
+
+			if metadata.ResourceData.HasChange("plan") {
+				props.Plan = pointer.To(tables.TablePlanEnum(config.Plan))
+			}
+
+			if config.Plan == string(tables.TablePlanEnumAnalytics) {
+				if metadata.ResourceData.HasChange("retention_in_days") {
+					props.RetentionInDays = pointer.To(config.RetentionInDays)
+				}
+			} else {
+				props.RetentionInDays = nil
+			}
+
+			if metadata.ResourceData.HasChange("total_retention_in_days") {
+				props.TotalRetentionInDays = pointer.To(config.TotalRetentionInDays)
+			}
+
+			// the remaining properties are read-only and are rejected by the service when sent back
+			props.ArchiveRetentionInDays = nil
+			props.LastPlanModifiedDate = nil
+			props.ProvisioningState = nil
+			props.ResultStatistics = nil
+			props.RetentionInDaysAsDefault = nil
+			props.TotalRetentionInDaysAsDefault = nil
+			props.Schema.StandardColumns = nil
+			props.Schema.Categories = nil
+			props.Schema.Labels = nil
+			props.Schema.Solutions = nil
+			props.Schema.Source = nil
+			props.Schema.TableSubType = nil
+			props.Schema.TableType = nil
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceCustomTableResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.TablesClient
+
+			id, err := tables.ParseTableID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := LogAnalyticsWorkspaceCustomTableResourceModel{
+				Name:        id.TableName,
+				WorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Plan = string(pointer.From(props.Plan))
+					if pointer.From(props.Plan) == tables.TablePlanEnumAnalytics {
+						state.RetentionInDays = pointer.From(props.RetentionInDays)
+					}
+					state.TotalRetentionInDays = pointer.From(props.TotalRetentionInDays)
+
+					if schema := props.Schema; schema != nil {
+						state.Column = flattenLogAnalyticsWorkspaceCustomTableColumns(schema.Columns)
+						state.Description = pointer.From(schema.Description)
+						state.DisplayName = pointer.From(schema.DisplayName)
+					}
+				}
+			}
+
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceCustomTableResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.TablesClient
+
+			id, err := tables.ParseTableID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandLogAnalyticsWorkspaceCustomTableColumns(input []LogAnalyticsWorkspaceCustomTableColumn) *[]tables.Column {
+	result := make([]tables.Column, 0)
+	for _, v := range input {
+		column := tables.Column{
+			Name: pointer.To(v.Name),
+			Type: pointer.To(tables.ColumnTypeEnum(v.Type)),
+		}
+
+		if v.Description != "" {
+			column.Description = pointer.To(v.Description)
+		}
+
+		if v.DisplayName != "" {
+			column.DisplayName = pointer.To(v.DisplayName)
+		}
+
+		result = append(result, column)
+	}
+
+	return &result
+}
+
+func flattenLogAnalyticsWorkspaceCustomTableColumns(input *[]tables.Column) []LogAnalyticsWorkspaceCustomTableColumn {
+	result := make([]LogAnalyticsWorkspaceCustomTableColumn, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		result = append(result, LogAnalyticsWorkspaceCustomTableColumn{
+			Name:        pointer.From(v.Name),
+			Type:        string(pointer.From(v.Type)),
+			Description: pointer.From(v.Description),
+			DisplayName: pointer.From(v.DisplayName),
+		})
+	}
+
+	return result
+}
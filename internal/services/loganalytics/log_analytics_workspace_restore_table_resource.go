@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loganalytics
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/tables"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name log_analytics_workspace_restore_table -service-package-name loganalytics -properties "table_name:name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:workspace_id,workspace_name:workspace_id"
+
+type LogAnalyticsWorkspaceRestoreTableResource struct{}
+
+var _ sdk.ResourceWithIdentity = LogAnalyticsWorkspaceRestoreTableResource{}
+
+type LogAnalyticsWorkspaceRestoreTableResourceModel struct {
+	Name             string `tfschema:"name"`
+	WorkspaceId      string `tfschema:"workspace_id"`
+	SourceTableName  string `tfschema:"source_table_name"`
+	StartRestoreTime string `tfschema:"start_restore_time"`
+	EndRestoreTime   string `tfschema:"end_restore_time"`
+}
+
+func (r LogAnalyticsWorkspaceRestoreTableResource) Identity() resourceids.ResourceId {
+	return &tables.TableId{}
+}
+
+func (r LogAnalyticsWorkspaceRestoreTableResource) ResourceType() string {
+	return "azurerm_log_analytics_workspace_restore_table"
+}
+
+func (r LogAnalyticsWorkspaceRestoreTableResource) ModelObject() interface{} {
+	return &LogAnalyticsWorkspaceRestoreTableResourceModel{}
+}
+
+func (r LogAnalyticsWorkspaceRestoreTableResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return tables.ValidateTableID
+}
+
+func (r LogAnalyticsWorkspaceRestoreTableResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]{0,58}_RST$`),
+				"`name` must start with a letter, may only contain letters, numbers and underscores, and must end with `_RST`",
+			),
+		},
+
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"source_table_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"start_restore_time": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"end_restore_time": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceRestoreTableResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r LogAnalyticsWorkspaceRestoreTableResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 2 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.TablesClient
+
+			var model LogAnalyticsWorkspaceRestoreTableResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := tables.NewTableID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			startRestoreTime, err := time.Parse(time.RFC3339, model.StartRestoreTime)
+			if err != nil {
+				return fmt.Errorf("parsing `start_restore_time`: %+v", err)
+			}
+
+			endRestoreTime, err := time.Parse(time.RFC3339, model.EndRestoreTime)
+			if err != nil {
+				return fmt.Errorf("parsing `end_restore_time`: %+v", err)
+			}
+
+			restoredLogs := &tables.RestoredLogs{
+				SourceTable: pointer.To(model.SourceTableName),
+			}
+			restoredLogs.SetStartRestoreTimeAsTime(startRestoreTime)
+			restoredLogs.SetEndRestoreTimeAsTime(endRestoreTime)
+
+			payload := tables.Table{
+				Properties: &tables.TableProperties{
+					RestoredLogs: restoredLogs,
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceRestoreTableResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.TablesClient
+
+			id, err := tables.ParseTableID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := LogAnalyticsWorkspaceRestoreTableResourceModel{
+				Name:        id.TableName,
+				WorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					if restoredLogs := props.RestoredLogs; restoredLogs != nil {
+						state.SourceTableName = pointer.From(restoredLogs.SourceTable)
+
+						startRestoreTime, err := restoredLogs.GetStartRestoreTimeAsTime()
+						if err != nil {
+							return fmt.Errorf("parsing `startRestoreTime`: %+v", err)
+						}
+						if startRestoreTime != nil {
+							state.StartRestoreTime = startRestoreTime.Format(time.RFC3339)
+						}
+
+						endRestoreTime, err := restoredLogs.GetEndRestoreTimeAsTime()
+						if err != nil {
+							return fmt.Errorf("parsing `endRestoreTime`: %+v", err)
+						}
+						if endRestoreTime != nil {
+							state.EndRestoreTime = endRestoreTime.Format(time.RFC3339)
+						}
+					}
+				}
+			}
+
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceRestoreTableResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.TablesClient
+
+			id, err := tables.ParseTableID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
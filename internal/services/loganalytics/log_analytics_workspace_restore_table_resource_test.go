@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loganalytics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/tables"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type LogAnalyticsWorkspaceRestoreTableResource struct{}
+
+func TestAccLogAnalyticsWorkspaceRestoreTable_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_restore_table", "test")
+	r := LogAnalyticsWorkspaceRestoreTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogAnalyticsWorkspaceRestoreTable_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_restore_table", "test")
+	r := LogAnalyticsWorkspaceRestoreTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r LogAnalyticsWorkspaceRestoreTableResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := tables.ParseTableID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.LogAnalytics.TablesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r LogAnalyticsWorkspaceRestoreTableResource) basic(data acceptance.TestData) string {
+	startTime, endTime := r.restoreWindow()
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_restore_table" "test" {
+  name               = "acctest%d_RST"
+  workspace_id       = azurerm_log_analytics_workspace.test.id
+  source_table_name  = azurerm_log_analytics_workspace_custom_table.test.name
+  start_restore_time = "%s"
+  end_restore_time   = "%s"
+}
+`, LogAnalyticsWorkspaceCustomTableResource{}.basic(data), data.RandomInteger, startTime.Format(time.RFC3339), endTime.Format(time.RFC3339))
+}
+
+func (r LogAnalyticsWorkspaceRestoreTableResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_restore_table" "import" {
+  name               = azurerm_log_analytics_workspace_restore_table.test.name
+  workspace_id       = azurerm_log_analytics_workspace_restore_table.test.workspace_id
+  source_table_name  = azurerm_log_analytics_workspace_restore_table.test.source_table_name
+  start_restore_time = azurerm_log_analytics_workspace_restore_table.test.start_restore_time
+  end_restore_time   = azurerm_log_analytics_workspace_restore_table.test.end_restore_time
+}
+`, r.basic(data))
+}
+
+// restoreWindow returns a time range ending at the start of the current day, so that the configuration is stable across test steps
+func (r LogAnalyticsWorkspaceRestoreTableResource) restoreWindow() (time.Time, time.Time) {
+	endTime := time.Now().UTC().Truncate(24 * time.Hour)
+	return endTime.Add(-2 * time.Hour), endTime
+}
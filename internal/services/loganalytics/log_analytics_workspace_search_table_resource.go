@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loganalytics
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/tables"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name log_analytics_workspace_search_table -service-package-name loganalytics -properties "table_name:name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:workspace_id,workspace_name:workspace_id"
+
+type LogAnalyticsWorkspaceSearchTableResource struct{}
+
+var _ sdk.ResourceWithIdentity = LogAnalyticsWorkspaceSearchTableResource{}
+
+type LogAnalyticsWorkspaceSearchTableResourceModel struct {
+	Name            string `tfschema:"name"`
+	WorkspaceId     string `tfschema:"workspace_id"`
+	Query           string `tfschema:"query"`
+	StartSearchTime string `tfschema:"start_search_time"`
+	EndSearchTime   string `tfschema:"end_search_time"`
+	Description     string `tfschema:"description"`
+	Limit           int64  `tfschema:"limit"`
+	SourceTableName string `tfschema:"source_table_name"`
+}
+
+func (r LogAnalyticsWorkspaceSearchTableResource) Identity() resourceids.ResourceId {
+	return &tables.TableId{}
+}
+
+func (r LogAnalyticsWorkspaceSearchTableResource) ResourceType() string {
+	return "azurerm_log_analytics_workspace_search_table"
+}
+
+func (r LogAnalyticsWorkspaceSearchTableResource) ModelObject() interface{} {
+	return &LogAnalyticsWorkspaceSearchTableResourceModel{}
+}
+
+func (r LogAnalyticsWorkspaceSearchTableResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return tables.ValidateTableID
+}
+
+func (r LogAnalyticsWorkspaceSearchTableResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]{0,57}_SRCH$`),
+				"`name` must start with a letter, may only contain letters, numbers and underscores, and must end with `_SRCH`",
+			),
+		},
+
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"query": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"start_search_time": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"end_search_time": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"limit": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntBetween(1, 1000000),
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceSearchTableResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"source_table_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceSearchTableResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 2 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.TablesClient
+
+			var model LogAnalyticsWorkspaceSearchTableResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := tables.NewTableID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			startSearchTime, err := time.Parse(time.RFC3339, model.StartSearchTime)
+			if err != nil {
+				return fmt.Errorf("parsing `start_search_time`: %+v", err)
+			}
+
+			endSearchTime, err := time.Parse(time.RFC3339, model.EndSearchTime)
+			if err != nil {
+				return fmt.Errorf("parsing `end_search_time`: %+v", err)
+			}
+
+			searchResults := &tables.SearchResults{
+				Query: pointer.To(model.Query),
+			}
+			searchResults.SetStartSearchTimeAsTime(startSearchTime)
+			searchResults.SetEndSearchTimeAsTime(endSearchTime)
+
+			if model.Description != "" {
+				searchResults.Description = pointer.To(model.Description)
+			}
+
+			if model.Limit != 0 {
+				searchResults.Limit = pointer.To(model.Limit)
+			}
+
+			payload := tables.Table{
+				Properties: &tables.TableProperties{
+					SearchResults: searchResults,
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceSearchTableResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.TablesClient
+
+			id, err := tables.ParseTableID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := LogAnalyticsWorkspaceSearchTableResourceModel{
+				Name:        id.TableName,
+				WorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					if searchResults := props.SearchResults; searchResults != nil {
+						state.Query = pointer.From(searchResults.Query)
+						state.Description = pointer.From(searchResults.Description)
+						state.Limit = pointer.From(searchResults.Limit)
+						state.SourceTableName = pointer.From(searchResults.SourceTable)
+
+						startSearchTime, err := searchResults.GetStartSearchTimeAsTime()
+						if err != nil {
+							return fmt.Errorf("parsing `startSearchTime`: %+v", err)
+						}
+						if startSearchTime != nil {
+							state.StartSearchTime = startSearchTime.Format(time.RFC3339)
+						}
+
+						endSearchTime, err := searchResults.GetEndSearchTimeAsTime()
+						if err != nil {
+							return fmt.Errorf("parsing `endSearchTime`: %+v", err)
+						}
+						if endSearchTime != nil {
+							state.EndSearchTime = endSearchTime.Format(time.RFC3339)
+						}
+					}
+				}
+			}
+
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceSearchTableResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.TablesClient
+
+			id, err := tables.ParseTableID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loganalytics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/tables"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type LogAnalyticsWorkspaceSearchTableResource struct{}
+
+func TestAccLogAnalyticsWorkspaceSearchTable_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_search_table", "test")
+	r := LogAnalyticsWorkspaceSearchTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("source_table_name").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogAnalyticsWorkspaceSearchTable_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_search_table", "test")
+	r := LogAnalyticsWorkspaceSearchTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("limit").HasValue("1000"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r LogAnalyticsWorkspaceSearchTableResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := tables.ParseTableID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.LogAnalytics.TablesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r LogAnalyticsWorkspaceSearchTableResource) basic(data acceptance.TestData) string {
+	startTime, endTime := r.searchWindow()
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_search_table" "test" {
+  name              = "acctest%d_SRCH"
+  workspace_id      = azurerm_log_analytics_workspace.test.id
+  query             = "${azurerm_log_analytics_workspace_custom_table.test.name} | where Message contains 'error'"
+  start_search_time = "%s"
+  end_search_time   = "%s"
+}
+`, LogAnalyticsWorkspaceCustomTableResource{}.plan(data, "Basic"), data.RandomInteger, startTime.Format(time.RFC3339), endTime.Format(time.RFC3339))
+}
+
+func (r LogAnalyticsWorkspaceSearchTableResource) complete(data acceptance.TestData) string {
+	startTime, endTime := r.searchWindow()
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_search_table" "test" {
+  name              = "acctest%d_SRCH"
+  workspace_id      = azurerm_log_analytics_workspace.test.id
+  query             = "${azurerm_log_analytics_workspace_custom_table.test.name} | where Message contains 'error'"
+  description       = "Acceptance test search job"
+  limit             = 1000
+  start_search_time = "%s"
+  end_search_time   = "%s"
+}
+`, LogAnalyticsWorkspaceCustomTableResource{}.plan(data, "Basic"), data.RandomInteger, startTime.Format(time.RFC3339), endTime.Format(time.RFC3339))
+}
+
+// searchWindow returns a time range ending at the start of the current day, so that the configuration is stable across test steps
+func (r LogAnalyticsWorkspaceSearchTableResource) searchWindow() (time.Time, time.Time) {
+	endTime := time.Now().UTC().Truncate(24 * time.Hour)
+	return endTime.Add(-24 * time.Hour), endTime
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/tables"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,11 +20,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name log_analytics_workspace_table -service-package-name loganalytics -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,workspace_name:id" -test-name updateRetention
+
 type LogAnalyticsWorkspaceTableResource struct{}
 
 var (
 	_ sdk.ResourceWithUpdate        = LogAnalyticsWorkspaceTableResource{}
 	_ sdk.ResourceWithCustomizeDiff = LogAnalyticsWorkspaceTableResource{}
+	_ sdk.ResourceWithIdentity      = LogAnalyticsWorkspaceTableResource{}
 )
 
 type LogAnalyticsWorkspaceTableResourceModel struct {
@@ -104,6 +108,10 @@ func (r LogAnalyticsWorkspaceTableResource) IDValidationFunc() pluginsdk.SchemaV
 	return tables.ValidateTableID
 }
 
+func (r LogAnalyticsWorkspaceTableResource) Identity() resourceids.ResourceId {
+	return &tables.TableId{}
+}
+
 func (r LogAnalyticsWorkspaceTableResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
@@ -252,6 +260,10 @@ func (r LogAnalyticsWorkspaceTableResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -32,6 +32,9 @@ func (r Registration) Resources() []sdk.Resource {
 		LogAnalyticsQueryPackQueryResource{},
 		LogAnalyticsSolutionResource{},
 		LogAnalyticsWorkspaceTableResource{},
+		LogAnalyticsWorkspaceCustomTableResource{},
+		LogAnalyticsWorkspaceRestoreTableResource{},
+		LogAnalyticsWorkspaceSearchTableResource{},
 	}
 }
 
@@ -10,11 +10,14 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/workflows"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name logic_app_action_custom -service-package-name logic -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,workflow_name:id"
+
 func resourceLogicAppActionCustom() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceLogicAppActionCustomCreateUpdate,
@@ -22,10 +25,11 @@ func resourceLogicAppActionCustom() *pluginsdk.Resource {
 		Update: resourceLogicAppActionCustomCreateUpdate,
 		Delete: resourceLogicAppActionCustomDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.ActionID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.ActionId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.ActionId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -113,7 +117,7 @@ func resourceLogicAppActionCustomRead(d *pluginsdk.ResourceData, meta interface{
 		return fmt.Errorf("setting `body` for Action %q: %+v", id.Name, err)
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceLogicAppActionCustomDelete(d *pluginsdk.ResourceData, meta interface{}) error {
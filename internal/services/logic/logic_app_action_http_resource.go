@@ -20,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name logic_app_action_http -service-package-name logic -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,workflow_name:id"
+
 func resourceLogicAppActionHTTP() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceLogicAppActionHTTPCreateUpdate,
@@ -27,10 +29,11 @@ func resourceLogicAppActionHTTP() *pluginsdk.Resource {
 		Update: resourceLogicAppActionHTTPCreateUpdate,
 		Delete: resourceLogicAppActionHTTPDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.ActionID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.ActionId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.ActionId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -268,7 +271,7 @@ func resourceLogicAppActionHTTPRead(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceLogicAppActionHTTPDelete(d *pluginsdk.ResourceData, meta interface{}) error {
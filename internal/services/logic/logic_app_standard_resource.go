@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/resourceproviders"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -30,6 +31,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name logic_app_standard -service-package-name logic -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type LogicAppStandardResource
+
 type LogicAppResource struct{}
 
 type LogicAppResourceModel struct {
@@ -712,6 +715,10 @@ func (r LogicAppResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return commonids.ValidateLogicAppId
 }
 
+func (r LogicAppResource) Identity() resourceids.ResourceId {
+	return &commonids.LogicAppId{}
+}
+
 func (r LogicAppResource) Update() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -891,6 +898,8 @@ func (r LogicAppResource) Update() sdk.ResourceFunc {
 
 var _ sdk.ResourceWithUpdate = &LogicAppResource{}
 
+var _ sdk.ResourceWithIdentity = &LogicAppResource{}
+
 func getBasicLogicAppSettings(d LogicAppResourceModel, endpointSuffix string) ([]webapps.NameValuePair, error) {
 	appKindPropName := "APP_KIND"
 	appKindPropValue := "workflowApp"
@@ -12,10 +12,13 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/workflows"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/workflowtriggers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name logic_app_trigger_custom -service-package-name logic -properties "name" -compare-values "subscription_id:id,resource_group_name:id,workflow_name:id"
+
 func resourceLogicAppTriggerCustom() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceLogicAppTriggerCustomCreateUpdate,
@@ -23,10 +26,11 @@ func resourceLogicAppTriggerCustom() *pluginsdk.Resource {
 		Update: resourceLogicAppTriggerCustomCreateUpdate,
 		Delete: resourceLogicAppTriggerCustomDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := workflowtriggers.ParseTriggerID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&workflowtriggers.TriggerId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&workflowtriggers.TriggerId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -124,7 +128,7 @@ func resourceLogicAppTriggerCustomRead(d *pluginsdk.ResourceData, meta interface
 		return fmt.Errorf("setting `body` for %s: %+v", id.ID(), err)
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceLogicAppTriggerCustomDelete(d *pluginsdk.ResourceData, meta interface{}) error {
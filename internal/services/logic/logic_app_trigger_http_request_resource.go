@@ -13,11 +13,14 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/workflows"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/workflowtriggers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name logic_app_trigger_http_request -service-package-name logic -properties "name" -compare-values "subscription_id:id,resource_group_name:id,workflow_name:id"
+
 func resourceLogicAppTriggerHttpRequest() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceLogicAppTriggerHttpRequestCreateUpdate,
@@ -25,10 +28,11 @@ func resourceLogicAppTriggerHttpRequest() *pluginsdk.Resource {
 		Update: resourceLogicAppTriggerHttpRequestCreateUpdate,
 		Delete: resourceLogicAppTriggerHttpRequestDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := workflowtriggers.ParseTriggerID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&workflowtriggers.TriggerId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&workflowtriggers.TriggerId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -185,7 +189,7 @@ func resourceLogicAppTriggerHttpRequestRead(d *pluginsdk.ResourceData, meta inte
 		d.Set("schema", string(schema))
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceLogicAppTriggerHttpRequestDelete(d *pluginsdk.ResourceData, meta interface{}) error {
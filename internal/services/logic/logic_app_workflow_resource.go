@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccounts"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationserviceenvironments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/workflows"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -28,6 +29,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name logic_app_workflow -service-package-name logic -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name empty
+
 var logicAppResourceName = "azurerm_logic_app"
 
 func resourceLogicAppWorkflow() *pluginsdk.Resource {
@@ -37,10 +40,11 @@ func resourceLogicAppWorkflow() *pluginsdk.Resource {
 		Update: resourceLogicAppWorkflowUpdate,
 		Delete: resourceLogicAppWorkflowDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := workflows.ParseWorkflowID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&workflows.WorkflowId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&workflows.WorkflowId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -571,10 +575,12 @@ func resourceLogicAppWorkflowRead(d *pluginsdk.ResourceData, meta interface{}) e
 			d.Set("logic_app_integration_account_id", integrationAccountId)
 		}
 
-		return tags.FlattenAndSet(d, model.Tags)
+		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+			return err
+		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceLogicAppWorkflowDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,6 +21,24 @@ func TestLogicAppStandardIDFormatter(t *testing.T) {
 	}
 }
 
+func TestLogicAppStandardIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&LogicAppStandardId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual LogicAppStandardId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestLogicAppStandardID(t *testing.T) {
 	testData := []struct {
 		Input    string
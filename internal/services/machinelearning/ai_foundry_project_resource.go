@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -24,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name ai_foundry_project -service-package-name machinelearning -properties "name" -compare-values "subscription_id:id,resource_group_name:id" -test-resource-type AIFoundryProject
+
 type AIFoundryProject struct{}
 
 type AIFoundryProjectModel struct {
@@ -75,6 +78,11 @@ func (r AIFoundryProject) CustomImporter() sdk.ResourceRunFunc {
 var _ sdk.ResourceWithUpdate = AIFoundryProject{}
 
 var _ sdk.ResourceWithCustomImporter = AIFoundryProject{}
+var _ sdk.ResourceWithIdentity = AIFoundryProject{}
+
+func (r AIFoundryProject) Identity() resourceids.ResourceId {
+	return &workspaces.WorkspaceId{}
+}
 
 func (r AIFoundryProject) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -342,6 +350,10 @@ func (r AIFoundryProject) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&hub)
 		},
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	components "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2020-02-02/componentsapis"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/registries"
@@ -29,6 +30,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name ai_foundry -service-package-name machinelearning -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type AIFoundry
+
 type AIFoundry struct{}
 
 type AIFoundryModel struct {
@@ -98,6 +101,11 @@ func (r AIFoundry) CustomImporter() sdk.ResourceRunFunc {
 var _ sdk.ResourceWithUpdate = AIFoundry{}
 
 var _ sdk.ResourceWithCustomImporter = AIFoundry{}
+var _ sdk.ResourceWithIdentity = AIFoundry{}
+
+func (r AIFoundry) Identity() resourceids.ResourceId {
+	return &workspaces.WorkspaceId{}
+}
 
 func (r AIFoundry) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -519,6 +527,10 @@ func (r AIFoundry) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&hub)
 		},
 	}
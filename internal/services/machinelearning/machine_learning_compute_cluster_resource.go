@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/machinelearningcomputes"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/workspaces"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -23,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name machine_learning_compute_cluster -service-package-name machinelearning -properties "name" -compare-values "subscription_id:id,resource_group_name:id,workspace_name:id" -test-resource-type ComputeClusterResource
+
 func resourceComputeCluster() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceComputeClusterCreate,
@@ -30,10 +33,11 @@ func resourceComputeCluster() *pluginsdk.Resource {
 		Update: resourceComputeClusterUpdate,
 		Delete: resourceComputeClusterDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := machinelearningcomputes.ParseComputeID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&machinelearningcomputes.ComputeId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&machinelearningcomputes.ComputeId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -330,7 +334,11 @@ func resourceComputeClusterRead(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("setting `identity`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, computeResource.Model.Tags)
+	if err := tags.FlattenAndSet(d, computeResource.Model.Tags); err != nil {
+		return err
+	}
+
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceComputeClusterUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
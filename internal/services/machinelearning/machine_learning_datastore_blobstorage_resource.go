@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/datastore"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/workspaces"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name machine_learning_datastore_blobstorage -service-package-name machinelearning -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,workspace_name:id,name:id" -test-name blobStorageAccountKey -test-resource-type MachineLearningDataStoreBlobStorage
+
 type MachineLearningDataStoreBlobStorage struct{}
 
 type MachineLearningDataStoreBlobStorageModel struct {
@@ -52,9 +55,14 @@ func (r MachineLearningDataStoreBlobStorage) IDValidationFunc() pluginsdk.Schema
 	return datastore.ValidateDataStoreID
 }
 
+func (r MachineLearningDataStoreBlobStorage) Identity() resourceids.ResourceId {
+	return &datastore.DataStoreId{}
+}
+
 var (
 	_ sdk.ResourceWithUpdate        = MachineLearningDataStoreBlobStorage{}
 	_ sdk.ResourceWithCustomizeDiff = MachineLearningDataStoreBlobStorage{}
+	_ sdk.ResourceWithIdentity      = MachineLearningDataStoreBlobStorage{}
 )
 
 func (r MachineLearningDataStoreBlobStorage) Arguments() map[string]*pluginsdk.Schema {
@@ -374,6 +382,10 @@ func (r MachineLearningDataStoreBlobStorage) Read() sdk.ResourceFunc {
 				model.Tags = *data.Tags
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&model)
 		},
 	}
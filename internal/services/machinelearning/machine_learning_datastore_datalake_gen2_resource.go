@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/datastore"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/workspaces"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -25,6 +26,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name machine_learning_datastore_datalake_gen2 -service-package-name machinelearning -properties "name" -compare-values "subscription_id:id,resource_group_name:id,workspace_name:id" -test-name dataLakeGen2Basic -test-resource-type MachineLearningDataStoreDataLakeGen2
+
 type MachineLearningDataStoreDataLakeGen2 struct{}
 
 type MachineLearningDataStoreDataLakeGen2Model struct {
@@ -63,6 +66,11 @@ func (r MachineLearningDataStoreDataLakeGen2) IDValidationFunc() pluginsdk.Schem
 }
 
 var _ sdk.ResourceWithUpdate = MachineLearningDataStoreDataLakeGen2{}
+var _ sdk.ResourceWithIdentity = MachineLearningDataStoreDataLakeGen2{}
+
+func (r MachineLearningDataStoreDataLakeGen2) Identity() resourceids.ResourceId {
+	return &datastore.DataStoreId{}
+}
 
 func (r MachineLearningDataStoreDataLakeGen2) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -356,6 +364,10 @@ func (r MachineLearningDataStoreDataLakeGen2) Read() sdk.ResourceFunc {
 				model.Tags = *data.Tags
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&model)
 		},
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/datastore"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/workspaces"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name machine_learning_datastore_fileshare -service-package-name machinelearning -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,workspace_name:id,name:id" -test-name fileShareAccountKey -test-resource-type MachineLearningDataStoreFileShare
+
 type MachineLearningDataStoreFileShare struct{}
 
 type MachineLearningDataStoreFileShareModel struct {
@@ -48,7 +51,12 @@ func (r MachineLearningDataStoreFileShare) IDValidationFunc() pluginsdk.SchemaVa
 	return datastore.ValidateDataStoreID
 }
 
+func (r MachineLearningDataStoreFileShare) Identity() resourceids.ResourceId {
+	return &datastore.DataStoreId{}
+}
+
 var _ sdk.ResourceWithUpdate = MachineLearningDataStoreFileShare{}
+var _ sdk.ResourceWithIdentity = MachineLearningDataStoreFileShare{}
 
 func (r MachineLearningDataStoreFileShare) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -329,6 +337,10 @@ func (r MachineLearningDataStoreFileShare) Read() sdk.ResourceFunc {
 				model.Tags = *data.Tags
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&model)
 		},
 	}
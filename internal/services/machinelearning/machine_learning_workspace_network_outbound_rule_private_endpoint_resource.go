@@ -34,9 +34,12 @@ type machineLearningWorkspaceOutboundRulePrivateEndpointModel struct {
 	SparkEnabled      bool   `tfschema:"spark_enabled"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name machine_learning_workspace_network_outbound_rule_private_endpoint -service-package-name machinelearning -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,workspace_name:id" -test-name onlyApprovedOutbound -test-resource-type WorkspaceNetworkOutboundPrivateEndpointResource
+
 type WorkspaceNetworkOutboundRulePrivateEndpoint struct{}
 
 var _ sdk.Resource = WorkspaceNetworkOutboundRulePrivateEndpoint{}
+var _ sdk.ResourceWithIdentity = WorkspaceNetworkOutboundRulePrivateEndpoint{}
 
 func (r WorkspaceNetworkOutboundRulePrivateEndpoint) ResourceType() string {
 	return "azurerm_machine_learning_workspace_network_outbound_rule_private_endpoint"
@@ -50,6 +53,10 @@ func (r WorkspaceNetworkOutboundRulePrivateEndpoint) IDValidationFunc() pluginsd
 	return managednetwork.ValidateOutboundRuleID
 }
 
+func (r WorkspaceNetworkOutboundRulePrivateEndpoint) Identity() resourceids.ResourceId {
+	return &managednetwork.OutboundRuleId{}
+}
+
 func (r WorkspaceNetworkOutboundRulePrivateEndpoint) Arguments() map[string]*pluginsdk.Schema {
 	arguments := map[string]*pluginsdk.Schema{
 		"name": {
@@ -209,6 +216,10 @@ func (r WorkspaceNetworkOutboundRulePrivateEndpoint) Read() sdk.ResourceFunc {
 					}
 				}
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
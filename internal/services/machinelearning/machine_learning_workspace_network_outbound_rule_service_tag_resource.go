@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/managednetwork"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -17,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name machine_learning_workspace_network_outbound_rule_service_tag -service-package-name machinelearning -properties "name" -compare-values "subscription_id:id,resource_group_name:id,workspace_name:id" -test-resource-type WorkspaceNetworkOutboundRuleServiceTagResource
+
 var _ sdk.ResourceWithUpdate = WorkspaceNetworkOutboundRuleServiceTag{}
 
 type MachineLearningWorkspaceServiceTagOutboundRuleModel struct {
@@ -30,6 +33,11 @@ type MachineLearningWorkspaceServiceTagOutboundRuleModel struct {
 type WorkspaceNetworkOutboundRuleServiceTag struct{}
 
 var _ sdk.Resource = WorkspaceNetworkOutboundRuleServiceTag{}
+var _ sdk.ResourceWithIdentity = WorkspaceNetworkOutboundRuleServiceTag{}
+
+func (r WorkspaceNetworkOutboundRuleServiceTag) Identity() resourceids.ResourceId {
+	return &managednetwork.OutboundRuleId{}
+}
 
 func (r WorkspaceNetworkOutboundRuleServiceTag) ResourceType() string {
 	return "azurerm_machine_learning_workspace_network_outbound_rule_service_tag"
@@ -297,6 +305,10 @@ func (r WorkspaceNetworkOutboundRuleServiceTag) Read() sdk.ResourceFunc {
 					}
 				}
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
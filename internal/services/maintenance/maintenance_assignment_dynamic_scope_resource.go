@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/maintenance/2023-04-01/configurationassignments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/maintenance/2023-04-01/maintenanceconfigurations"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name maintenance_assignment_dynamic_scope -service-package-name maintenance -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type MaintenanceDynamicScopeResource
+
 type MaintenanceDynamicScopeResource struct{}
 
 type Tag struct {
@@ -42,6 +45,8 @@ type MaintenanceDynamicScopeModel struct {
 
 var _ sdk.Resource = MaintenanceDynamicScopeResource{}
 
+var _ sdk.ResourceWithIdentity = MaintenanceDynamicScopeResource{}
+
 func (MaintenanceDynamicScopeResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -395,3 +400,7 @@ func (MaintenanceDynamicScopeResource) Delete() sdk.ResourceFunc {
 func (MaintenanceDynamicScopeResource) IDValidationFunc() func(interface{}, string) ([]string, []error) {
 	return configurationassignments.ValidateConfigurationAssignmentID
 }
+
+func (MaintenanceDynamicScopeResource) Identity() resourceids.ResourceId {
+	return &configurationassignments.ConfigurationAssignmentId{}
+}
@@ -11,13 +11,21 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/managedidentity/2023-01-31/managedidentities"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name federated_identity_credential -service-package-name managedidentity -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "user_assigned_identity_name:id" -test-resource-type FederatedIdentityCredentialTestResource
+
 var _ sdk.Resource = FederatedIdentityCredentialResource{}
+var _ sdk.ResourceWithIdentity = FederatedIdentityCredentialResource{}
+
+func (r FederatedIdentityCredentialResource) Identity() resourceids.ResourceId {
+	return &managedidentities.FederatedIdentityCredentialId{}
+}
 
 type FederatedIdentityCredentialResource struct{}
 
@@ -157,6 +165,10 @@ func (r FederatedIdentityCredentialResource) Read() sdk.ResourceFunc {
 				r.mapFederatedIdentityCredentialToFederatedIdentityCredentialResourceSchema(*model, &schema)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -4,6 +4,8 @@
 package managedidentity
 
 import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedidentity/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -11,9 +13,18 @@ import (
 
 var (
 	_ sdk.Resource                   = UserAssignedIdentityResource{}
+	_ sdk.ResourceWithIdentity       = UserAssignedIdentityResource{}
 	_ sdk.ResourceWithStateMigration = UserAssignedIdentityResource{}
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name user_assigned_identity -service-package-name managedidentity -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type UserAssignedIdentityTestResource
+
+// Identity is defined here rather than in the generated resource, the Resource Identity data is then set from the
+// ID of the resource once the generated Read function completes
+func (r UserAssignedIdentityResource) Identity() resourceids.ResourceId {
+	return &commonids.UserAssignedIdentityId{}
+}
+
 func (r UserAssignedIdentityResource) StateUpgraders() sdk.StateUpgradeData {
 	return sdk.StateUpgradeData{
 		SchemaVersion: 1,
@@ -18,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name managed_redis_geo_replication -service-package-name managedredis -compare-values "subscription_id:id,resource_group_name:id,name:id"
+
 // Geo-replication / linked databases are managed as a separate resource because when dbs are linked, ARM
 // will mutate the state of all dbs out of bound, causing unexpected plan diff.
 //
@@ -30,8 +32,13 @@ type ManagedRedisGeoReplicationResource struct{}
 var (
 	_ sdk.ResourceWithCustomizeDiff = ManagedRedisGeoReplicationResource{}
 	_ sdk.ResourceWithUpdate        = ManagedRedisGeoReplicationResource{}
+	_ sdk.ResourceWithIdentity      = ManagedRedisGeoReplicationResource{}
 )
 
+func (r ManagedRedisGeoReplicationResource) Identity() resourceids.ResourceId {
+	return &redisenterprise.RedisEnterpriseId{}
+}
+
 type ManagedRedisGeoReplicationResourceModel struct {
 	ManagedRedisId        string   `tfschema:"managed_redis_id"`
 	LinkedManagedRedisIds []string `tfschema:"linked_managed_redis_ids"`
@@ -141,6 +148,10 @@ func (r ManagedRedisGeoReplicationResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, clusterId); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
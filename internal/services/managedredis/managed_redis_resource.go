@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/redisenterprise/2025-07-01/databases"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/redisenterprise/2025-07-01/redisenterprise"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
@@ -28,6 +29,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name managed_redis -service-package-name managedredis -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 // Azure Managed Redis (AMR) consists of two ARM resource types: cluster and database. Cluster is where compute, load
 // balancer, network and other infrastructure is setup. Database refers to the Redis instance / process itself. Database
 // is a child of cluster with 1-1 mapping.
@@ -41,6 +44,11 @@ import (
 type ManagedRedisResource struct{}
 
 var _ sdk.ResourceWithUpdate = ManagedRedisResource{}
+var _ sdk.ResourceWithIdentity = ManagedRedisResource{}
+
+func (r ManagedRedisResource) Identity() resourceids.ResourceId {
+	return &redisenterprise.RedisEnterpriseId{}
+}
 
 type ManagedRedisResourceModel struct {
 	Name              string `tfschema:"name"`
@@ -413,6 +421,10 @@ func (r ManagedRedisResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, clusterId); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managementgroup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/managementgroups/2020-05-01/managementgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ManagementGroupDescendantsDataSource struct{}
+
+type ManagementGroupDescendantsDataSourceModel struct {
+	ManagementGroupId  string                                  `tfschema:"management_group_id"`
+	ManagementGroups   []ManagementGroupDescendantModel        `tfschema:"management_groups"`
+	Subscriptions      []ManagementGroupDescendantSubscription `tfschema:"subscriptions"`
+	ManagementGroupIds []string                                `tfschema:"management_group_ids"`
+	SubscriptionIds    []string                                `tfschema:"subscription_ids"`
+}
+
+type ManagementGroupDescendantModel struct {
+	Id                      string `tfschema:"id"`
+	Name                    string `tfschema:"name"`
+	DisplayName             string `tfschema:"display_name"`
+	ParentManagementGroupId string `tfschema:"parent_management_group_id"`
+}
+
+type ManagementGroupDescendantSubscription struct {
+	Id                      string `tfschema:"id"`
+	SubscriptionId          string `tfschema:"subscription_id"`
+	DisplayName             string `tfschema:"display_name"`
+	ParentManagementGroupId string `tfschema:"parent_management_group_id"`
+}
+
+var _ sdk.DataSource = ManagementGroupDescendantsDataSource{}
+
+func (d ManagementGroupDescendantsDataSource) ModelObject() interface{} {
+	return &ManagementGroupDescendantsDataSourceModel{}
+}
+
+func (d ManagementGroupDescendantsDataSource) ResourceType() string {
+	return "azurerm_management_group_descendants"
+}
+
+func (d ManagementGroupDescendantsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"management_group_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.ManagementGroupID,
+		},
+	}
+}
+
+func (d ManagementGroupDescendantsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"management_groups": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"display_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"parent_management_group_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"subscriptions": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"subscription_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"display_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"parent_management_group_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"management_group_ids": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"subscription_ids": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (d ManagementGroupDescendantsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagementGroups.GroupsClient
+
+			var state ManagementGroupDescendantsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			managementGroupId, err := parse.ManagementGroupID(state.ManagementGroupId)
+			if err != nil {
+				return err
+			}
+
+			id := commonids.NewManagementGroupID(managementGroupId.Name)
+
+			resp, err := client.GetDescendantsComplete(ctx, id, managementgroups.DefaultGetDescendantsOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.LatestHttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("listing descendants of %s: %+v", id, err)
+			}
+
+			state.ManagementGroups = make([]ManagementGroupDescendantModel, 0)
+			state.Subscriptions = make([]ManagementGroupDescendantSubscription, 0)
+			state.ManagementGroupIds = make([]string, 0)
+			state.SubscriptionIds = make([]string, 0)
+
+			for _, item := range resp.Items {
+				if item.Id == nil || item.Type == nil {
+					continue
+				}
+
+				displayName := ""
+				parentId := ""
+				if props := item.Properties; props != nil {
+					displayName = pointer.From(props.DisplayName)
+					if parent := props.Parent; parent != nil && parent.Id != nil {
+						parentManagementGroupId, err := commonids.ParseManagementGroupIDInsensitively(*parent.Id)
+						if err != nil {
+							return fmt.Errorf("parsing parent Management Group ID %q: %+v", *parent.Id, err)
+						}
+						parentId = parentManagementGroupId.ID()
+					}
+				}
+
+				switch managementgroups.ManagementGroupChildType(*item.Type) {
+				case managementgroups.ManagementGroupChildTypeMicrosoftPointManagementManagementGroups:
+					managementGroupId, err := commonids.ParseManagementGroupIDInsensitively(*item.Id)
+					if err != nil {
+						return fmt.Errorf("parsing descendant Management Group ID %q: %+v", *item.Id, err)
+					}
+					state.ManagementGroups = append(state.ManagementGroups, ManagementGroupDescendantModel{
+						Id:                      managementGroupId.ID(),
+						Name:                    managementGroupId.GroupId,
+						DisplayName:             displayName,
+						ParentManagementGroupId: parentId,
+					})
+					state.ManagementGroupIds = append(state.ManagementGroupIds, managementGroupId.ID())
+
+				case managementgroups.ManagementGroupChildTypeSubscriptions:
+					subscriptionId, err := commonids.ParseSubscriptionIDInsensitively(*item.Id)
+					if err != nil {
+						return fmt.Errorf("parsing descendant Subscription ID %q: %+v", *item.Id, err)
+					}
+					state.Subscriptions = append(state.Subscriptions, ManagementGroupDescendantSubscription{
+						Id:                      subscriptionId.ID(),
+						SubscriptionId:          subscriptionId.SubscriptionId,
+						DisplayName:             displayName,
+						ParentManagementGroupId: parentId,
+					})
+					state.SubscriptionIds = append(state.SubscriptionIds, subscriptionId.SubscriptionId)
+				}
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managementgroup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/managementgroups/2020-05-01/managementgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name management_group_hierarchy_settings -service-package-name managementgroup -compare-values "management_group_name:management_group_id" -test-sequential
+
+type ManagementGroupHierarchySettingsResource struct{}
+
+type ManagementGroupHierarchySettingsModel struct {
+	ManagementGroupId                    string `tfschema:"management_group_id"`
+	DefaultManagementGroupId             string `tfschema:"default_management_group_id"`
+	RequireAuthorizationForGroupCreation bool   `tfschema:"require_authorization_for_group_creation"`
+	TenantId                             string `tfschema:"tenant_id"`
+}
+
+var (
+	_ sdk.ResourceWithUpdate   = ManagementGroupHierarchySettingsResource{}
+	_ sdk.ResourceWithIdentity = ManagementGroupHierarchySettingsResource{}
+)
+
+func (r ManagementGroupHierarchySettingsResource) Identity() resourceids.ResourceId {
+	return &parse.HierarchySettingsId{}
+}
+
+func (r ManagementGroupHierarchySettingsResource) ModelObject() interface{} {
+	return &ManagementGroupHierarchySettingsModel{}
+}
+
+func (r ManagementGroupHierarchySettingsResource) ResourceType() string {
+	return "azurerm_management_group_hierarchy_settings"
+}
+
+func (r ManagementGroupHierarchySettingsResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.HierarchySettingsID
+}
+
+func (r ManagementGroupHierarchySettingsResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"management_group_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ManagementGroupID,
+		},
+
+		"default_management_group_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.ManagementGroupID,
+		},
+
+		"require_authorization_for_group_creation": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r ManagementGroupHierarchySettingsResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"tenant_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ManagementGroupHierarchySettingsResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagementGroups.GroupsClient
+
+			var model ManagementGroupHierarchySettingsModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			managementGroupId, err := parse.ManagementGroupID(model.ManagementGroupId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewHierarchySettingsID(managementGroupId.Name)
+
+			existing, err := client.HierarchySettingsGet(ctx, commonids.NewManagementGroupID(id.ManagementGroupName))
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := managementgroups.CreateOrUpdateSettingsRequest{
+				Properties: &managementgroups.CreateOrUpdateSettingsProperties{
+					RequireAuthorizationForGroupCreation: pointer.To(model.RequireAuthorizationForGroupCreation),
+				},
+			}
+
+			if model.DefaultManagementGroupId != "" {
+				payload.Properties.DefaultManagementGroup = pointer.To(model.DefaultManagementGroupId)
+			}
+
+			if _, err := client.HierarchySettingsCreateOrUpdate(ctx, commonids.NewManagementGroupID(id.ManagementGroupName), payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r ManagementGroupHierarchySettingsResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagementGroups.GroupsClient
+
+			id, err := parse.HierarchySettingsID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.HierarchySettingsGet(ctx, commonids.NewManagementGroupID(id.ManagementGroupName))
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ManagementGroupHierarchySettingsModel{
+				ManagementGroupId: parse.NewManagementGroupId(id.ManagementGroupName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.RequireAuthorizationForGroupCreation = pointer.From(props.RequireAuthorizationForGroupCreation)
+					state.TenantId = pointer.From(props.TenantId)
+
+					// new Subscriptions are placed in the Management Group these settings belong to (the Tenant Root Group)
+					// unless a different default is configured, so this is treated as unset
+					if v := pointer.From(props.DefaultManagementGroup); v != "" {
+						defaultManagementGroupId, err := parse.ManagementGroupID(v)
+						if err != nil {
+							return err
+						}
+						if !strings.EqualFold(defaultManagementGroupId.Name, id.ManagementGroupName) {
+							state.DefaultManagementGroupId = defaultManagementGroupId.ID()
+						}
+					}
+				}
+			}
+
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagementGroupHierarchySettingsResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagementGroups.GroupsClient
+
+			id, err := parse.HierarchySettingsID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagementGroupHierarchySettingsModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the PATCH endpoint ignores omitted values, so when `default_management_group_id` is removed the default
+			// has to be explicitly pointed back at the Tenant Root Group
+			defaultManagementGroupId := model.DefaultManagementGroupId
+			if defaultManagementGroupId == "" {
+				defaultManagementGroupId = parse.NewManagementGroupId(id.ManagementGroupName).ID()
+			}
+
+			payload := managementgroups.CreateOrUpdateSettingsRequest{
+				Properties: &managementgroups.CreateOrUpdateSettingsProperties{
+					DefaultManagementGroup:               pointer.To(defaultManagementGroupId),
+					RequireAuthorizationForGroupCreation: pointer.To(model.RequireAuthorizationForGroupCreation),
+				},
+			}
+
+			if _, err := client.HierarchySettingsUpdate(ctx, commonids.NewManagementGroupID(id.ManagementGroupName), payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagementGroupHierarchySettingsResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagementGroups.GroupsClient
+
+			id, err := parse.HierarchySettingsID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.HierarchySettingsDelete(ctx, commonids.NewManagementGroupID(id.ManagementGroupName)); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
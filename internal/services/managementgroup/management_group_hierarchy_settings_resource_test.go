@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managementgroup_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ManagementGroupHierarchySettingsResource struct{}
+
+func TestAccManagementGroupHierarchySettings(t *testing.T) {
+	// the Hierarchy Settings belong to the Tenant Root Group, so these tests will conflict if run at the same time
+	acceptance.RunTestsInSequence(t, map[string]map[string]func(t *testing.T){
+		"hierarchySettings": {
+			"basic":          testAccManagementGroupHierarchySettings_basic,
+			"requiresImport": testAccManagementGroupHierarchySettings_requiresImport,
+			"complete":       testAccManagementGroupHierarchySettings_complete,
+			"update":         testAccManagementGroupHierarchySettings_update,
+		},
+	})
+}
+
+func testAccManagementGroupHierarchySettings_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_hierarchy_settings", "test")
+	r := ManagementGroupHierarchySettingsResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tenant_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccManagementGroupHierarchySettings_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_hierarchy_settings", "test")
+	r := ManagementGroupHierarchySettingsResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func testAccManagementGroupHierarchySettings_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_hierarchy_settings", "test")
+	r := ManagementGroupHierarchySettingsResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccManagementGroupHierarchySettings_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_hierarchy_settings", "test")
+	r := ManagementGroupHierarchySettingsResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("default_management_group_id").IsEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ManagementGroupHierarchySettingsResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.HierarchySettingsID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ManagementGroups.GroupsClient.HierarchySettingsGet(ctx, commonids.NewManagementGroupID(id.ManagementGroupName))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (ManagementGroupHierarchySettingsResource) basic(_ acceptance.TestData) string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+data "azurerm_management_group" "root" {
+  name = data.azurerm_client_config.current.tenant_id
+}
+
+resource "azurerm_management_group_hierarchy_settings" "test" {
+  management_group_id = data.azurerm_management_group.root.id
+}
+`
+}
+
+func (r ManagementGroupHierarchySettingsResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_management_group_hierarchy_settings" "import" {
+  management_group_id = azurerm_management_group_hierarchy_settings.test.management_group_id
+}
+`, r.basic(data))
+}
+
+func (ManagementGroupHierarchySettingsResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+data "azurerm_management_group" "root" {
+  name = data.azurerm_client_config.current.tenant_id
+}
+
+resource "azurerm_management_group" "test" {
+  display_name = "acctestmg-%d"
+}
+
+resource "azurerm_management_group_hierarchy_settings" "test" {
+  management_group_id                      = data.azurerm_management_group.root.id
+  default_management_group_id              = azurerm_management_group.test.id
+  require_authorization_for_group_creation = true
+}
+`, data.RandomInteger)
+}
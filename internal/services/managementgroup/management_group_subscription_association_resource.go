@@ -43,7 +43,7 @@ func resourceManagementGroupSubscriptionAssociation() *pluginsdk.Resource {
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := managementgroups.ParseSubscriptionID(id)
 			return err
-		}),
+		}, &managementgroups.SubscriptionId{}),
 
 		Schema: map[string]*pluginsdk.Schema{
 			"management_group_id": {
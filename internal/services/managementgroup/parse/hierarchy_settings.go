@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &HierarchySettingsId{}
+
+// HierarchySettingsId is the ID of the Hierarchy Settings of a Management Group, the API only supports a single
+// settings object per Management Group which is always named `default`
+type HierarchySettingsId struct {
+	ManagementGroupName string
+}
+
+func NewHierarchySettingsID(managementGroupName string) HierarchySettingsId {
+	return HierarchySettingsId{
+		ManagementGroupName: managementGroupName,
+	}
+}
+
+func (id HierarchySettingsId) String() string {
+	segments := []string{
+		fmt.Sprintf("Management Group Name %q", id.ManagementGroupName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Management Group Hierarchy Settings", segmentsStr)
+}
+
+func (id HierarchySettingsId) ID() string {
+	fmtString := "/providers/Microsoft.Management/managementGroups/%s/settings/default"
+	return fmt.Sprintf(fmtString, id.ManagementGroupName)
+}
+
+func (id HierarchySettingsId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftManagement", "Microsoft.Management", "Microsoft.Management"),
+		resourceids.StaticSegment("staticManagementGroups", "managementGroups", "managementGroups"),
+		resourceids.UserSpecifiedSegment("managementGroupName", "managementGroupName"),
+		resourceids.StaticSegment("staticSettings", "settings", "settings"),
+		resourceids.StaticSegment("staticDefault", "default", "default"),
+	}
+}
+
+// HierarchySettingsID parses a Hierarchy Settings ID into an HierarchySettingsId struct
+func HierarchySettingsID(input string) (*HierarchySettingsId, error) {
+	id := HierarchySettingsId{}
+	parsed, err := resourceids.NewParserFromResourceIdType(&id).Parse(input, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *HierarchySettingsId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.ManagementGroupName, ok = input.Parsed["managementGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "managementGroupName", input)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+)
+
+func TestHierarchySettingsID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *HierarchySettingsId
+	}{
+		{
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			Input:    "/providers/Microsoft.Management/managementGroups",
+			Expected: nil,
+		},
+		{
+			Input:    "/providers/Microsoft.Management/managementGroups/00000000-0000-0000-0000-000000000000",
+			Expected: nil,
+		},
+		{
+			Input:    "/providers/Microsoft.Management/managementGroups/00000000-0000-0000-0000-000000000000/settings",
+			Expected: nil,
+		},
+		{
+			Input:    "/providers/Microsoft.Management/managementGroups/00000000-0000-0000-0000-000000000000/settings/other",
+			Expected: nil,
+		},
+		{
+			Input: "/providers/Microsoft.Management/managementGroups/00000000-0000-0000-0000-000000000000/settings/default",
+			Expected: &HierarchySettingsId{
+				ManagementGroupName: "00000000-0000-0000-0000-000000000000",
+			},
+		},
+		{
+			Input:    "/providers/Microsoft.Management/managementGroups/00000000-0000-0000-0000-000000000000/settings/default/extra",
+			Expected: nil,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := HierarchySettingsID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got a value for %q", v.Input)
+		}
+
+		if actual.ManagementGroupName != v.Expected.ManagementGroupName {
+			t.Fatalf("Expected %q but got %q for ManagementGroupName", v.Expected.ManagementGroupName, actual.ManagementGroupName)
+		}
+
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected %q but got %q for ID()", v.Input, actual.ID())
+		}
+	}
+}
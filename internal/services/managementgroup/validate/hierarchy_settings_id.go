@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/parse"
+)
+
+func HierarchySettingsID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := parse.HierarchySettingsID(v); err != nil {
+		errors = append(errors, fmt.Errorf("cannot parse %q as a management group hierarchy settings id: %v", k, err))
+		return
+	}
+
+	return
+}
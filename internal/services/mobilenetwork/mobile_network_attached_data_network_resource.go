@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/attacheddatanetwork"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/packetcoredataplane"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mobile_network_attached_data_network -service-package-name mobilenetwork -compare-values "subscription_id:id,resource_group_name:id,packet_core_control_plane_name:id,packet_core_data_plane_name:id,name:id"
+
 type AttachedDataNetworkModel struct {
 	MobileNetworkDataNetworkName         string                   `tfschema:"mobile_network_data_network_name"`
 	MobileNetworkPacketCoreDataPlaneId   string                   `tfschema:"mobile_network_packet_core_data_plane_id"`
@@ -54,6 +57,11 @@ type PortRangeModel struct {
 type AttachedDataNetworkResource struct{}
 
 var _ sdk.ResourceWithUpdate = AttachedDataNetworkResource{}
+var _ sdk.ResourceWithIdentity = AttachedDataNetworkResource{}
+
+func (r AttachedDataNetworkResource) Identity() resourceids.ResourceId {
+	return &attacheddatanetwork.AttachedDataNetworkId{}
+}
 
 func (r AttachedDataNetworkResource) ResourceType() string {
 	return "azurerm_mobile_network_attached_data_network"
@@ -436,6 +444,10 @@ func (r AttachedDataNetworkResource) Read() sdk.ResourceFunc {
 				state.Tags = tags.Flatten(model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/datanetwork"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/mobilenetwork"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mobile_network_data_network -service-package-name mobilenetwork -properties "name" -compare-values "subscription_id:id,resource_group_name:id,mobile_network_name:id"
+
 type DataNetworkResourceModel struct {
 	Name            string            `tfschema:"name"`
 	MobileNetworkId string            `tfschema:"mobile_network_id"`
@@ -29,6 +32,11 @@ type DataNetworkResourceModel struct {
 type DataNetworkResource struct{}
 
 var _ sdk.ResourceWithUpdate = DataNetworkResource{}
+var _ sdk.ResourceWithIdentity = DataNetworkResource{}
+
+func (r DataNetworkResource) Identity() resourceids.ResourceId {
+	return &datanetwork.DataNetworkId{}
+}
 
 func (r DataNetworkResource) ResourceType() string {
 	return "azurerm_mobile_network_data_network"
@@ -201,6 +209,10 @@ func (r DataNetworkResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/databoxedge/2022-03-01/devices"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/packetcorecontrolplane"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/site"
@@ -29,6 +30,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mobile_network_packet_core_control_plane -service-package-name mobilenetwork -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type PacketCoreControlPlaneModel struct {
 	Name                          string                                     `tfschema:"name"`
 	ResourceGroupName             string                                     `tfschema:"resource_group_name"`
@@ -65,6 +68,11 @@ type PlatformConfigurationModel struct {
 type PacketCoreControlPlaneResource struct{}
 
 var _ sdk.ResourceWithUpdate = PacketCoreControlPlaneResource{}
+var _ sdk.ResourceWithIdentity = PacketCoreControlPlaneResource{}
+
+func (r PacketCoreControlPlaneResource) Identity() resourceids.ResourceId {
+	return &packetcorecontrolplane.PacketCoreControlPlaneId{}
+}
 
 func (r PacketCoreControlPlaneResource) ResourceType() string {
 	return "azurerm_mobile_network_packet_core_control_plane"
@@ -525,6 +533,10 @@ func (r PacketCoreControlPlaneResource) Read() sdk.ResourceFunc {
 				state.Tags = pointer.From(model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
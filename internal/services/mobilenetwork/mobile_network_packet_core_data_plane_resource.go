@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/packetcorecontrolplane"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/packetcoredataplane"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mobile_network_packet_core_data_plane -service-package-name mobilenetwork -properties "name" -compare-values "subscription_id:id,resource_group_name:id,packet_core_control_plane_name:id"
+
 type PacketCoreDataPlaneModel struct {
 	Name                                  string            `tfschema:"name"`
 	MobileNetworkPacketCoreControlPlaneId string            `tfschema:"mobile_network_packet_core_control_plane_id"`
@@ -35,6 +38,11 @@ type PacketCoreDataPlaneModel struct {
 type PacketCoreDataPlaneResource struct{}
 
 var _ sdk.ResourceWithUpdate = PacketCoreDataPlaneResource{}
+var _ sdk.ResourceWithIdentity = PacketCoreDataPlaneResource{}
+
+func (r PacketCoreDataPlaneResource) Identity() resourceids.ResourceId {
+	return &packetcoredataplane.PacketCoreDataPlaneId{}
+}
 
 func (r PacketCoreDataPlaneResource) ResourceType() string {
 	return "azurerm_mobile_network_packet_core_data_plane"
@@ -253,6 +261,10 @@ func (r PacketCoreDataPlaneResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -13,12 +13,15 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/mobilenetwork"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mobile_network -service-package-name mobilenetwork -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type MobileNetworkResourceModel struct {
 	Name              string            `tfschema:"name"`
 	ResourceGroupName string            `tfschema:"resource_group_name"`
@@ -32,6 +35,11 @@ type MobileNetworkResourceModel struct {
 type MobileNetworkResource struct{}
 
 var _ sdk.ResourceWithUpdate = MobileNetworkResource{}
+var _ sdk.ResourceWithIdentity = MobileNetworkResource{}
+
+func (r MobileNetworkResource) Identity() resourceids.ResourceId {
+	return &mobilenetwork.MobileNetworkId{}
+}
 
 func (r MobileNetworkResource) ResourceType() string {
 	return "azurerm_mobile_network"
@@ -219,6 +227,10 @@ func (r MobileNetworkResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
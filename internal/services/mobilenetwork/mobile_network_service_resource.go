@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/mobilenetwork"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/service"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mobile_network_service -service-package-name mobilenetwork -properties "name" -compare-values "subscription_id:id,resource_group_name:id,mobile_network_name:id"
+
 type ServiceResource struct{}
 
 type ServiceResourceModel struct {
@@ -70,6 +73,11 @@ type ServiceResourceQosPolicyModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = ServiceResource{}
+var _ sdk.ResourceWithIdentity = ServiceResource{}
+
+func (r ServiceResource) Identity() resourceids.ResourceId {
+	return &service.ServiceId{}
+}
 
 func (r ServiceResource) ResourceType() string {
 	return "azurerm_mobile_network_service"
@@ -491,6 +499,10 @@ func (r ServiceResource) Read() sdk.ResourceFunc {
 				state.Tags = *model.Tags
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
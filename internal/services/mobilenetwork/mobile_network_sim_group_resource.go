@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/mobilenetwork"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/simgroup"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mobile_network_sim_group -service-package-name mobilenetwork -properties "name" -compare-values "subscription_id:id,resource_group_name:id"
+
 type SimGroupResourceModel struct {
 	Name             string                       `tfschema:"name"`
 	EncryptionKeyURL string                       `tfschema:"encryption_key_url"`
@@ -31,6 +34,11 @@ type SimGroupResourceModel struct {
 type SimGroupResource struct{}
 
 var _ sdk.ResourceWithUpdate = SimGroupResource{}
+var _ sdk.ResourceWithIdentity = SimGroupResource{}
+
+func (r SimGroupResource) Identity() resourceids.ResourceId {
+	return &simgroup.SimGroupId{}
+}
 
 func (r SimGroupResource) ResourceType() string {
 	return "azurerm_mobile_network_sim_group"
@@ -244,6 +252,10 @@ func (r SimGroupResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
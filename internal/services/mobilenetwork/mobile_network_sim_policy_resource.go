@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/datanetwork"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/mobilenetwork"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/service"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mobile_network_sim_policy -service-package-name mobilenetwork -properties "name" -compare-values "subscription_id:id,resource_group_name:id,mobile_network_name:id"
+
 type SimPolicyResource struct{}
 
 type SimPolicyResourceModel struct {
@@ -61,6 +64,11 @@ type AmbrResourceModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = SimPolicyResource{}
+var _ sdk.ResourceWithIdentity = SimPolicyResource{}
+
+func (r SimPolicyResource) Identity() resourceids.ResourceId {
+	return &simpolicy.SimPolicyId{}
+}
 
 func (r SimPolicyResource) ResourceType() string {
 	return "azurerm_mobile_network_sim_policy"
@@ -439,6 +447,10 @@ func (r SimPolicyResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
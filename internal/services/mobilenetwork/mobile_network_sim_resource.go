@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/attacheddatanetwork"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/sim"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/simgroup"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mobile_network_sim -service-package-name mobilenetwork -properties "name" -compare-values "subscription_id:id,resource_group_name:id,sim_group_name:id"
+
 type SimResourceModel struct {
 	Name                                  string                       `tfschema:"name"`
 	MobileNetworkSimGroupId               string                       `tfschema:"mobile_network_sim_group_id"`
@@ -48,8 +51,13 @@ type SimResource struct{}
 var (
 	_ sdk.ResourceWithUpdate         = SimResource{}
 	_ sdk.ResourceWithCustomImporter = SimResource{}
+	_ sdk.ResourceWithIdentity       = SimResource{}
 )
 
+func (r SimResource) Identity() resourceids.ResourceId {
+	return &sim.SimId{}
+}
+
 func (r SimResource) ResourceType() string {
 	return "azurerm_mobile_network_sim"
 }
@@ -376,6 +384,10 @@ func (r SimResource) Read() sdk.ResourceFunc {
 			state.AuthenticationKey = plan.AuthenticationKey
 			state.OperatorKeyCode = plan.OperatorKeyCode
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
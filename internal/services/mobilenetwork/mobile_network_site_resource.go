@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/mobilenetwork"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/site"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mobile_network_site -service-package-name mobilenetwork -properties "name" -compare-values "subscription_id:id,resource_group_name:id,mobile_network_name:id"
+
 type SiteResourceModel struct {
 	Name                string            `tfschema:"name"`
 	MobileNetworkId     string            `tfschema:"mobile_network_id"`
@@ -29,6 +32,11 @@ type SiteResourceModel struct {
 type SiteResource struct{}
 
 var _ sdk.ResourceWithUpdate = SiteResource{}
+var _ sdk.ResourceWithIdentity = SiteResource{}
+
+func (r SiteResource) Identity() resourceids.ResourceId {
+	return &site.SiteId{}
+}
 
 func (r SiteResource) ResourceType() string {
 	return "azurerm_mobile_network_site"
@@ -194,6 +202,10 @@ func (r SiteResource) Read() sdk.ResourceFunc {
 				state.Tags = *model.Tags
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
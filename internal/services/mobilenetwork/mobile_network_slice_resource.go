@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/mobilenetwork"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/slice"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mobile_network_slice -service-package-name mobilenetwork -properties "name" -compare-values "subscription_id:id,resource_group_name:id,mobile_network_name:id"
+
 type SliceResourceModel struct {
 	Name                                             string                                                          `tfschema:"name"`
 	MobileNetworkId                                  string                                                          `tfschema:"mobile_network_id"`
@@ -40,6 +43,11 @@ type SingleNetworkSliceSelectionAssistanceInformationResourceModel struct {
 type SliceResource struct{}
 
 var _ sdk.ResourceWithUpdate = SliceResource{}
+var _ sdk.ResourceWithIdentity = SliceResource{}
+
+func (r SliceResource) Identity() resourceids.ResourceId {
+	return &slice.SliceId{}
+}
 
 func (r SliceResource) ResourceType() string {
 	return "azurerm_mobile_network_slice"
@@ -315,6 +323,10 @@ func (r SliceResource) Read() sdk.ResourceFunc {
 				state.Tags = pointer.From(model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
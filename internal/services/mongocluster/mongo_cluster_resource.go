@@ -14,17 +14,25 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mongocluster/2025-09-01/mongoclusters"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mongo_cluster -service-package-name mongocluster -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type MongoClusterResource struct{}
 
 var _ sdk.ResourceWithUpdate = MongoClusterResource{}
 
 var _ sdk.ResourceWithCustomizeDiff = MongoClusterResource{}
+var _ sdk.ResourceWithIdentity = MongoClusterResource{}
+
+func (r MongoClusterResource) Identity() resourceids.ResourceId {
+	return &mongoclusters.MongoClusterId{}
+}
 
 type MongoClusterResourceModel struct {
 	Name                  string                         `tfschema:"name"`
@@ -495,6 +503,10 @@ func (r MongoClusterResource) Read() sdk.ResourceFunc {
 				state.ConnectionStrings = flattenMongoClusterConnectionStrings(model.ConnectionStrings, state.AdministratorUserName, state.AdministratorPassword)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
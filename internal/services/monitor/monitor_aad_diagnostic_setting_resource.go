@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -27,16 +28,18 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name monitor_aad_diagnostic_setting -service-package-name monitor -properties "name" -test-name eventhub -test-resource-type MonitorAADDiagnosticSettingResource -test-sequential
 func resourceMonitorAADDiagnosticSetting() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
-		Create: resourceMonitorAADDiagnosticSettingCreate,
-		Read:   resourceMonitorAADDiagnosticSettingRead,
-		Update: resourceMonitorAADDiagnosticSettingUpdate,
-		Delete: resourceMonitorAADDiagnosticSettingDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := diagnosticsettings.ParseDiagnosticSettingID(id)
-			return err
-		}),
+		Create:   resourceMonitorAADDiagnosticSettingCreate,
+		Read:     resourceMonitorAADDiagnosticSettingRead,
+		Update:   resourceMonitorAADDiagnosticSettingUpdate,
+		Delete:   resourceMonitorAADDiagnosticSettingDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&diagnosticsettings.DiagnosticSettingId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&diagnosticsettings.DiagnosticSettingId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -330,7 +333,7 @@ func resourceMonitorAADDiagnosticSettingRead(d *pluginsdk.ResourceData, meta int
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceMonitorAADDiagnosticSettingDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -26,6 +26,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name monitor_activity_log_alert -service-package-name monitor -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceMonitorActivityLogAlert() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceMonitorActivityLogAlertCreateUpdate,
@@ -33,10 +35,11 @@ func resourceMonitorActivityLogAlert() *pluginsdk.Resource {
 		Update: resourceMonitorActivityLogAlertCreateUpdate,
 		Delete: resourceMonitorActivityLogAlertDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := activitylogalertsapis.ParseActivityLogAlertID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&activitylogalertsapis.ActivityLogAlertId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&activitylogalertsapis.ActivityLogAlertId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -520,7 +523,7 @@ func resourceMonitorActivityLogAlertRead(d *pluginsdk.ResourceData, meta interfa
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceMonitorActivityLogAlertDelete(d *pluginsdk.ResourceData, meta interface{}) error {
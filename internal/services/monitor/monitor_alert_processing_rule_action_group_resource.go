@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/alertsmanagement/2021-08-08/alertprocessingrules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
@@ -16,6 +17,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name monitor_alert_processing_rule_action_group -service-package-name monitor -compare-values "subscription_id:id,resource_group_name:id,name:id"
+
 type AlertProcessingRuleActionGroupModel struct {
 	Name              string                              `tfschema:"name"`
 	ResourceGroupName string                              `tfschema:"resource_group_name"`
@@ -31,6 +34,11 @@ type AlertProcessingRuleActionGroupModel struct {
 type AlertProcessingRuleActionGroupResource struct{}
 
 var _ sdk.ResourceWithUpdate = AlertProcessingRuleActionGroupResource{}
+var _ sdk.ResourceWithIdentity = AlertProcessingRuleActionGroupResource{}
+
+func (r AlertProcessingRuleActionGroupResource) Identity() resourceids.ResourceId {
+	return &alertprocessingrules.ActionRuleId{}
+}
 
 func (r AlertProcessingRuleActionGroupResource) ResourceType() string {
 	return "azurerm_monitor_alert_processing_rule_action_group"
@@ -239,6 +247,10 @@ func (r AlertProcessingRuleActionGroupResource) Read() sdk.ResourceFunc {
 				state.Schedule = flattenAlertProcessingRuleSchedule(properties.Schedule)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
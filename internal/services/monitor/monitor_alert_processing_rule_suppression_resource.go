@@ -9,12 +9,15 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/alertsmanagement/2021-08-08/alertprocessingrules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name monitor_alert_processing_rule_suppression -service-package-name monitor -compare-values "subscription_id:id,resource_group_name:id,name:id"
+
 type AlertProcessingRuleSuppressionModel struct {
 	Name              string                              `tfschema:"name"`
 	ResourceGroupName string                              `tfschema:"resource_group_name"`
@@ -29,6 +32,11 @@ type AlertProcessingRuleSuppressionModel struct {
 type AlertProcessingRuleSuppressionResource struct{}
 
 var _ sdk.ResourceWithUpdate = AlertProcessingRuleSuppressionResource{}
+var _ sdk.ResourceWithIdentity = AlertProcessingRuleSuppressionResource{}
+
+func (r AlertProcessingRuleSuppressionResource) Identity() resourceids.ResourceId {
+	return &alertprocessingrules.ActionRuleId{}
+}
 
 func (r AlertProcessingRuleSuppressionResource) ResourceType() string {
 	return "azurerm_monitor_alert_processing_rule_suppression"
@@ -208,6 +216,10 @@ func (r AlertProcessingRuleSuppressionResource) Read() sdk.ResourceFunc {
 			state.Condition = flattenAlertProcessingRuleConditions(properties.Conditions)
 			state.Schedule = flattenAlertProcessingRuleSchedule(properties.Schedule)
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
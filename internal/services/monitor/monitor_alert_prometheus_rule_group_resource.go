@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/alertsmanagement/2023-03-01/prometheusrulegroups"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name monitor_alert_prometheus_rule_group -service-package-name monitor -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type AlertPrometheusRuleGroupTestResource
+
 type AlertPrometheusRuleGroupResourceModel struct {
 	Name              string                `tfschema:"name"`
 	Location          string                `tfschema:"location"`
@@ -59,6 +62,11 @@ type PrometheusRuleAlertResolutionModel struct {
 type AlertPrometheusRuleGroupResource struct{}
 
 var _ sdk.ResourceWithUpdate = AlertPrometheusRuleGroupResource{}
+var _ sdk.ResourceWithIdentity = AlertPrometheusRuleGroupResource{}
+
+func (r AlertPrometheusRuleGroupResource) Identity() resourceids.ResourceId {
+	return &prometheusrulegroups.PrometheusRuleGroupId{}
+}
 
 func (r AlertPrometheusRuleGroupResource) ResourceType() string {
 	return "azurerm_monitor_alert_prometheus_rule_group"
@@ -397,6 +405,10 @@ func (r AlertPrometheusRuleGroupResource) Read() sdk.ResourceFunc {
 				state.Scopes = resp.Model.Properties.Scopes
 				state.Tags = pointer.From(resp.Model.Tags)
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2022-10-01/autoscalesettings"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -26,6 +27,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name monitor_autoscale_setting -service-package-name monitor -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type MonitorAutoScaleSettingResource
+
 func resourceMonitorAutoScaleSetting() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceMonitorAutoScaleSettingCreateUpdate,
@@ -33,10 +36,11 @@ func resourceMonitorAutoScaleSetting() *pluginsdk.Resource {
 		Update: resourceMonitorAutoScaleSettingCreateUpdate,
 		Delete: resourceMonitorAutoScaleSettingDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := autoscalesettings.ParseAutoScaleSettingID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&autoscalesettings.AutoScaleSettingId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&autoscalesettings.AutoScaleSettingId{}),
+		},
 
 		SchemaVersion: 2,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -547,7 +551,7 @@ func resourceMonitorAutoScaleSettingRead(d *pluginsdk.ResourceData, meta interfa
 			return err
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceMonitorAutoScaleSettingDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-03-11/datacollectionendpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -19,11 +20,18 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name monitor_data_collection_endpoint -service-package-name monitor -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 var (
-	_ sdk.Resource           = DataCollectionEndpointResource{}
-	_ sdk.ResourceWithUpdate = DataCollectionEndpointResource{}
+	_ sdk.Resource             = DataCollectionEndpointResource{}
+	_ sdk.ResourceWithUpdate   = DataCollectionEndpointResource{}
+	_ sdk.ResourceWithIdentity = DataCollectionEndpointResource{}
 )
 
+func (r DataCollectionEndpointResource) Identity() resourceids.ResourceId {
+	return &datacollectionendpoints.DataCollectionEndpointId{}
+}
+
 type DataCollectionEndpointResource struct{}
 
 type DataCollectionEndpoint struct {
@@ -207,6 +215,10 @@ func (r DataCollectionEndpointResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&DataCollectionEndpoint{
 				ConfigurationAccessEndpoint: configurationAccessEndpoint,
 				Description:                 description,
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-03-11/datacollectionendpoints"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-03-11/datacollectionruleassociations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-03-11/datacollectionrules"
@@ -27,8 +28,12 @@ type DataCollectionRuleAssociationModel struct {
 	Description              string `tfschema:"description"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name monitor_data_collection_rule_association -service-package-name monitor -properties "name" -compare-values "resource_uri:id"
+
 type DataCollectionRuleAssociationResource struct{}
 
+var _ sdk.ResourceWithIdentity = DataCollectionRuleAssociationResource{}
+
 func (r DataCollectionRuleAssociationResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"target_resource_id": {
@@ -81,6 +86,10 @@ func (r DataCollectionRuleAssociationResource) IDValidationFunc() pluginsdk.Sche
 	return datacollectionruleassociations.ValidateScopedDataCollectionRuleAssociationID
 }
 
+func (r DataCollectionRuleAssociationResource) Identity() resourceids.ResourceId {
+	return &datacollectionruleassociations.ScopedDataCollectionRuleAssociationId{}
+}
+
 func (r DataCollectionRuleAssociationResource) ModelObject() interface{} {
 	return &DataCollectionRuleAssociationModel{}
 }
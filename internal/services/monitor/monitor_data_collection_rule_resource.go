@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-03-11/datacollectionendpoints"
@@ -26,11 +27,18 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name monitor_data_collection_rule -service-package-name monitor -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 var (
 	_ sdk.ResourceWithUpdate        = DataCollectionRuleResource{}
 	_ sdk.ResourceWithCustomizeDiff = DataCollectionRuleResource{}
+	_ sdk.ResourceWithIdentity      = DataCollectionRuleResource{}
 )
 
+func (r DataCollectionRuleResource) Identity() resourceids.ResourceId {
+	return &datacollectionrules.DataCollectionRuleId{}
+}
+
 type DataCollectionRule struct {
 	DataCollectionEndpointId string                 `tfschema:"data_collection_endpoint_id"`
 	DataFlows                []DataFlow             `tfschema:"data_flow"`
@@ -1041,6 +1049,10 @@ func (r DataCollectionRuleResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&DataCollectionRule{
 				Name:                     id.DataCollectionRuleName,
 				ResourceGroupName:        id.ResourceGroupName,
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-03-15-preview/scheduledqueryrules"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name monitor_scheduled_query_rules_alert_v2 -service-package-name monitor -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type ScheduledQueryRulesAlertV2Model struct {
 	Name                                  string                                     `tfschema:"name"`
 	ResourceGroupName                     string                                     `tfschema:"resource_group_name"`
@@ -75,6 +78,11 @@ type ScheduledQueryRulesAlertV2FailingPeriodsModel struct {
 type ScheduledQueryRulesAlertV2Resource struct{}
 
 var _ sdk.ResourceWithUpdate = ScheduledQueryRulesAlertV2Resource{}
+var _ sdk.ResourceWithIdentity = ScheduledQueryRulesAlertV2Resource{}
+
+func (r ScheduledQueryRulesAlertV2Resource) Identity() resourceids.ResourceId {
+	return &scheduledqueryrules.ScheduledQueryRuleId{}
+}
 
 func (r ScheduledQueryRulesAlertV2Resource) ResourceType() string {
 	return "azurerm_monitor_scheduled_query_rules_alert_v2"
@@ -727,6 +735,10 @@ func (r ScheduledQueryRulesAlertV2Resource) Read() sdk.ResourceFunc {
 				state.Tags = *model.Tags
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
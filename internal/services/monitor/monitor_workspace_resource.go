@@ -12,12 +12,15 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-04-03/azuremonitorworkspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name monitor_workspace -service-package-name monitor -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type WorkspaceTestResource
+
 type WorkspaceResourceModel struct {
 	Name                            string            `tfschema:"name"`
 	ResourceGroupName               string            `tfschema:"resource_group_name"`
@@ -32,6 +35,11 @@ type WorkspaceResourceModel struct {
 type WorkspaceResource struct{}
 
 var _ sdk.ResourceWithUpdate = WorkspaceResource{}
+var _ sdk.ResourceWithIdentity = WorkspaceResource{}
+
+func (r WorkspaceResource) Identity() resourceids.ResourceId {
+	return &azuremonitorworkspaces.AccountId{}
+}
 
 func (r WorkspaceResource) ResourceType() string {
 	return "azurerm_monitor_workspace"
@@ -232,6 +240,10 @@ func (r WorkspaceResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/failovergroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/servers"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -47,10 +48,13 @@ type ReadWriteEndpointFailurePolicyModel struct {
 }
 
 var (
-	_ sdk.Resource           = MsSqlFailoverGroupResource{}
-	_ sdk.ResourceWithUpdate = MsSqlFailoverGroupResource{}
+	_ sdk.Resource             = MsSqlFailoverGroupResource{}
+	_ sdk.ResourceWithUpdate   = MsSqlFailoverGroupResource{}
+	_ sdk.ResourceWithIdentity = MsSqlFailoverGroupResource{}
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mssql_failover_group -service-package-name mssql -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,server_name:id" -test-name automaticFailover -test-resource-type MsSqlFailoverGroupResource
+
 type MsSqlFailoverGroupResource struct{}
 
 func (r MsSqlFailoverGroupResource) ResourceType() string {
@@ -65,6 +69,10 @@ func (r MsSqlFailoverGroupResource) IDValidationFunc() pluginsdk.SchemaValidateF
 	return validate.FailoverGroupID
 }
 
+func (r MsSqlFailoverGroupResource) Identity() resourceids.ResourceId {
+	return &failovergroups.FailoverGroupId{}
+}
+
 func (r MsSqlFailoverGroupResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -344,6 +352,10 @@ func (r MsSqlFailoverGroupResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&model)
 		},
 	}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/firewallrules"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mssql_firewall_rule -service-package-name mssql -properties "name" -compare-values "subscription_id:id,resource_group_name:id,server_name:id" -test-resource-type MsSqlFirewallRuleResource
+
 func resourceMsSqlFirewallRule() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceMsSqlFirewallRuleCreateUpdate,
@@ -27,10 +30,11 @@ func resourceMsSqlFirewallRule() *pluginsdk.Resource {
 		Update: resourceMsSqlFirewallRuleCreateUpdate,
 		Delete: resourceMsSqlFirewallRuleDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := firewallrules.ParseFirewallRuleID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&firewallrules.FirewallRuleId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&firewallrules.FirewallRuleId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -148,7 +152,7 @@ func resourceMsSqlFirewallRuleRead(d *pluginsdk.ResourceData, meta interface{})
 	serverId := parse.NewServerID(id.SubscriptionId, id.ResourceGroupName, id.ServerName)
 	d.Set("server_id", serverId.ID())
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceMsSqlFirewallRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,14 +12,16 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobcredentials"
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mssql_job_credential -service-package-name mssql -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,server_name:id,job_agent_name:id" -test-resource-type MsSqlJobCredentialResource
+
 func resourceMsSqlJobCredential() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceMsSqlJobCredentialCreate,
@@ -27,10 +29,11 @@ func resourceMsSqlJobCredential() *pluginsdk.Resource {
 		Update: resourceMsSqlJobCredentialUpdate,
 		Delete: resourceMsSqlJobCredentialDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.JobCredentialID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&jobcredentials.CredentialId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&jobcredentials.CredentialId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
@@ -215,7 +218,7 @@ func resourceMsSqlJobCredentialRead(d *pluginsdk.ResourceData, meta interface{})
 
 	d.Set("password_wo_version", d.Get("password_wo_version").(int))
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceMsSqlJobCredentialDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobs"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mssql_job_schedule -service-package-name mssql -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,server_name:id,job_agent_name:id,name:id" -test-resource-type MsSqlJobScheduleResource
+
 type MsSqlJobScheduleResource struct{}
 
 type MsSqlJobScheduleResourceModel struct {
@@ -33,6 +36,7 @@ type MsSqlJobScheduleResourceModel struct {
 var (
 	_ sdk.ResourceWithUpdate        = MsSqlJobScheduleResource{}
 	_ sdk.ResourceWithCustomizeDiff = MsSqlJobScheduleResource{}
+	_ sdk.ResourceWithIdentity      = MsSqlJobScheduleResource{}
 )
 
 func (MsSqlJobScheduleResource) Arguments() map[string]*pluginsdk.Schema {
@@ -330,3 +334,7 @@ func (MsSqlJobScheduleResource) Delete() sdk.ResourceFunc {
 func (MsSqlJobScheduleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return jobs.ValidateJobID
 }
+
+func (MsSqlJobScheduleResource) Identity() resourceids.ResourceId {
+	return &jobs.JobId{}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobcredentials"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobsteps"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobtargetgroups"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mssql_job_step -service-package-name mssql -properties "name" -compare-values "subscription_id:id,resource_group_name:id,server_name:id,job_agent_name:id,job_name:id" -test-resource-type MsSqlJobStepTestResource
+
 type MsSqlJobStepResource struct{}
 
 type MsSqlJobStepResourceModel struct {
@@ -47,8 +50,13 @@ type JobStepOutputTarget struct {
 var (
 	_ sdk.ResourceWithUpdate        = MsSqlJobStepResource{}
 	_ sdk.ResourceWithCustomizeDiff = MsSqlJobStepResource{}
+	_ sdk.ResourceWithIdentity      = MsSqlJobStepResource{}
 )
 
+func (r MsSqlJobStepResource) Identity() resourceids.ResourceId {
+	return &jobsteps.StepId{}
+}
+
 func (MsSqlJobStepResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -301,6 +309,10 @@ func (r MsSqlJobStepResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
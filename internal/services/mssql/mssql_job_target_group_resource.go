@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobcredentials"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobtargetgroups"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mssql_job_target_group -service-package-name mssql -properties "name" -compare-values "subscription_id:id,resource_group_name:id,server_name:id,job_agent_name:id" -test-resource-type MsSqlJobTargetGroupResource
+
 type MsSqlJobTargetGroupResource struct{}
 
 type MsSqlJobTargetGroupResourceModel struct {
@@ -39,8 +42,13 @@ type MsSqlJobTarget struct {
 var (
 	_ sdk.ResourceWithUpdate        = MsSqlJobTargetGroupResource{}
 	_ sdk.ResourceWithCustomizeDiff = MsSqlJobTargetGroupResource{}
+	_ sdk.ResourceWithIdentity      = MsSqlJobTargetGroupResource{}
 )
 
+func (r MsSqlJobTargetGroupResource) Identity() resourceids.ResourceId {
+	return &jobtargetgroups.TargetGroupId{}
+}
+
 func (r MsSqlJobTargetGroupResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -203,6 +211,10 @@ func (r MsSqlJobTargetGroupResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
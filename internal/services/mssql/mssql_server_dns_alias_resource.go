@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/serverdnsaliases"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
@@ -23,10 +24,14 @@ type ServerDNSAliasModel struct {
 	DNSRecord     string `tfschema:"dns_record"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mssql_server_dns_alias -service-package-name mssql -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,server_name:id" -test-resource-type ServerDNSAliasResource
+
 type ServerDNSAliasResource struct{}
 
 var _ sdk.Resource = (*ServerDNSAliasResource)(nil)
 
+var _ sdk.ResourceWithIdentity = ServerDNSAliasResource{}
+
 func (m ServerDNSAliasResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"mssql_server_id": {
@@ -123,6 +128,10 @@ func (m ServerDNSAliasResource) Read() sdk.ResourceFunc {
 					state.DNSRecord = pointer.From(prop.AzureDnsRecord)
 				}
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -150,3 +159,7 @@ func (m ServerDNSAliasResource) Delete() sdk.ResourceFunc {
 func (m ServerDNSAliasResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return validate.ServerDNSAliasID
 }
+
+func (m ServerDNSAliasResource) Identity() resourceids.ResourceId {
+	return &serverdnsaliases.DnsAliasId{}
+}
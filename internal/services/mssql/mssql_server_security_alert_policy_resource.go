@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/serversecurityalertpolicies"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
@@ -23,6 +24,8 @@ import (
 
 // TODO 4.0 - consider/investigate inlining this within the mssql_server resource now that it exists.
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mssql_server_security_alert_policy -service-package-name mssql -properties "resource_group_name,server_name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "name:id" -test-resource-type MsSqlServerSecurityAlertPolicyResource
+
 func resourceMsSqlServerSecurityAlertPolicy() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceMsSqlServerSecurityAlertPolicyCreate,
@@ -30,10 +33,11 @@ func resourceMsSqlServerSecurityAlertPolicy() *pluginsdk.Resource {
 		Update: resourceMsSqlServerSecurityAlertPolicyUpdate,
 		Delete: resourceMsSqlServerSecurityAlertPolicyDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.ServerSecurityAlertPolicyID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.ServerSecurityAlertPolicyId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.ServerSecurityAlertPolicyId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -293,7 +297,7 @@ func resourceMsSqlServerSecurityAlertPolicyRead(d *pluginsdk.ResourceData, meta
 	}
 	d.Set("storage_account_access_key", accessKey)
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceMsSqlServerSecurityAlertPolicyUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/encryptionprotectors"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/serverkeys"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	keyVaultParser "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
@@ -28,6 +29,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mssql_server_transparent_data_encryption -service-package-name mssql -known-values "subscription_id:data.Subscriptions.Primary,name:current" -compare-values "resource_group_name:server_id,server_name:server_id" -test-name systemManaged -test-resource-type MsSqlServerTransparentDataEncryptionResource
 func resourceMsSqlTransparentDataEncryption() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceMsSqlTransparentDataEncryptionCreateUpdate,
@@ -35,11 +37,11 @@ func resourceMsSqlTransparentDataEncryption() *pluginsdk.Resource {
 		Update: resourceMsSqlTransparentDataEncryptionCreateUpdate,
 		Delete: resourceMsSqlTransparentDataEncryptionDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.EncryptionProtectorID(id)
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.EncryptionProtectorId{}),
 
-			return err
-		}),
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.EncryptionProtectorId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -287,7 +289,7 @@ func resourceMsSqlTransparentDataEncryptionRead(d *pluginsdk.ResourceData, meta
 		return fmt.Errorf("setting `auto_rotation_enabled`: %+v", err)
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceMsSqlTransparentDataEncryptionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/serversecurityalertpolicies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/servervulnerabilityassessments"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mssql_server_vulnerability_assessment -service-package-name mssql -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,server_name:id,name:id" -test-resource-type MsSqlServerVulnerabilityAssessmentResource
+
 func resourceMsSqlServerVulnerabilityAssessment() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceMsSqlServerVulnerabilityAssessmentCreate,
@@ -28,10 +31,11 @@ func resourceMsSqlServerVulnerabilityAssessment() *pluginsdk.Resource {
 		Update: resourceMsSqlServerVulnerabilityAssessmentUpdate,
 		Delete: resourceMsSqlServerVulnerabilityAssessmentDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.ServerVulnerabilityAssessmentID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.ServerVulnerabilityAssessmentId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.ServerVulnerabilityAssessmentId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -285,7 +289,7 @@ func resourceMsSqlServerVulnerabilityAssessmentRead(d *pluginsdk.ResourceData, m
 	}
 	d.Set("storage_container_sas_key", storageContainerSasKey)
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceMsSqlServerVulnerabilityAssessmentUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
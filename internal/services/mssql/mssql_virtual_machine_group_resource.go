@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2023-10-01/availabilitygrouplisteners"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2023-10-01/sqlvirtualmachinegroups"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mssql_virtual_machine_group -service-package-name mssql -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type MsSqlVirtualMachineGroupResource
+
 type MsSqlVirtualMachineGroupResource struct{}
 
 type MsSqlVirtualMachineGroupModel struct {
@@ -46,8 +49,9 @@ type WsfcDomainProfile struct {
 }
 
 var (
-	_ sdk.Resource           = MsSqlVirtualMachineGroupResource{}
-	_ sdk.ResourceWithUpdate = MsSqlVirtualMachineGroupResource{}
+	_ sdk.Resource             = MsSqlVirtualMachineGroupResource{}
+	_ sdk.ResourceWithUpdate   = MsSqlVirtualMachineGroupResource{}
+	_ sdk.ResourceWithIdentity = MsSqlVirtualMachineGroupResource{}
 )
 
 func (r MsSqlVirtualMachineGroupResource) ModelObject() interface{} {
@@ -62,6 +66,10 @@ func (r MsSqlVirtualMachineGroupResource) IDValidationFunc() pluginsdk.SchemaVal
 	return availabilitygrouplisteners.ValidateSqlVirtualMachineGroupID
 }
 
+func (r MsSqlVirtualMachineGroupResource) Identity() resourceids.ResourceId {
+	return &sqlvirtualmachinegroups.SqlVirtualMachineGroupId{}
+}
+
 func (r MsSqlVirtualMachineGroupResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -257,6 +265,10 @@ func (r MsSqlVirtualMachineGroupResource) Read() sdk.ResourceFunc {
 					state.Tags = pointer.From(model.Tags)
 				}
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
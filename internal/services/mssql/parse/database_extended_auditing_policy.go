@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &DatabaseExtendedAuditingPolicyId{}
+
 type DatabaseExtendedAuditingPolicyId struct {
 	SubscriptionId              string
 	ResourceGroup               string
@@ -47,6 +49,51 @@ func (id DatabaseExtendedAuditingPolicyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.DatabaseName, id.ExtendedAuditingSettingName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this DatabaseExtendedAuditingPolicy ID
+func (id DatabaseExtendedAuditingPolicyId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "group1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSql", "Microsoft.Sql", "Microsoft.Sql"),
+		resourceids.StaticSegment("staticServers", "servers", "servers"),
+		resourceids.UserSpecifiedSegment("serverName", "server1"),
+		resourceids.StaticSegment("staticDatabases", "databases", "databases"),
+		resourceids.UserSpecifiedSegment("databaseName", "database1"),
+		resourceids.StaticSegment("staticExtendedAuditingSettings", "extendedAuditingSettings", "extendedAuditingSettings"),
+		resourceids.UserSpecifiedSegment("extendedAuditingSettingName", "default"),
+	}
+}
+
+// FromParseResult populates the fields of this DatabaseExtendedAuditingPolicy ID from the ParseResult, for use with resourceids.Parser
+func (id *DatabaseExtendedAuditingPolicyId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ServerName, ok = input.Parsed["serverName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "serverName", input)
+	}
+
+	if id.DatabaseName, ok = input.Parsed["databaseName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "databaseName", input)
+	}
+
+	if id.ExtendedAuditingSettingName, ok = input.Parsed["extendedAuditingSettingName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "extendedAuditingSettingName", input)
+	}
+
+	return nil
+}
+
 // DatabaseExtendedAuditingPolicyID parses a DatabaseExtendedAuditingPolicy ID into an DatabaseExtendedAuditingPolicyId struct
 func DatabaseExtendedAuditingPolicyID(input string) (*DatabaseExtendedAuditingPolicyId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
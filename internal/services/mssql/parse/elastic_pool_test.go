@@ -21,6 +21,24 @@ func TestElasticPoolIDFormatter(t *testing.T) {
 	}
 }
 
+func TestElasticPoolIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&ElasticPoolId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/elasticPools/pool1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual ElasticPoolId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/elasticPools/pool1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestElasticPoolID(t *testing.T) {
 	testData := []struct {
 		Input    string
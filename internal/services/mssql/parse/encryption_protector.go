@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &EncryptionProtectorId{}
+
 type EncryptionProtectorId struct {
 	SubscriptionId          string
 	ResourceGroup           string
@@ -44,6 +46,45 @@ func (id EncryptionProtectorId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.EncryptionProtectorName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this EncryptionProtector ID
+func (id EncryptionProtectorId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "group1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSql", "Microsoft.Sql", "Microsoft.Sql"),
+		resourceids.StaticSegment("staticServers", "servers", "servers"),
+		resourceids.UserSpecifiedSegment("serverName", "server1"),
+		resourceids.StaticSegment("staticEncryptionProtector", "encryptionProtector", "encryptionProtector"),
+		resourceids.UserSpecifiedSegment("encryptionProtectorName", "current"),
+	}
+}
+
+// FromParseResult populates the fields of this EncryptionProtector ID from the ParseResult, for use with resourceids.Parser
+func (id *EncryptionProtectorId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ServerName, ok = input.Parsed["serverName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "serverName", input)
+	}
+
+	if id.EncryptionProtectorName, ok = input.Parsed["encryptionProtectorName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "encryptionProtectorName", input)
+	}
+
+	return nil
+}
+
 // EncryptionProtectorID parses a EncryptionProtector ID into an EncryptionProtectorId struct
 func EncryptionProtectorID(input string) (*EncryptionProtectorId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -21,6 +21,24 @@ func TestJobAgentIDFormatter(t *testing.T) {
 	}
 }
 
+func TestJobAgentIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&JobAgentId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/jobAgents/jobagent1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual JobAgentId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/jobAgents/jobagent1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestJobAgentID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &ManagedInstancesSecurityAlertPolicyId{}
+
 type ManagedInstancesSecurityAlertPolicyId struct {
 	SubscriptionId          string
 	ResourceGroup           string
@@ -44,6 +46,45 @@ func (id ManagedInstancesSecurityAlertPolicyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName, id.SecurityAlertPolicyName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ManagedInstancesSecurityAlertPolicy ID
+func (id ManagedInstancesSecurityAlertPolicyId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "group1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSql", "Microsoft.Sql", "Microsoft.Sql"),
+		resourceids.StaticSegment("staticManagedInstances", "managedInstances", "managedInstances"),
+		resourceids.UserSpecifiedSegment("managedInstanceName", "instance1"),
+		resourceids.StaticSegment("staticSecurityAlertPolicies", "securityAlertPolicies", "securityAlertPolicies"),
+		resourceids.UserSpecifiedSegment("securityAlertPolicyName", "Default"),
+	}
+}
+
+// FromParseResult populates the fields of this ManagedInstancesSecurityAlertPolicy ID from the ParseResult, for use with resourceids.Parser
+func (id *ManagedInstancesSecurityAlertPolicyId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ManagedInstanceName, ok = input.Parsed["managedInstanceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "managedInstanceName", input)
+	}
+
+	if id.SecurityAlertPolicyName, ok = input.Parsed["securityAlertPolicyName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "securityAlertPolicyName", input)
+	}
+
+	return nil
+}
+
 // ManagedInstancesSecurityAlertPolicyID parses a ManagedInstancesSecurityAlertPolicy ID into an ManagedInstancesSecurityAlertPolicyId struct
 func ManagedInstancesSecurityAlertPolicyID(input string) (*ManagedInstancesSecurityAlertPolicyId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -21,6 +21,24 @@ func TestOutboundFirewallRuleIDFormatter(t *testing.T) {
 	}
 }
 
+func TestOutboundFirewallRuleIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&OutboundFirewallRuleId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/outboundFirewallRules/fqdn1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual OutboundFirewallRuleId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/outboundFirewallRules/fqdn1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestOutboundFirewallRuleID(t *testing.T) {
 	testData := []struct {
 		Input    string
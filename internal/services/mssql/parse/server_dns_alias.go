@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &ServerDNSAliasId{}
+
 type ServerDNSAliasId struct {
 	SubscriptionId string
 	ResourceGroup  string
@@ -44,6 +46,45 @@ func (id ServerDNSAliasId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.DnsAliaseName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ServerDNSAlias ID
+func (id ServerDNSAliasId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "group1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSql", "Microsoft.Sql", "Microsoft.Sql"),
+		resourceids.StaticSegment("staticServers", "servers", "servers"),
+		resourceids.UserSpecifiedSegment("serverName", "server1"),
+		resourceids.StaticSegment("staticDnsAliases", "dnsAliases", "dnsAliases"),
+		resourceids.UserSpecifiedSegment("dnsAliaseName", "default"),
+	}
+}
+
+// FromParseResult populates the fields of this ServerDNSAlias ID from the ParseResult, for use with resourceids.Parser
+func (id *ServerDNSAliasId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ServerName, ok = input.Parsed["serverName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "serverName", input)
+	}
+
+	if id.DnsAliaseName, ok = input.Parsed["dnsAliaseName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "dnsAliaseName", input)
+	}
+
+	return nil
+}
+
 // ServerDNSAliasID parses a ServerDNSAlias ID into an ServerDNSAliasId struct
 func ServerDNSAliasID(input string) (*ServerDNSAliasId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
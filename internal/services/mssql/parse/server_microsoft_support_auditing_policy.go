@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &ServerMicrosoftSupportAuditingPolicyId{}
+
 type ServerMicrosoftSupportAuditingPolicyId struct {
 	SubscriptionId            string
 	ResourceGroup             string
@@ -44,6 +46,45 @@ func (id ServerMicrosoftSupportAuditingPolicyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.DevOpsAuditingSettingName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ServerMicrosoftSupportAuditingPolicy ID
+func (id ServerMicrosoftSupportAuditingPolicyId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "group1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSql", "Microsoft.Sql", "Microsoft.Sql"),
+		resourceids.StaticSegment("staticServers", "servers", "servers"),
+		resourceids.UserSpecifiedSegment("serverName", "server1"),
+		resourceids.StaticSegment("staticDevOpsAuditingSettings", "devOpsAuditingSettings", "devOpsAuditingSettings"),
+		resourceids.UserSpecifiedSegment("devOpsAuditingSettingName", "default"),
+	}
+}
+
+// FromParseResult populates the fields of this ServerMicrosoftSupportAuditingPolicy ID from the ParseResult, for use with resourceids.Parser
+func (id *ServerMicrosoftSupportAuditingPolicyId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ServerName, ok = input.Parsed["serverName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "serverName", input)
+	}
+
+	if id.DevOpsAuditingSettingName, ok = input.Parsed["devOpsAuditingSettingName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "devOpsAuditingSettingName", input)
+	}
+
+	return nil
+}
+
 // ServerMicrosoftSupportAuditingPolicyID parses a ServerMicrosoftSupportAuditingPolicy ID into an ServerMicrosoftSupportAuditingPolicyId struct
 func ServerMicrosoftSupportAuditingPolicyID(input string) (*ServerMicrosoftSupportAuditingPolicyId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
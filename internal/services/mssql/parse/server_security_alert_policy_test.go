@@ -21,6 +21,24 @@ func TestServerSecurityAlertPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestServerSecurityAlertPolicyIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&ServerSecurityAlertPolicyId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/securityAlertPolicies/Default", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual ServerSecurityAlertPolicyId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/securityAlertPolicies/Default"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestServerSecurityAlertPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,24 @@ func TestSqlVirtualMachineIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSqlVirtualMachineIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&SqlVirtualMachineId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.SqlVirtualMachine/sqlVirtualMachines/virtualMachine1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual SqlVirtualMachineId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.SqlVirtualMachine/sqlVirtualMachines/virtualMachine1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestSqlVirtualMachineID(t *testing.T) {
 	testData := []struct {
 		Input    string
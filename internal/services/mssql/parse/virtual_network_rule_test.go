@@ -21,6 +21,24 @@ func TestVirtualNetworkRuleIDFormatter(t *testing.T) {
 	}
 }
 
+func TestVirtualNetworkRuleIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&VirtualNetworkRuleId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Sql/servers/server1/virtualNetworkRules/virtualNetworkRule1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual VirtualNetworkRuleId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Sql/servers/server1/virtualNetworkRules/virtualNetworkRule1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestVirtualNetworkRuleID(t *testing.T) {
 	testData := []struct {
 		Input    string
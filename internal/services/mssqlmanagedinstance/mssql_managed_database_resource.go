@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/managedbackupshorttermretentionpolicies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/manageddatabases"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/managedinstancelongtermretentionpolicies"
@@ -50,10 +51,13 @@ type PointInTimeRestore struct {
 }
 
 var (
-	_ sdk.Resource           = MsSqlManagedDatabaseResource{}
-	_ sdk.ResourceWithUpdate = MsSqlManagedDatabaseResource{}
+	_ sdk.Resource             = MsSqlManagedDatabaseResource{}
+	_ sdk.ResourceWithUpdate   = MsSqlManagedDatabaseResource{}
+	_ sdk.ResourceWithIdentity = MsSqlManagedDatabaseResource{}
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mssql_managed_database -service-package-name mssqlmanagedinstance -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,managed_instance_name:id" -test-resource-type MsSqlManagedDatabase
+
 type MsSqlManagedDatabaseResource struct{}
 
 func (r MsSqlManagedDatabaseResource) ResourceType() string {
@@ -68,6 +72,10 @@ func (r MsSqlManagedDatabaseResource) IDValidationFunc() pluginsdk.SchemaValidat
 	return validate.ManagedDatabaseID
 }
 
+func (r MsSqlManagedDatabaseResource) Identity() resourceids.ResourceId {
+	return &commonids.SqlManagedInstanceDatabaseId{}
+}
+
 func (r MsSqlManagedDatabaseResource) Arguments() map[string]*pluginsdk.Schema {
 	resource := map[string]*pluginsdk.Schema{
 		"name": {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/instancefailovergroups"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -47,10 +48,13 @@ type MsSqlManagedInstancePartnerRegionModel struct {
 }
 
 var (
-	_ sdk.Resource           = MsSqlManagedInstanceFailoverGroupResource{}
-	_ sdk.ResourceWithUpdate = MsSqlManagedInstanceFailoverGroupResource{}
+	_ sdk.Resource             = MsSqlManagedInstanceFailoverGroupResource{}
+	_ sdk.ResourceWithUpdate   = MsSqlManagedInstanceFailoverGroupResource{}
+	_ sdk.ResourceWithIdentity = MsSqlManagedInstanceFailoverGroupResource{}
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mssql_managed_instance_failover_group -service-package-name mssqlmanagedinstance -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,location_name:id" -test-resource-type MsSqlManagedInstanceFailoverGroupResource
+
 type MsSqlManagedInstanceFailoverGroupResource struct{}
 
 func (r MsSqlManagedInstanceFailoverGroupResource) ResourceType() string {
@@ -65,6 +69,10 @@ func (r MsSqlManagedInstanceFailoverGroupResource) IDValidationFunc() pluginsdk.
 	return validate.ManagedInstanceFailoverGroupID
 }
 
+func (r MsSqlManagedInstanceFailoverGroupResource) Identity() resourceids.ResourceId {
+	return &instancefailovergroups.InstanceFailoverGroupId{}
+}
+
 func (r MsSqlManagedInstanceFailoverGroupResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -401,6 +409,10 @@ func (r MsSqlManagedInstanceFailoverGroupResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&model)
 		},
 	}
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/maintenance/2023-04-01/publicmaintenanceconfigurations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/managedinstanceadministrators"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/managedinstanceazureadonlyauthentications"
@@ -78,8 +79,11 @@ var (
 	_ sdk.Resource                  = MsSqlManagedInstanceResource{}
 	_ sdk.ResourceWithUpdate        = MsSqlManagedInstanceResource{}
 	_ sdk.ResourceWithCustomizeDiff = MsSqlManagedInstanceResource{}
+	_ sdk.ResourceWithIdentity      = MsSqlManagedInstanceResource{}
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mssql_managed_instance -service-package-name mssqlmanagedinstance -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type MsSqlManagedInstanceResource
+
 type MsSqlManagedInstanceResource struct{}
 
 func (r MsSqlManagedInstanceResource) ResourceType() string {
@@ -94,6 +98,10 @@ func (r MsSqlManagedInstanceResource) IDValidationFunc() pluginsdk.SchemaValidat
 	return validate.ManagedInstanceID
 }
 
+func (r MsSqlManagedInstanceResource) Identity() resourceids.ResourceId {
+	return &commonids.SqlManagedInstanceId{}
+}
+
 func (r MsSqlManagedInstanceResource) Arguments() map[string]*pluginsdk.Schema {
 	args := map[string]*pluginsdk.Schema{
 		"name": {
@@ -712,6 +720,10 @@ func (r MsSqlManagedInstanceResource) Read() sdk.ResourceFunc {
 					model.HybridSecondaryUsage = string(pointer.From(props.HybridSecondaryUsage))
 				}
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&model)
 		},
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	schedule "github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/startstopmanagedinstanceschedules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssqlmanagedinstance/parse"
@@ -35,9 +36,12 @@ type ScheduleItemModel struct {
 	StopTime  string             `tfschema:"stop_time"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mssql_managed_instance_start_stop_schedule -service-package-name mssqlmanagedinstance -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,managed_instance_name:id,name:id" -test-resource-type sqlManagedInstanceStartStopScheduleResource
+
 type MsSqlManagedInstanceStartStopScheduleResource struct{}
 
 var _ sdk.ResourceWithUpdate = MsSqlManagedInstanceStartStopScheduleResource{}
+var _ sdk.ResourceWithIdentity = MsSqlManagedInstanceStartStopScheduleResource{}
 
 func (r MsSqlManagedInstanceStartStopScheduleResource) ResourceType() string {
 	return "azurerm_mssql_managed_instance_start_stop_schedule"
@@ -51,6 +55,10 @@ func (r MsSqlManagedInstanceStartStopScheduleResource) IDValidationFunc() plugin
 	return validate.ManagedInstanceStartStopScheduleID
 }
 
+func (r MsSqlManagedInstanceStartStopScheduleResource) Identity() resourceids.ResourceId {
+	return &parse.ManagedInstanceStartStopScheduleId{}
+}
+
 func (r MsSqlManagedInstanceStartStopScheduleResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"managed_instance_id": {
@@ -269,6 +277,10 @@ func (r MsSqlManagedInstanceStartStopScheduleResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/managedinstanceencryptionprotectors"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/managedinstancekeys"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	keyVaultParser "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
@@ -28,6 +29,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mssql_managed_instance_transparent_data_encryption -service-package-name mssqlmanagedinstance -known-values "subscription_id:data.Subscriptions.Primary,name:current" -compare-values "resource_group_name:managed_instance_id,managed_instance_name:managed_instance_id" -test-name systemManaged -test-resource-type MsSqlManagedInstanceTransparentDataEncryptionResource
 func resourceMsSqlManagedInstanceTransparentDataEncryption() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceMsSqlManagedInstanceTransparentDataEncryptionCreateUpdate,
@@ -35,11 +37,11 @@ func resourceMsSqlManagedInstanceTransparentDataEncryption() *pluginsdk.Resource
 		Update: resourceMsSqlManagedInstanceTransparentDataEncryptionCreateUpdate,
 		Delete: resourceMsSqlManagedInstanceTransparentDataEncryptionDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.ManagedInstanceEncryptionProtectorID(id)
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.ManagedInstanceEncryptionProtectorId{}),
 
-			return err
-		}),
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.ManagedInstanceEncryptionProtectorId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -283,7 +285,7 @@ func resourceMsSqlManagedInstanceTransparentDataEncryptionRead(d *pluginsdk.Reso
 			return fmt.Errorf("setting `auto_rotation_enabled`: %+v", err)
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceMsSqlManagedInstanceTransparentDataEncryptionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
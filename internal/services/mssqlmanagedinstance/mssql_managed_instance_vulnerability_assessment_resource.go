@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/managedinstancevulnerabilityassessments"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssqlmanagedinstance/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssqlmanagedinstance/validate"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mssql_managed_instance_vulnerability_assessment -service-package-name mssqlmanagedinstance -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,managed_instance_name:id,name:id" -test-resource-type MsSqlManagedInstanceVulnerabilityAssessmentResource
+
 func resourceMsSqlManagedInstanceVulnerabilityAssessment() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceMsSqlManagedInstanceVulnerabilityAssessmentCreate,
@@ -27,10 +30,11 @@ func resourceMsSqlManagedInstanceVulnerabilityAssessment() *pluginsdk.Resource {
 		Update: resourceMsSqlManagedInstanceVulnerabilityAssessmentUpdate,
 		Delete: resourceMsSqlManagedInstanceVulnerabilityAssessmentDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.ManagedInstanceVulnerabilityAssessmentID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.ManagedInstanceVulnerabilityAssessmentId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.ManagedInstanceVulnerabilityAssessmentId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
@@ -196,7 +200,7 @@ func resourceMsSqlManagedInstanceVulnerabilityAssessmentRead(d *pluginsdk.Resour
 			}
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceMsSqlManagedInstanceVulnerabilityAssessmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,6 +21,24 @@ func TestManagedInstanceEncryptionProtectorIDFormatter(t *testing.T) {
 	}
 }
 
+func TestManagedInstanceEncryptionProtectorIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&ManagedInstanceEncryptionProtectorId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/managedInstances/instance1/encryptionProtector/current", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual ManagedInstanceEncryptionProtectorId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/managedInstances/instance1/encryptionProtector/current"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestManagedInstanceEncryptionProtectorID(t *testing.T) {
 	testData := []struct {
 		Input    string
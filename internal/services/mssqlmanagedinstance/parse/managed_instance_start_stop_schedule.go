@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &ManagedInstanceStartStopScheduleId{}
+
 type ManagedInstanceStartStopScheduleId struct {
 	SubscriptionId        string
 	ResourceGroup         string
@@ -44,6 +46,45 @@ func (id ManagedInstanceStartStopScheduleId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName, id.StartStopScheduleName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ManagedInstanceStartStopSchedule ID
+func (id ManagedInstanceStartStopScheduleId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSql", "Microsoft.Sql", "Microsoft.Sql"),
+		resourceids.StaticSegment("staticManagedInstances", "managedInstances", "managedInstances"),
+		resourceids.UserSpecifiedSegment("managedInstanceName", "instance1"),
+		resourceids.StaticSegment("staticStartStopSchedules", "startStopSchedules", "startStopSchedules"),
+		resourceids.UserSpecifiedSegment("startStopScheduleName", "default"),
+	}
+}
+
+// FromParseResult populates the fields of this ManagedInstanceStartStopSchedule ID from the ParseResult, for use with resourceids.Parser
+func (id *ManagedInstanceStartStopScheduleId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ManagedInstanceName, ok = input.Parsed["managedInstanceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "managedInstanceName", input)
+	}
+
+	if id.StartStopScheduleName, ok = input.Parsed["startStopScheduleName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "startStopScheduleName", input)
+	}
+
+	return nil
+}
+
 // ManagedInstanceStartStopScheduleID parses a ManagedInstanceStartStopSchedule ID into an ManagedInstanceStartStopScheduleId struct
 func ManagedInstanceStartStopScheduleID(input string) (*ManagedInstanceStartStopScheduleId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -21,6 +21,24 @@ func TestManagedInstanceStartStopScheduleIDFormatter(t *testing.T) {
 	}
 }
 
+func TestManagedInstanceStartStopScheduleIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&ManagedInstanceStartStopScheduleId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Sql/managedInstances/instance1/startStopSchedules/default", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual ManagedInstanceStartStopScheduleId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Sql/managedInstances/instance1/startStopSchedules/default"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestManagedInstanceStartStopScheduleID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/databases"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/validate"
@@ -19,15 +20,18 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mysql_flexible_database -service-package-name mysql -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "flexible_server_name:id" -test-resource-type MySQLFlexibleDatabaseResource
+
 func resourceMySqlFlexibleDatabase() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceMySqlFlexibleDatabaseCreate,
-		Read:   resourceMySqlFlexibleDatabaseRead,
-		Delete: resourceMySqlFlexibleDatabaseDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := databases.ParseDatabaseID(id)
-			return err
-		}),
+		Create:   resourceMySqlFlexibleDatabaseCreate,
+		Read:     resourceMySqlFlexibleDatabaseRead,
+		Delete:   resourceMySqlFlexibleDatabaseDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&databases.DatabaseId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&databases.DatabaseId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
@@ -132,7 +136,7 @@ func resourceMySqlFlexibleDatabaseRead(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceMySqlFlexibleDatabaseDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/azureadadministrators"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/parse"
@@ -27,9 +28,12 @@ type MySQLFlexibleServerAdministratorModel struct {
 	TenantId   string `tfschema:"tenant_id"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mysql_flexible_server_active_directory_administrator -service-package-name mysql -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,flexible_server_name:id,name:id" -test-resource-type MySQLFlexibleServerAdministratorResource
+
 type MySQLFlexibleServerAdministratorResource struct{}
 
 var _ sdk.ResourceWithUpdate = MySQLFlexibleServerAdministratorResource{}
+var _ sdk.ResourceWithIdentity = MySQLFlexibleServerAdministratorResource{}
 
 func (r MySQLFlexibleServerAdministratorResource) ResourceType() string {
 	return "azurerm_mysql_flexible_server_active_directory_administrator"
@@ -43,6 +47,10 @@ func (r MySQLFlexibleServerAdministratorResource) IDValidationFunc() pluginsdk.S
 	return validate.FlexibleServerAzureActiveDirectoryAdministratorID
 }
 
+func (r MySQLFlexibleServerAdministratorResource) Identity() resourceids.ResourceId {
+	return &parse.FlexibleServerAzureActiveDirectoryAdministratorId{}
+}
+
 func (r MySQLFlexibleServerAdministratorResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"server_id": {
@@ -221,6 +229,10 @@ func (r MySQLFlexibleServerAdministratorResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/firewallrules"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mysql_flexible_server_firewall_rule -service-package-name mysql -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "flexible_server_name:id" -test-resource-type MySQLFlexibleServerFirewallRuleResource
+
 func resourceMySqlFlexibleServerFirewallRule() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceMySqlFlexibleServerFirewallRuleCreateUpdate,
@@ -25,10 +28,11 @@ func resourceMySqlFlexibleServerFirewallRule() *pluginsdk.Resource {
 		Update: resourceMySqlFlexibleServerFirewallRuleCreateUpdate,
 		Delete: resourceMySqlFlexibleServerFirewallRuleDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := firewallrules.ParseFirewallRuleID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&firewallrules.FirewallRuleId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&firewallrules.FirewallRuleId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -131,7 +135,7 @@ func resourceMySqlFlexibleServerFirewallRuleRead(d *pluginsdk.ResourceData, meta
 		d.Set("end_ip_address", model.Properties.EndIPAddress)
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceMySqlFlexibleServerFirewallRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
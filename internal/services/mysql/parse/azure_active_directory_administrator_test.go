@@ -21,6 +21,24 @@ func TestAzureActiveDirectoryAdministratorIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAzureActiveDirectoryAdministratorIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&AzureActiveDirectoryAdministratorId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforMySQL/servers/server1/administrators/activeDirectory", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual AzureActiveDirectoryAdministratorId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforMySQL/servers/server1/administrators/activeDirectory"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestAzureActiveDirectoryAdministratorID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,24 @@ func TestFlexibleServerAzureActiveDirectoryAdministratorIDFormatter(t *testing.T
 	}
 }
 
+func TestFlexibleServerAzureActiveDirectoryAdministratorIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&FlexibleServerAzureActiveDirectoryAdministratorId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforMySQL/flexibleServers/server1/administrators/ActiveDirectory", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual FlexibleServerAzureActiveDirectoryAdministratorId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforMySQL/flexibleServers/server1/administrators/ActiveDirectory"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestFlexibleServerAzureActiveDirectoryAdministratorID(t *testing.T) {
 	testData := []struct {
 		Input    string
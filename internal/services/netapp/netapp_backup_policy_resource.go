@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2025-06-01/backuppolicy"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -22,9 +23,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name netapp_backup_policy -service-package-name netapp -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,net_app_account_name:id,name:id" -test-resource-type NetAppBackupPolicyResource
+
 type NetAppBackupPolicyResource struct{}
 
 var _ sdk.Resource = NetAppBackupPolicyResource{}
+var _ sdk.ResourceWithIdentity = NetAppBackupPolicyResource{}
 
 func (r NetAppBackupPolicyResource) ModelObject() interface{} {
 	return &netAppModels.NetAppBackupPolicyModel{}
@@ -38,6 +42,10 @@ func (r NetAppBackupPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateF
 	return backuppolicy.ValidateBackupPolicyID
 }
 
+func (r NetAppBackupPolicyResource) Identity() resourceids.ResourceId {
+	return &backuppolicy.BackupPolicyId{}
+}
+
 func (r NetAppBackupPolicyResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -242,6 +250,10 @@ func (r NetAppBackupPolicyResource) Read() sdk.ResourceFunc {
 
 			metadata.SetID(id)
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2025-06-01/backups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2025-06-01/backupvaults"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -23,9 +24,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name netapp_backup_vault -service-package-name netapp -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,net_app_account_name:id,name:id" -test-resource-type NetAppBackupVaultResource
+
 type NetAppBackupVaultResource struct{}
 
 var _ sdk.Resource = NetAppBackupVaultResource{}
+var _ sdk.ResourceWithIdentity = NetAppBackupVaultResource{}
 
 func (r NetAppBackupVaultResource) ModelObject() interface{} {
 	return &netAppModels.NetAppBackupVaultModel{}
@@ -39,6 +43,10 @@ func (r NetAppBackupVaultResource) IDValidationFunc() pluginsdk.SchemaValidateFu
 	return backupvaults.ValidateBackupVaultID
 }
 
+func (r NetAppBackupVaultResource) Identity() resourceids.ResourceId {
+	return &backupvaults.BackupVaultId{}
+}
+
 func (r NetAppBackupVaultResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -180,6 +188,10 @@ func (r NetAppBackupVaultResource) Read() sdk.ResourceFunc {
 
 			metadata.SetID(id)
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2025-06-01/capacitypools"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2025-06-01/volumegroups"
@@ -26,9 +27,16 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name netapp_volume_group_oracle -service-package-name netapp -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "net_app_account_name:id" -test-name basicAvailabilityZone -test-resource-type NetAppVolumeGroupOracleResource
+
 type NetAppVolumeGroupOracleResource struct{}
 
 var _ sdk.Resource = NetAppVolumeGroupOracleResource{}
+var _ sdk.ResourceWithIdentity = NetAppVolumeGroupOracleResource{}
+
+func (r NetAppVolumeGroupOracleResource) Identity() resourceids.ResourceId {
+	return &volumegroups.VolumeGroupId{}
+}
 
 func (r NetAppVolumeGroupOracleResource) ModelObject() interface{} {
 	return &netAppModels.NetAppVolumeGroupOracleModel{}
@@ -617,6 +625,10 @@ func (r NetAppVolumeGroupOracleResource) Read() sdk.ResourceFunc {
 
 			metadata.SetID(id)
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&model)
 		},
 	}
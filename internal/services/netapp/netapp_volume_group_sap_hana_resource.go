@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2025-06-01/capacitypools"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2025-06-01/volumegroups"
@@ -26,9 +27,16 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name netapp_volume_group_sap_hana -service-package-name netapp -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "net_app_account_name:id" -test-resource-type NetAppVolumeGroupSAPHanaResource
+
 type NetAppVolumeGroupSAPHanaResource struct{}
 
 var _ sdk.Resource = NetAppVolumeGroupSAPHanaResource{}
+var _ sdk.ResourceWithIdentity = NetAppVolumeGroupSAPHanaResource{}
+
+func (r NetAppVolumeGroupSAPHanaResource) Identity() resourceids.ResourceId {
+	return &volumegroups.VolumeGroupId{}
+}
 
 func (r NetAppVolumeGroupSAPHanaResource) ModelObject() interface{} {
 	return &netAppModels.NetAppVolumeGroupSAPHanaModel{}
@@ -611,6 +619,10 @@ func (r NetAppVolumeGroupSAPHanaResource) Read() sdk.ResourceFunc {
 
 			metadata.SetID(id)
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&model)
 		},
 	}
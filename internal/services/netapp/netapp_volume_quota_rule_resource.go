@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2025-06-01/volumequotarules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2025-06-01/volumes"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -23,9 +24,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name netapp_volume_quota_rule -service-package-name netapp -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,net_app_account_name:id,capacity_pool_name:id,volume_name:id,name:id" -test-name individualGroupQuotaType -test-resource-type NetAppVolumeQuotaRuleResource
+
 type NetAppVolumeQuotaRuleResource struct{}
 
 var _ sdk.Resource = NetAppVolumeQuotaRuleResource{}
+var _ sdk.ResourceWithIdentity = NetAppVolumeQuotaRuleResource{}
 
 func (r NetAppVolumeQuotaRuleResource) ModelObject() interface{} {
 	return &netAppModels.NetAppVolumeQuotaRuleModel{}
@@ -39,6 +43,10 @@ func (r NetAppVolumeQuotaRuleResource) IDValidationFunc() pluginsdk.SchemaValida
 	return volumequotarules.ValidateVolumeQuotaRuleID
 }
 
+func (r NetAppVolumeQuotaRuleResource) Identity() resourceids.ResourceId {
+	return &volumequotarules.VolumeQuotaRuleId{}
+}
+
 func (r NetAppVolumeQuotaRuleResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -223,6 +231,10 @@ func (r NetAppVolumeQuotaRuleResource) Read() sdk.ResourceFunc {
 
 			metadata.SetID(id)
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&model)
 		},
 	}
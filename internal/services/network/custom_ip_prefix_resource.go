@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/customipprefixes"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -40,6 +41,9 @@ type CustomIpPrefixModel struct {
 
 var _ sdk.ResourceWithUpdate = CustomIpPrefixResource{}
 
+var _ sdk.ResourceWithIdentity = CustomIpPrefixResource{}
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name custom_ip_prefix -service-package-name network -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name ipv4Provisioned -test-resource-type CustomIpPrefixResource -test-sequential
 type CustomIpPrefixResource struct {
 	client *customipprefixes.CustomIPPrefixesClient
 }
@@ -56,6 +60,10 @@ func (CustomIpPrefixResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return customipprefixes.ValidateCustomIPPrefixID
 }
 
+func (CustomIpPrefixResource) Identity() resourceids.ResourceId {
+	return &customipprefixes.CustomIPPrefixId{}
+}
+
 func (r CustomIpPrefixResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/expressrouteports"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/expressroutecircuits"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -26,18 +27,21 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name express_route_circuit -service-package-name network -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name basicMeteredConfig -test-sequential
+
 var expressRouteCircuitResourceName = "azurerm_express_route_circuit"
 
 func resourceExpressRouteCircuit() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceExpressRouteCircuitCreate,
-		Read:   resourceExpressRouteCircuitRead,
-		Update: resourceExpressRouteCircuitUpdate,
-		Delete: resourceExpressRouteCircuitDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := expressroutecircuits.ParseExpressRouteCircuitID(id)
-			return err
-		}),
+		Create:   resourceExpressRouteCircuitCreate,
+		Read:     resourceExpressRouteCircuitRead,
+		Update:   resourceExpressRouteCircuitUpdate,
+		Delete:   resourceExpressRouteCircuitDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&expressroutecircuits.ExpressRouteCircuitId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&expressroutecircuits.ExpressRouteCircuitId{}),
+		},
 
 		CustomizeDiff: pluginsdk.CustomDiffInSequence(
 			// If bandwidth is reduced force a new resource
@@ -403,9 +407,11 @@ func resourceExpressRouteCircuitRead(d *pluginsdk.ResourceData, meta interface{}
 				d.Set("bandwidth_in_mbps", serviceProviderProps.BandwidthInMbps)
 			}
 		}
-		return tags.FlattenAndSet(d, model.Tags)
+		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+			return err
+		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceExpressRouteCircuitDelete(d *pluginsdk.ResourceData, meta interface{}) error {
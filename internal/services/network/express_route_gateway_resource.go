@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/virtualwans"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/expressrouteconnections"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/expressroutegateways"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -23,16 +24,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name express_route_gateway -service-package-name network -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceExpressRouteGateway() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceExpressRouteGatewayCreate,
-		Read:   resourceExpressRouteGatewayRead,
-		Update: resourceExpressRouteGatewayUpdate,
-		Delete: resourceExpressRouteGatewayDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := expressroutegateways.ParseExpressRouteGatewayID(id)
-			return err
-		}),
+		Create:   resourceExpressRouteGatewayCreate,
+		Read:     resourceExpressRouteGatewayRead,
+		Update:   resourceExpressRouteGatewayUpdate,
+		Delete:   resourceExpressRouteGatewayDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&expressroutegateways.ExpressRouteGatewayId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&expressroutegateways.ExpressRouteGatewayId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
@@ -241,9 +245,11 @@ func resourceExpressRouteGatewayRead(d *pluginsdk.ResourceData, meta interface{}
 			}
 			d.Set("scale_units", scaleUnits)
 		}
-		return tags.FlattenAndSet(d, model.Tags)
+		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+			return err
+		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceExpressRouteGatewayDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/expressrouteports"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/expressrouteportauthorizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -19,15 +20,18 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name express_route_port_authorization -service-package-name network -properties "resource_group_name,express_route_port_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-sequential
+
 func resourceExpressRoutePortAuthorization() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceExpressRoutePortAuthorizationCreate,
-		Read:   resourceExpressRoutePortAuthorizationRead,
-		Delete: resourceExpressRoutePortAuthorizationDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := expressrouteportauthorizations.ParseExpressRoutePortAuthorizationID(id)
-			return err
-		}),
+		Create:   resourceExpressRoutePortAuthorizationCreate,
+		Read:     resourceExpressRoutePortAuthorizationRead,
+		Delete:   resourceExpressRoutePortAuthorizationDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&expressrouteportauthorizations.ExpressRoutePortAuthorizationId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&expressrouteportauthorizations.ExpressRoutePortAuthorizationId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -133,7 +137,7 @@ func resourceExpressRoutePortAuthorizationRead(d *pluginsdk.ResourceData, meta i
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceExpressRoutePortAuthorizationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
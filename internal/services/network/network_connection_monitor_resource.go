@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/networkwatchers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/connectionmonitors"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -29,16 +30,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_connection_monitor -service-package-name network -properties "name" -compare-values "subscription_id:id,resource_group_name:id,network_watcher_name:id" -test-name basicAddressConfig -test-sequential
+
 func resourceNetworkConnectionMonitor() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceNetworkConnectionMonitorCreate,
-		Read:   resourceNetworkConnectionMonitorRead,
-		Update: resourceNetworkConnectionMonitorUpdate,
-		Delete: resourceNetworkConnectionMonitorDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := connectionmonitors.ParseConnectionMonitorID(id)
-			return err
-		}),
+		Create:   resourceNetworkConnectionMonitorCreate,
+		Read:     resourceNetworkConnectionMonitorRead,
+		Update:   resourceNetworkConnectionMonitorUpdate,
+		Delete:   resourceNetworkConnectionMonitorDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&connectionmonitors.ConnectionMonitorId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&connectionmonitors.ConnectionMonitorId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -605,10 +609,12 @@ func resourceNetworkConnectionMonitorRead(d *pluginsdk.ResourceData, meta interf
 			}
 		}
 
-		return tags.FlattenAndSet(d, model.Tags)
+		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+			return err
+		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceNetworkConnectionMonitorDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/networkgroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/adminrulecollections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -17,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_manager_admin_rule_collection -service-package-name network -properties "name" -compare-values "subscription_id:id,resource_group_name:id,network_manager_name:id,security_admin_configuration_name:id" -test-resource-type NetworkAdminRuleCollectionResource -test-sequential
+
 type ManagerAdminRuleCollectionModel struct {
 	Name                         string   `tfschema:"name"`
 	SecurityAdminConfigurationId string   `tfschema:"security_admin_configuration_id"`
@@ -27,6 +30,11 @@ type ManagerAdminRuleCollectionModel struct {
 type ManagerAdminRuleCollectionResource struct{}
 
 var _ sdk.ResourceWithUpdate = ManagerAdminRuleCollectionResource{}
+var _ sdk.ResourceWithIdentity = ManagerAdminRuleCollectionResource{}
+
+func (r ManagerAdminRuleCollectionResource) Identity() resourceids.ResourceId {
+	return &adminrulecollections.SecurityAdminConfigurationRuleCollectionId{}
+}
 
 func (r ManagerAdminRuleCollectionResource) ResourceType() string {
 	return "azurerm_network_manager_admin_rule_collection"
@@ -208,6 +216,10 @@ func (r ManagerAdminRuleCollectionResource) Read() sdk.ResourceFunc {
 				state.Description = *properties.Description
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/adminrules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -16,6 +17,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_manager_admin_rule -service-package-name network -properties "name" -compare-values "subscription_id:id,resource_group_name:id,network_manager_name:id,security_admin_configuration_name:id,rule_collection_name:id" -test-resource-type ManagerAdminRuleResource -test-sequential
+
 type ManagerAdminRuleModel struct {
 	Name                    string                                        `tfschema:"name"`
 	NetworkRuleCollectionId string                                        `tfschema:"admin_rule_collection_id"`
@@ -38,6 +41,11 @@ type AddressPrefixItemModel struct {
 type ManagerAdminRuleResource struct{}
 
 var _ sdk.ResourceWithUpdate = ManagerAdminRuleResource{}
+var _ sdk.ResourceWithIdentity = ManagerAdminRuleResource{}
+
+func (r ManagerAdminRuleResource) Identity() resourceids.ResourceId {
+	return &adminrules.SecurityAdminConfigurationRuleCollectionRuleId{}
+}
 
 func (r ManagerAdminRuleResource) ResourceType() string {
 	return "azurerm_network_manager_admin_rule"
@@ -376,6 +384,10 @@ func (r ManagerAdminRuleResource) Read() sdk.ResourceFunc {
 				state.SourcePortRanges = *properties.SourcePortRanges
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/connectivityconfigurations"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -17,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_manager_connectivity_configuration -service-package-name network -properties "name" -compare-values "subscription_id:id,resource_group_name:id,network_manager_name:id" -test-resource-type ManagerConnectivityConfigurationResource -test-sequential
+
 type ManagerConnectivityConfigurationModel struct {
 	Name                         string                                          `tfschema:"name"`
 	NetworkManagerId             string                                          `tfschema:"network_manager_id"`
@@ -43,6 +46,11 @@ type HubModel struct {
 type ManagerConnectivityConfigurationResource struct{}
 
 var _ sdk.ResourceWithUpdate = ManagerConnectivityConfigurationResource{}
+var _ sdk.ResourceWithIdentity = ManagerConnectivityConfigurationResource{}
+
+func (r ManagerConnectivityConfigurationResource) Identity() resourceids.ResourceId {
+	return &connectivityconfigurations.ConnectivityConfigurationId{}
+}
 
 func (r ManagerConnectivityConfigurationResource) ResourceType() string {
 	return "azurerm_network_manager_connectivity_configuration"
@@ -311,6 +319,10 @@ func (r ManagerConnectivityConfigurationResource) Read() sdk.ResourceFunc {
 				state.Description = *properties.Description
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
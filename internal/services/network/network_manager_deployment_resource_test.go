@@ -49,6 +49,20 @@ func testAccNetworkManagerDeployment_basicAdmin(t *testing.T) {
 	})
 }
 
+func testAccNetworkManagerDeployment_basicUser(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_deployment", "test")
+	r := ManagerDeploymentResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicUser(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func testAccNetworkManagerDeployment_withTriggers(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_network_manager_deployment", "test")
 	r := ManagerDeploymentResource{}
@@ -163,7 +177,7 @@ resource "azurerm_network_manager" "test" {
   scope {
     subscription_ids = [data.azurerm_subscription.current.id]
   }
-  scope_accesses = ["SecurityAdmin", "Connectivity"]
+  scope_accesses = ["SecurityAdmin", "Connectivity", "SecurityUser"]
 }
 
 resource "azurerm_network_manager_network_group" "test" {
@@ -255,6 +269,50 @@ resource "azurerm_network_manager_deployment" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r ManagerDeploymentResource) basicUser(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_security_user_configuration" "test" {
+  name               = "acctest-nmsuc-%d"
+  network_manager_id = azurerm_network_manager.test.id
+}
+
+resource "azurerm_network_manager_user_rule_collection" "test" {
+  name                           = "acctest-nmurc-%[2]d"
+  security_user_configuration_id = azurerm_network_manager_security_user_configuration.test.id
+  network_group_ids              = [azurerm_network_manager_network_group.test.id]
+}
+
+resource "azurerm_network_manager_user_rule" "test" {
+  name                    = "acctest-nmur-%[2]d"
+  user_rule_collection_id = azurerm_network_manager_user_rule_collection.test.id
+  description             = "test"
+  direction               = "Inbound"
+  protocol                = "Tcp"
+  source_port_ranges      = ["80"]
+  destination_port_ranges = ["80"]
+  source {
+    address_prefix_type = "ServiceTag"
+    address_prefix      = "Internet"
+  }
+  destination {
+    address_prefix_type = "IPPrefix"
+    address_prefix      = "*"
+  }
+}
+
+resource "azurerm_network_manager_deployment" "test" {
+  network_manager_id = azurerm_network_manager.test.id
+  location           = "eastus"
+  scope_access       = "SecurityUser"
+  configuration_ids  = [azurerm_network_manager_security_user_configuration.test.id]
+  depends_on         = [azurerm_network_manager_user_rule.test]
+}
+`, template, data.RandomInteger)
+}
+
 func (r ManagerDeploymentResource) requiresImport(data acceptance.TestData) string {
 	config := r.basic(data)
 	return fmt.Sprintf(`
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/ipampools"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -23,12 +24,19 @@ import (
 
 var _ sdk.ResourceWithUpdate = ManagerIpamPoolResource{}
 
+var _ sdk.ResourceWithIdentity = ManagerIpamPoolResource{}
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_manager_ipam_pool -service-package-name network -properties "name" -compare-values "subscription_id:id,resource_group_name:id,network_manager_name:id" -test-resource-type ManagerIpamPoolResource -test-sequential
 type ManagerIpamPoolResource struct{}
 
 func (ManagerIpamPoolResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return ipampools.ValidateIPamPoolID
 }
 
+func (ManagerIpamPoolResource) Identity() resourceids.ResourceId {
+	return &ipampools.IPamPoolId{}
+}
+
 func (ManagerIpamPoolResource) ResourceType() string {
 	return "azurerm_network_manager_ipam_pool"
 }
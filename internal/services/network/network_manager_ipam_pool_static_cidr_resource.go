@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/staticcidrs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
@@ -21,12 +22,19 @@ import (
 
 var _ sdk.ResourceWithUpdate = ManagerIpamPoolStaticCidrResource{}
 
+var _ sdk.ResourceWithIdentity = ManagerIpamPoolStaticCidrResource{}
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_manager_ipam_pool_static_cidr -service-package-name network -properties "name" -compare-values "subscription_id:id,resource_group_name:id,network_manager_name:id,ipam_pool_name:id" -test-resource-type ManagerIpamPoolStaticCidrResource -test-sequential
 type ManagerIpamPoolStaticCidrResource struct{}
 
 func (ManagerIpamPoolStaticCidrResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return staticcidrs.ValidateStaticCidrID
 }
 
+func (ManagerIpamPoolStaticCidrResource) Identity() resourceids.ResourceId {
+	return &staticcidrs.StaticCidrId{}
+}
+
 func (ManagerIpamPoolStaticCidrResource) ResourceType() string {
 	return "azurerm_network_manager_ipam_pool_static_cidr"
 }
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/networkgroups"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -17,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_manager_network_group -service-package-name network -properties "name" -compare-values "subscription_id:id,resource_group_name:id,network_manager_name:id" -test-resource-type ManagerNetworkGroupResource -test-sequential
+
 type ManagerNetworkGroupModel struct {
 	Name             string `tfschema:"name"`
 	NetworkManagerId string `tfschema:"network_manager_id"`
@@ -26,6 +29,11 @@ type ManagerNetworkGroupModel struct {
 type ManagerNetworkGroupResource struct{}
 
 var _ sdk.ResourceWithUpdate = ManagerNetworkGroupResource{}
+var _ sdk.ResourceWithIdentity = ManagerNetworkGroupResource{}
+
+func (r ManagerNetworkGroupResource) Identity() resourceids.ResourceId {
+	return &networkgroups.NetworkGroupId{}
+}
 
 func (r ManagerNetworkGroupResource) ResourceType() string {
 	return "azurerm_network_manager_network_group"
@@ -187,6 +195,10 @@ func (r ManagerNetworkGroupResource) Read() sdk.ResourceFunc {
 				Description:      pointer.From(properties.Description),
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
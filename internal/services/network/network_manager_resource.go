@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/networkmanagers"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -45,8 +46,11 @@ type ManagerCrossTenantScopeModel struct {
 	Subscriptions    []string `tfschema:"subscriptions"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_manager -service-package-name network -properties "name" -compare-values "subscription_id:id,resource_group_name:id" -test-resource-type ManagerResource -test-sequential
 type ManagerResource struct{}
 
+var _ sdk.ResourceWithIdentity = ManagerResource{}
+
 func (r ManagerResource) ResourceType() string {
 	return "azurerm_network_manager"
 }
@@ -55,6 +59,10 @@ func (r ManagerResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return networkmanagers.ValidateNetworkManagerID
 }
 
+func (r ManagerResource) Identity() resourceids.ResourceId {
+	return &networkmanagers.NetworkManagerId{}
+}
+
 func (r ManagerResource) ModelObject() interface{} {
 	return &ManagerModel{}
 }
@@ -111,6 +119,7 @@ func (r ManagerResource) Arguments() map[string]*pluginsdk.Schema {
 					string(networkmanagers.ConfigurationTypeConnectivity),
 					string(networkmanagers.ConfigurationTypeRouting),
 					string(networkmanagers.ConfigurationTypeSecurityAdmin),
+					string(networkmanagers.ConfigurationTypeSecurityUser),
 				}, false),
 			},
 		},
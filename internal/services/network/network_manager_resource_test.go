@@ -87,9 +87,26 @@ func TestAccNetworkManager(t *testing.T) {
 			"update":         testAccNetworkManagerAdminRule_update,
 			"requiresImport": testAccNetworkManagerAdminRule_requiresImport,
 		},
+		"SecurityUserConfiguration": {
+			"basic":          testAccNetworkManagerSecurityUserConfiguration_basic,
+			"update":         testAccNetworkManagerSecurityUserConfiguration_update,
+			"requiresImport": testAccNetworkManagerSecurityUserConfiguration_requiresImport,
+		},
+		"UserRuleCollection": {
+			"basic":          testAccNetworkManagerUserRuleCollection_basic,
+			"update":         testAccNetworkManagerUserRuleCollection_update,
+			"requiresImport": testAccNetworkManagerUserRuleCollection_requiresImport,
+		},
+		"UserRule": {
+			"basic":          testAccNetworkManagerUserRule_basic,
+			"complete":       testAccNetworkManagerUserRule_complete,
+			"update":         testAccNetworkManagerUserRule_update,
+			"requiresImport": testAccNetworkManagerUserRule_requiresImport,
+		},
 		"Deployment": {
 			"basic":          testAccNetworkManagerDeployment_basic,
 			"basicAdmin":     testAccNetworkManagerDeployment_basicAdmin,
+			"basicUser":      testAccNetworkManagerDeployment_basicUser,
 			"complete":       testAccNetworkManagerDeployment_complete,
 			"update":         testAccNetworkManagerDeployment_update,
 			"withTriggers":   testAccNetworkManagerDeployment_withTriggers,
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/networkmanagerroutingconfigurations"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -20,12 +21,19 @@ import (
 
 var _ sdk.ResourceWithUpdate = ManagerRoutingConfigurationResource{}
 
+var _ sdk.ResourceWithIdentity = ManagerRoutingConfigurationResource{}
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_manager_routing_configuration -service-package-name network -properties "name" -compare-values "subscription_id:id,resource_group_name:id,network_manager_name:id" -test-resource-type ManagerRoutingConfigurationResource -test-sequential
 type ManagerRoutingConfigurationResource struct{}
 
 func (ManagerRoutingConfigurationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return networkmanagerroutingconfigurations.ValidateRoutingConfigurationID
 }
 
+func (ManagerRoutingConfigurationResource) Identity() resourceids.ResourceId {
+	return &networkmanagerroutingconfigurations.RoutingConfigurationId{}
+}
+
 func (ManagerRoutingConfigurationResource) ResourceType() string {
 	return "azurerm_network_manager_routing_configuration"
 }
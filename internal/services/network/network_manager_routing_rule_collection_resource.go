@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/networkgroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/routingrulecollections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -21,12 +22,19 @@ import (
 
 var _ sdk.ResourceWithUpdate = ManagerRoutingRuleCollectionResource{}
 
+var _ sdk.ResourceWithIdentity = ManagerRoutingRuleCollectionResource{}
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_manager_routing_rule_collection -service-package-name network -properties "name" -compare-values "subscription_id:id,resource_group_name:id,network_manager_name:id,routing_configuration_name:id" -test-resource-type ManagerRoutingRuleCollectionResource -test-sequential
 type ManagerRoutingRuleCollectionResource struct{}
 
 func (ManagerRoutingRuleCollectionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return routingrulecollections.ValidateRuleCollectionID
 }
 
+func (ManagerRoutingRuleCollectionResource) Identity() resourceids.ResourceId {
+	return &routingrulecollections.RuleCollectionId{}
+}
+
 func (ManagerRoutingRuleCollectionResource) ResourceType() string {
 	return "azurerm_network_manager_routing_rule_collection"
 }
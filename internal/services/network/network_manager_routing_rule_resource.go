@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/routingrules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -20,14 +21,21 @@ import (
 var (
 	_ sdk.ResourceWithUpdate        = ManagerRoutingRuleResource{}
 	_ sdk.ResourceWithCustomizeDiff = ManagerRoutingRuleResource{}
+	_ sdk.ResourceWithIdentity      = ManagerRoutingRuleResource{}
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_manager_routing_rule -service-package-name network -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,network_manager_name:id,routing_configuration_name:id,rule_collection_name:id" -test-resource-type ManagerRoutingRuleResource
+
 type ManagerRoutingRuleResource struct{}
 
 func (ManagerRoutingRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return routingrules.ValidateRuleID
 }
 
+func (ManagerRoutingRuleResource) Identity() resourceids.ResourceId {
+	return &routingrules.RuleId{}
+}
+
 func (ManagerRoutingRuleResource) ResourceType() string {
 	return "azurerm_network_manager_routing_rule"
 }
@@ -230,6 +238,10 @@ func (r ManagerRoutingRuleResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
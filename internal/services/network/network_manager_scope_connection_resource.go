@@ -9,12 +9,15 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/scopeconnections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_manager_scope_connection -service-package-name network -properties "name" -compare-values "subscription_id:id,resource_group_name:id,network_manager_name:id" -test-resource-type ManagerScopeConnectionResource -test-sequential
+
 type ManagerScopeConnectionModel struct {
 	Name             string `tfschema:"name"`
 	NetworkManagerId string `tfschema:"network_manager_id"`
@@ -27,6 +30,11 @@ type ManagerScopeConnectionModel struct {
 type ManagerScopeConnectionResource struct{}
 
 var _ sdk.ResourceWithUpdate = ManagerScopeConnectionResource{}
+var _ sdk.ResourceWithIdentity = ManagerScopeConnectionResource{}
+
+func (r ManagerScopeConnectionResource) Identity() resourceids.ResourceId {
+	return &scopeconnections.ScopeConnectionId{}
+}
 
 func (r ManagerScopeConnectionResource) ResourceType() string {
 	return "azurerm_network_manager_scope_connection"
@@ -242,6 +250,10 @@ func (r ManagerScopeConnectionResource) Read() sdk.ResourceFunc {
 				state.TenantId = *properties.TenantId
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/securityadminconfigurations"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -16,6 +17,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_manager_security_admin_configuration -service-package-name network -properties "name" -compare-values "subscription_id:id,resource_group_name:id,network_manager_name:id" -test-resource-type ManagerSecurityAdminConfigurationResource -test-sequential
+
 type ManagerSecurityAdminConfigurationModel struct {
 	Name                                    string   `tfschema:"name"`
 	NetworkManagerId                        string   `tfschema:"network_manager_id"`
@@ -26,6 +29,11 @@ type ManagerSecurityAdminConfigurationModel struct {
 type ManagerSecurityAdminConfigurationResource struct{}
 
 var _ sdk.ResourceWithUpdate = ManagerSecurityAdminConfigurationResource{}
+var _ sdk.ResourceWithIdentity = ManagerSecurityAdminConfigurationResource{}
+
+func (r ManagerSecurityAdminConfigurationResource) Identity() resourceids.ResourceId {
+	return &securityadminconfigurations.SecurityAdminConfigurationId{}
+}
 
 func (r ManagerSecurityAdminConfigurationResource) ResourceType() string {
 	return "azurerm_network_manager_security_admin_configuration"
@@ -216,6 +224,10 @@ func (r ManagerSecurityAdminConfigurationResource) Read() sdk.ResourceFunc {
 				state.Description = *properties.Description
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -10,12 +10,15 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/staticmembers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_manager_static_member -service-package-name network -properties "name" -compare-values "subscription_id:id,resource_group_name:id,network_manager_name:id,network_group_name:id" -test-resource-type ManagerStaticMemberResource -test-sequential
+
 type ManagerStaticMemberModel struct {
 	Name           string `tfschema:"name"`
 	NetworkGroupId string `tfschema:"network_group_id"`
@@ -26,6 +29,11 @@ type ManagerStaticMemberModel struct {
 type ManagerStaticMemberResource struct{}
 
 var _ sdk.Resource = ManagerStaticMemberResource{}
+var _ sdk.ResourceWithIdentity = ManagerStaticMemberResource{}
+
+func (r ManagerStaticMemberResource) Identity() resourceids.ResourceId {
+	return &staticmembers.StaticMemberId{}
+}
 
 func (r ManagerStaticMemberResource) ResourceType() string {
 	return "azurerm_network_manager_static_member"
@@ -154,6 +162,10 @@ func (r ManagerStaticMemberResource) Read() sdk.ResourceFunc {
 				state.TargetVNetId = *properties.ResourceId
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
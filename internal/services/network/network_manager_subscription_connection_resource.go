@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/networkmanagers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/networkmanagerconnections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -17,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_manager_subscription_connection -service-package-name network -properties "name" -compare-values "subscription_id:id" -test-resource-type ManagerSubscriptionConnectionResource -test-sequential
+
 type ManagerSubscriptionConnectionModel struct {
 	Name             string `tfschema:"name"`
 	SubscriptionId   string `tfschema:"subscription_id"`
@@ -28,6 +31,11 @@ type ManagerSubscriptionConnectionModel struct {
 type ManagerSubscriptionConnectionResource struct{}
 
 var _ sdk.ResourceWithUpdate = ManagerSubscriptionConnectionResource{}
+var _ sdk.ResourceWithIdentity = ManagerSubscriptionConnectionResource{}
+
+func (r ManagerSubscriptionConnectionResource) Identity() resourceids.ResourceId {
+	return &networkmanagerconnections.NetworkManagerConnectionId{}
+}
 
 func (r ManagerSubscriptionConnectionResource) ResourceType() string {
 	return "azurerm_network_manager_subscription_connection"
@@ -221,6 +229,10 @@ func (r ManagerSubscriptionConnectionResource) Read() sdk.ResourceFunc {
 				state.NetworkManagerId = *properties.NetworkManagerId
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -0,0 +1,372 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/securityuserrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_manager_user_rule -service-package-name network -properties "name" -compare-values "subscription_id:id,resource_group_name:id,network_manager_name:id,security_user_configuration_name:id,rule_collection_name:id" -test-resource-type ManagerUserRuleResource -test-sequential
+
+type ManagerUserRuleModel struct {
+	Name                  string                                               `tfschema:"name"`
+	UserRuleCollectionId  string                                               `tfschema:"user_rule_collection_id"`
+	Description           string                                               `tfschema:"description"`
+	DestinationPortRanges []string                                             `tfschema:"destination_port_ranges"`
+	Destinations          []UserRuleAddressPrefixItemModel                     `tfschema:"destination"`
+	Direction             securityuserrules.SecurityConfigurationRuleDirection `tfschema:"direction"`
+	Protocol              securityuserrules.SecurityConfigurationRuleProtocol  `tfschema:"protocol"`
+	SourcePortRanges      []string                                             `tfschema:"source_port_ranges"`
+	Sources               []UserRuleAddressPrefixItemModel                     `tfschema:"source"`
+}
+
+type UserRuleAddressPrefixItemModel struct {
+	AddressPrefix     string                              `tfschema:"address_prefix"`
+	AddressPrefixType securityuserrules.AddressPrefixType `tfschema:"address_prefix_type"`
+}
+
+type ManagerUserRuleResource struct{}
+
+var _ sdk.ResourceWithUpdate = ManagerUserRuleResource{}
+var _ sdk.ResourceWithIdentity = ManagerUserRuleResource{}
+
+func (r ManagerUserRuleResource) Identity() resourceids.ResourceId {
+	return &securityuserrules.RuleCollectionRuleId{}
+}
+
+func (r ManagerUserRuleResource) ResourceType() string {
+	return "azurerm_network_manager_user_rule"
+}
+
+func (r ManagerUserRuleResource) ModelObject() interface{} {
+	return &ManagerUserRuleModel{}
+}
+
+func (r ManagerUserRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return securityuserrules.ValidateRuleCollectionRuleID
+}
+
+func (r ManagerUserRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"user_rule_collection_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: securityuserrules.ValidateSecurityUserConfigurationRuleCollectionID,
+		},
+
+		"direction": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(securityuserrules.PossibleValuesForSecurityConfigurationRuleDirection(), false),
+		},
+
+		"protocol": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(securityuserrules.PossibleValuesForSecurityConfigurationRuleProtocol(), false),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"destination_port_ranges": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"destination": userRuleAddressPrefixItemSchema(),
+
+		"source_port_ranges": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"source": userRuleAddressPrefixItemSchema(),
+	}
+}
+
+func (r ManagerUserRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ManagerUserRuleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ManagerUserRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Network.SecurityUserRules
+			ruleCollectionId, err := securityuserrules.ParseSecurityUserConfigurationRuleCollectionID(model.UserRuleCollectionId)
+			if err != nil {
+				return err
+			}
+
+			id := securityuserrules.NewRuleCollectionRuleID(ruleCollectionId.SubscriptionId, ruleCollectionId.ResourceGroupName,
+				ruleCollectionId.NetworkManagerName, ruleCollectionId.SecurityUserConfigurationName, ruleCollectionId.RuleCollectionName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			rule := securityuserrules.SecurityUserRule{
+				Properties: &securityuserrules.SecurityUserRulePropertiesFormat{
+					Destinations:          expandUserRuleAddressPrefixItemModel(model.Destinations),
+					DestinationPortRanges: pointer.To(model.DestinationPortRanges),
+					Direction:             model.Direction,
+					Protocol:              model.Protocol,
+					SourcePortRanges:      pointer.To(model.SourcePortRanges),
+					Sources:               expandUserRuleAddressPrefixItemModel(model.Sources),
+				},
+			}
+
+			if model.Description != "" {
+				rule.Properties.Description = pointer.To(model.Description)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, rule); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagerUserRuleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.SecurityUserRules
+
+			id, err := securityuserrules.ParseRuleCollectionRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagerUserRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: model properties was nil", *id)
+			}
+
+			properties := existing.Model.Properties
+
+			if metadata.ResourceData.HasChange("description") {
+				if model.Description != "" {
+					properties.Description = pointer.To(model.Description)
+				} else {
+					properties.Description = nil
+				}
+			}
+
+			if metadata.ResourceData.HasChange("destination_port_ranges") {
+				properties.DestinationPortRanges = pointer.To(model.DestinationPortRanges)
+			}
+
+			if metadata.ResourceData.HasChange("destination") {
+				properties.Destinations = expandUserRuleAddressPrefixItemModel(model.Destinations)
+			}
+
+			if metadata.ResourceData.HasChange("direction") {
+				properties.Direction = model.Direction
+			}
+
+			if metadata.ResourceData.HasChange("protocol") {
+				properties.Protocol = model.Protocol
+			}
+
+			if metadata.ResourceData.HasChange("source_port_ranges") {
+				properties.SourcePortRanges = pointer.To(model.SourcePortRanges)
+			}
+
+			if metadata.ResourceData.HasChange("source") {
+				properties.Sources = expandUserRuleAddressPrefixItemModel(model.Sources)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, *existing.Model); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagerUserRuleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.SecurityUserRules
+
+			id, err := securityuserrules.ParseRuleCollectionRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: model properties was nil", *id)
+			}
+
+			properties := existing.Model.Properties
+
+			state := ManagerUserRuleModel{
+				Name: id.RuleName,
+				UserRuleCollectionId: securityuserrules.NewSecurityUserConfigurationRuleCollectionID(id.SubscriptionId, id.ResourceGroupName,
+					id.NetworkManagerName, id.SecurityUserConfigurationName, id.RuleCollectionName).ID(),
+				Description:           pointer.From(properties.Description),
+				DestinationPortRanges: pointer.From(properties.DestinationPortRanges),
+				Destinations:          flattenUserRuleAddressPrefixItemModel(properties.Destinations),
+				Direction:             properties.Direction,
+				Protocol:              properties.Protocol,
+				SourcePortRanges:      pointer.From(properties.SourcePortRanges),
+				Sources:               flattenUserRuleAddressPrefixItemModel(properties.Sources),
+			}
+
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerUserRuleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.SecurityUserRules
+
+			id, err := securityuserrules.ParseRuleCollectionRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			err = client.DeleteThenPoll(ctx, *id, securityuserrules.DeleteOperationOptions{
+				Force: pointer.To(true),
+			})
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func userRuleAddressPrefixItemSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"address_prefix": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"address_prefix_type": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(securityuserrules.AddressPrefixTypeIPPrefix),
+						string(securityuserrules.AddressPrefixTypeServiceTag),
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func expandUserRuleAddressPrefixItemModel(inputList []UserRuleAddressPrefixItemModel) *[]securityuserrules.AddressPrefixItem {
+	outputList := make([]securityuserrules.AddressPrefixItem, 0, len(inputList))
+	for _, input := range inputList {
+		output := securityuserrules.AddressPrefixItem{
+			AddressPrefixType: pointer.To(input.AddressPrefixType),
+		}
+
+		if input.AddressPrefix != "" {
+			output.AddressPrefix = pointer.To(input.AddressPrefix)
+		}
+
+		outputList = append(outputList, output)
+	}
+
+	return &outputList
+}
+
+func flattenUserRuleAddressPrefixItemModel(inputList *[]securityuserrules.AddressPrefixItem) []UserRuleAddressPrefixItemModel {
+	if inputList == nil {
+		return []UserRuleAddressPrefixItemModel{}
+	}
+
+	outputList := make([]UserRuleAddressPrefixItemModel, 0, len(*inputList))
+	for _, input := range *inputList {
+		outputList = append(outputList, UserRuleAddressPrefixItemModel{
+			AddressPrefix:     pointer.From(input.AddressPrefix),
+			AddressPrefixType: pointer.From(input.AddressPrefixType),
+		})
+	}
+
+	return outputList
+}
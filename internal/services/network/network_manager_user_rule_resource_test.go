@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/securityuserrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ManagerUserRuleResource struct{}
+
+func testAccNetworkManagerUserRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_user_rule", "test")
+	r := ManagerUserRuleResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerUserRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_user_rule", "test")
+	r := ManagerUserRuleResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func testAccNetworkManagerUserRule_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_user_rule", "test")
+	r := ManagerUserRuleResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerUserRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_user_rule", "test")
+	r := ManagerUserRuleResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ManagerUserRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := securityuserrules.ParseRuleCollectionRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.SecurityUserRules.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ManagerUserRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_user_rule" "test" {
+  name                    = "acctest-nmur-%d"
+  user_rule_collection_id = azurerm_network_manager_user_rule_collection.test.id
+  direction               = "Outbound"
+  protocol                = "Tcp"
+}
+`, ManagerUserRuleCollectionResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ManagerUserRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_user_rule" "import" {
+  name                    = azurerm_network_manager_user_rule.test.name
+  user_rule_collection_id = azurerm_network_manager_user_rule.test.user_rule_collection_id
+  direction               = azurerm_network_manager_user_rule.test.direction
+  protocol                = azurerm_network_manager_user_rule.test.protocol
+}
+`, r.basic(data))
+}
+
+func (r ManagerUserRuleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_user_rule" "test" {
+  name                    = "acctest-nmur-%d"
+  user_rule_collection_id = azurerm_network_manager_user_rule_collection.test.id
+  description             = "test user rule"
+  direction               = "Outbound"
+  protocol                = "Tcp"
+  source_port_ranges      = ["80", "22", "443"]
+  destination_port_ranges = ["80", "22"]
+  source {
+    address_prefix_type = "ServiceTag"
+    address_prefix      = "Internet"
+  }
+  destination {
+    address_prefix_type = "IPPrefix"
+    address_prefix      = "*"
+  }
+}
+`, ManagerUserRuleCollectionResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ManagerUserRuleResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_user_rule" "test" {
+  name                    = "acctest-nmur-%d"
+  user_rule_collection_id = azurerm_network_manager_user_rule_collection.test.id
+  description             = "test"
+  direction               = "Inbound"
+  protocol                = "Udp"
+  source_port_ranges      = ["80", "1024-65535"]
+  destination_port_ranges = ["80"]
+  source {
+    address_prefix_type = "ServiceTag"
+    address_prefix      = "ActionGroup"
+  }
+  destination {
+    address_prefix_type = "IPPrefix"
+    address_prefix      = "10.1.0.1"
+  }
+  destination {
+    address_prefix_type = "IPPrefix"
+    address_prefix      = "10.0.0.0/24"
+  }
+}
+`, ManagerUserRuleCollectionResource{}.basic(data), data.RandomInteger)
+}
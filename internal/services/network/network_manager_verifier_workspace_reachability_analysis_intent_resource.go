@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-08-15/cosmosdb"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/reachabilityanalysisintent"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/reachabilityanalysisintents"
@@ -24,12 +25,19 @@ import (
 
 var _ sdk.Resource = ManagerVerifierWorkspaceReachabilityAnalysisIntentResource{}
 
+var _ sdk.ResourceWithIdentity = ManagerVerifierWorkspaceReachabilityAnalysisIntentResource{}
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_manager_verifier_workspace_reachability_analysis_intent -service-package-name network -properties "name" -compare-values "subscription_id:id,resource_group_name:id,network_manager_name:id,verifier_workspace_name:id" -test-resource-type ManagerVerifierWorkspaceReachabilityAnalysisIntentResource -test-sequential
 type ManagerVerifierWorkspaceReachabilityAnalysisIntentResource struct{}
 
 func (ManagerVerifierWorkspaceReachabilityAnalysisIntentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return reachabilityanalysisintent.ValidateReachabilityAnalysisIntentID
 }
 
+func (ManagerVerifierWorkspaceReachabilityAnalysisIntentResource) Identity() resourceids.ResourceId {
+	return &reachabilityanalysisintent.ReachabilityAnalysisIntentId{}
+}
+
 func (ManagerVerifierWorkspaceReachabilityAnalysisIntentResource) ResourceType() string {
 	return "azurerm_network_manager_verifier_workspace_reachability_analysis_intent"
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/verifierworkspaces"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -23,12 +24,19 @@ import (
 
 var _ sdk.ResourceWithUpdate = ManagerVerifierWorkspaceResource{}
 
+var _ sdk.ResourceWithIdentity = ManagerVerifierWorkspaceResource{}
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_manager_verifier_workspace -service-package-name network -properties "name" -compare-values "subscription_id:id,resource_group_name:id,network_manager_name:id" -test-resource-type ManagerVerifierWorkspaceResource -test-sequential
 type ManagerVerifierWorkspaceResource struct{}
 
 func (ManagerVerifierWorkspaceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return verifierworkspaces.ValidateVerifierWorkspaceID
 }
 
+func (ManagerVerifierWorkspaceResource) Identity() resourceids.ResourceId {
+	return &verifierworkspaces.VerifierWorkspaceId{}
+}
+
 func (ManagerVerifierWorkspaceResource) ResourceType() string {
 	return "azurerm_network_manager_verifier_workspace"
 }
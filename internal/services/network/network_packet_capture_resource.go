@@ -31,7 +31,7 @@ func resourceNetworkPacketCapture() *pluginsdk.Resource {
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := packetcaptures.ParsePacketCaptureID(id)
 			return err
-		}),
+		}, &packetcaptures.PacketCaptureId{}),
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -0,0 +1,337 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/networksecurityperimeteraccessrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_security_perimeter_access_rule -service-package-name network -properties "name" -compare-values "subscription_id:network_security_perimeter_profile_id,resource_group_name:network_security_perimeter_profile_id,network_security_perimeter_name:network_security_perimeter_profile_id,profile_name:network_security_perimeter_profile_id" -test-name addressPrefixes -test-resource-type NetworkSecurityPerimeterAccessRuleResource
+
+var (
+	_ sdk.ResourceWithUpdate        = NetworkSecurityPerimeterAccessRuleResource{}
+	_ sdk.ResourceWithIdentity      = NetworkSecurityPerimeterAccessRuleResource{}
+	_ sdk.ResourceWithCustomizeDiff = NetworkSecurityPerimeterAccessRuleResource{}
+)
+
+type NetworkSecurityPerimeterAccessRuleResource struct{}
+
+type NetworkSecurityPerimeterAccessRuleResourceModel struct {
+	Name                              string   `tfschema:"name"`
+	NetworkSecurityPerimeterProfileId string   `tfschema:"network_security_perimeter_profile_id"`
+	Direction                         string   `tfschema:"direction"`
+	AddressPrefixes                   []string `tfschema:"address_prefixes"`
+	FullyQualifiedDomainNames         []string `tfschema:"fully_qualified_domain_names"`
+	SubscriptionIds                   []string `tfschema:"subscription_ids"`
+}
+
+func (NetworkSecurityPerimeterAccessRuleResource) Identity() resourceids.ResourceId {
+	return &networksecurityperimeteraccessrules.AccessRuleId{}
+}
+
+func (NetworkSecurityPerimeterAccessRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return networksecurityperimeteraccessrules.ValidateAccessRuleID
+}
+
+func (NetworkSecurityPerimeterAccessRuleResource) ResourceType() string {
+	return "azurerm_network_security_perimeter_access_rule"
+}
+
+func (NetworkSecurityPerimeterAccessRuleResource) ModelObject() interface{} {
+	return &NetworkSecurityPerimeterAccessRuleResourceModel{}
+}
+
+func (NetworkSecurityPerimeterAccessRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	ruleTypes := []string{
+		"address_prefixes",
+		"fully_qualified_domain_names",
+		"subscription_ids",
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateNetworkSecurityPerimeterName,
+		},
+
+		"network_security_perimeter_profile_id": commonschema.ResourceIDReferenceRequiredForceNew(&networksecurityperimeteraccessrules.ProfileId{}),
+
+		"direction": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(networksecurityperimeteraccessrules.PossibleValuesForAccessRuleDirection(), false),
+		},
+
+		"address_prefixes": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsCIDR,
+			},
+			ExactlyOneOf: ruleTypes,
+		},
+
+		"fully_qualified_domain_names": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			ExactlyOneOf: ruleTypes,
+		},
+
+		"subscription_ids": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: commonids.ValidateSubscriptionID,
+			},
+			ExactlyOneOf: ruleTypes,
+		},
+	}
+}
+
+func (NetworkSecurityPerimeterAccessRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r NetworkSecurityPerimeterAccessRuleResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model NetworkSecurityPerimeterAccessRuleResourceModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			switch networksecurityperimeteraccessrules.AccessRuleDirection(model.Direction) {
+			case networksecurityperimeteraccessrules.AccessRuleDirectionInbound:
+				if len(model.FullyQualifiedDomainNames) > 0 {
+					return fmt.Errorf("`fully_qualified_domain_names` can only be specified when `direction` is `Outbound`")
+				}
+
+			case networksecurityperimeteraccessrules.AccessRuleDirectionOutbound:
+				if len(model.AddressPrefixes) > 0 || len(model.SubscriptionIds) > 0 {
+					return fmt.Errorf("`address_prefixes` and `subscription_ids` can only be specified when `direction` is `Inbound`")
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r NetworkSecurityPerimeterAccessRuleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkSecurityPerimeterAccessRules
+
+			var config NetworkSecurityPerimeterAccessRuleResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			profileId, err := networksecurityperimeteraccessrules.ParseProfileID(config.NetworkSecurityPerimeterProfileId)
+			if err != nil {
+				return err
+			}
+
+			id := networksecurityperimeteraccessrules.NewAccessRuleID(profileId.SubscriptionId, profileId.ResourceGroupName, profileId.NetworkSecurityPerimeterName, profileId.ProfileName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := networksecurityperimeteraccessrules.NspAccessRule{
+				Name: pointer.To(config.Name),
+				Properties: &networksecurityperimeteraccessrules.NspAccessRuleProperties{
+					Direction:                 pointer.To(networksecurityperimeteraccessrules.AccessRuleDirection(config.Direction)),
+					AddressPrefixes:           pointer.To(config.AddressPrefixes),
+					FullyQualifiedDomainNames: pointer.To(config.FullyQualifiedDomainNames),
+					Subscriptions:             expandNetworkSecurityPerimeterAccessRuleSubscriptions(config.SubscriptionIds),
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r NetworkSecurityPerimeterAccessRuleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkSecurityPerimeterAccessRules
+
+			id, err := networksecurityperimeteraccessrules.ParseAccessRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := flattenNetworkSecurityPerimeterAccessRule(*id, resp.Model)
+
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r NetworkSecurityPerimeterAccessRuleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkSecurityPerimeterAccessRules
+
+			id, err := networksecurityperimeteraccessrules.ParseAccessRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config NetworkSecurityPerimeterAccessRuleResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", id)
+			}
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `model.Properties` was nil", id)
+			}
+
+			payload := *existing.Model
+			if metadata.ResourceData.HasChange("address_prefixes") {
+				payload.Properties.AddressPrefixes = pointer.To(config.AddressPrefixes)
+			}
+			if metadata.ResourceData.HasChange("fully_qualified_domain_names") {
+				payload.Properties.FullyQualifiedDomainNames = pointer.To(config.FullyQualifiedDomainNames)
+			}
+			if metadata.ResourceData.HasChange("subscription_ids") {
+				payload.Properties.Subscriptions = expandNetworkSecurityPerimeterAccessRuleSubscriptions(config.SubscriptionIds)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r NetworkSecurityPerimeterAccessRuleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkSecurityPerimeterAccessRules
+
+			id, err := networksecurityperimeteraccessrules.ParseAccessRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func flattenNetworkSecurityPerimeterAccessRule(id networksecurityperimeteraccessrules.AccessRuleId, input *networksecurityperimeteraccessrules.NspAccessRule) NetworkSecurityPerimeterAccessRuleResourceModel {
+	output := NetworkSecurityPerimeterAccessRuleResourceModel{
+		Name:                              id.AccessRuleName,
+		NetworkSecurityPerimeterProfileId: networksecurityperimeteraccessrules.NewProfileID(id.SubscriptionId, id.ResourceGroupName, id.NetworkSecurityPerimeterName, id.ProfileName).ID(),
+	}
+
+	if input != nil {
+		if props := input.Properties; props != nil {
+			output.Direction = string(pointer.From(props.Direction))
+			output.AddressPrefixes = pointer.From(props.AddressPrefixes)
+			output.FullyQualifiedDomainNames = pointer.From(props.FullyQualifiedDomainNames)
+			output.SubscriptionIds = flattenNetworkSecurityPerimeterAccessRuleSubscriptions(props.Subscriptions)
+		}
+	}
+
+	return output
+}
+
+func expandNetworkSecurityPerimeterAccessRuleSubscriptions(input []string) *[]networksecurityperimeteraccessrules.SubscriptionId {
+	output := make([]networksecurityperimeteraccessrules.SubscriptionId, 0)
+	for _, v := range input {
+		output = append(output, networksecurityperimeteraccessrules.SubscriptionId{
+			Id: pointer.To(v),
+		})
+	}
+	return &output
+}
+
+func flattenNetworkSecurityPerimeterAccessRuleSubscriptions(input *[]networksecurityperimeteraccessrules.SubscriptionId) []string {
+	output := make([]string, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		if v.Id == nil {
+			continue
+		}
+
+		// the API can return the Subscription ID using a different casing
+		id, err := commonids.ParseSubscriptionIDInsensitively(*v.Id)
+		if err != nil {
+			output = append(output, *v.Id)
+			continue
+		}
+		output = append(output, id.ID())
+	}
+	return output
+}
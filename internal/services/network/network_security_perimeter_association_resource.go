@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/networksecurityperimeterassociations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/networksecurityperimeterprofiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_security_perimeter_association -service-package-name network -properties "name" -compare-values "subscription_id:network_security_perimeter_profile_id,resource_group_name:network_security_perimeter_profile_id,network_security_perimeter_name:network_security_perimeter_profile_id" -test-params "Learning" -test-resource-type NetworkSecurityPerimeterAssociationResource
+
+var (
+	_ sdk.ResourceWithUpdate   = NetworkSecurityPerimeterAssociationResource{}
+	_ sdk.ResourceWithIdentity = NetworkSecurityPerimeterAssociationResource{}
+)
+
+type NetworkSecurityPerimeterAssociationResource struct{}
+
+type NetworkSecurityPerimeterAssociationResourceModel struct {
+	Name                              string `tfschema:"name"`
+	NetworkSecurityPerimeterProfileId string `tfschema:"network_security_perimeter_profile_id"`
+	ResourceId                        string `tfschema:"resource_id"`
+	AccessMode                        string `tfschema:"access_mode"`
+}
+
+func (NetworkSecurityPerimeterAssociationResource) Identity() resourceids.ResourceId {
+	return &networksecurityperimeterassociations.ResourceAssociationId{}
+}
+
+func (NetworkSecurityPerimeterAssociationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return networksecurityperimeterassociations.ValidateResourceAssociationID
+}
+
+func (NetworkSecurityPerimeterAssociationResource) ResourceType() string {
+	return "azurerm_network_security_perimeter_association"
+}
+
+func (NetworkSecurityPerimeterAssociationResource) ModelObject() interface{} {
+	return &NetworkSecurityPerimeterAssociationResourceModel{}
+}
+
+func (NetworkSecurityPerimeterAssociationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateNetworkSecurityPerimeterName,
+		},
+
+		"network_security_perimeter_profile_id": commonschema.ResourceIDReferenceRequiredForceNew(&networksecurityperimeterprofiles.ProfileId{}),
+
+		"resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"access_mode": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(networksecurityperimeterassociations.PossibleValuesForAssociationAccessMode(), false),
+		},
+	}
+}
+
+func (NetworkSecurityPerimeterAssociationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r NetworkSecurityPerimeterAssociationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkSecurityPerimeterAssociations
+
+			var config NetworkSecurityPerimeterAssociationResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			profileId, err := networksecurityperimeterprofiles.ParseProfileID(config.NetworkSecurityPerimeterProfileId)
+			if err != nil {
+				return err
+			}
+
+			id := networksecurityperimeterassociations.NewResourceAssociationID(profileId.SubscriptionId, profileId.ResourceGroupName, profileId.NetworkSecurityPerimeterName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := networksecurityperimeterassociations.NspAssociation{
+				Name: pointer.To(config.Name),
+				Properties: &networksecurityperimeterassociations.NspAssociationProperties{
+					AccessMode: pointer.To(networksecurityperimeterassociations.AssociationAccessMode(config.AccessMode)),
+					PrivateLinkResource: &networksecurityperimeterassociations.SubResource{
+						Id: pointer.To(config.ResourceId),
+					},
+					Profile: &networksecurityperimeterassociations.SubResource{
+						Id: pointer.To(profileId.ID()),
+					},
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r NetworkSecurityPerimeterAssociationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkSecurityPerimeterAssociations
+
+			id, err := networksecurityperimeterassociations.ParseResourceAssociationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state, err := flattenNetworkSecurityPerimeterAssociation(*id, resp.Model)
+			if err != nil {
+				return err
+			}
+
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
+			return metadata.Encode(state)
+		},
+	}
+}
+
+func (r NetworkSecurityPerimeterAssociationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkSecurityPerimeterAssociations
+
+			id, err := networksecurityperimeterassociations.ParseResourceAssociationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config NetworkSecurityPerimeterAssociationResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", id)
+			}
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `model.Properties` was nil", id)
+			}
+
+			payload := *existing.Model
+			if metadata.ResourceData.HasChange("access_mode") {
+				payload.Properties.AccessMode = pointer.To(networksecurityperimeterassociations.AssociationAccessMode(config.AccessMode))
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r NetworkSecurityPerimeterAssociationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkSecurityPerimeterAssociations
+
+			id, err := networksecurityperimeterassociations.ParseResourceAssociationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func flattenNetworkSecurityPerimeterAssociation(id networksecurityperimeterassociations.ResourceAssociationId, input *networksecurityperimeterassociations.NspAssociation) (*NetworkSecurityPerimeterAssociationResourceModel, error) {
+	output := NetworkSecurityPerimeterAssociationResourceModel{
+		Name: id.ResourceAssociationName,
+	}
+
+	if input != nil {
+		if props := input.Properties; props != nil {
+			output.AccessMode = string(pointer.From(props.AccessMode))
+
+			if props.PrivateLinkResource != nil {
+				output.ResourceId = pointer.From(props.PrivateLinkResource.Id)
+			}
+
+			if props.Profile != nil && props.Profile.Id != nil {
+				profileId, err := networksecurityperimeterprofiles.ParseProfileIDInsensitively(*props.Profile.Id)
+				if err != nil {
+					return nil, err
+				}
+				output.NetworkSecurityPerimeterProfileId = profileId.ID()
+			}
+		}
+	}
+
+	return &output, nil
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/networksecurityperimeterassociations"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.FrameworkListWrappedResourceWithConfig = NetworkSecurityPerimeterAssociationListResource{}
+
+type NetworkSecurityPerimeterAssociationListResource struct{}
+
+type NetworkSecurityPerimeterAssociationListModel struct {
+	NetworkSecurityPerimeterId types.String `tfsdk:"network_security_perimeter_id"`
+}
+
+func (r NetworkSecurityPerimeterAssociationListResource) ResourceFunc() *pluginsdk.Resource {
+	return sdk.TypedListResourceFunc(NetworkSecurityPerimeterAssociationResource{})
+}
+
+func (r NetworkSecurityPerimeterAssociationListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = NetworkSecurityPerimeterAssociationResource{}.ResourceType()
+}
+
+func (r NetworkSecurityPerimeterAssociationListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"network_security_perimeter_id": listschema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: networksecurityperimeterassociations.ValidateNetworkSecurityPerimeterID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r NetworkSecurityPerimeterAssociationListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	client := metadata.Client.Network.NetworkSecurityPerimeterAssociations
+
+	var data NetworkSecurityPerimeterAssociationListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	perimeterId, err := networksecurityperimeterassociations.ParseNetworkSecurityPerimeterID(data.NetworkSecurityPerimeterId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, "parsing Network Security Perimeter ID", err)
+		return
+	}
+
+	resp, err := client.ListComplete(ctx, *perimeterId, networksecurityperimeterassociations.DefaultListOperationOptions())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing %s", NetworkSecurityPerimeterAssociationResource{}.ResourceType()), err)
+		return
+	}
+
+	listResults := resp.Items
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, item := range listResults {
+			result := request.NewListResult(ctx)
+			result.DisplayName = pointer.From(item.Name)
+
+			id, err := networksecurityperimeterassociations.ParseResourceAssociationIDInsensitively(pointer.From(item.Id))
+			if err != nil {
+				sdk.SetListIteratorErrorDiagnostic(result, push, "parsing Network Security Perimeter Association ID", err)
+				return
+			}
+
+			state, err := flattenNetworkSecurityPerimeterAssociation(*id, pointer.To(item))
+			if err != nil {
+				sdk.SetListIteratorErrorDiagnostic(result, push, "flattening Network Security Perimeter Association", err)
+				return
+			}
+
+			if err := sdk.EncodeTypedListResult(ctx, NetworkSecurityPerimeterAssociationResource{}, metadata.Client, id, state, &result); err != nil {
+				sdk.SetListIteratorErrorDiagnostic(result, push, "encoding Resource data", err)
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestAccNetworkSecurityPerimeterAssociation_list_basic(t *testing.T) {
+	r := NetworkSecurityPerimeterAssociationResource{}
+
+	data := acceptance.BuildTestData(t, "azurerm_network_security_perimeter_association", "test1")
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.basicList(data),
+			},
+			{
+				Query:             true,
+				Config:            r.basicListQuery(data), // TODO - Testing not currently functional
+				ConfigQueryChecks: []querycheck.QueryCheck{},
+			},
+		},
+	})
+}
+
+func (r NetworkSecurityPerimeterAssociationResource) basicList(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_key_vault" "test2" {
+  name                = "acctestkv2%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+}
+
+resource "azurerm_network_security_perimeter_association" "test1" {
+  name                                  = "acctest-nspa1-%[2]d"
+  network_security_perimeter_profile_id = azurerm_network_security_perimeter_profile.test.id
+  resource_id                           = azurerm_key_vault.test.id
+  access_mode                           = "Learning"
+}
+
+resource "azurerm_network_security_perimeter_association" "test2" {
+  name                                  = "acctest-nspa2-%[2]d"
+  network_security_perimeter_profile_id = azurerm_network_security_perimeter_profile.test.id
+  resource_id                           = azurerm_key_vault.test2.id
+  access_mode                           = "Learning"
+}
+`, r.template(data), data.RandomInteger, data.RandomString)
+}
+
+func (r NetworkSecurityPerimeterAssociationResource) basicListQuery(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+list "azurerm_network_security_perimeter_association" "list" {
+  provider = azurerm
+
+  config {
+    network_security_perimeter_id = "/subscriptions/%[1]s/resourceGroups/acctestRG-nsp-%[2]d/providers/Microsoft.Network/networkSecurityPerimeters/acctest-nsp-%[2]d"
+  }
+}
+`, data.Subscriptions.Primary, data.RandomInteger)
+}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/networksecurityperimeterprofiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_security_perimeter_profile -service-package-name network -properties "name" -compare-values "subscription_id:network_security_perimeter_id,resource_group_name:network_security_perimeter_id,network_security_perimeter_name:network_security_perimeter_id" -test-resource-type NetworkSecurityPerimeterProfileResource
+
+var (
+	_ sdk.Resource             = NetworkSecurityPerimeterProfileResource{}
+	_ sdk.ResourceWithIdentity = NetworkSecurityPerimeterProfileResource{}
+)
+
+type NetworkSecurityPerimeterProfileResource struct{}
+
+type NetworkSecurityPerimeterProfileResourceModel struct {
+	Name                       string `tfschema:"name"`
+	NetworkSecurityPerimeterId string `tfschema:"network_security_perimeter_id"`
+}
+
+func (NetworkSecurityPerimeterProfileResource) Identity() resourceids.ResourceId {
+	return &networksecurityperimeterprofiles.ProfileId{}
+}
+
+func (NetworkSecurityPerimeterProfileResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return networksecurityperimeterprofiles.ValidateProfileID
+}
+
+func (NetworkSecurityPerimeterProfileResource) ResourceType() string {
+	return "azurerm_network_security_perimeter_profile"
+}
+
+func (NetworkSecurityPerimeterProfileResource) ModelObject() interface{} {
+	return &NetworkSecurityPerimeterProfileResourceModel{}
+}
+
+func (NetworkSecurityPerimeterProfileResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateNetworkSecurityPerimeterName,
+		},
+
+		"network_security_perimeter_id": commonschema.ResourceIDReferenceRequiredForceNew(&networksecurityperimeterprofiles.NetworkSecurityPerimeterId{}),
+	}
+}
+
+func (NetworkSecurityPerimeterProfileResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r NetworkSecurityPerimeterProfileResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkSecurityPerimeterProfiles
+
+			var config NetworkSecurityPerimeterProfileResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			perimeterId, err := networksecurityperimeterprofiles.ParseNetworkSecurityPerimeterID(config.NetworkSecurityPerimeterId)
+			if err != nil {
+				return err
+			}
+
+			id := networksecurityperimeterprofiles.NewProfileID(perimeterId.SubscriptionId, perimeterId.ResourceGroupName, perimeterId.NetworkSecurityPerimeterName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := networksecurityperimeterprofiles.NspProfile{
+				Name:       pointer.To(config.Name),
+				Properties: &networksecurityperimeterprofiles.NspProfileProperties{},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r NetworkSecurityPerimeterProfileResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkSecurityPerimeterProfiles
+
+			id, err := networksecurityperimeterprofiles.ParseProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := flattenNetworkSecurityPerimeterProfile(*id)
+
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r NetworkSecurityPerimeterProfileResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkSecurityPerimeterProfiles
+
+			id, err := networksecurityperimeterprofiles.ParseProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func flattenNetworkSecurityPerimeterProfile(id networksecurityperimeterprofiles.ProfileId) NetworkSecurityPerimeterProfileResourceModel {
+	return NetworkSecurityPerimeterProfileResourceModel{
+		Name:                       id.ProfileName,
+		NetworkSecurityPerimeterId: networksecurityperimeterprofiles.NewNetworkSecurityPerimeterID(id.SubscriptionId, id.ResourceGroupName, id.NetworkSecurityPerimeterName).ID(),
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestAccNetworkSecurityPerimeterProfile_list_basic(t *testing.T) {
+	r := NetworkSecurityPerimeterProfileResource{}
+
+	data := acceptance.BuildTestData(t, "azurerm_network_security_perimeter_profile", "test1")
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.basicList(data),
+			},
+			{
+				Query:             true,
+				Config:            r.basicListQuery(data), // TODO - Testing not currently functional
+				ConfigQueryChecks: []querycheck.QueryCheck{},
+			},
+		},
+	})
+}
+
+func (r NetworkSecurityPerimeterProfileResource) basicList(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_network_security_perimeter_profile" "test1" {
+  name                          = "acctest-nspp1-%[2]d"
+  network_security_perimeter_id = azurerm_network_security_perimeter.test.id
+}
+
+resource "azurerm_network_security_perimeter_profile" "test2" {
+  name                          = "acctest-nspp2-%[2]d"
+  network_security_perimeter_id = azurerm_network_security_perimeter.test.id
+}
+`, NetworkSecurityPerimeterResource{}.basic(data), data.RandomInteger)
+}
+
+func (r NetworkSecurityPerimeterProfileResource) basicListQuery(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+list "azurerm_network_security_perimeter_profile" "list" {
+  provider = azurerm
+
+  config {
+    network_security_perimeter_id = "/subscriptions/%[1]s/resourceGroups/acctestRG-nsp-%[2]d/providers/Microsoft.Network/networkSecurityPerimeters/acctest-nsp-%[2]d"
+  }
+}
+`, data.Subscriptions.Primary, data.RandomInteger)
+}
@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/networksecurityperimeters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_security_perimeter -service-package-name network -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type NetworkSecurityPerimeterResource
+
+var (
+	_ sdk.ResourceWithUpdate   = NetworkSecurityPerimeterResource{}
+	_ sdk.ResourceWithIdentity = NetworkSecurityPerimeterResource{}
+)
+
+type NetworkSecurityPerimeterResource struct{}
+
+type NetworkSecurityPerimeterResourceModel struct {
+	Name              string            `tfschema:"name"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	Location          string            `tfschema:"location"`
+	Tags              map[string]string `tfschema:"tags"`
+	PerimeterGuid     string            `tfschema:"perimeter_guid"`
+}
+
+func (NetworkSecurityPerimeterResource) Identity() resourceids.ResourceId {
+	return &networksecurityperimeters.NetworkSecurityPerimeterId{}
+}
+
+func (NetworkSecurityPerimeterResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return networksecurityperimeters.ValidateNetworkSecurityPerimeterID
+}
+
+func (NetworkSecurityPerimeterResource) ResourceType() string {
+	return "azurerm_network_security_perimeter"
+}
+
+func (NetworkSecurityPerimeterResource) ModelObject() interface{} {
+	return &NetworkSecurityPerimeterResourceModel{}
+}
+
+func (NetworkSecurityPerimeterResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateNetworkSecurityPerimeterName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (NetworkSecurityPerimeterResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"perimeter_guid": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r NetworkSecurityPerimeterResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkSecurityPerimeters
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var config NetworkSecurityPerimeterResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := networksecurityperimeters.NewNetworkSecurityPerimeterID(subscriptionId, config.ResourceGroupName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := networksecurityperimeters.NetworkSecurityPerimeter{
+				Location:   location.Normalize(config.Location),
+				Properties: &networksecurityperimeters.NetworkSecurityPerimeterProperties{},
+				Tags:       pointer.To(config.Tags),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r NetworkSecurityPerimeterResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkSecurityPerimeters
+
+			id, err := networksecurityperimeters.ParseNetworkSecurityPerimeterID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := flattenNetworkSecurityPerimeter(*id, resp.Model)
+
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r NetworkSecurityPerimeterResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkSecurityPerimeters
+
+			id, err := networksecurityperimeters.ParseNetworkSecurityPerimeterID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config NetworkSecurityPerimeterResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload := networksecurityperimeters.UpdateTagsRequest{
+					Tags: pointer.To(config.Tags),
+				}
+				if _, err := client.Patch(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r NetworkSecurityPerimeterResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkSecurityPerimeters
+
+			id, err := networksecurityperimeters.ParseNetworkSecurityPerimeterID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id, networksecurityperimeters.DefaultDeleteOperationOptions()); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func flattenNetworkSecurityPerimeter(id networksecurityperimeters.NetworkSecurityPerimeterId, input *networksecurityperimeters.NetworkSecurityPerimeter) NetworkSecurityPerimeterResourceModel {
+	output := NetworkSecurityPerimeterResourceModel{
+		Name:              id.NetworkSecurityPerimeterName,
+		ResourceGroupName: id.ResourceGroupName,
+	}
+
+	if input != nil {
+		output.Location = location.Normalize(input.Location)
+		output.Tags = pointer.From(input.Tags)
+
+		if props := input.Properties; props != nil {
+			output.PerimeterGuid = pointer.From(props.PerimeterGuid)
+		}
+	}
+
+	return output
+}
+
+func validateNetworkSecurityPerimeterName(i interface{}, k string) ([]string, []error) {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9_.-]{0,78}[a-zA-Z0-9_])?$`),
+		"must be between 1 and 80 characters long, start with a letter or number, end with a letter, number or underscore, and may only contain letters, numbers, underscores (_), periods (.) and hyphens (-)",
+	)(i, k)
+}
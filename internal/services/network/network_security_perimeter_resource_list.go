@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/networksecurityperimeters"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.FrameworkListWrappedResource = NetworkSecurityPerimeterListResource{}
+
+type NetworkSecurityPerimeterListResource struct{}
+
+func (r NetworkSecurityPerimeterListResource) ResourceFunc() *pluginsdk.Resource {
+	return sdk.TypedListResourceFunc(NetworkSecurityPerimeterResource{})
+}
+
+func (r NetworkSecurityPerimeterListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = NetworkSecurityPerimeterResource{}.ResourceType()
+}
+
+func (r NetworkSecurityPerimeterListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	client := metadata.Client.Network.NetworkSecurityPerimeters
+
+	var data sdk.DefaultListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	subscriptionId := metadata.SubscriptionId
+	if data.SubscriptionId.ValueString() != "" {
+		subscriptionId = data.SubscriptionId.ValueString()
+	}
+
+	listResults := make([]networksecurityperimeters.NetworkSecurityPerimeter, 0)
+	switch {
+	case data.ResourceGroupName.ValueString() != "":
+		resp, err := client.ListComplete(ctx, commonids.NewResourceGroupID(subscriptionId, data.ResourceGroupName.ValueString()), networksecurityperimeters.DefaultListOperationOptions())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing %s", NetworkSecurityPerimeterResource{}.ResourceType()), err)
+			return
+		}
+		listResults = resp.Items
+
+	default:
+		resp, err := client.ListBySubscriptionComplete(ctx, commonids.NewSubscriptionID(subscriptionId), networksecurityperimeters.DefaultListBySubscriptionOperationOptions())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing %s", NetworkSecurityPerimeterResource{}.ResourceType()), err)
+			return
+		}
+		listResults = resp.Items
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, item := range listResults {
+			result := request.NewListResult(ctx)
+			result.DisplayName = pointer.From(item.Name)
+
+			id, err := networksecurityperimeters.ParseNetworkSecurityPerimeterIDInsensitively(pointer.From(item.Id))
+			if err != nil {
+				sdk.SetListIteratorErrorDiagnostic(result, push, "parsing Network Security Perimeter ID", err)
+				return
+			}
+
+			state := flattenNetworkSecurityPerimeter(*id, pointer.To(item))
+			if err := sdk.EncodeTypedListResult(ctx, NetworkSecurityPerimeterResource{}, metadata.Client, id, &state, &result); err != nil {
+				sdk.SetListIteratorErrorDiagnostic(result, push, "encoding Resource data", err)
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &ApplicationGatewayURLPathMapPathRuleId{}
+
 type ApplicationGatewayURLPathMapPathRuleId struct {
 	SubscriptionId         string
 	ResourceGroup          string
@@ -47,6 +49,51 @@ func (id ApplicationGatewayURLPathMapPathRuleId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName, id.UrlPathMapName, id.PathRuleName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ApplicationGatewayURLPathMapPathRule ID
+func (id ApplicationGatewayURLPathMapPathRuleId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticApplicationGateways", "applicationGateways", "applicationGateways"),
+		resourceids.UserSpecifiedSegment("applicationGatewayName", "applicationGateway1"),
+		resourceids.StaticSegment("staticUrlPathMaps", "urlPathMaps", "urlPathMaps"),
+		resourceids.UserSpecifiedSegment("urlPathMapName", "urlPathMap1"),
+		resourceids.StaticSegment("staticPathRules", "pathRules", "pathRules"),
+		resourceids.UserSpecifiedSegment("pathRuleName", "pathRule1"),
+	}
+}
+
+// FromParseResult populates the fields of this ApplicationGatewayURLPathMapPathRule ID from the ParseResult, for use with resourceids.Parser
+func (id *ApplicationGatewayURLPathMapPathRuleId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ApplicationGatewayName, ok = input.Parsed["applicationGatewayName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "applicationGatewayName", input)
+	}
+
+	if id.UrlPathMapName, ok = input.Parsed["urlPathMapName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "urlPathMapName", input)
+	}
+
+	if id.PathRuleName, ok = input.Parsed["pathRuleName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "pathRuleName", input)
+	}
+
+	return nil
+}
+
 // ApplicationGatewayURLPathMapPathRuleID parses a ApplicationGatewayURLPathMapPathRule ID into an ApplicationGatewayURLPathMapPathRuleId struct
 func ApplicationGatewayURLPathMapPathRuleID(input string) (*ApplicationGatewayURLPathMapPathRuleId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -21,6 +21,24 @@ func TestApplicationGatewayURLPathMapPathRuleIDFormatter(t *testing.T) {
 	}
 }
 
+func TestApplicationGatewayURLPathMapPathRuleIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&ApplicationGatewayURLPathMapPathRuleId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/applicationGateways/applicationGateway1/urlPathMaps/urlPathMap1/pathRules/pathRule1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual ApplicationGatewayURLPathMapPathRuleId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/applicationGateways/applicationGateway1/urlPathMaps/urlPathMap1/pathRules/pathRule1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestApplicationGatewayURLPathMapPathRuleID(t *testing.T) {
 	testData := []struct {
 		Input    string
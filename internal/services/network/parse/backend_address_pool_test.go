@@ -21,6 +21,24 @@ func TestBackendAddressPoolIDFormatter(t *testing.T) {
 	}
 }
 
+func TestBackendAddressPoolIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&BackendAddressPoolId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/applicationGateways/applicationGateway1/backendAddressPools/beap1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual BackendAddressPoolId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/applicationGateways/applicationGateway1/backendAddressPools/beap1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestBackendAddressPoolID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,24 @@ func TestHttpListenerIDFormatter(t *testing.T) {
 	}
 }
 
+func TestHttpListenerIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&HttpListenerId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/applicationGateways/applicationGateway1/httpListeners/listener1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual HttpListenerId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/applicationGateways/applicationGateway1/httpListeners/listener1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestHttpListenerID(t *testing.T) {
 	testData := []struct {
 		Input    string
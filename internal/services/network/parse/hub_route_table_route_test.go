@@ -21,6 +21,24 @@ func TestHubRouteTableRouteIDFormatter(t *testing.T) {
 	}
 }
 
+func TestHubRouteTableRouteIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&HubRouteTableRouteId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualHubs/virtualHub1/hubRouteTables/routeTable1/routes/route1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual HubRouteTableRouteId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualHubs/virtualHub1/hubRouteTables/routeTable1/routes/route1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestHubRouteTableRouteID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &NetworkInterfaceIpConfigurationId{}
+
 type NetworkInterfaceIpConfigurationId struct {
 	SubscriptionId       string
 	ResourceGroup        string
@@ -44,6 +46,45 @@ func (id NetworkInterfaceIpConfigurationId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NetworkInterfaceName, id.IpConfigurationName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this NetworkInterfaceIpConfiguration ID
+func (id NetworkInterfaceIpConfigurationId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticNetworkInterfaces", "networkInterfaces", "networkInterfaces"),
+		resourceids.UserSpecifiedSegment("networkInterfaceName", "networkInterface1"),
+		resourceids.StaticSegment("staticIpConfigurations", "ipConfigurations", "ipConfigurations"),
+		resourceids.UserSpecifiedSegment("ipConfigurationName", "config1"),
+	}
+}
+
+// FromParseResult populates the fields of this NetworkInterfaceIpConfiguration ID from the ParseResult, for use with resourceids.Parser
+func (id *NetworkInterfaceIpConfigurationId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.NetworkInterfaceName, ok = input.Parsed["networkInterfaceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "networkInterfaceName", input)
+	}
+
+	if id.IpConfigurationName, ok = input.Parsed["ipConfigurationName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "ipConfigurationName", input)
+	}
+
+	return nil
+}
+
 // NetworkInterfaceIpConfigurationID parses a NetworkInterfaceIpConfiguration ID into an NetworkInterfaceIpConfigurationId struct
 func NetworkInterfaceIpConfigurationID(input string) (*NetworkInterfaceIpConfigurationId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
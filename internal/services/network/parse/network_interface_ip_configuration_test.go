@@ -21,6 +21,24 @@ func TestNetworkInterfaceIpConfigurationIDFormatter(t *testing.T) {
 	}
 }
 
+func TestNetworkInterfaceIpConfigurationIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&NetworkInterfaceIpConfigurationId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkInterfaces/networkInterface1/ipConfigurations/config1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual NetworkInterfaceIpConfigurationId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkInterfaces/networkInterface1/ipConfigurations/config1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestNetworkInterfaceIpConfigurationID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -47,7 +47,7 @@ func NetworkManagerDeploymentID(networkManagerDeploymentId string) (*ManagerDepl
 	normalizedLocation := azure.NormalizeLocation(v[1])
 
 	if v[2] == "" {
-		return nil, fmt.Errorf("expected scopeAccess in network manager deployment ID with format `{networkManagerId}/commit|{location}|{scopeAccess} to be one of the [Connectivity, SecurityAdmin]`, but got %s in %s", v[2], networkManagerDeploymentId)
+		return nil, fmt.Errorf("expected scopeAccess in network manager deployment ID with format `{networkManagerId}/commit|{location}|{scopeAccess} to be one of the [Connectivity, SecurityAdmin, Routing, SecurityUser]`, but got %s in %s", v[2], networkManagerDeploymentId)
 	}
 	scopeAccess := v[2]
 	networkManagerDeployment := NewNetworkManagerDeploymentID(managerId.SubscriptionId, managerId.ResourceGroupName, managerId.NetworkManagerName, normalizedLocation, scopeAccess)
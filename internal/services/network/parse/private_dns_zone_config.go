@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &PrivateDnsZoneConfigId{}
+
 type PrivateDnsZoneConfigId struct {
 	SubscriptionId          string
 	ResourceGroup           string
@@ -47,6 +49,51 @@ func (id PrivateDnsZoneConfigId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.PrivateEndpointName, id.PrivateDnsZoneGroupName, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this PrivateDnsZoneConfig ID
+func (id PrivateDnsZoneConfigId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticPrivateEndpoints", "privateEndpoints", "privateEndpoints"),
+		resourceids.UserSpecifiedSegment("privateEndpointName", "endpoint1"),
+		resourceids.StaticSegment("staticPrivateDnsZoneGroups", "privateDnsZoneGroups", "privateDnsZoneGroups"),
+		resourceids.UserSpecifiedSegment("privateDnsZoneGroupName", "privateDnsZoneGroup1"),
+		resourceids.StaticSegment("staticPrivateDnsZoneConfigs", "privateDnsZoneConfigs", "privateDnsZoneConfigs"),
+		resourceids.UserSpecifiedSegment("name", "privateDnsZoneConfig1"),
+	}
+}
+
+// FromParseResult populates the fields of this PrivateDnsZoneConfig ID from the ParseResult, for use with resourceids.Parser
+func (id *PrivateDnsZoneConfigId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.PrivateEndpointName, ok = input.Parsed["privateEndpointName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "privateEndpointName", input)
+	}
+
+	if id.PrivateDnsZoneGroupName, ok = input.Parsed["privateDnsZoneGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "privateDnsZoneGroupName", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
+
 // PrivateDnsZoneConfigID parses a PrivateDnsZoneConfig ID into an PrivateDnsZoneConfigId struct
 func PrivateDnsZoneConfigID(input string) (*PrivateDnsZoneConfigId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
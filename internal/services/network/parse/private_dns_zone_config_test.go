@@ -21,6 +21,24 @@ func TestPrivateDnsZoneConfigIDFormatter(t *testing.T) {
 	}
 }
 
+func TestPrivateDnsZoneConfigIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&PrivateDnsZoneConfigId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/privateEndpoints/endpoint1/privateDnsZoneGroups/privateDnsZoneGroup1/privateDnsZoneConfigs/privateDnsZoneConfig1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual PrivateDnsZoneConfigId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/privateEndpoints/endpoint1/privateDnsZoneGroups/privateDnsZoneGroup1/privateDnsZoneConfigs/privateDnsZoneConfig1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestPrivateDnsZoneConfigID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &RedirectConfigurationsId{}
+
 type RedirectConfigurationsId struct {
 	SubscriptionId            string
 	ResourceGroup             string
@@ -44,6 +46,45 @@ func (id RedirectConfigurationsId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName, id.RedirectConfigurationName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this RedirectConfigurations ID
+func (id RedirectConfigurationsId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "group1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticApplicationGateways", "applicationGateways", "applicationGateways"),
+		resourceids.UserSpecifiedSegment("applicationGatewayName", "applicationGateway1"),
+		resourceids.StaticSegment("staticRedirectConfigurations", "redirectConfigurations", "redirectConfigurations"),
+		resourceids.UserSpecifiedSegment("redirectConfigurationName", "redirectConfig1"),
+	}
+}
+
+// FromParseResult populates the fields of this RedirectConfigurations ID from the ParseResult, for use with resourceids.Parser
+func (id *RedirectConfigurationsId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ApplicationGatewayName, ok = input.Parsed["applicationGatewayName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "applicationGatewayName", input)
+	}
+
+	if id.RedirectConfigurationName, ok = input.Parsed["redirectConfigurationName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "redirectConfigurationName", input)
+	}
+
+	return nil
+}
+
 // RedirectConfigurationsID parses a RedirectConfigurations ID into an RedirectConfigurationsId struct
 func RedirectConfigurationsID(input string) (*RedirectConfigurationsId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
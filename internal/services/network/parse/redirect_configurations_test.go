@@ -21,6 +21,24 @@ func TestRedirectConfigurationsIDFormatter(t *testing.T) {
 	}
 }
 
+func TestRedirectConfigurationsIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&RedirectConfigurationsId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/applicationGateways/applicationGateway1/redirectConfigurations/redirectConfig1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual RedirectConfigurationsId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/applicationGateways/applicationGateway1/redirectConfigurations/redirectConfig1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestRedirectConfigurationsID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,24 @@ func TestVirtualNetworkDnsServersIDFormatter(t *testing.T) {
 	}
 }
 
+func TestVirtualNetworkDnsServersIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&VirtualNetworkDnsServersId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/dnsServers/default", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual VirtualNetworkDnsServersId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/dnsServers/default"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestVirtualNetworkDnsServersID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,24 @@ func TestVirtualNetworkGatewayIpConfigurationIDFormatter(t *testing.T) {
 	}
 }
 
+func TestVirtualNetworkGatewayIpConfigurationIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&VirtualNetworkGatewayIpConfigurationId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworkGateways/gw1/ipConfigurations/cfg1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual VirtualNetworkGatewayIpConfigurationId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworkGateways/gw1/ipConfigurations/cfg1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestVirtualNetworkGatewayIpConfigurationID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &VirtualNetworkGatewayPolicyGroupId{}
+
 type VirtualNetworkGatewayPolicyGroupId struct {
 	SubscriptionId            string
 	ResourceGroup             string
@@ -44,6 +46,45 @@ func (id VirtualNetworkGatewayPolicyGroupId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkGatewayName, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this VirtualNetworkGatewayPolicyGroup ID
+func (id VirtualNetworkGatewayPolicyGroupId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticVirtualNetworkGateways", "virtualNetworkGateways", "virtualNetworkGateways"),
+		resourceids.UserSpecifiedSegment("virtualNetworkGatewayName", "gw1"),
+		resourceids.StaticSegment("staticVirtualNetworkGatewayPolicyGroups", "virtualNetworkGatewayPolicyGroups", "virtualNetworkGatewayPolicyGroups"),
+		resourceids.UserSpecifiedSegment("name", "policyGroup1"),
+	}
+}
+
+// FromParseResult populates the fields of this VirtualNetworkGatewayPolicyGroup ID from the ParseResult, for use with resourceids.Parser
+func (id *VirtualNetworkGatewayPolicyGroupId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.VirtualNetworkGatewayName, ok = input.Parsed["virtualNetworkGatewayName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "virtualNetworkGatewayName", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
+
 // VirtualNetworkGatewayPolicyGroupID parses a VirtualNetworkGatewayPolicyGroup ID into an VirtualNetworkGatewayPolicyGroupId struct
 func VirtualNetworkGatewayPolicyGroupID(input string) (*VirtualNetworkGatewayPolicyGroupId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -21,6 +21,24 @@ func TestVirtualNetworkGatewayPolicyGroupIDFormatter(t *testing.T) {
 	}
 }
 
+func TestVirtualNetworkGatewayPolicyGroupIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&VirtualNetworkGatewayPolicyGroupId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworkGateways/gw1/virtualNetworkGatewayPolicyGroups/policyGroup1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual VirtualNetworkGatewayPolicyGroupId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworkGateways/gw1/virtualNetworkGatewayPolicyGroups/policyGroup1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestVirtualNetworkGatewayPolicyGroupID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/virtualwans"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -24,16 +25,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name point_to_site_vpn_gateway -service-package-name network -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type PointToSiteVPNGatewayResource
+
 func resourcePointToSiteVPNGateway() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourcePointToSiteVPNGatewayCreate,
-		Read:   resourcePointToSiteVPNGatewayRead,
-		Update: resourcePointToSiteVPNGatewayUpdate,
-		Delete: resourcePointToSiteVPNGatewayDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := commonids.ParseVirtualWANP2SVPNGatewayID(id)
-			return err
-		}),
+		Create:   resourcePointToSiteVPNGatewayCreate,
+		Read:     resourcePointToSiteVPNGatewayRead,
+		Update:   resourcePointToSiteVPNGatewayUpdate,
+		Delete:   resourcePointToSiteVPNGatewayDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&commonids.VirtualWANP2SVPNGatewayId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&commonids.VirtualWANP2SVPNGatewayId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
@@ -346,9 +350,11 @@ func resourcePointToSiteVPNGatewayRead(d *pluginsdk.ResourceData, meta interface
 			}
 			d.Set("routing_preference_internet_enabled", routingPreferenceInternetEnabled)
 		}
-		return tags.FlattenAndSet(d, model.Tags)
+		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+			return err
+		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourcePointToSiteVPNGatewayDelete(d *pluginsdk.ResourceData, meta interface{}) error {
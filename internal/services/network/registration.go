@@ -63,10 +63,17 @@ func (r Registration) Resources() []sdk.Resource {
 		ManagerRoutingRuleResource{},
 		ManagerScopeConnectionResource{},
 		ManagerSecurityAdminConfigurationResource{},
+		ManagerSecurityUserConfigurationResource{},
 		ManagerStaticMemberResource{},
 		ManagerSubscriptionConnectionResource{},
+		ManagerUserRuleCollectionResource{},
+		ManagerUserRuleResource{},
 		ManagerVerifierWorkspaceResource{},
 		ManagerVerifierWorkspaceReachabilityAnalysisIntentResource{},
+		NetworkSecurityPerimeterResource{},
+		NetworkSecurityPerimeterAccessRuleResource{},
+		NetworkSecurityPerimeterAssociationResource{},
+		NetworkSecurityPerimeterProfileResource{},
 		PrivateEndpointApplicationSecurityGroupAssociationResource{},
 		RouteMapResource{},
 		VirtualHubRoutingIntentResource{},
@@ -199,6 +206,10 @@ func (r Registration) Actions() []func() action.Action {
 
 func (r Registration) ListResources() []sdk.FrameworkListWrappedResource {
 	return []sdk.FrameworkListWrappedResource{
+		NetworkSecurityPerimeterListResource{},
+		NetworkSecurityPerimeterAccessRuleListResource{},
+		NetworkSecurityPerimeterAssociationListResource{},
+		NetworkSecurityPerimeterProfileListResource{},
 		VirtualNetworkListResource{},
 	}
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/networkwatchers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/packetcaptures"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
@@ -22,16 +23,18 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name virtual_machine_packet_capture -service-package-name network -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:network_watcher_id,network_watcher_name:network_watcher_id" -test-name localDiskConfig -test-resource-type VirtualMachinePacketCaptureResource -test-sequential
 func resourceVirtualMachinePacketCapture() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceVirtualMachinePacketCaptureCreate,
 		Read:   resourceVirtualMachinePacketCaptureRead,
 		Delete: resourceVirtualMachinePacketCaptureDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := packetcaptures.ParsePacketCaptureID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&packetcaptures.PacketCaptureId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&packetcaptures.PacketCaptureId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -245,7 +248,7 @@ func resourceVirtualMachinePacketCaptureRead(d *pluginsdk.ResourceData, meta int
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceVirtualMachinePacketCaptureDelete(d *pluginsdk.ResourceData, meta interface{}) error {
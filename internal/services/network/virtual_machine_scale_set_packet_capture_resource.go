@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/networkwatchers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/packetcaptures"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	computeParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
@@ -23,16 +24,18 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name virtual_machine_scale_set_packet_capture -service-package-name network -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:network_watcher_id,network_watcher_name:network_watcher_id" -test-name localDiskConfig -test-resource-type VirtualMachineScaleSetPacketCaptureResource -test-sequential
 func resourceVirtualMachineScaleSetPacketCapture() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceVirtualMachineScaleSetPacketCaptureCreate,
 		Read:   resourceVirtualMachineScaleSetPacketCaptureRead,
 		Delete: resourceVirtualMachineScaleSetPacketCaptureDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := packetcaptures.ParsePacketCaptureID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&packetcaptures.PacketCaptureId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&packetcaptures.PacketCaptureId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -290,7 +293,7 @@ func resourceVirtualMachineScaleSetPacketCaptureRead(d *pluginsdk.ResourceData,
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceVirtualMachineScaleSetPacketCaptureDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -33,7 +33,7 @@ func resourceVirtualNetworkPeering() *pluginsdk.Resource {
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := virtualnetworkpeerings.ParseVirtualNetworkPeeringID(id)
 			return err
-		}),
+		}, &virtualnetworkpeerings.VirtualNetworkPeeringId{}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/virtualwans"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -21,16 +22,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name virtual_wan -service-package-name network -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceVirtualWan() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceVirtualWanCreate,
-		Read:   resourceVirtualWanRead,
-		Update: resourceVirtualWanUpdate,
-		Delete: resourceVirtualWanDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := virtualwans.ParseVirtualWANID(id)
-			return err
-		}),
+		Create:   resourceVirtualWanCreate,
+		Read:     resourceVirtualWanRead,
+		Update:   resourceVirtualWanUpdate,
+		Delete:   resourceVirtualWanDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&virtualwans.VirtualWANId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&virtualwans.VirtualWANId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -210,9 +214,11 @@ func resourceVirtualWanRead(d *pluginsdk.ResourceData, meta interface{}) error {
 			d.Set("office365_local_breakout_category", pointer.From(props.Office365LocalBreakoutCategory))
 			d.Set("type", props.Type)
 		}
-		return tags.FlattenAndSet(d, model.Tags)
+		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+			return err
+		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceVirtualWanDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/nginx/2024-11-01-preview/nginxcertificate"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/nginx/2024-11-01-preview/nginxdeployment"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -26,10 +27,14 @@ type CertificateModel struct {
 	KeyVaultSecretId       string `tfschema:"key_vault_secret_id"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name nginx_certificate -service-package-name nginx -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:nginx_deployment_id,nginx_deployment_name:nginx_deployment_id" -test-resource-type CertificateResource
+
 type CertificateResource struct{}
 
 var _ sdk.ResourceWithUpdate = (*CertificateResource)(nil)
 
+var _ sdk.ResourceWithIdentity = CertificateResource{}
+
 func (m CertificateResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -225,3 +230,7 @@ func (m CertificateResource) Delete() sdk.ResourceFunc {
 func (m CertificateResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return nginxcertificate.ValidateCertificateID
 }
+
+func (m CertificateResource) Identity() resourceids.ResourceId {
+	return &nginxcertificate.CertificateId{}
+}
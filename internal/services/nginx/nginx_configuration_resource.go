@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/nginx/2024-11-01-preview/nginxconfiguration"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/nginx/2024-11-01-preview/nginxdeployment"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -92,10 +93,14 @@ func (c ConfigurationModel) ToSDKModel() nginxconfiguration.NginxConfigurationRe
 	return req
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name nginx_configuration -service-package-name nginx -known-values "subscription_id:data.Subscriptions.Primary,name:default" -compare-values "resource_group_name:nginx_deployment_id,nginx_deployment_name:nginx_deployment_id" -test-resource-type ConfigurationResource
+
 type ConfigurationResource struct{}
 
 var _ sdk.Resource = (*ConfigurationResource)(nil)
 
+var _ sdk.ResourceWithIdentity = ConfigurationResource{}
+
 func (m ConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"nginx_deployment_id": {
@@ -395,3 +400,7 @@ func (m ConfigurationResource) Delete() sdk.ResourceFunc {
 func (m ConfigurationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return nginxconfiguration.ValidateConfigurationID
 }
+
+func (m ConfigurationResource) Identity() resourceids.ResourceId {
+	return &nginxconfiguration.ConfigurationId{}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/nginx/2024-11-01-preview/nginxdeployment"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -126,10 +127,14 @@ func expandNetworkProfile(public []FrontendPublic, private []FrontendPrivate, ne
 	return &out
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name nginx_deployment -service-package-name nginx -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type DeploymentResource
+
 type DeploymentResource struct{}
 
 var _ sdk.ResourceWithUpdate = (*DeploymentResource)(nil)
 
+var _ sdk.ResourceWithIdentity = DeploymentResource{}
+
 func (m DeploymentResource) Arguments() map[string]*pluginsdk.Schema {
 	resource := map[string]*pluginsdk.Schema{
 		"resource_group_name": commonschema.ResourceGroupName(),
@@ -777,3 +782,7 @@ func (m DeploymentResource) Delete() sdk.ResourceFunc {
 func (m DeploymentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return nginxdeployment.ValidateNginxDeploymentID
 }
+
+func (m DeploymentResource) Identity() resourceids.ResourceId {
+	return &nginxdeployment.NginxDeploymentId{}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/notificationhubs/2023-09-01/namespaces"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/notificationhub/migration"
@@ -26,16 +27,20 @@ import (
 
 var notificationHubNamespaceResourceName = "azurerm_notification_hub_namespace"
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name notification_hub_namespace -service-package-name notificationhub -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name free
+
 func resourceNotificationHubNamespace() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceNotificationHubNamespaceCreate,
 		Read:   resourceNotificationHubNamespaceRead,
 		Update: resourceNotificationHubNamespaceUpdate,
 		Delete: resourceNotificationHubNamespaceDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := namespaces.ParseNamespaceID(id)
-			return err
-		}),
+
+		Importer: pluginsdk.ImporterValidatingIdentity(&namespaces.NamespaceId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&namespaces.NamespaceId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -250,9 +255,11 @@ func resourceNotificationHubNamespaceRead(d *pluginsdk.ResourceData, meta interf
 			d.Set("replication_region", location.Normalize(replicationRegion))
 		}
 
-		return tags.FlattenAndSet(d, model.Tags)
+		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+			return err
+		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceNotificationHubNamespaceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
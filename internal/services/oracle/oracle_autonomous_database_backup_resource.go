@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-09-01/autonomousdatabasebackups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-09-01/autonomousdatabases"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,6 +22,9 @@ import (
 
 var _ sdk.Resource = AutonomousDatabaseBackupResource{}
 
+var _ sdk.ResourceWithIdentity = AutonomousDatabaseBackupResource{}
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name oracle_autonomous_database_backup -service-package-name oracle -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:autonomous_database_id,autonomous_database_name:autonomous_database_id" -test-resource-type AutonomousDatabaseBackupResource
 type AutonomousDatabaseBackupResource struct{}
 
 type AutonomousDatabaseBackupResourceModel struct {
@@ -252,3 +256,7 @@ func (r AutonomousDatabaseBackupResource) Delete() sdk.ResourceFunc {
 func (r AutonomousDatabaseBackupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return autonomousdatabasebackups.ValidateAutonomousDatabaseBackupID
 }
+
+func (r AutonomousDatabaseBackupResource) Identity() resourceids.ResourceId {
+	return &autonomousdatabasebackups.AutonomousDatabaseBackupId{}
+}
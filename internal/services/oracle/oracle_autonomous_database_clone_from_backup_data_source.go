@@ -30,64 +30,64 @@ type AutonomousDatabaseCloneFromBackupDataSourceModel struct {
 	SourceAutonomousDatabaseId string `tfschema:"source_autonomous_database_id"`
 
 	// Base properties (computed)
-	AllowedIpAddresses                            []string                        `tfschema:"allowed_ip_addresses"`
-	BackupRetentionPeriodInDays                   int64                           `tfschema:"backup_retention_period_in_days"`
-	CharacterSet                                  string                          `tfschema:"character_set"`
-	ComputeCount                                  float64                         `tfschema:"compute_count"`
-	ComputeModel                                  string                          `tfschema:"compute_model"`
-	ConnectionStrings                             []string                        `tfschema:"connection_strings"`
-	CustomerContacts                              []string                        `tfschema:"customer_contacts"`
-	DataStorageSizeInGb                           int64                           `tfschema:"data_storage_size_in_gb"`
-	DataStorageSizeInTb                           int64                           `tfschema:"data_storage_size_in_tb"`
-	DatabaseVersion                               string                          `tfschema:"database_version"`
-	DatabaseWorkload                              string                          `tfschema:"database_workload"`
-	DisplayName                                   string                          `tfschema:"display_name"`
-	LicenseModel                                  string                          `tfschema:"license_model"`
-	AutoScalingEnabled                            bool                            `tfschema:"auto_scaling_enabled"`
-	AutoScalingForStorageEnabled                  bool                            `tfschema:"auto_scaling_for_storage_enabled"`
-	MtlsConnectionRequired                        bool                            `tfschema:"mtls_connection_required"`
-	NationalCharacterSet                          string                          `tfschema:"national_character_set"`
-	SubnetId                                      string                          `tfschema:"subnet_id"`
-	VnetId                                        string                          `tfschema:"virtual_network_id"`
-	LifecycleState                                string                          `tfschema:"lifecycle_state"`
-	PrivateEndpointUrl                            string                          `tfschema:"private_endpoint_url"`
-	PrivateEndpointIp                             string                          `tfschema:"private_endpoint_ip"`
-	ServiceConsoleUrl                             string                          `tfschema:"service_console_url"`
-	SqlWebDeveloperUrl                            string                          `tfschema:"sql_web_developer_url"`
-	TimeCreatedUtc                                string                          `tfschema:"time_created_in_utc"`
-	OciUrl                                        string                          `tfschema:"oci_url"`
-	ActualUsedDataStorageSizeInTb                 float64                         `tfschema:"actual_used_data_storage_size_in_tb"`
-	AllocatedStorageSizeInTb                      float64                         `tfschema:"allocated_storage_size_in_tb"`
-	AvailableUpgradeVersions                      []string                        `tfschema:"available_upgrade_versions"`
-	CpuCoreCount                                  int64                           `tfschema:"cpu_core_count"`
-	FailedDataRecoveryInSeconds                   int64                           `tfschema:"failed_data_recovery_in_seconds"`
-	LifecycleDetails                              string                          `tfschema:"lifecycle_details"`
-	LocalAdgAutoFailoverMaxDataLossLimitInSeconds int64                           `tfschema:"local_adg_auto_failover_max_data_loss_limit_in_seconds"`
-	LocalDataGuardEnabled                         bool                            `tfschema:"local_data_guard_enabled"`
-	LongTermBackupSchedule                        []LongTermBackUpScheduleDetails `tfschema:"long_term_backup_schedule"`
-	MemoryAreaInGb                                int64                           `tfschema:"in_memory_area_in_gb"`
-	MemoryPerOracleComputeUnitInGb                int64                           `tfschema:"memory_per_oracle_compute_unit_in_gb"`
-	NextLongTermBackupTimestamp                   string                          `tfschema:"next_long_term_backup_timestamp"`
-	Ocid                                          string                          `tfschema:"ocid"`
-	PeerDatabaseIds                               []string                        `tfschema:"peer_database_ids"`
-	Preview                                       bool                            `tfschema:"preview"`
-	PreviewVersionWithServiceTermsAccepted        bool                            `tfschema:"preview_version_with_service_terms_accepted"`
-	PrivateEndpointLabel                          string                          `tfschema:"private_endpoint_label"`
-	ProvisionableCPUs                             []int64                         `tfschema:"provisionable_cpus"`
-	RemoteDataGuardEnabled                        bool                            `tfschema:"remote_data_guard_enabled"`
-	SupportedRegionsToCloneTo                     []string                        `tfschema:"supported_regions_to_clone_to"`
-	TimeDataGuardRoleChangedInUtc                 string                          `tfschema:"time_data_guard_role_changed_in_utc"`
-	TimeDeletionOfFreeAutonomousDatabaseInUtc     string                          `tfschema:"time_deletion_of_free_autonomous_database_in_utc"`
-	TimeLocalDataGuardEnabledInUtc                string                          `tfschema:"time_local_data_guard_enabled_in_utc"`
-	TimeMaintenanceBeginInUtc                     string                          `tfschema:"time_maintenance_begin_in_utc"`
-	TimeMaintenanceEndInUtc                       string                          `tfschema:"time_maintenance_end_in_utc"`
-	TimeOfLastFailoverInUtc                       string                          `tfschema:"time_of_last_failover_in_utc"`
-	TimeOfLastRefreshInUtc                        string                          `tfschema:"time_of_last_refresh_in_utc"`
-	TimeOfLastRefreshPointInUtc                   string                          `tfschema:"time_of_last_refresh_point_in_utc"`
-	TimeOfLastSwitchoverInUtc                     string                          `tfschema:"time_of_last_switchover_in_utc"`
-	TimeReclamationOfFreeAutonomousDatabaseInUtc  string                          `tfschema:"time_reclamation_of_free_autonomous_database_in_utc"`
-	UsedDataStorageSizeInGb                       int64                           `tfschema:"used_data_storage_size_in_gb"`
-	UsedDataStorageSizeInTb                       int64                           `tfschema:"used_data_storage_size_in_tb"`
+	AllowedIpAddresses                            []string                             `tfschema:"allowed_ip_addresses"`
+	BackupRetentionPeriodInDays                   int64                                `tfschema:"backup_retention_period_in_days"`
+	CharacterSet                                  string                               `tfschema:"character_set"`
+	ComputeCount                                  float64                              `tfschema:"compute_count"`
+	ComputeModel                                  string                               `tfschema:"compute_model"`
+	ConnectionStrings                             []string                             `tfschema:"connection_strings"`
+	CustomerContacts                              []string                             `tfschema:"customer_contacts"`
+	DataStorageSizeInGb                           int64                                `tfschema:"data_storage_size_in_gb"`
+	DataStorageSizeInTb                           int64                                `tfschema:"data_storage_size_in_tb"`
+	DatabaseVersion                               string                               `tfschema:"database_version"`
+	DatabaseWorkload                              string                               `tfschema:"database_workload"`
+	DisplayName                                   string                               `tfschema:"display_name"`
+	LicenseModel                                  string                               `tfschema:"license_model"`
+	AutoScalingEnabled                            bool                                 `tfschema:"auto_scaling_enabled"`
+	AutoScalingForStorageEnabled                  bool                                 `tfschema:"auto_scaling_for_storage_enabled"`
+	MtlsConnectionRequired                        bool                                 `tfschema:"mtls_connection_required"`
+	NationalCharacterSet                          string                               `tfschema:"national_character_set"`
+	SubnetId                                      string                               `tfschema:"subnet_id"`
+	VnetId                                        string                               `tfschema:"virtual_network_id"`
+	LifecycleState                                string                               `tfschema:"lifecycle_state"`
+	PrivateEndpointUrl                            string                               `tfschema:"private_endpoint_url"`
+	PrivateEndpointIp                             string                               `tfschema:"private_endpoint_ip"`
+	ServiceConsoleUrl                             string                               `tfschema:"service_console_url"`
+	SqlWebDeveloperUrl                            string                               `tfschema:"sql_web_developer_url"`
+	TimeCreatedUtc                                string                               `tfschema:"time_created_in_utc"`
+	OciUrl                                        string                               `tfschema:"oci_url"`
+	ActualUsedDataStorageSizeInTb                 float64                              `tfschema:"actual_used_data_storage_size_in_tb"`
+	AllocatedStorageSizeInTb                      float64                              `tfschema:"allocated_storage_size_in_tb"`
+	AvailableUpgradeVersions                      []string                             `tfschema:"available_upgrade_versions"`
+	CpuCoreCount                                  int64                                `tfschema:"cpu_core_count"`
+	FailedDataRecoveryInSeconds                   int64                                `tfschema:"failed_data_recovery_in_seconds"`
+	LifecycleDetails                              string                               `tfschema:"lifecycle_details"`
+	LocalAdgAutoFailoverMaxDataLossLimitInSeconds int64                                `tfschema:"local_adg_auto_failover_max_data_loss_limit_in_seconds"`
+	LocalDataGuardEnabled                         bool                                 `tfschema:"local_data_guard_enabled"`
+	LongTermBackupSchedule                        []LongTermBackUpScheduleCloneDetails `tfschema:"long_term_backup_schedule"`
+	MemoryAreaInGb                                int64                                `tfschema:"in_memory_area_in_gb"`
+	MemoryPerOracleComputeUnitInGb                int64                                `tfschema:"memory_per_oracle_compute_unit_in_gb"`
+	NextLongTermBackupTimestamp                   string                               `tfschema:"next_long_term_backup_timestamp"`
+	Ocid                                          string                               `tfschema:"ocid"`
+	PeerDatabaseIds                               []string                             `tfschema:"peer_database_ids"`
+	Preview                                       bool                                 `tfschema:"preview"`
+	PreviewVersionWithServiceTermsAccepted        bool                                 `tfschema:"preview_version_with_service_terms_accepted"`
+	PrivateEndpointLabel                          string                               `tfschema:"private_endpoint_label"`
+	ProvisionableCPUs                             []int64                              `tfschema:"provisionable_cpus"`
+	RemoteDataGuardEnabled                        bool                                 `tfschema:"remote_data_guard_enabled"`
+	SupportedRegionsToCloneTo                     []string                             `tfschema:"supported_regions_to_clone_to"`
+	TimeDataGuardRoleChangedInUtc                 string                               `tfschema:"time_data_guard_role_changed_in_utc"`
+	TimeDeletionOfFreeAutonomousDatabaseInUtc     string                               `tfschema:"time_deletion_of_free_autonomous_database_in_utc"`
+	TimeLocalDataGuardEnabledInUtc                string                               `tfschema:"time_local_data_guard_enabled_in_utc"`
+	TimeMaintenanceBeginInUtc                     string                               `tfschema:"time_maintenance_begin_in_utc"`
+	TimeMaintenanceEndInUtc                       string                               `tfschema:"time_maintenance_end_in_utc"`
+	TimeOfLastFailoverInUtc                       string                               `tfschema:"time_of_last_failover_in_utc"`
+	TimeOfLastRefreshInUtc                        string                               `tfschema:"time_of_last_refresh_in_utc"`
+	TimeOfLastRefreshPointInUtc                   string                               `tfschema:"time_of_last_refresh_point_in_utc"`
+	TimeOfLastSwitchoverInUtc                     string                               `tfschema:"time_of_last_switchover_in_utc"`
+	TimeReclamationOfFreeAutonomousDatabaseInUtc  string                               `tfschema:"time_reclamation_of_free_autonomous_database_in_utc"`
+	UsedDataStorageSizeInGb                       int64                                `tfschema:"used_data_storage_size_in_gb"`
+	UsedDataStorageSizeInTb                       int64                                `tfschema:"used_data_storage_size_in_tb"`
 }
 
 func (AutonomousDatabaseCloneFromBackupDataSource) Arguments() map[string]*pluginsdk.Schema {
@@ -508,7 +508,7 @@ func (AutonomousDatabaseCloneFromBackupDataSource) Read() sdk.ResourceFunc {
 				state.LifecycleState = pointer.FromEnum(props.LifecycleState)
 				state.LocalAdgAutoFailoverMaxDataLossLimitInSeconds = pointer.From(props.LocalAdgAutoFailoverMaxDataLossLimit)
 				state.LocalDataGuardEnabled = pointer.From(props.IsLocalDataGuardEnabled)
-				state.LongTermBackupSchedule = FlattenLongTermBackUpScheduleDetails(props.LongTermBackupSchedule)
+				state.LongTermBackupSchedule = FlattenLongTermBackUpScheduleCloneDetails(props.LongTermBackupSchedule)
 				state.MemoryAreaInGb = pointer.From(props.InMemoryAreaInGbs)
 				state.MemoryPerOracleComputeUnitInGb = pointer.From(props.MemoryPerOracleComputeUnitInGbs)
 				state.MtlsConnectionRequired = pointer.From(props.IsMtlsConnectionRequired)
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-09-01/autonomousdatabases"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -20,7 +21,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name oracle_autonomous_database_clone_from_backup -service-package-name oracle -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type AutonomousDatabaseCloneFromBackupResource
+
 var _ sdk.Resource = AutonomousDatabaseCloneFromBackupResource{}
+var _ sdk.ResourceWithIdentity = AutonomousDatabaseCloneFromBackupResource{}
+
+func (r AutonomousDatabaseCloneFromBackupResource) Identity() resourceids.ResourceId {
+	return &autonomousdatabases.AutonomousDatabaseId{}
+}
 
 type AutonomousDatabaseCloneFromBackupResource struct{}
 
@@ -378,6 +386,10 @@ func (r AutonomousDatabaseCloneFromBackupResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
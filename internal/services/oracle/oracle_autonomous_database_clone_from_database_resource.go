@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-09-01/autonomousdatabases"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -20,7 +21,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name oracle_autonomous_database_clone_from_database -service-package-name oracle -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type AutonomousDatabaseCloneFromDatabaseResource
+
 var _ sdk.ResourceWithCustomizeDiff = AutonomousDatabaseCloneFromDatabaseResource{}
+var _ sdk.ResourceWithIdentity = AutonomousDatabaseCloneFromDatabaseResource{}
+
+func (r AutonomousDatabaseCloneFromDatabaseResource) Identity() resourceids.ResourceId {
+	return &autonomousdatabases.AutonomousDatabaseId{}
+}
 
 type AutonomousDatabaseCloneFromDatabaseResource struct{}
 
@@ -384,6 +392,10 @@ func (r AutonomousDatabaseCloneFromDatabaseResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -90,6 +90,13 @@ type LongTermBackUpScheduleDetails struct {
 	Enabled               bool   `tfschema:"enabled"`
 }
 
+type LongTermBackUpScheduleCloneDetails struct {
+	RepeatCadence         string `tfschema:"repeat_cadence"`
+	TimeOfBackupInUtc     string `tfschema:"time_of_backup_in_utc"`
+	RetentionPeriodInDays int64  `tfschema:"retention_period_in_days"`
+	Enabled               bool   `tfschema:"enabled"`
+}
+
 func (d AutonomousDatabaseRegularDataSource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
@@ -413,7 +420,7 @@ func (d AutonomousDatabaseRegularDataSource) Attributes() map[string]*pluginsdk.
 			Type:     pluginsdk.TypeList,
 			Computed: true,
 			Elem: &pluginsdk.Schema{
-				Type: pluginsdk.TypeInt,
+				Type: pluginsdk.TypeString,
 			},
 		},
 
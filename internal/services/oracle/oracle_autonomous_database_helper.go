@@ -26,6 +26,19 @@ func FlattenLongTermBackUpScheduleDetails(longTermBackUpScheduleDetails *autonom
 	return output
 }
 
+func FlattenLongTermBackUpScheduleCloneDetails(longTermBackUpScheduleDetails *autonomousdatabases.LongTermBackUpScheduleDetails) []LongTermBackUpScheduleCloneDetails {
+	output := make([]LongTermBackUpScheduleCloneDetails, 0)
+	if longTermBackUpScheduleDetails != nil {
+		return append(output, LongTermBackUpScheduleCloneDetails{
+			RepeatCadence:         string(pointer.From(longTermBackUpScheduleDetails.RepeatCadence)),
+			TimeOfBackupInUtc:     pointer.From(longTermBackUpScheduleDetails.TimeOfBackup),
+			RetentionPeriodInDays: pointer.From(longTermBackUpScheduleDetails.RetentionPeriodInDays),
+			Enabled:               !pointer.From(longTermBackUpScheduleDetails.IsDisabled),
+		})
+	}
+	return output
+}
+
 // getBackupFromOCI retrieves a backups by making a direct API call to OCI.
 // It bypasses the standard client.Get() method because backup data is not
 // stored within Azure's metadata resource provider (MetaRp).
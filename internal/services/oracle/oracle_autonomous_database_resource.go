@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-09-01/autonomousdatabases"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/oracle/validate"
@@ -22,6 +23,9 @@ import (
 
 var _ sdk.Resource = AutonomousDatabaseRegularResource{}
 
+var _ sdk.ResourceWithIdentity = AutonomousDatabaseRegularResource{}
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name oracle_autonomous_database -service-package-name oracle -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type AdbsRegularResource
 type AutonomousDatabaseRegularResource struct{}
 
 type AutonomousDatabaseRegularResourceModel struct {
@@ -478,6 +482,10 @@ func (AutonomousDatabaseRegularResource) IDValidationFunc() pluginsdk.SchemaVali
 	return autonomousdatabases.ValidateAutonomousDatabaseID
 }
 
+func (AutonomousDatabaseRegularResource) Identity() resourceids.ResourceId {
+	return &autonomousdatabases.AutonomousDatabaseId{}
+}
+
 func expandAdbsCustomerContacts(customerContactsList []string) []autonomousdatabases.CustomerContact {
 	customerContacts := make([]autonomousdatabases.CustomerContact, 0, len(customerContactsList))
 	for _, customerContact := range customerContactsList {
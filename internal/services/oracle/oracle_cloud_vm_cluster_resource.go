@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-09-01/cloudexadatainfrastructures"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-09-01/cloudvmclusters"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,6 +25,9 @@ import (
 
 var _ sdk.Resource = CloudVmClusterResource{}
 
+var _ sdk.ResourceWithIdentity = CloudVmClusterResource{}
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name oracle_cloud_vm_cluster -service-package-name oracle -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type CloudVmClusterResource
 type CloudVmClusterResource struct{}
 
 type CloudVmClusterResourceModel struct {
@@ -571,6 +575,10 @@ func (CloudVmClusterResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return cloudvmclusters.ValidateCloudVMClusterID
 }
 
+func (CloudVmClusterResource) Identity() resourceids.ResourceId {
+	return &cloudvmclusters.CloudVMClusterId{}
+}
+
 func FlattenDataCollectionOptions(dataCollectionOptions *cloudvmclusters.DataCollectionOptions) []DataCollectionOptionsModel {
 	output := make([]DataCollectionOptionsModel, 0)
 	if dataCollectionOptions != nil {
@@ -86,7 +86,7 @@ func (d DbSystemShapesDataSource) Attributes() map[string]*pluginsdk.Schema {
 						Computed: true,
 					},
 					"available_data_storage_per_server_in_tbs": {
-						Type:     pluginsdk.TypeInt,
+						Type:     pluginsdk.TypeFloat,
 						Computed: true,
 					},
 					"available_db_node_per_node_in_gbs": {
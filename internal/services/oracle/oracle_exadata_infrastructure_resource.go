@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-09-01/cloudexadatainfrastructures"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -22,6 +23,9 @@ import (
 
 var _ sdk.Resource = ExadataInfraResource{}
 
+var _ sdk.ResourceWithIdentity = ExadataInfraResource{}
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name oracle_exadata_infrastructure -service-package-name oracle -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type ExadataInfraResource
 type ExadataInfraResource struct{}
 
 type ExadataInfraResourceModel struct {
@@ -383,6 +387,10 @@ func (ExadataInfraResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return cloudexadatainfrastructures.ValidateCloudExadataInfrastructureID
 }
 
+func (ExadataInfraResource) Identity() resourceids.ResourceId {
+	return &cloudexadatainfrastructures.CloudExadataInfrastructureId{}
+}
+
 func ExpandCustomerContacts(customerContactsList []string) []cloudexadatainfrastructures.CustomerContact {
 	customerContacts := make([]cloudexadatainfrastructures.CustomerContact, 0, len(customerContactsList))
 	for _, customerContact := range customerContactsList {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-09-01/exascaledbstoragevaults"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -22,6 +23,9 @@ import (
 
 var _ sdk.Resource = ExascaleDatabaseStorageVaultResource{}
 
+var _ sdk.ResourceWithIdentity = ExascaleDatabaseStorageVaultResource{}
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name oracle_exascale_database_storage_vault -service-package-name oracle -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type ExascaleDatabaseStorageVaultResource
 type ExascaleDatabaseStorageVaultResource struct{}
 
 type ExascaleDatabaseStorageVaultResourceModel struct {
@@ -276,3 +280,7 @@ func (ExascaleDatabaseStorageVaultResource) Delete() sdk.ResourceFunc {
 func (ExascaleDatabaseStorageVaultResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return exascaledbstoragevaults.ValidateExascaleDbStorageVaultID
 }
+
+func (ExascaleDatabaseStorageVaultResource) Identity() resourceids.ResourceId {
+	return &exascaledbstoragevaults.ExascaleDbStorageVaultId{}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-09-01/resourceanchors"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/oracle/validate"
@@ -19,6 +20,9 @@ import (
 
 var _ sdk.Resource = ResourceAnchorResource{}
 
+var _ sdk.ResourceWithIdentity = ResourceAnchorResource{}
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name oracle_resource_anchor -service-package-name oracle -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type ResourceAnchorResource
 type ResourceAnchorResource struct{}
 
 type ResourceAnchorResourceModel struct {
@@ -187,3 +191,7 @@ func (ResourceAnchorResource) Delete() sdk.ResourceFunc {
 func (ResourceAnchorResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return resourceanchors.ValidateResourceAnchorID
 }
+
+func (ResourceAnchorResource) Identity() resourceids.ResourceId {
+	return &resourceanchors.ResourceAnchorId{}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/certificateobjectlocalrulestack"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/localrulestacks"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -20,9 +21,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name palo_alto_local_rulestack_certificate -service-package-name paloalto -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,local_rulestack_name:id" -test-resource-type LocalRulestackCertificateResource
+
 type LocalRuleStackCertificate struct{}
 
 var _ sdk.ResourceWithUpdate = LocalRuleStackCertificate{}
+var _ sdk.ResourceWithIdentity = LocalRuleStackCertificate{}
 
 type LocalRuleStackCertificateModel struct {
 	Name                string `tfschema:"name"`
@@ -37,6 +41,10 @@ func (r LocalRuleStackCertificate) IDValidationFunc() pluginsdk.SchemaValidateFu
 	return certificateobjectlocalrulestack.ValidateLocalRulestackCertificateID
 }
 
+func (r LocalRuleStackCertificate) Identity() resourceids.ResourceId {
+	return &certificateobjectlocalrulestack.LocalRulestackCertificateId{}
+}
+
 func (r LocalRuleStackCertificate) ResourceType() string {
 	return "azurerm_palo_alto_local_rulestack_certificate"
 }
@@ -191,6 +199,10 @@ func (r LocalRuleStackCertificate) Read() sdk.ResourceFunc {
 				state.SelfSigned = boolEnumAsBoolCert(props.CertificateSelfSigned)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
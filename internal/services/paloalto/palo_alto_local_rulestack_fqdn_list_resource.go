@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/fqdnlistlocalrulestack"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/localrulestacks"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -20,9 +21,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name palo_alto_local_rulestack_fqdn_list -service-package-name paloalto -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,local_rulestack_name:id" -test-resource-type LocalRulestackFQDNList
+
 type LocalRulestackFQDNList struct{}
 
 var _ sdk.ResourceWithUpdate = LocalRulestackFQDNList{}
+var _ sdk.ResourceWithIdentity = LocalRulestackFQDNList{}
 
 type LocalRulestackFQDNListModel struct {
 	Name         string   `tfschema:"name"`
@@ -40,6 +44,10 @@ func (r LocalRulestackFQDNList) IDValidationFunc() pluginsdk.SchemaValidateFunc
 	return fqdnlistlocalrulestack.ValidateLocalRulestackFqdnListID
 }
 
+func (r LocalRulestackFQDNList) Identity() resourceids.ResourceId {
+	return &fqdnlistlocalrulestack.LocalRulestackFqdnListId{}
+}
+
 func (r LocalRulestackFQDNList) ResourceType() string {
 	return "azurerm_palo_alto_local_rulestack_fqdn_list"
 }
@@ -180,6 +188,10 @@ func (r LocalRulestackFQDNList) Read() sdk.ResourceFunc {
 				state.Description = pointer.From(props.Description)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	certificates "github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/certificateobjectlocalrulestack"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/localrulestacks"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name palo_alto_local_rulestack_outbound_trust_certificate_association -service-package-name paloalto -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,local_rulestack_name:id,name:id" -test-resource-type LocalRulestackOutboundTrustCertificateResource
+
 type LocalRulestackOutboundTrustCertificateAssociationResource struct{}
 
 type LocalRulestackOutboundTrustCertificateResourceModel struct {
@@ -25,11 +28,16 @@ type LocalRulestackOutboundTrustCertificateResourceModel struct {
 }
 
 var _ sdk.Resource = LocalRulestackOutboundTrustCertificateAssociationResource{}
+var _ sdk.ResourceWithIdentity = LocalRulestackOutboundTrustCertificateAssociationResource{}
 
 func (l LocalRulestackOutboundTrustCertificateAssociationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return certificates.ValidateLocalRulestackCertificateID
 }
 
+func (l LocalRulestackOutboundTrustCertificateAssociationResource) Identity() resourceids.ResourceId {
+	return &certificates.LocalRulestackCertificateId{}
+}
+
 func (l LocalRulestackOutboundTrustCertificateAssociationResource) ModelObject() interface{} {
 	return &LocalRulestackOutboundTrustCertificateResourceModel{}
 }
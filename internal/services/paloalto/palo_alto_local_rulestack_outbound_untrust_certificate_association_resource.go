@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	certificates "github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/certificateobjectlocalrulestack"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/localrulestacks"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name palo_alto_local_rulestack_outbound_untrust_certificate_association -service-package-name paloalto -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,local_rulestack_name:id,name:id" -test-resource-type LocalRulestackOutboundUnTrustCertificateResource
+
 type LocalRulestackOutboundUnTrustCertificateAssociationResource struct{}
 
 type LocalRulestackOutboundUnTrustCertificateResourceModel struct {
@@ -25,11 +28,16 @@ type LocalRulestackOutboundUnTrustCertificateResourceModel struct {
 }
 
 var _ sdk.Resource = LocalRulestackOutboundUnTrustCertificateAssociationResource{}
+var _ sdk.ResourceWithIdentity = LocalRulestackOutboundUnTrustCertificateAssociationResource{}
 
 func (l LocalRulestackOutboundUnTrustCertificateAssociationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return certificates.ValidateLocalRulestackCertificateID
 }
 
+func (l LocalRulestackOutboundUnTrustCertificateAssociationResource) Identity() resourceids.ResourceId {
+	return &certificates.LocalRulestackCertificateId{}
+}
+
 func (l LocalRulestackOutboundUnTrustCertificateAssociationResource) ModelObject() interface{} {
 	return &LocalRulestackOutboundUnTrustCertificateResourceModel{}
 }
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/localrulestacks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/prefixlistlocalrulestack"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -20,9 +21,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name palo_alto_local_rulestack_prefix_list -service-package-name paloalto -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,local_rulestack_name:id" -test-resource-type LocalRuleStackPrefixList
+
 type LocalRuleStackPrefixList struct{}
 
 var _ sdk.ResourceWithUpdate = LocalRuleStackPrefixList{}
+var _ sdk.ResourceWithIdentity = LocalRuleStackPrefixList{}
 
 type LocalRuleStackPrefixListModel struct {
 	Name         string   `tfschema:"name"`
@@ -36,6 +40,10 @@ func (r LocalRuleStackPrefixList) IDValidationFunc() pluginsdk.SchemaValidateFun
 	return prefixlistlocalrulestack.ValidateLocalRulestackPrefixListID
 }
 
+func (r LocalRuleStackPrefixList) Identity() resourceids.ResourceId {
+	return &prefixlistlocalrulestack.LocalRulestackPrefixListId{}
+}
+
 func (r LocalRuleStackPrefixList) ResourceType() string {
 	return "azurerm_palo_alto_local_rulestack_prefix_list"
 }
@@ -179,6 +187,10 @@ func (r LocalRuleStackPrefixList) Read() sdk.ResourceFunc {
 				state.Description = pointer.From(props.Description)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
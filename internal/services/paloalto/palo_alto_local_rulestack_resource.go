@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/localrulestacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -20,9 +21,16 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name palo_alto_local_rulestack -service-package-name paloalto -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type LocalRulestackResource
+
 type LocalRuleStack struct{}
 
 var _ sdk.ResourceWithUpdate = LocalRuleStack{}
+var _ sdk.ResourceWithIdentity = LocalRuleStack{}
+
+func (r LocalRuleStack) Identity() resourceids.ResourceId {
+	return &localrulestacks.LocalRulestackId{}
+}
 
 const (
 	RuleStackSecurityServicesCustom       string = "Custom"
@@ -259,6 +267,10 @@ func (r LocalRuleStack) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	certificates "github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/certificateobjectlocalrulestack"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/localrules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/localrulestacks"
@@ -24,9 +25,16 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name palo_alto_local_rulestack_rule -service-package-name paloalto -properties "name" -compare-values "subscription_id:id,resource_group_name:id,local_rulestack_name:id" -test-resource-type LocalRuleResource
+
 type LocalRuleStackRule struct{}
 
 var _ sdk.ResourceWithUpdate = LocalRuleStackRule{}
+var _ sdk.ResourceWithIdentity = LocalRuleStackRule{}
+
+func (r LocalRuleStackRule) Identity() resourceids.ResourceId {
+	return &localrules.LocalRuleId{}
+}
 
 var protocolApplicationDefault = "application-default"
 
@@ -352,6 +360,10 @@ func (r LocalRuleStackRule) Read() sdk.ResourceFunc {
 				state.Tags = flattenTagsFromRule(props.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
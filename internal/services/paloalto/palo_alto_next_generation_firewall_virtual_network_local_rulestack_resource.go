@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/localrulestacks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-05-23/firewalls"
@@ -24,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name palo_alto_next_generation_firewall_virtual_network_local_rulestack -service-package-name paloalto -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type NextGenerationFirewallVnetResource
+
 type NextGenerationFirewallVNetLocalRulestackResource struct{}
 
 type NextGenerationFirewallVnetLocalRulestackModel struct {
@@ -39,6 +42,11 @@ type NextGenerationFirewallVnetLocalRulestackModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = NextGenerationFirewallVNetLocalRulestackResource{}
+var _ sdk.ResourceWithIdentity = NextGenerationFirewallVNetLocalRulestackResource{}
+
+func (r NextGenerationFirewallVNetLocalRulestackResource) Identity() resourceids.ResourceId {
+	return &firewalls.FirewallId{}
+}
 
 func (r NextGenerationFirewallVNetLocalRulestackResource) ModelObject() interface{} {
 	return &NextGenerationFirewallVnetLocalRulestackModel{}
@@ -217,6 +225,10 @@ func (r NextGenerationFirewallVNetLocalRulestackResource) Read() sdk.ResourceFun
 				state.Tags = tags.Flatten(existing.Model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
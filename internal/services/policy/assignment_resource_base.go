@@ -126,6 +126,17 @@ func (br assignmentBaseResource) createFunc(resourceName, scopeFieldName string)
 	}
 }
 
+// importerFunc ensures the scope of the Policy Assignment being imported matches the type of resource, since the
+// resource identity for each Policy Assignment resource uses the same (scoped) Resource ID
+func (br assignmentBaseResource) importerFunc(validateFunc pluginsdk.SchemaValidateFunc) sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		if _, errs := validateFunc(metadata.ResourceData.Id(), "id"); len(errs) > 0 {
+			return errs[0]
+		}
+		return nil
+	}
+}
+
 func (br assignmentBaseResource) deleteFunc() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
@@ -217,7 +228,7 @@ func (br assignmentBaseResource) readFunc(scopeFieldName string) sdk.ResourceFun
 				metadata.ResourceData.Set("resource_selectors", resourceSel)
 			}
 
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, id)
 		},
 		Timeout: 5 * time.Minute,
 	}
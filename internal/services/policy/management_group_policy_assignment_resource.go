@@ -6,6 +6,8 @@ package policy
 import (
 	"regexp"
 
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-06-01/policyassignments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	managementGroupValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/validate"
@@ -13,7 +15,13 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
-var _ sdk.ResourceWithUpdate = ManagementGroupAssignmentResource{}
+var (
+	_ sdk.ResourceWithUpdate         = ManagementGroupAssignmentResource{}
+	_ sdk.ResourceWithIdentity       = ManagementGroupAssignmentResource{}
+	_ sdk.ResourceWithCustomImporter = ManagementGroupAssignmentResource{}
+)
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name management_group_policy_assignment -service-package-name policy -properties "name,scope:management_group_id" -test-name withBuiltInPolicyBasic -test-resource-type ManagementGroupAssignmentTestResource
 
 type ManagementGroupAssignmentResource struct {
 	base assignmentBaseResource
@@ -54,6 +62,14 @@ func (r ManagementGroupAssignmentResource) Delete() sdk.ResourceFunc {
 	return r.base.deleteFunc()
 }
 
+func (r ManagementGroupAssignmentResource) Identity() resourceids.ResourceId {
+	return &policyassignments.ScopedPolicyAssignmentId{}
+}
+
+func (r ManagementGroupAssignmentResource) CustomImporter() sdk.ResourceRunFunc {
+	return r.base.importerFunc(r.IDValidationFunc())
+}
+
 func (r ManagementGroupAssignmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return validate.ManagementGroupAssignmentID
 }
@@ -11,12 +11,15 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2025-01-01/policysetdefinitions"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name management_group_policy_set_definition -service-package-name policy -properties "name" -compare-values "management_group_name:id" -test-resource-type ManagementGroupPolicySetDefinitionResourceTest
+
 type ManagementGroupPolicySetDefinitionResource struct{}
 
 type ManagementGroupPolicySetDefinitionResourceModel struct {
@@ -34,8 +37,13 @@ type ManagementGroupPolicySetDefinitionResourceModel struct {
 var (
 	_ sdk.ResourceWithUpdate        = ManagementGroupPolicySetDefinitionResource{}
 	_ sdk.ResourceWithCustomizeDiff = ManagementGroupPolicySetDefinitionResource{}
+	_ sdk.ResourceWithIdentity      = ManagementGroupPolicySetDefinitionResource{}
 )
 
+func (r ManagementGroupPolicySetDefinitionResource) Identity() resourceids.ResourceId {
+	return &policysetdefinitions.Providers2PolicySetDefinitionId{}
+}
+
 func (r ManagementGroupPolicySetDefinitionResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -236,6 +244,10 @@ func (r ManagementGroupPolicySetDefinitionResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &ResourceGroupPolicyRemediationId{}
+
 type ResourceGroupPolicyRemediationId struct {
 	SubscriptionId  string
 	ResourceGroup   string
@@ -41,6 +43,39 @@ func (id ResourceGroupPolicyRemediationId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.RemediationName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ResourceGroupPolicyRemediation ID
+func (id ResourceGroupPolicyRemediationId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftPolicyInsights", "Microsoft.PolicyInsights", "Microsoft.PolicyInsights"),
+		resourceids.StaticSegment("staticRemediations", "remediations", "remediations"),
+		resourceids.UserSpecifiedSegment("remediationName", "remediation1"),
+	}
+}
+
+// FromParseResult populates the fields of this ResourceGroupPolicyRemediation ID from the ParseResult, for use with resourceids.Parser
+func (id *ResourceGroupPolicyRemediationId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.RemediationName, ok = input.Parsed["remediationName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "remediationName", input)
+	}
+
+	return nil
+}
+
 // ResourceGroupPolicyRemediationID parses a ResourceGroupPolicyRemediation ID into an ResourceGroupPolicyRemediationId struct
 func ResourceGroupPolicyRemediationID(input string) (*ResourceGroupPolicyRemediationId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
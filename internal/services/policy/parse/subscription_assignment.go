@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &SubscriptionAssignmentId{}
+
 type SubscriptionAssignmentId struct {
 	SubscriptionId       string
 	PolicyAssignmentName string
@@ -38,6 +40,33 @@ func (id SubscriptionAssignmentId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.PolicyAssignmentName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SubscriptionAssignment ID
+func (id SubscriptionAssignmentId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAuthorization", "Microsoft.Authorization", "Microsoft.Authorization"),
+		resourceids.StaticSegment("staticPolicyAssignments", "policyAssignments", "policyAssignments"),
+		resourceids.UserSpecifiedSegment("policyAssignmentName", "assignment1"),
+	}
+}
+
+// FromParseResult populates the fields of this SubscriptionAssignment ID from the ParseResult, for use with resourceids.Parser
+func (id *SubscriptionAssignmentId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.PolicyAssignmentName, ok = input.Parsed["policyAssignmentName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "policyAssignmentName", input)
+	}
+
+	return nil
+}
+
 // SubscriptionAssignmentID parses a SubscriptionAssignment ID into an SubscriptionAssignmentId struct
 func SubscriptionAssignmentID(input string) (*SubscriptionAssignmentId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -25,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name resource_group_policy_exemption -service-package-name policy -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,name:id"
+
 func resourceArmResourceGroupPolicyExemption() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceArmResourceGroupPolicyExemptionCreateUpdate,
@@ -32,10 +34,11 @@ func resourceArmResourceGroupPolicyExemption() *pluginsdk.Resource {
 		Update: resourceArmResourceGroupPolicyExemptionCreateUpdate,
 		Delete: resourceArmResourceGroupPolicyExemptionDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.ResourceGroupPolicyExemptionID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.ResourceGroupPolicyExemptionId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.ResourceGroupPolicyExemptionId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -218,7 +221,7 @@ func resourceArmResourceGroupPolicyExemptionRead(d *pluginsdk.ResourceData, meta
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceArmResourceGroupPolicyExemptionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,9 +12,9 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/policyinsights/2021-10-01/remediations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/validate"
 	resourceParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
 	resourceValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
@@ -24,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name resource_group_policy_remediation -service-package-name policy -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id"
+
 func resourceArmResourceGroupPolicyRemediation() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceArmResourceGroupPolicyRemediationCreateUpdate,
@@ -31,10 +33,11 @@ func resourceArmResourceGroupPolicyRemediation() *pluginsdk.Resource {
 		Update: resourceArmResourceGroupPolicyRemediationCreateUpdate,
 		Delete: resourceArmResourceGroupPolicyRemediationDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.ResourceGroupPolicyRemediationID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&remediations.ProviderRemediationId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&remediations.ProviderRemediationId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -179,7 +182,11 @@ func resourceArmResourceGroupPolicyRemediationRead(d *pluginsdk.ResourceData, me
 	d.Set("name", id.RemediationName)
 	d.Set("resource_group_id", resourceGroupId.ID())
 
-	return setRemediationProperties(d, resp.Model.Properties)
+	if err := setRemediationProperties(d, resp.Model.Properties); err != nil {
+		return err
+	}
+
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceArmResourceGroupPolicyRemediationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -6,13 +6,21 @@ package policy
 import (
 	"regexp"
 
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-06-01/policyassignments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
-var _ sdk.ResourceWithUpdate = ResourceAssignmentResource{}
+var (
+	_ sdk.ResourceWithUpdate         = ResourceAssignmentResource{}
+	_ sdk.ResourceWithIdentity       = ResourceAssignmentResource{}
+	_ sdk.ResourceWithCustomImporter = ResourceAssignmentResource{}
+)
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name resource_policy_assignment -service-package-name policy -properties "name,scope:resource_id" -test-name withBuiltInPolicyBasic -test-resource-type ResourceAssignmentTestResource
 
 type ResourceAssignmentResource struct {
 	base assignmentBaseResource
@@ -54,6 +62,14 @@ func (r ResourceAssignmentResource) Delete() sdk.ResourceFunc {
 	return r.base.deleteFunc()
 }
 
+func (r ResourceAssignmentResource) Identity() resourceids.ResourceId {
+	return &policyassignments.ScopedPolicyAssignmentId{}
+}
+
+func (r ResourceAssignmentResource) CustomImporter() sdk.ResourceRunFunc {
+	return r.base.importerFunc(r.IDValidationFunc())
+}
+
 func (r ResourceAssignmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return validate.ResourceAssignmentId()
 }
@@ -24,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name subscription_policy_exemption -service-package-name policy -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "name:id"
+
 func resourceArmSubscriptionPolicyExemption() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceArmSubscriptionPolicyExemptionCreateUpdate,
@@ -31,10 +33,11 @@ func resourceArmSubscriptionPolicyExemption() *pluginsdk.Resource {
 		Update: resourceArmSubscriptionPolicyExemptionCreateUpdate,
 		Delete: resourceArmSubscriptionPolicyExemptionDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.SubscriptionPolicyExemptionID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.SubscriptionPolicyExemptionId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.SubscriptionPolicyExemptionId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -216,7 +219,7 @@ func resourceArmSubscriptionPolicyExemptionRead(d *pluginsdk.ResourceData, meta
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceArmSubscriptionPolicyExemptionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,6 +21,24 @@ func TestDashboardIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDashboardIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&DashboardId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Portal/dashboards/dashboard1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual DashboardId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Portal/dashboards/dashboard1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestDashboardID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/portal/2019-01-01-preview/dashboard"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -23,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name portal_dashboard -service-package-name portal -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourcePortalDashboard() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourcePortalDashboardCreateUpdate,
@@ -30,10 +33,11 @@ func resourcePortalDashboard() *pluginsdk.Resource {
 		Update: resourcePortalDashboardCreateUpdate,
 		Delete: resourcePortalDashboardDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := dashboard.ParseDashboardID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&dashboard.DashboardId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&dashboard.DashboardId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -142,10 +146,12 @@ func resourcePortalDashboardRead(d *pluginsdk.ResourceData, meta interface{}) er
 			d.Set("dashboard_properties", string(v))
 		}
 
-		return tags.FlattenAndSet(d, model.Tags)
+		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+			return err
+		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourcePortalDashboardDelete(d *pluginsdk.ResourceData, meta interface{}) error {
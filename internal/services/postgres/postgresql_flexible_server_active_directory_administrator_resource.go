@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2024-08-01/administrators"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -20,15 +21,18 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name postgresql_flexible_server_active_directory_administrator -service-package-name postgres -properties "resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "flexible_server_name:id,object_id:object_id" -test-resource-type PostgresqlFlexibleServerAdministratorResource
+
 func resourcePostgresqlFlexibleServerAdministrator() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourcePostgresqlFlexibleServerAdministratorCreate,
-		Read:   resourcePostgresqlFlexibleServerAdministratorRead,
-		Delete: resourcePostgresqlFlexibleServerAdministratorDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := administrators.ParseAdministratorID(id)
-			return err
-		}),
+		Create:   resourcePostgresqlFlexibleServerAdministratorCreate,
+		Read:     resourcePostgresqlFlexibleServerAdministratorRead,
+		Delete:   resourcePostgresqlFlexibleServerAdministratorDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&administrators.AdministratorId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&administrators.AdministratorId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -154,7 +158,7 @@ func resourcePostgresqlFlexibleServerAdministratorRead(d *pluginsdk.ResourceData
 		d.Set("tenant_id", props.TenantId)
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourcePostgresqlFlexibleServerAdministratorDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2024-08-01/backups"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -18,7 +19,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name postgresql_flexible_server_backup -service-package-name postgres -properties "name" -compare-values "subscription_id:id,resource_group_name:id,flexible_server_name:id"
+
 var _ sdk.Resource = PostgresqlFlexibleServerBackupResource{}
+var _ sdk.ResourceWithIdentity = PostgresqlFlexibleServerBackupResource{}
+
+func (r PostgresqlFlexibleServerBackupResource) Identity() resourceids.ResourceId {
+	return &backups.BackupId{}
+}
 
 type PostgresqlFlexibleServerBackupResource struct{}
 
@@ -132,6 +140,10 @@ func (r PostgresqlFlexibleServerBackupResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
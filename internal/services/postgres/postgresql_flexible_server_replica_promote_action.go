@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2024-08-01/servers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type PostgresqlFlexibleServerReplicaPromoteAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &PostgresqlFlexibleServerReplicaPromoteAction{}
+
+func newPostgresqlFlexibleServerReplicaPromoteAction() action.Action {
+	return &PostgresqlFlexibleServerReplicaPromoteAction{}
+}
+
+type PostgresqlFlexibleServerReplicaPromoteActionModel struct {
+	ReplicaServerId types.String `tfsdk:"replica_server_id"`
+	PromoteMode     types.String `tfsdk:"promote_mode"`
+	PromoteOption   types.String `tfsdk:"promote_option"`
+}
+
+func (a *PostgresqlFlexibleServerReplicaPromoteAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"replica_server_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the PostgreSQL Flexible Server replica to promote.",
+				MarkdownDescription: "The ID of the PostgreSQL Flexible Server replica to promote.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: servers.ValidateFlexibleServerID,
+					},
+				},
+			},
+
+			"promote_mode": schema.StringAttribute{
+				Required:            true,
+				Description:         "Whether the replica should be promoted to a standalone server or switched over with its primary. Possible values are `standalone` and `switchover`.",
+				MarkdownDescription: "Whether the replica should be promoted to a standalone server or switched over with its primary. Possible values are `standalone` and `switchover`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(servers.PossibleValuesForReadReplicaPromoteMode()...),
+				},
+			},
+
+			"promote_option": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Whether the promotion should wait for the replica to catch up with its primary (`planned`) or happen immediately (`forced`). Possible values are `planned` and `forced`. Defaults to `planned`.",
+				MarkdownDescription: "Whether the promotion should wait for the replica to catch up with its primary (`planned`) or happen immediately (`forced`). Possible values are `planned` and `forced`. Defaults to `planned`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(servers.PossibleValuesForReplicationPromoteOption()...),
+				},
+			},
+		},
+	}
+}
+
+func (a *PostgresqlFlexibleServerReplicaPromoteAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_postgresql_flexible_server_replica_promote"
+}
+
+func (a *PostgresqlFlexibleServerReplicaPromoteAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.Postgres.FlexibleServersClient
+
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*60)
+	defer cancel()
+
+	model := PostgresqlFlexibleServerReplicaPromoteActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := servers.ParseFlexibleServerID(model.ReplicaServerId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	promoteMode := servers.ReadReplicaPromoteMode(model.PromoteMode.ValueString())
+	promoteOption := servers.ReplicationPromoteOptionPlanned
+	if v := model.PromoteOption.ValueString(); v != "" {
+		promoteOption = servers.ReplicationPromoteOption(v)
+	}
+
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("retrieving %s: %+v", id, err))
+		return
+	}
+
+	if existing.Model == nil || existing.Model.Properties == nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("retrieving %s: `properties` was nil", id))
+		return
+	}
+
+	switch pointer.From(existing.Model.Properties.ReplicationRole) {
+	case servers.ReplicationRoleAsyncReplica, servers.ReplicationRoleGeoAsyncReplica:
+	default:
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("%s is not a replica and cannot be promoted", id))
+		return
+	}
+
+	// both the replica and its primary are updated during a promotion
+	if v := pointer.From(existing.Model.Properties.SourceServerResourceId); v != "" {
+		if sourceServerId, err := servers.ParseFlexibleServerIDInsensitively(v); err == nil {
+			locks.ByName(sourceServerId.FlexibleServerName, postgresqlFlexibleServerResourceName)
+			defer locks.UnlockByName(sourceServerId.FlexibleServerName, postgresqlFlexibleServerResourceName)
+		}
+	}
+
+	locks.ByName(id.FlexibleServerName, postgresqlFlexibleServerResourceName)
+	defer locks.UnlockByName(id.FlexibleServerName, postgresqlFlexibleServerResourceName)
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("promoting %s (mode: %s, option: %s)", id.FlexibleServerName, promoteMode, promoteOption),
+	})
+
+	parameters := servers.ServerForUpdate{
+		Properties: &servers.ServerPropertiesForUpdate{
+			Replica: &servers.Replica{
+				Role:          pointer.To(servers.ReplicationRolePrimary),
+				PromoteMode:   pointer.To(promoteMode),
+				PromoteOption: pointer.To(promoteOption),
+			},
+		},
+	}
+
+	if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("promoting %s: %+v", id, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("promotion of %s completed", id.FlexibleServerName),
+	})
+}
+
+func (a *PostgresqlFlexibleServerReplicaPromoteAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(ctx, request, response)
+}
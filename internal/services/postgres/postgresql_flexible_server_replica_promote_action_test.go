@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type PostgresqlFlexibleServerReplicaPromoteAction struct{}
+
+func TestAccPostgresqlFlexibleServerReplicaPromoteAction_standalone(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_postgresql_flexible_server_replica", "test")
+	a := PostgresqlFlexibleServerReplicaPromoteAction{}
+	r := PostgresqlFlexibleServerReplicaResource{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: r.template(data),
+			},
+			{
+				PreConfig: func() { time.Sleep(15 * time.Minute) },
+				Config:    r.basic(data),
+			},
+			{
+				Config: a.promote(data, "standalone", "planned"),
+			},
+			{
+				// refresh to pick up the role of the promoted server
+				Config: a.promote(data, "standalone", "planned"),
+				Check: acceptance.ComposeTestCheckFunc(
+					check.That(data.ResourceName).ExistsInAzure(r),
+					check.That(data.ResourceName).Key("replication_role").MatchesRegex(regexp.MustCompile("^(None|Primary)$")),
+				),
+			},
+			// the source server is no longer returned once promoted
+			data.ImportStep("source_server_id"),
+		},
+	})
+}
+
+func (a PostgresqlFlexibleServerReplicaPromoteAction) promote(data acceptance.TestData, mode string, option string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_postgresql_flexible_server_replica.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_postgresql_flexible_server_replica_promote.test]
+    }
+  }
+}
+
+action "azurerm_postgresql_flexible_server_replica_promote" "test" {
+  config {
+    replica_server_id = azurerm_postgresql_flexible_server_replica.test.id
+    promote_mode      = "%s"
+    promote_option    = "%s"
+  }
+}
+`, PostgresqlFlexibleServerReplicaResource{}.basic(data), mode, option)
+}
@@ -0,0 +1,395 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2024-08-01/servers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2024-06-01/privatezones"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+)
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name postgresql_flexible_server_replica -service-package-name postgres -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type PostgresqlFlexibleServerReplicaResource
+
+var (
+	_ sdk.ResourceWithUpdate        = PostgresqlFlexibleServerReplicaResource{}
+	_ sdk.ResourceWithIdentity      = PostgresqlFlexibleServerReplicaResource{}
+	_ sdk.ResourceWithCustomizeDiff = PostgresqlFlexibleServerReplicaResource{}
+)
+
+type PostgresqlFlexibleServerReplicaResource struct{}
+
+type PostgresqlFlexibleServerReplicaResourceModel struct {
+	Name                       string            `tfschema:"name"`
+	ResourceGroupName          string            `tfschema:"resource_group_name"`
+	Location                   string            `tfschema:"location"`
+	SourceServerId             string            `tfschema:"source_server_id"`
+	DelegatedSubnetId          string            `tfschema:"delegated_subnet_id"`
+	PrivateDnsZoneId           string            `tfschema:"private_dns_zone_id"`
+	SkuName                    string            `tfschema:"sku_name"`
+	Zone                       string            `tfschema:"zone"`
+	Tags                       map[string]string `tfschema:"tags"`
+	Fqdn                       string            `tfschema:"fqdn"`
+	PublicNetworkAccessEnabled bool              `tfschema:"public_network_access_enabled"`
+	ReplicationRole            string            `tfschema:"replication_role"`
+	ReplicationState           string            `tfschema:"replication_state"`
+	Version                    string            `tfschema:"version"`
+}
+
+func (r PostgresqlFlexibleServerReplicaResource) Identity() resourceids.ResourceId {
+	return &servers.FlexibleServerId{}
+}
+
+func (r PostgresqlFlexibleServerReplicaResource) ModelObject() interface{} {
+	return &PostgresqlFlexibleServerReplicaResourceModel{}
+}
+
+func (r PostgresqlFlexibleServerReplicaResource) ResourceType() string {
+	return "azurerm_postgresql_flexible_server_replica"
+}
+
+func (r PostgresqlFlexibleServerReplicaResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return servers.ValidateFlexibleServerID
+}
+
+func (r PostgresqlFlexibleServerReplicaResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.FlexibleServerName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		// this is only ForceNew whilst the server is a replica, see CustomizeDiff
+		"source_server_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: servers.ValidateFlexibleServerID,
+		},
+
+		"delegated_subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateSubnetID,
+		},
+
+		"private_dns_zone_id": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ForceNew:         true,
+			DiffSuppressFunc: suppress.CaseDifference,
+			ValidateFunc:     privatezones.ValidatePrivateDnsZoneID,
+		},
+
+		// defaults to the SKU of the source server when omitted
+		"sku_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validate.FlexibleServerSkuName,
+		},
+
+		"zone": commonschema.ZoneSingleOptionalForceNew(),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r PostgresqlFlexibleServerReplicaResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"fqdn": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"public_network_access_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"replication_role": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"replication_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r PostgresqlFlexibleServerReplicaResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Postgres.FlexibleServersClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model PostgresqlFlexibleServerReplicaResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := servers.NewFlexibleServerID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			sourceServerId, err := servers.ParseFlexibleServerID(model.SourceServerId)
+			if err != nil {
+				return err
+			}
+
+			// The source server will be Updating status when creating a replica
+			locks.ByName(sourceServerId.FlexibleServerName, postgresqlFlexibleServerResourceName)
+			defer locks.UnlockByName(sourceServerId.FlexibleServerName, postgresqlFlexibleServerResourceName)
+
+			sku, err := expandFlexibleServerSku(model.SkuName)
+			if err != nil {
+				return fmt.Errorf("expanding `sku_name`: %+v", err)
+			}
+
+			parameters := servers.Server{
+				Location: location.Normalize(model.Location),
+				Properties: &servers.ServerProperties{
+					CreateMode:             pointer.To(servers.CreateModeReplica),
+					SourceServerResourceId: pointer.To(sourceServerId.ID()),
+				},
+				Sku:  sku,
+				Tags: pointer.To(model.Tags),
+			}
+
+			if model.DelegatedSubnetId != "" || model.PrivateDnsZoneId != "" {
+				network := servers.Network{}
+				if model.DelegatedSubnetId != "" {
+					network.DelegatedSubnetResourceId = pointer.To(model.DelegatedSubnetId)
+				}
+				if model.PrivateDnsZoneId != "" {
+					network.PrivateDnsZoneArmResourceId = pointer.To(model.PrivateDnsZoneId)
+				}
+				parameters.Properties.Network = &network
+			}
+
+			if model.Zone != "" {
+				parameters.Properties.AvailabilityZone = pointer.To(model.Zone)
+			}
+
+			if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PostgresqlFlexibleServerReplicaResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Postgres.FlexibleServersClient
+
+			id, err := servers.ParseFlexibleServerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var config PostgresqlFlexibleServerReplicaResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := PostgresqlFlexibleServerReplicaResourceModel{
+				Name:              id.FlexibleServerName,
+				ResourceGroupName: id.ResourceGroupName,
+				// `sourceServerResourceId` is no longer returned once the replica has been promoted,
+				// so the value from state is retained to avoid the promoted server being recreated
+				SourceServerId: config.SourceServerId,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				skuName, err := flattenFlexibleServerSku(model.Sku)
+				if err != nil {
+					return fmt.Errorf("flattening `sku_name`: %+v", err)
+				}
+				state.SkuName = skuName
+
+				if props := model.Properties; props != nil {
+					if v := pointer.From(props.SourceServerResourceId); v != "" {
+						sourceServerId, err := servers.ParseFlexibleServerIDInsensitively(v)
+						if err != nil {
+							return err
+						}
+						state.SourceServerId = sourceServerId.ID()
+					}
+
+					state.Fqdn = pointer.From(props.FullyQualifiedDomainName)
+					state.Version = string(pointer.From(props.Version))
+					state.Zone = pointer.From(props.AvailabilityZone)
+					state.ReplicationRole = string(pointer.From(props.ReplicationRole))
+
+					if replica := props.Replica; replica != nil {
+						if replica.Role != nil {
+							state.ReplicationRole = string(*replica.Role)
+						}
+						state.ReplicationState = string(pointer.From(replica.ReplicationState))
+					}
+
+					if network := props.Network; network != nil {
+						state.DelegatedSubnetId = pointer.From(network.DelegatedSubnetResourceId)
+						state.PrivateDnsZoneId = pointer.From(network.PrivateDnsZoneArmResourceId)
+						state.PublicNetworkAccessEnabled = pointer.From(network.PublicNetworkAccess) == servers.ServerPublicNetworkAccessStateEnabled
+					}
+				}
+			}
+
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PostgresqlFlexibleServerReplicaResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Postgres.FlexibleServersClient
+
+			id, err := servers.ParseFlexibleServerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PostgresqlFlexibleServerReplicaResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByName(id.FlexibleServerName, postgresqlFlexibleServerResourceName)
+			defer locks.UnlockByName(id.FlexibleServerName, postgresqlFlexibleServerResourceName)
+
+			parameters := servers.ServerForUpdate{}
+
+			if metadata.ResourceData.HasChange("sku_name") {
+				sku, err := expandFlexibleServerSku(model.SkuName)
+				if err != nil {
+					return fmt.Errorf("expanding `sku_name`: %+v", err)
+				}
+				parameters.Sku = sku
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				parameters.Tags = pointer.To(model.Tags)
+			}
+
+			// `source_server_id` can only change once the replica has been promoted, in which case there's nothing to update
+			if parameters.Sku == nil && parameters.Tags == nil {
+				return nil
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PostgresqlFlexibleServerReplicaResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			if metadata.ResourceDiff.Id() == "" || !metadata.ResourceDiff.HasChange("source_server_id") {
+				return nil
+			}
+
+			// once promoted the server is no longer linked to the source server (which is then no longer returned by
+			// the API, for example when importing a promoted replica), so changing `source_server_id` is a no-op
+			switch servers.ReplicationRole(metadata.ResourceDiff.Get("replication_role").(string)) {
+			case servers.ReplicationRolePrimary, servers.ReplicationRoleNone:
+				return nil
+			}
+
+			return metadata.ResourceDiff.ForceNew("source_server_id")
+		},
+	}
+}
+
+func (r PostgresqlFlexibleServerReplicaResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Postgres.FlexibleServersClient
+
+			id, err := servers.ParseFlexibleServerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PostgresqlFlexibleServerReplicaResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// The source server will be Updating status when deleting a replica
+			if sourceServerId, err := servers.ParseFlexibleServerID(model.SourceServerId); err == nil {
+				locks.ByName(sourceServerId.FlexibleServerName, postgresqlFlexibleServerResourceName)
+				defer locks.UnlockByName(sourceServerId.FlexibleServerName, postgresqlFlexibleServerResourceName)
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
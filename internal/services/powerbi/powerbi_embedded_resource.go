@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/powerbidedicated/2021-01-01/capacities"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -23,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name powerbi_embedded -service-package-name powerbi -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type PowerBIEmbeddedResource
+
 func resourcePowerBIEmbedded() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourcePowerBIEmbeddedCreate,
@@ -37,10 +40,11 @@ func resourcePowerBIEmbedded() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := capacities.ParseCapacityID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&capacities.CapacityId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&capacities.CapacityId{}),
+		},
 
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
@@ -185,7 +189,7 @@ func resourcePowerBIEmbeddedRead(d *pluginsdk.ResourceData, meta interface{}) er
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourcePowerBIEmbeddedUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
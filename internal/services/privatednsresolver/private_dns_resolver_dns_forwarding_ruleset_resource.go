@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/dnsforwardingrulesets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/outboundendpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name private_dns_resolver_dns_forwarding_ruleset -service-package-name privatednsresolver -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type PrivateDNSResolverDnsForwardingRulesetResource
+
 type PrivateDNSResolverDnsForwardingRulesetModel struct {
 	Name                         string            `tfschema:"name"`
 	ResourceGroupName            string            `tfschema:"resource_group_name"`
@@ -29,6 +32,11 @@ type PrivateDNSResolverDnsForwardingRulesetModel struct {
 type PrivateDNSResolverDnsForwardingRulesetResource struct{}
 
 var _ sdk.ResourceWithUpdate = PrivateDNSResolverDnsForwardingRulesetResource{}
+var _ sdk.ResourceWithIdentity = PrivateDNSResolverDnsForwardingRulesetResource{}
+
+func (r PrivateDNSResolverDnsForwardingRulesetResource) Identity() resourceids.ResourceId {
+	return &dnsforwardingrulesets.DnsForwardingRulesetId{}
+}
 
 func (r PrivateDNSResolverDnsForwardingRulesetResource) ResourceType() string {
 	return "azurerm_private_dns_resolver_dns_forwarding_ruleset"
@@ -201,6 +209,10 @@ func (r PrivateDNSResolverDnsForwardingRulesetResource) Read() sdk.ResourceFunc
 				state.Tags = *model.Tags
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
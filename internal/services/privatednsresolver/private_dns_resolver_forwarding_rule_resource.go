@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/dnsforwardingrulesets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/forwardingrules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -16,6 +17,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name private_dns_resolver_forwarding_rule -service-package-name privatednsresolver -properties "name" -compare-values "subscription_id:id,resource_group_name:id,dns_forwarding_ruleset_name:id" -test-resource-type PrivateDNSResolverForwardingRuleResource
+
 type PrivateDNSResolverForwardingRuleModel struct {
 	Name                   string                 `tfschema:"name"`
 	DnsForwardingRulesetId string                 `tfschema:"dns_forwarding_ruleset_id"`
@@ -33,6 +36,11 @@ type TargetDnsServerModel struct {
 type PrivateDNSResolverForwardingRuleResource struct{}
 
 var _ sdk.ResourceWithUpdate = PrivateDNSResolverForwardingRuleResource{}
+var _ sdk.ResourceWithIdentity = PrivateDNSResolverForwardingRuleResource{}
+
+func (r PrivateDNSResolverForwardingRuleResource) Identity() resourceids.ResourceId {
+	return &forwardingrules.ForwardingRuleId{}
+}
 
 func (r PrivateDNSResolverForwardingRuleResource) ResourceType() string {
 	return "azurerm_private_dns_resolver_forwarding_rule"
@@ -263,6 +271,10 @@ func (r PrivateDNSResolverForwardingRuleResource) Read() sdk.ResourceFunc {
 
 			state.TargetDnsServers = flattenTargetDnsServerModel(&properties.TargetDnsServers)
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
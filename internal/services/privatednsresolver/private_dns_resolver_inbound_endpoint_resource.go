@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/dnsresolvers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/inboundendpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name private_dns_resolver_inbound_endpoint -service-package-name privatednsresolver -properties "name" -compare-values "subscription_id:id,resource_group_name:id,dns_resolver_name:id" -test-resource-type DNSResolverInboundEndpointResource
+
 type PrivateDNSResolverInboundEndpointModel struct {
 	Name                 string                 `tfschema:"name"`
 	PrivateDNSResolverId string                 `tfschema:"private_dns_resolver_id"`
@@ -37,6 +40,11 @@ type IPConfigurationModel struct {
 type PrivateDNSResolverInboundEndpointResource struct{}
 
 var _ sdk.ResourceWithUpdate = PrivateDNSResolverInboundEndpointResource{}
+var _ sdk.ResourceWithIdentity = PrivateDNSResolverInboundEndpointResource{}
+
+func (r PrivateDNSResolverInboundEndpointResource) Identity() resourceids.ResourceId {
+	return &inboundendpoints.InboundEndpointId{}
+}
 
 func (r PrivateDNSResolverInboundEndpointResource) ResourceType() string {
 	return "azurerm_private_dns_resolver_inbound_endpoint"
@@ -232,6 +240,10 @@ func (r PrivateDNSResolverInboundEndpointResource) Read() sdk.ResourceFunc {
 				state.Tags = *model.Tags
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -12,12 +12,15 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/dnsresolvers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name private_dns_resolver -service-package-name privatednsresolver -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type PrivateDNSResolverDnsResolverResource
+
 type PrivateDNSResolverDnsResolverModel struct {
 	Name              string            `tfschema:"name"`
 	ResourceGroupName string            `tfschema:"resource_group_name"`
@@ -29,6 +32,11 @@ type PrivateDNSResolverDnsResolverModel struct {
 type PrivateDNSResolverDnsResolverResource struct{}
 
 var _ sdk.ResourceWithUpdate = PrivateDNSResolverDnsResolverResource{}
+var _ sdk.ResourceWithIdentity = PrivateDNSResolverDnsResolverResource{}
+
+func (r PrivateDNSResolverDnsResolverResource) Identity() resourceids.ResourceId {
+	return &dnsresolvers.DnsResolverId{}
+}
 
 func (r PrivateDNSResolverDnsResolverResource) ResourceType() string {
 	return "azurerm_private_dns_resolver"
@@ -189,6 +197,10 @@ func (r PrivateDNSResolverDnsResolverResource) Read() sdk.ResourceFunc {
 				state.Tags = *model.Tags
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/dnsforwardingrulesets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/virtualnetworklinks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -17,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name private_dns_resolver_virtual_network_link -service-package-name privatednsresolver -properties "name" -compare-values "subscription_id:id,resource_group_name:id,dns_forwarding_ruleset_name:id" -test-resource-type PrivateDNSResolverVirtualNetworkLinkResource
+
 type PrivateDNSResolverVirtualNetworkLinkModel struct {
 	Name                   string            `tfschema:"name"`
 	DnsForwardingRulesetId string            `tfschema:"dns_forwarding_ruleset_id"`
@@ -27,6 +30,11 @@ type PrivateDNSResolverVirtualNetworkLinkModel struct {
 type PrivateDNSResolverVirtualNetworkLinkResource struct{}
 
 var _ sdk.ResourceWithUpdate = PrivateDNSResolverVirtualNetworkLinkResource{}
+var _ sdk.ResourceWithIdentity = PrivateDNSResolverVirtualNetworkLinkResource{}
+
+func (r PrivateDNSResolverVirtualNetworkLinkResource) Identity() resourceids.ResourceId {
+	return &virtualnetworklinks.VirtualNetworkLinkId{}
+}
 
 func (r PrivateDNSResolverVirtualNetworkLinkResource) ResourceType() string {
 	return "azurerm_private_dns_resolver_virtual_network_link"
@@ -203,6 +211,10 @@ func (r PrivateDNSResolverVirtualNetworkLinkResource) Read() sdk.ResourceFunc {
 
 			state.VirtualNetworkId = properties.VirtualNetwork.Id
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/subnets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/qumulostorage/2024-06-19/filesystems"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -22,7 +23,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name qumulo_file_system -service-package-name qumulo -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type FileSystemTestResource
+
 var _ sdk.ResourceWithUpdate = FileSystemResource{}
+var _ sdk.ResourceWithIdentity = FileSystemResource{}
+
+func (r FileSystemResource) Identity() resourceids.ResourceId {
+	return &filesystems.FileSystemId{}
+}
 
 type FileSystemResource struct{}
 
@@ -240,6 +248,10 @@ func (r FileSystemResource) Read() sdk.ResourceFunc {
 				state.SubnetId = subnetId.ID()
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/protectioncontainers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -23,15 +24,18 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name backup_container_storage_account -service-package-name recoveryservices -properties "resource_group_name,vault_name:recovery_vault_name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "backup_fabric_name:id,name:id" -test-resource-type BackupProtectionContainerStorageAccountResource
+
 func resourceBackupProtectionContainerStorageAccount() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceBackupProtectionContainerStorageAccountCreate,
-		Read:   resourceBackupProtectionContainerStorageAccountRead,
-		Delete: resourceBackupProtectionContainerStorageAccountDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := protectioncontainers.ParseProtectionContainerID(id)
-			return err
-		}),
+		Create:   resourceBackupProtectionContainerStorageAccountCreate,
+		Read:     resourceBackupProtectionContainerStorageAccountRead,
+		Delete:   resourceBackupProtectionContainerStorageAccountDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&protectioncontainers.ProtectionContainerId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&protectioncontainers.ProtectionContainerId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -150,7 +154,7 @@ func resourceBackupProtectionContainerStorageAccountRead(d *pluginsdk.ResourceDa
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceBackupProtectionContainerStorageAccountDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2024-10-01/protectionpolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -24,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name backup_policy_vm_workload -service-package-name recoveryservices -properties "resource_group_name,vault_name:recovery_vault_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type BackupProtectionPolicyVMWorkloadResource
+
 type BackupProtectionPolicyVMWorkloadModel struct {
 	Name               string             `tfschema:"name"`
 	ResourceGroupName  string             `tfschema:"resource_group_name"`
@@ -88,6 +91,11 @@ type Settings struct {
 type BackupProtectionPolicyVMWorkloadResource struct{}
 
 var _ sdk.ResourceWithUpdate = BackupProtectionPolicyVMWorkloadResource{}
+var _ sdk.ResourceWithIdentity = BackupProtectionPolicyVMWorkloadResource{}
+
+func (r BackupProtectionPolicyVMWorkloadResource) Identity() resourceids.ResourceId {
+	return &protectionpolicies.BackupPolicyId{}
+}
 
 func (r BackupProtectionPolicyVMWorkloadResource) ResourceType() string {
 	return "azurerm_backup_policy_vm_workload"
@@ -546,6 +554,10 @@ func (r BackupProtectionPolicyVMWorkloadResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -20,10 +20,10 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/backupprotecteditems"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/protecteditems"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/protectioncontainers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/parse"
 	recoveryServicesValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -31,6 +31,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name backup_protected_file_share -service-package-name recoveryservices -properties "resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "vault_name:id,backup_fabric_name:id,protection_container_name:id,name:id"
+
 func resourceBackupProtectedFileShare() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceBackupProtectedFileShareCreateUpdate,
@@ -38,10 +40,11 @@ func resourceBackupProtectedFileShare() *pluginsdk.Resource {
 		Update: resourceBackupProtectedFileShareCreateUpdate,
 		Delete: resourceBackupProtectedFileShareDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.ProtectedItemID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&protecteditems.ProtectedItemId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&protecteditems.ProtectedItemId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(80 * time.Minute),
@@ -291,7 +294,7 @@ func resourceBackupProtectedFileShareRead(d *pluginsdk.ResourceData, meta interf
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceBackupProtectedFileShareDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -30,6 +30,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name backup_protected_vm -service-package-name recoveryservices -properties "resource_group_name,vault_name:recovery_vault_name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "backup_fabric_name:id,protection_container_name:id,name:id"
+
 func resourceRecoveryServicesBackupProtectedVM() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceRecoveryServicesBackupProtectedVMCreate,
@@ -37,10 +39,11 @@ func resourceRecoveryServicesBackupProtectedVM() *pluginsdk.Resource {
 		Update: resourceRecoveryServicesBackupProtectedVMUpdate,
 		Delete: resourceRecoveryServicesBackupProtectedVMDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := protecteditems.ParseProtectedItemID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&protecteditems.ProtectedItemId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&protecteditems.ProtectedItemId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(120 * time.Minute),
@@ -232,7 +235,7 @@ func resourceRecoveryServicesBackupProtectedVMRead(d *pluginsdk.ResourceData, me
 	d.Set("resource_group_name", id.ResourceGroupName)
 	d.Set("recovery_vault_name", id.VaultName)
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceRecoveryServicesBackupProtectedVMUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &ProtectedItemId{}
+
 type ProtectedItemId struct {
 	SubscriptionId          string
 	ResourceGroup           string
@@ -50,6 +52,57 @@ func (id ProtectedItemId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VaultName, id.BackupFabricName, id.ProtectionContainerName, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ProtectedItem ID
+func (id ProtectedItemId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "group1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftRecoveryServices", "Microsoft.RecoveryServices", "Microsoft.RecoveryServices"),
+		resourceids.StaticSegment("staticVaults", "vaults", "vaults"),
+		resourceids.UserSpecifiedSegment("vaultName", "vault1"),
+		resourceids.StaticSegment("staticBackupFabrics", "backupFabrics", "backupFabrics"),
+		resourceids.UserSpecifiedSegment("backupFabricName", "Azure"),
+		resourceids.StaticSegment("staticProtectionContainers", "protectionContainers", "protectionContainers"),
+		resourceids.UserSpecifiedSegment("protectionContainerName", "container1"),
+		resourceids.StaticSegment("staticProtectedItems", "protectedItems", "protectedItems"),
+		resourceids.UserSpecifiedSegment("name", "protectedItem1"),
+	}
+}
+
+// FromParseResult populates the fields of this ProtectedItem ID from the ParseResult, for use with resourceids.Parser
+func (id *ProtectedItemId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.VaultName, ok = input.Parsed["vaultName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "vaultName", input)
+	}
+
+	if id.BackupFabricName, ok = input.Parsed["backupFabricName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "backupFabricName", input)
+	}
+
+	if id.ProtectionContainerName, ok = input.Parsed["protectionContainerName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "protectionContainerName", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
+
 // ProtectedItemID parses a ProtectedItem ID into an ProtectedItemId struct
 func ProtectedItemID(input string) (*ProtectedItemId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
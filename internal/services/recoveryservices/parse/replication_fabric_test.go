@@ -21,6 +21,24 @@ func TestReplicationFabricIDFormatter(t *testing.T) {
 	}
 }
 
+func TestReplicationFabricIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&ReplicationFabricId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.RecoveryServices/vaults/vault1/replicationFabrics/fabric1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual ReplicationFabricId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.RecoveryServices/vaults/vault1/replicationFabrics/fabric1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestReplicationFabricID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,24 @@ func TestReplicationNetworkMappingIDFormatter(t *testing.T) {
 	}
 }
 
+func TestReplicationNetworkMappingIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&ReplicationNetworkMappingId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.RecoveryServices/vaults/vault1/replicationFabrics/fabric1/replicationNetworks/network1/replicationNetworkMappings/mapping1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual ReplicationNetworkMappingId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.RecoveryServices/vaults/vault1/replicationFabrics/fabric1/replicationNetworks/network1/replicationNetworkMappings/mapping1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestReplicationNetworkMappingID(t *testing.T) {
 	testData := []struct {
 		Input    string
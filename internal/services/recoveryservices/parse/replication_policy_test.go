@@ -21,6 +21,24 @@ func TestReplicationPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestReplicationPolicyIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&ReplicationPolicyId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.RecoveryServices/vaults/vault1/replicationPolicies/policy1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual ReplicationPolicyId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.RecoveryServices/vaults/vault1/replicationPolicies/policy1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestReplicationPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,24 @@ func TestReplicationProtectionContainerIDFormatter(t *testing.T) {
 	}
 }
 
+func TestReplicationProtectionContainerIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&ReplicationProtectionContainerId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.RecoveryServices/vaults/vault1/replicationFabrics/fabric1/replicationProtectionContainers/container1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual ReplicationProtectionContainerId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.RecoveryServices/vaults/vault1/replicationFabrics/fabric1/replicationProtectionContainers/container1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestReplicationProtectionContainerID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/resourceguards"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservices/2024-01-01/vaults"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/resourceguardproxy"
@@ -25,9 +26,12 @@ const (
 	VaultGuardProxyDeleteRequestName = "default" // this name does not matter, this value comes from Portal.
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name recovery_services_vault_resource_guard_association -service-package-name recoveryservices -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,vault_name:id,name:id" -test-resource-type VaultResourceGuardAssociationResource
+
 type VaultGuardProxyResource struct{}
 
 var _ sdk.Resource = VaultGuardProxyResource{}
+var _ sdk.ResourceWithIdentity = VaultGuardProxyResource{}
 
 type VaultGuardProxyModel struct {
 	VaultId         string `tfschema:"vault_id"`
@@ -38,6 +42,10 @@ func (r VaultGuardProxyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc
 	return resourceguardproxy.ValidateBackupResourceGuardProxyID
 }
 
+func (r VaultGuardProxyResource) Identity() resourceids.ResourceId {
+	return &resourceguardproxy.BackupResourceGuardProxyId{}
+}
+
 func (r VaultGuardProxyResource) ModelObject() interface{} {
 	return &VaultGuardProxyModel{}
 }
@@ -135,6 +143,10 @@ func (r VaultGuardProxyResource) Read() sdk.ResourceFunc {
 				state.ResourceGuardId = pointer.From(resp.Model.Properties.ResourceGuardResourceId)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
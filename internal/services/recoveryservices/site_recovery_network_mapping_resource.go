@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationnetworkmappings"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -22,15 +23,18 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name site_recovery_network_mapping -service-package-name recoveryservices -properties "resource_group_name,vault_name:recovery_vault_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "replication_fabric_name:id,replication_network_name:id"
+
 func resourceSiteRecoveryNetworkMapping() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceSiteRecoveryNetworkMappingCreate,
-		Read:   resourceSiteRecoveryNetworkMappingRead,
-		Delete: resourceSiteRecoveryNetworkMappingDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := replicationnetworkmappings.ParseReplicationNetworkMappingID(id)
-			return err
-		}),
+		Create:   resourceSiteRecoveryNetworkMappingCreate,
+		Read:     resourceSiteRecoveryNetworkMappingRead,
+		Delete:   resourceSiteRecoveryNetworkMappingDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&replicationnetworkmappings.ReplicationNetworkMappingId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&replicationnetworkmappings.ReplicationNetworkMappingId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -177,7 +181,7 @@ func resourceSiteRecoveryNetworkMappingRead(d *pluginsdk.ResourceData, meta inte
 		d.Set("target_recovery_fabric_name", targetFabricId.Name)
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceSiteRecoveryNetworkMappingDelete(d *pluginsdk.ResourceData, meta interface{}) error {
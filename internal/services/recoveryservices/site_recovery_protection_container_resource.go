@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationprotectioncontainers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/validate"
@@ -18,15 +19,18 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name site_recovery_protection_container -service-package-name recoveryservices -properties "resource_group_name,vault_name:recovery_vault_name,replication_fabric_name:recovery_fabric_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceSiteRecoveryProtectionContainer() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceSiteRecoveryProtectionContainerCreate,
-		Read:   resourceSiteRecoveryProtectionContainerRead,
-		Delete: resourceSiteRecoveryProtectionContainerDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := replicationprotectioncontainers.ParseReplicationProtectionContainerID(id)
-			return err
-		}),
+		Create:   resourceSiteRecoveryProtectionContainerCreate,
+		Read:     resourceSiteRecoveryProtectionContainerRead,
+		Delete:   resourceSiteRecoveryProtectionContainerDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&replicationprotectioncontainers.ReplicationProtectionContainerId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&replicationprotectioncontainers.ReplicationProtectionContainerId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -122,7 +126,7 @@ func resourceSiteRecoveryProtectionContainerRead(d *pluginsdk.ResourceData, meta
 	d.Set("resource_group_name", id.ResourceGroupName)
 	d.Set("recovery_vault_name", id.VaultName)
 	d.Set("recovery_fabric_name", id.ReplicationFabricName)
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceSiteRecoveryProtectionContainerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -60,7 +60,7 @@ func (r SiteRecoveryReplicationRecoveryPlanDataSource) ResourceType() string {
 }
 
 func (r SiteRecoveryReplicationRecoveryPlanDataSource) ModelObject() interface{} {
-	return &SiteRecoveryReplicationRecoveryPlanModel{}
+	return &SiteRecoveryReplicationRecoveryPlanDataSourceModel{}
 }
 
 func (r SiteRecoveryReplicationRecoveryPlanDataSource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
@@ -297,50 +297,47 @@ func (r SiteRecoveryReplicationRecoveryPlanDataSource) Attributes() map[string]*
 	}
 }
 
-func dataSourceSiteRecoveryReplicationPlanActions() *pluginsdk.Schema {
-	return &pluginsdk.Schema{
-		Type: pluginsdk.TypeList,
-		Elem: &pluginsdk.Resource{
-			Schema: map[string]*schema.Schema{
-				"name": {
-					Type:     pluginsdk.TypeString,
-					Computed: true,
-				},
-				"type": {
-					Type:     pluginsdk.TypeString,
-					Computed: true,
-				},
-				"fail_over_directions": {
-					Type:     pluginsdk.TypeSet,
-					Computed: true,
-					Elem: &pluginsdk.Schema{
-						Type: pluginsdk.TypeString,
-					},
-				},
-				"fail_over_types": {
-					Type:     pluginsdk.TypeSet,
-					Computed: true,
-					Elem: &pluginsdk.Schema{
-						Type: pluginsdk.TypeString,
-					},
-				},
-				"runbook_id": {
-					Type:     pluginsdk.TypeString,
-					Computed: true,
-				},
-				"fabric_location": {
-					Type:     pluginsdk.TypeString,
-					Computed: true,
-				},
-				"manual_action_instruction": {
-					Type:     pluginsdk.TypeString,
-					Computed: true,
+func dataSourceSiteRecoveryReplicationPlanActions() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+			"fail_over_directions": {
+				Type:     pluginsdk.TypeSet,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
 				},
-				"script_path": {
-					Type:     pluginsdk.TypeString,
-					Computed: true,
+			},
+			"fail_over_types": {
+				Type:     pluginsdk.TypeSet,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
 				},
 			},
+			"runbook_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+			"fabric_location": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+			"manual_action_instruction": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+			"script_path": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationfabrics"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -21,9 +22,12 @@ type HyperVSiteModel struct {
 	RecoveryVaultId string `tfschema:"recovery_vault_id"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name site_recovery_services_vault_hyperv_site -service-package-name recoveryservices -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,vault_name:id" -test-resource-type HyperVSiteResource
+
 type HyperVSiteResource struct{}
 
 var _ sdk.Resource = HyperVSiteResource{}
+var _ sdk.ResourceWithIdentity = HyperVSiteResource{}
 
 func (r HyperVSiteResource) Arguments() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
@@ -124,6 +128,10 @@ func (r HyperVSiteResource) Read() sdk.ResourceFunc {
 			vaultId := replicationfabrics.NewVaultID(id.SubscriptionId, id.ResourceGroupName, id.VaultName)
 			state.RecoveryVaultId = vaultId.ID()
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -152,3 +160,7 @@ func (r HyperVSiteResource) Delete() sdk.ResourceFunc {
 func (r HyperVSiteResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return replicationfabrics.ValidateReplicationFabricID
 }
+
+func (r HyperVSiteResource) Identity() resourceids.ResourceId {
+	return &replicationfabrics.ReplicationFabricId{}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/redhatopenshift/2023-09-04/openshiftclusters"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -24,7 +25,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name redhat_openshift_cluster -service-package-name redhatopenshift -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type OpenShiftClusterResource
+
 var _ sdk.ResourceWithUpdate = RedHatOpenShiftCluster{}
+var _ sdk.ResourceWithIdentity = RedHatOpenShiftCluster{}
+
+func (r RedHatOpenShiftCluster) Identity() resourceids.ResourceId {
+	return &openshiftclusters.ProviderOpenShiftClusterId{}
+}
 
 type RedHatOpenShiftCluster struct{}
 
@@ -524,6 +532,10 @@ func (r RedHatOpenShiftCluster) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2024-11-01/rediscacheaccesspolicyassignments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -16,9 +17,16 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name redis_cache_access_policy_assignment -service-package-name redis -properties "name" -compare-values "subscription_id:id,resource_group_name:id,redis_name:id"
+
 type RedisCacheAccessPolicyAssignmentResource struct{}
 
 var _ sdk.Resource = RedisCacheAccessPolicyAssignmentResource{}
+var _ sdk.ResourceWithIdentity = RedisCacheAccessPolicyAssignmentResource{}
+
+func (r RedisCacheAccessPolicyAssignmentResource) Identity() resourceids.ResourceId {
+	return &rediscacheaccesspolicyassignments.AccessPolicyAssignmentId{}
+}
 
 type RedisCacheAccessPolicyAssignmentResourceModel struct {
 	Name             string `tfschema:"name"`
@@ -158,6 +166,10 @@ func (r RedisCacheAccessPolicyAssignmentResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -43,16 +43,20 @@ var skuWeight = map[string]int8{
 	"Premium":  3,
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name redis_cache -service-package-name redis -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name standard
+
 func resourceRedisCache() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceRedisCacheCreate,
 		Read:   resourceRedisCacheRead,
 		Update: resourceRedisCacheUpdate,
 		Delete: resourceRedisCacheDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := redisresources.ParseRediID(id)
-			return err
-		}),
+
+		Importer: pluginsdk.ImporterValidatingIdentity(&redisresources.RediId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&redisresources.RediId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(180 * time.Minute),
@@ -797,7 +801,7 @@ func resourceRedisCacheRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceRedisCacheDelete(d *pluginsdk.ResourceData, meta interface{}) error {
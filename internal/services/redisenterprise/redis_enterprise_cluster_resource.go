@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/redisenterprise/2024-10-01/redisenterprise"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -28,18 +29,21 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name redis_enterprise_cluster -service-package-name redisenterprise -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceRedisEnterpriseCluster() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		DeprecationMessage: "The `azurerm_redis_enterprise_cluster` resource has been deprecated in favor of `azurerm_managed_redis_cluster`",
 
-		Create: resourceRedisEnterpriseClusterCreate,
-		Read:   resourceRedisEnterpriseClusterRead,
-		Update: resourceRedisEnterpriseClusterUpdate,
-		Delete: resourceRedisEnterpriseClusterDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := redisenterprise.ParseRedisEnterpriseID(id)
-			return err
-		}),
+		Create:   resourceRedisEnterpriseClusterCreate,
+		Read:     resourceRedisEnterpriseClusterRead,
+		Update:   resourceRedisEnterpriseClusterUpdate,
+		Delete:   resourceRedisEnterpriseClusterDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&redisenterprise.RedisEnterpriseId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&redisenterprise.RedisEnterpriseId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -222,7 +226,7 @@ func resourceRedisEnterpriseClusterRead(d *pluginsdk.ResourceData, meta interfac
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceRedisEnterpriseClusterUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/hybridconnections"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name relay_hybrid_connection_authorization_rule -service-package-name relay -properties "resource_group_name,namespace_name,hybrid_connection_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceRelayHybridConnectionAuthorizationRule() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceRelayHybridConnectionAuthorizationRuleCreateUpdate,
@@ -25,10 +28,11 @@ func resourceRelayHybridConnectionAuthorizationRule() *pluginsdk.Resource {
 		Update: resourceRelayHybridConnectionAuthorizationRuleCreateUpdate,
 		Delete: resourceRelayHybridConnectionAuthorizationRuleDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := hybridconnections.ParseHybridConnectionAuthorizationRuleID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&hybridconnections.HybridConnectionAuthorizationRuleId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&hybridconnections.HybridConnectionAuthorizationRuleId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -142,7 +146,7 @@ func resourceRelayHybridConnectionAuthorizationRuleRead(d *pluginsdk.ResourceDat
 	d.Set("secondary_key", keysResp.Model.SecondaryKey)
 	d.Set("secondary_connection_string", keysResp.Model.SecondaryConnectionString)
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceRelayHybridConnectionAuthorizationRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
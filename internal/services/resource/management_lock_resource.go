@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/managementlocks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
@@ -19,16 +20,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name management_lock -service-package-name resource -properties "name,scope" -test-name resourceGroupReadOnlyBasic
+
 func resourceManagementLock() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceManagementLockCreate,
 		Read:   resourceManagementLockRead,
 		Delete: resourceManagementLockDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := managementlocks.ParseScopedLockID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&managementlocks.ScopedLockId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&managementlocks.ScopedLockId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -150,7 +154,7 @@ func resourceManagementLockRead(d *pluginsdk.ResourceData, meta interface{}) err
 		d.Set("notes", model.Properties.Notes)
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceManagementLockDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,6 +21,24 @@ func TestTemplateSpecVersionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestTemplateSpecVersionIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&TemplateSpecVersionId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/templateSpecRG/providers/Microsoft.Resources/templateSpecs/templateSpec1/versions/v1.0", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual TemplateSpecVersionId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/templateSpecRG/providers/Microsoft.Resources/templateSpecs/templateSpec1/versions/v1.0"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestTemplateSpecVersionID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -12,16 +12,24 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-10-01/deploymentscripts"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name resource_deployment_script_azure_cli -service-package-name resource -compare-values "subscription_id:id,resource_group_name:id,name:id" -test-resource-type ResourceDeploymentScriptAzureCLIResource
+
 type ResourceDeploymentScriptAzureCliModel ResourceDeploymentScriptModel
 
 type ResourceDeploymentScriptAzureCliResource struct{}
 
 var _ sdk.ResourceWithUpdate = ResourceDeploymentScriptAzureCliResource{}
+var _ sdk.ResourceWithIdentity = ResourceDeploymentScriptAzureCliResource{}
+
+func (r ResourceDeploymentScriptAzureCliResource) Identity() resourceids.ResourceId {
+	return &deploymentscripts.DeploymentScriptId{}
+}
 
 func (r ResourceDeploymentScriptAzureCliResource) ResourceType() string {
 	return "azurerm_resource_deployment_script_azure_cli"
@@ -220,6 +228,10 @@ func (r ResourceDeploymentScriptAzureCliResource) Read() sdk.ResourceFunc {
 				state.Tags = *model.Tags
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
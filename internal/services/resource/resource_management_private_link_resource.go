@@ -12,13 +12,21 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/resourcemanagementprivatelink"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name resource_management_private_link -service-package-name resource -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type ResourceManagementPrivateLinkTestResource
+
 var _ sdk.Resource = ResourceManagementPrivateLinkResource{}
+var _ sdk.ResourceWithIdentity = ResourceManagementPrivateLinkResource{}
+
+func (r ResourceManagementPrivateLinkResource) Identity() resourceids.ResourceId {
+	return &resourcemanagementprivatelink.ResourceManagementPrivateLinkId{}
+}
 
 type ResourceManagementPrivateLinkResource struct{}
 
@@ -124,6 +132,10 @@ func (r ResourceManagementPrivateLinkResource) Read() sdk.ResourceFunc {
 				schema.Location = location.NormalizeNilable(model.Location)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2025-05-01/services"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2025-05-01/sharedprivatelinkresources"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -20,13 +21,20 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name search_shared_private_link_service -service-package-name search -properties "name" -compare-values "subscription_id:id,resource_group_name:id,search_service_name:id"
+
 type SharedPrivateLinkServiceResource struct{}
 
 var (
-	_ sdk.Resource           = SharedPrivateLinkServiceResource{}
-	_ sdk.ResourceWithUpdate = SharedPrivateLinkServiceResource{}
+	_ sdk.Resource             = SharedPrivateLinkServiceResource{}
+	_ sdk.ResourceWithUpdate   = SharedPrivateLinkServiceResource{}
+	_ sdk.ResourceWithIdentity = SharedPrivateLinkServiceResource{}
 )
 
+func (r SharedPrivateLinkServiceResource) Identity() resourceids.ResourceId {
+	return &sharedprivatelinkresources.SharedPrivateLinkResourceId{}
+}
+
 type SharedPrivateLinkServiceModel struct {
 	Name             string `tfschema:"name"`
 	SearchServiceId  string `tfschema:"search_service_id"`
@@ -190,6 +198,10 @@ func (r SharedPrivateLinkServiceResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(state)
 		},
 		Timeout: 5 * time.Minute,
@@ -21,6 +21,24 @@ func TestAssessmentMetadataIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAssessmentMetadataIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&AssessmentMetadataId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Security/assessmentMetadata/metadata1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual AssessmentMetadataId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Security/assessmentMetadata/metadata1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestAssessmentMetadataID(t *testing.T) {
 	testData := []struct {
 		Input    string
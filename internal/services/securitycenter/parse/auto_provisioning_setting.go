@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &AutoProvisioningSettingId{}
+
 type AutoProvisioningSettingId struct {
 	SubscriptionId string
 	Name           string
@@ -38,6 +40,33 @@ func (id AutoProvisioningSettingId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this AutoProvisioningSetting ID
+func (id AutoProvisioningSettingId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSecurity", "Microsoft.Security", "Microsoft.Security"),
+		resourceids.StaticSegment("staticAutoProvisioningSettings", "autoProvisioningSettings", "autoProvisioningSettings"),
+		resourceids.UserSpecifiedSegment("name", "default"),
+	}
+}
+
+// FromParseResult populates the fields of this AutoProvisioningSetting ID from the ParseResult, for use with resourceids.Parser
+func (id *AutoProvisioningSettingId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
+
 // AutoProvisioningSettingID parses a AutoProvisioningSetting ID into an AutoProvisioningSettingId struct
 func AutoProvisioningSettingID(input string) (*AutoProvisioningSettingId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
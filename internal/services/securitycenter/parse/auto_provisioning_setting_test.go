@@ -21,6 +21,24 @@ func TestAutoProvisioningSettingIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAutoProvisioningSettingIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&AutoProvisioningSettingId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Security/autoProvisioningSettings/default", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual AutoProvisioningSettingId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Security/autoProvisioningSettings/default"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestAutoProvisioningSettingID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,24 @@ func TestAutomationIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAutomationIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&AutomationId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Security/automations/testAutomation1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual AutomationId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Security/automations/testAutomation1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestAutomationID(t *testing.T) {
 	testData := []struct {
 		Input    string
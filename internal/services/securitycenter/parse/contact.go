@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &ContactId{}
+
 type ContactId struct {
 	SubscriptionId      string
 	SecurityContactName string
@@ -38,6 +40,33 @@ func (id ContactId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.SecurityContactName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this Contact ID
+func (id ContactId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSecurity", "Microsoft.Security", "Microsoft.Security"),
+		resourceids.StaticSegment("staticSecurityContacts", "securityContacts", "securityContacts"),
+		resourceids.UserSpecifiedSegment("securityContactName", "contact1"),
+	}
+}
+
+// FromParseResult populates the fields of this Contact ID from the ParseResult, for use with resourceids.Parser
+func (id *ContactId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.SecurityContactName, ok = input.Parsed["securityContactName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "securityContactName", input)
+	}
+
+	return nil
+}
+
 // ContactID parses a Contact ID into an ContactId struct
 func ContactID(input string) (*ContactId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -21,6 +21,24 @@ func TestContactIDFormatter(t *testing.T) {
 	}
 }
 
+func TestContactIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&ContactId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Security/securityContacts/contact1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual ContactId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Security/securityContacts/contact1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestContactID(t *testing.T) {
 	testData := []struct {
 		Input    string
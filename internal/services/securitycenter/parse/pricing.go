@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &PricingId{}
+
 type PricingId struct {
 	SubscriptionId string
 	Name           string
@@ -38,6 +40,33 @@ func (id PricingId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this Pricing ID
+func (id PricingId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSecurity", "Microsoft.Security", "Microsoft.Security"),
+		resourceids.StaticSegment("staticPricings", "pricings", "pricings"),
+		resourceids.UserSpecifiedSegment("name", "pricing1"),
+	}
+}
+
+// FromParseResult populates the fields of this Pricing ID from the ParseResult, for use with resourceids.Parser
+func (id *PricingId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
+
 // PricingID parses a Pricing ID into an PricingId struct
 func PricingID(input string) (*PricingId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -21,6 +21,24 @@ func TestPricingIDFormatter(t *testing.T) {
 	}
 }
 
+func TestPricingIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&PricingId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Security/pricings/pricing1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual PricingId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Security/pricings/pricing1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestPricingID(t *testing.T) {
 	testData := []struct {
 		Input    string
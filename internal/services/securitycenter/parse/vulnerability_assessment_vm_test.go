@@ -21,6 +21,24 @@ func TestVulnerabilityAssessmentVmIDFormatter(t *testing.T) {
 	}
 }
 
+func TestVulnerabilityAssessmentVmIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&VulnerabilityAssessmentVmId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/vm-name1/providers/Microsoft.Security/serverVulnerabilityAssessments/default1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual VulnerabilityAssessmentVmId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/vm-name1/providers/Microsoft.Security/serverVulnerabilityAssessments/default1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestVulnerabilityAssessmentVmID(t *testing.T) {
 	testData := []struct {
 		Input    string
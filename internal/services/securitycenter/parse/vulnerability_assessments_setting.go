@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &VulnerabilityAssessmentsSettingId{}
+
 type VulnerabilityAssessmentsSettingId struct {
 	SubscriptionId                            string
 	ServerVulnerabilityAssessmentsSettingName string
@@ -38,6 +40,33 @@ func (id VulnerabilityAssessmentsSettingId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ServerVulnerabilityAssessmentsSettingName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this VulnerabilityAssessmentsSetting ID
+func (id VulnerabilityAssessmentsSettingId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSecurity", "Microsoft.Security", "Microsoft.Security"),
+		resourceids.StaticSegment("staticServerVulnerabilityAssessmentsSettings", "serverVulnerabilityAssessmentsSettings", "serverVulnerabilityAssessmentsSettings"),
+		resourceids.UserSpecifiedSegment("serverVulnerabilityAssessmentsSettingName", "AzureServersSetting"),
+	}
+}
+
+// FromParseResult populates the fields of this VulnerabilityAssessmentsSetting ID from the ParseResult, for use with resourceids.Parser
+func (id *VulnerabilityAssessmentsSettingId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ServerVulnerabilityAssessmentsSettingName, ok = input.Parsed["serverVulnerabilityAssessmentsSettingName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "serverVulnerabilityAssessmentsSettingName", input)
+	}
+
+	return nil
+}
+
 // VulnerabilityAssessmentsSettingID parses a VulnerabilityAssessmentsSetting ID into an VulnerabilityAssessmentsSettingId struct
 func VulnerabilityAssessmentsSettingID(input string) (*VulnerabilityAssessmentsSettingId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -21,6 +21,24 @@ func TestVulnerabilityAssessmentsSettingIDFormatter(t *testing.T) {
 	}
 }
 
+func TestVulnerabilityAssessmentsSettingIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&VulnerabilityAssessmentsSettingId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Security/serverVulnerabilityAssessmentsSettings/AzureServersSetting", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual VulnerabilityAssessmentsSettingId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Security/serverVulnerabilityAssessmentsSettings/AzureServersSetting"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestVulnerabilityAssessmentsSettingID(t *testing.T) {
 	testData := []struct {
 		Input    string
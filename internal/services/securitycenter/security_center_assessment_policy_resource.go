@@ -38,7 +38,7 @@ func resourceArmSecurityCenterAssessmentPolicy() *pluginsdk.Resource {
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := assessmentsmetadata.ParseProviderAssessmentMetadataID(id)
 			return err
-		}),
+		}, &assessmentsmetadata.ProviderAssessmentMetadataId{}),
 
 		Schema: map[string]*pluginsdk.Schema{
 			"description": {
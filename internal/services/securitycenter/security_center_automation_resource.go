@@ -28,6 +28,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name security_center_automation -service-package-name securitycenter -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name logicApp
+
 func resourceSecurityCenterAutomation() *pluginsdk.Resource {
 	r := &pluginsdk.Resource{
 		Create: resourceSecurityCenterAutomationCreateUpdate,
@@ -35,10 +37,11 @@ func resourceSecurityCenterAutomation() *pluginsdk.Resource {
 		Update: resourceSecurityCenterAutomationCreateUpdate,
 		Delete: resourceSecurityCenterAutomationDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.AutomationID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.AutomationId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.AutomationId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -327,7 +330,11 @@ func resourceSecurityCenterAutomationRead(d *pluginsdk.ResourceData, meta interf
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Model.Tags)
+	if err := tags.FlattenAndSet(d, resp.Model.Tags); err != nil {
+		return err
+	}
+
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceSecurityCenterAutomationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
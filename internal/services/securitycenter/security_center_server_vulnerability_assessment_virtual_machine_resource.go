@@ -11,6 +11,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/security/mgmt/v3.0/security" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/securitycenter/parse"
@@ -24,16 +25,18 @@ const (
 	vmType          = "virtualMachines"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name security_center_server_vulnerability_assessment_virtual_machine -service-package-name securitycenter -known-values "subscription_id:data.Subscriptions.Primary,name:Default" -compare-values "resource_group_name:virtual_machine_id,virtual_machine_name:virtual_machine_id" -test-name basicCfg -test-resource-type ServerVulnerabilityAssessmentVirtualMachineResource -test-sequential
 func resourceServerVulnerabilityAssessmentVirtualMachine() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceServerVulnerabilityAssessmentVirtualMachineCreate,
 		Read:   resourceServerVulnerabilityAssessmentVirtualMachineRead,
 		Delete: resourceServerVulnerabilityAssessmentVirtualMachineDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.VulnerabilityAssessmentVmID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.VulnerabilityAssessmentVmId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.VulnerabilityAssessmentVmId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -121,7 +124,7 @@ func resourceServerVulnerabilityAssessmentVirtualMachineRead(d *pluginsdk.Resour
 	d.SetId(id.ID())
 	d.Set("virtual_machine_id", virtualMachineId.ID())
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceServerVulnerabilityAssessmentVirtualMachineDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2025-02-15/topics"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name security_center_storage_defender -service-package-name securitycenter -compare-values "scope:id"
+
 type StorageDefenderResource struct{}
 
 type StorageDefenderModel struct {
@@ -34,11 +37,16 @@ type StorageDefenderModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = StorageDefenderResource{}
+var _ sdk.ResourceWithIdentity = StorageDefenderResource{}
 
 func (s StorageDefenderResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return commonids.ValidateStorageAccountID
 }
 
+func (s StorageDefenderResource) Identity() resourceids.ResourceId {
+	return &commonids.ScopeId{}
+}
+
 func (s StorageDefenderResource) ModelObject() interface{} {
 	return &StorageDefenderModel{}
 }
@@ -284,6 +292,10 @@ func (s StorageDefenderResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
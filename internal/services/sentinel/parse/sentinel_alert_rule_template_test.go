@@ -21,6 +21,24 @@ func TestSentinelAlertRuleTemplateIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSentinelAlertRuleTemplateIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&SentinelAlertRuleTemplateId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/providers/Microsoft.SecurityInsights/alertRuleTemplates/template1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual SentinelAlertRuleTemplateId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/providers/Microsoft.SecurityInsights/alertRuleTemplates/template1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestSentinelAlertRuleTemplateID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &ThreatIntelligenceIndicatorId{}
+
 type ThreatIntelligenceIndicatorId struct {
 	SubscriptionId         string
 	ResourceGroup          string
@@ -47,6 +49,53 @@ func (id ThreatIntelligenceIndicatorId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.ThreatIntelligenceName, id.IndicatorName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ThreatIntelligenceIndicator ID
+func (id ThreatIntelligenceIndicatorId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftOperationalInsights", "Microsoft.OperationalInsights", "Microsoft.OperationalInsights"),
+		resourceids.StaticSegment("staticWorkspaces", "workspaces", "workspaces"),
+		resourceids.UserSpecifiedSegment("workspaceName", "workspace1"),
+		resourceids.StaticSegment("staticProviders2", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSecurityInsights", "Microsoft.SecurityInsights", "Microsoft.SecurityInsights"),
+		resourceids.StaticSegment("staticThreatIntelligence", "threatIntelligence", "threatIntelligence"),
+		resourceids.UserSpecifiedSegment("threatIntelligenceName", "main"),
+		resourceids.StaticSegment("staticIndicators", "indicators", "indicators"),
+		resourceids.UserSpecifiedSegment("indicatorName", "indicator1"),
+	}
+}
+
+// FromParseResult populates the fields of this ThreatIntelligenceIndicator ID from the ParseResult, for use with resourceids.Parser
+func (id *ThreatIntelligenceIndicatorId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.WorkspaceName, ok = input.Parsed["workspaceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "workspaceName", input)
+	}
+
+	if id.ThreatIntelligenceName, ok = input.Parsed["threatIntelligenceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "threatIntelligenceName", input)
+	}
+
+	if id.IndicatorName, ok = input.Parsed["indicatorName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "indicatorName", input)
+	}
+
+	return nil
+}
+
 // ThreatIntelligenceIndicatorID parses a ThreatIntelligenceIndicator ID into an ThreatIntelligenceIndicatorId struct
 func ThreatIntelligenceIndicatorID(input string) (*ThreatIntelligenceIndicatorId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
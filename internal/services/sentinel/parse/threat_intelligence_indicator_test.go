@@ -21,6 +21,24 @@ func TestThreatIntelligenceIndicatorIDFormatter(t *testing.T) {
 	}
 }
 
+func TestThreatIntelligenceIndicatorIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&ThreatIntelligenceIndicatorId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/providers/Microsoft.SecurityInsights/threatIntelligence/main/indicators/indicator1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual ThreatIntelligenceIndicatorId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/providers/Microsoft.SecurityInsights/threatIntelligence/main/indicators/indicator1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestThreatIntelligenceIndicatorID(t *testing.T) {
 	testData := []struct {
 		Input    string
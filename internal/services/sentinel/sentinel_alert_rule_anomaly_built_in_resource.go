@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -41,9 +42,12 @@ type AlertRuleAnomalyBuiltInModel struct {
 	PrioritizeExcludeObservation []AnomalyRulePriorityModel              `tfschema:"prioritized_exclude_observation"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name sentinel_alert_rule_anomaly_built_in -service-package-name sentinel -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,workspace_name:id,name:id"
+
 type AlertRuleAnomalyBuiltInResource struct{}
 
 var _ sdk.ResourceWithUpdate = AlertRuleAnomalyBuiltInResource{}
+var _ sdk.ResourceWithIdentity = AlertRuleAnomalyBuiltInResource{}
 
 func (r AlertRuleAnomalyBuiltInResource) ModelObject() interface{} {
 	return &AlertRuleAnomalyBuiltInModel{}
@@ -57,6 +61,10 @@ func (r AlertRuleAnomalyBuiltInResource) IDValidationFunc() pluginsdk.SchemaVali
 	return validate.MLAnalyticsSettingsID
 }
 
+func (r AlertRuleAnomalyBuiltInResource) Identity() resourceids.ResourceId {
+	return &parse.MLAnalyticsSettingsId{}
+}
+
 func (r AlertRuleAnomalyBuiltInResource) Arguments() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"name": {
@@ -321,6 +329,10 @@ func (r AlertRuleAnomalyBuiltInResource) Read() sdk.ResourceFunc {
 				state.ThresholdObservation = flattenSentinelAlertRuleAnomalyThreshold(resp.CustomizableObservations.ThresholdObservations)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -44,9 +45,12 @@ type AlertRuleAnomalyDuplicateModel struct {
 	PrioritizeExcludeObservation []AnomalyRulePriorityModel              `tfschema:"prioritized_exclude_observation"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name sentinel_alert_rule_anomaly_duplicate -service-package-name sentinel -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,workspace_name:id,name:id"
+
 type AlertRuleAnomalyDuplicateResource struct{}
 
 var _ sdk.ResourceWithUpdate = AlertRuleAnomalyDuplicateResource{}
+var _ sdk.ResourceWithIdentity = AlertRuleAnomalyDuplicateResource{}
 
 func (r AlertRuleAnomalyDuplicateResource) ModelObject() interface{} {
 	return &AlertRuleAnomalyDuplicateModel{}
@@ -60,6 +64,10 @@ func (r AlertRuleAnomalyDuplicateResource) IDValidationFunc() pluginsdk.SchemaVa
 	return validate.MLAnalyticsSettingsID
 }
 
+func (r AlertRuleAnomalyDuplicateResource) Identity() resourceids.ResourceId {
+	return &parse.MLAnalyticsSettingsId{}
+}
+
 func (r AlertRuleAnomalyDuplicateResource) Arguments() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"display_name": {
@@ -491,6 +499,10 @@ func (r AlertRuleAnomalyDuplicateResource) Read() sdk.ResourceFunc {
 				state.BuiltInRuleId = AlertRuleAnomalyIdFromWorkspaceId(workspaceId, resp.SettingsDefinitionID.String())
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2023-12-01-preview/alertrules"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -20,6 +21,8 @@ import (
 
 const SentinelAlertRuleFusionName = "BuiltInFusion"
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name sentinel_alert_rule_fusion -service-package-name sentinel -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,workspace_name:id,rule_id:id"
+
 func resourceSentinelAlertRuleFusion() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceSentinelAlertRuleFusionCreate,
@@ -27,10 +30,11 @@ func resourceSentinelAlertRuleFusion() *pluginsdk.Resource {
 		Update: resourceSentinelAlertRuleFusionUpdate,
 		Delete: resourceSentinelAlertRuleFusionDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := alertrules.ParseAlertRuleID(id)
-			return err
-		}, importSentinelAlertRule(alertrules.AlertRuleKindFusion)),
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&alertrules.AlertRuleId{}, importSentinelAlertRule(alertrules.AlertRuleKindFusion)),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&alertrules.AlertRuleId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -265,7 +269,7 @@ func resourceSentinelAlertRuleFusionRead(d *pluginsdk.ResourceData, meta interfa
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceSentinelAlertRuleFusionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2023-12-01-preview/alertrules"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -17,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name sentinel_alert_rule_machine_learning_behavior_analytics -service-package-name sentinel -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,workspace_name:id,rule_id:id" -test-resource-type SentinelAlertRuleMLBehaviorAnalyticsResource
+
 func resourceSentinelAlertRuleMLBehaviorAnalytics() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceSentinelAlertRuleMLBehaviorAnalyticsCreateUpdate,
@@ -24,10 +27,11 @@ func resourceSentinelAlertRuleMLBehaviorAnalytics() *pluginsdk.Resource {
 		Update: resourceSentinelAlertRuleMLBehaviorAnalyticsCreateUpdate,
 		Delete: resourceSentinelAlertRuleMLBehaviorAnalyticsDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := alertrules.ParseAlertRuleID(id)
-			return err
-		}, importSentinelAlertRule(alertrules.AlertRuleKindMLBehaviorAnalytics)),
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&alertrules.AlertRuleId{}, importSentinelAlertRule(alertrules.AlertRuleKindMLBehaviorAnalytics)),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&alertrules.AlertRuleId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -158,7 +162,7 @@ func resourceSentinelAlertRuleMLBehaviorAnalyticsRead(d *pluginsdk.ResourceData,
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceSentinelAlertRuleMLBehaviorAnalyticsDelete(d *pluginsdk.ResourceData, meta interface{}) error {
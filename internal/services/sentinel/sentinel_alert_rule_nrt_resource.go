@@ -20,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name sentinel_alert_rule_nrt -service-package-name sentinel -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,workspace_name:id,rule_id:id"
+
 func resourceSentinelAlertRuleNrt() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceSentinelAlertRuleNrtCreateUpdate,
@@ -27,10 +29,11 @@ func resourceSentinelAlertRuleNrt() *pluginsdk.Resource {
 		Update: resourceSentinelAlertRuleNrtCreateUpdate,
 		Delete: resourceSentinelAlertRuleNrtDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := alertrules.ParseAlertRuleID(id)
-			return err
-		}, importSentinelAlertRule(alertrules.AlertRuleKindNRT)),
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&alertrules.AlertRuleId{}, importSentinelAlertRule(alertrules.AlertRuleKindNRT)),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&alertrules.AlertRuleId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -490,7 +493,7 @@ func resourceSentinelAlertRuleNrtRead(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceSentinelAlertRuleNrtDelete(d *pluginsdk.ResourceData, meta interface{}) error {
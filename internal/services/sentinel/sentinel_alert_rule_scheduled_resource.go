@@ -23,6 +23,8 @@ import (
 	"github.com/rickb777/date/period"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name sentinel_alert_rule_scheduled -service-package-name sentinel -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,workspace_name:id,rule_id:id"
+
 func resourceSentinelAlertRuleScheduled() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceSentinelAlertRuleScheduledCreateUpdate,
@@ -30,10 +32,11 @@ func resourceSentinelAlertRuleScheduled() *pluginsdk.Resource {
 		Update: resourceSentinelAlertRuleScheduledCreateUpdate,
 		Delete: resourceSentinelAlertRuleScheduledDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := alertrules.ParseAlertRuleID(id)
-			return err
-		}, importSentinelAlertRule(alertrules.AlertRuleKindScheduled)),
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&alertrules.AlertRuleId{}, importSentinelAlertRule(alertrules.AlertRuleKindScheduled)),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&alertrules.AlertRuleId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -548,7 +551,7 @@ func resourceSentinelAlertRuleScheduledRead(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceSentinelAlertRuleScheduledDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -10,6 +10,7 @@ import (
 
 	alertruletemplates "github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2021-09-01-preview/securityinsight" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2023-12-01-preview/alertrules"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -27,11 +28,14 @@ type AlertRuleThreatIntelligenceModel struct {
 	Enabled      bool   `tfschema:"enabled"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name sentinel_alert_rule_threat_intelligence -service-package-name sentinel -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,workspace_name:id,rule_id:id"
+
 type AlertRuleThreatIntelligenceResource struct{}
 
 var (
 	_ sdk.ResourceWithCustomImporter = AlertRuleThreatIntelligenceResource{}
 	_ sdk.ResourceWithUpdate         = AlertRuleThreatIntelligenceResource{}
+	_ sdk.ResourceWithIdentity       = AlertRuleThreatIntelligenceResource{}
 )
 
 func (a AlertRuleThreatIntelligenceResource) ModelObject() interface{} {
@@ -46,6 +50,10 @@ func (a AlertRuleThreatIntelligenceResource) IDValidationFunc() pluginsdk.Schema
 	return alertrules.ValidateAlertRuleID
 }
 
+func (a AlertRuleThreatIntelligenceResource) Identity() resourceids.ResourceId {
+	return &alertrules.AlertRuleId{}
+}
+
 func (a AlertRuleThreatIntelligenceResource) CustomImporter() sdk.ResourceRunFunc {
 	return importSentinelAlertRuleForTypedSdk(alertrules.AlertRuleKindThreatIntelligence)
 }
@@ -185,6 +193,10 @@ func (a AlertRuleThreatIntelligenceResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
@@ -18,9 +19,12 @@ import (
 	securityinsight "github.com/jackofallops/kermit/sdk/securityinsights/2022-10-01-preview/securityinsights"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name sentinel_data_connector_dynamics_365 -service-package-name sentinel -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,workspace_name:id"
+
 type DataConnectorDynamics365Resource struct{}
 
 var _ sdk.ResourceWithCustomImporter = DataConnectorDynamics365Resource{}
+var _ sdk.ResourceWithIdentity = DataConnectorDynamics365Resource{}
 
 type DataConnectorDynamics365Model struct {
 	Name                    string `tfschema:"name"`
@@ -70,6 +74,10 @@ func (r DataConnectorDynamics365Resource) IDValidationFunc() pluginsdk.SchemaVal
 	return validate.DataConnectorID
 }
 
+func (r DataConnectorDynamics365Resource) Identity() resourceids.ResourceId {
+	return &parse.DataConnectorId{}
+}
+
 func (r DataConnectorDynamics365Resource) CustomImporter() sdk.ResourceRunFunc {
 	return importDataConnectorTyped(securityinsight.DataConnectorKindDynamics365)
 }
@@ -168,6 +176,10 @@ func (r DataConnectorDynamics365Resource) Read() sdk.ResourceFunc {
 				TenantId:                tenantId,
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&model)
 		},
 	}
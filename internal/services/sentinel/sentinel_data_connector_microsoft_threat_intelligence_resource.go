@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -20,6 +21,8 @@ import (
 	securityinsight "github.com/jackofallops/kermit/sdk/securityinsights/2022-10-01-preview/securityinsights"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name sentinel_data_connector_microsoft_threat_intelligence -service-package-name sentinel -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,workspace_name:id" -test-resource-type SentinelDataConnectorMicrosoftThreatIntelligence
+
 type DataConnectorMicrosoftThreatIntelligenceResource struct{}
 
 type DataConnectorMicrosoftThreatIntelligenceModel struct {
@@ -34,6 +37,8 @@ type DataConnectorMicrosoftThreatIntelligenceDataType struct {
 	LookbackDate string `tfschema:"lookback_date"`
 }
 
+var _ sdk.ResourceWithIdentity = DataConnectorMicrosoftThreatIntelligenceResource{}
+
 func (s DataConnectorMicrosoftThreatIntelligenceResource) Arguments() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"name": {
@@ -178,6 +183,10 @@ func (s DataConnectorMicrosoftThreatIntelligenceResource) Read() sdk.ResourceFun
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -207,6 +216,10 @@ func (s DataConnectorMicrosoftThreatIntelligenceResource) IDValidationFunc() plu
 	return validate.DataConnectorID
 }
 
+func (s DataConnectorMicrosoftThreatIntelligenceResource) Identity() resourceids.ResourceId {
+	return &parse.DataConnectorId{}
+}
+
 func expandSentinelDataConnectorMicrosoftThreatIntelligenceMicrosoftEmergingThreatFeed(input DataConnectorMicrosoftThreatIntelligenceModel) *securityinsight.MSTIDataConnectorDataTypesMicrosoftEmergingThreatFeed {
 	if input.MicrosoftEmergingThreatFeedLookBackDate == "" {
 		return &securityinsight.MSTIDataConnectorDataTypesMicrosoftEmergingThreatFeed{
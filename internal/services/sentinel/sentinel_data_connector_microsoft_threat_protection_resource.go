@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
@@ -18,9 +19,12 @@ import (
 	securityinsight "github.com/jackofallops/kermit/sdk/securityinsights/2022-10-01-preview/securityinsights"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name sentinel_data_connector_microsoft_threat_protection -service-package-name sentinel -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,workspace_name:id"
+
 type DataConnectorMicrosoftThreatProtectionResource struct{}
 
 var _ sdk.ResourceWithCustomImporter = DataConnectorMicrosoftThreatProtectionResource{}
+var _ sdk.ResourceWithIdentity = DataConnectorMicrosoftThreatProtectionResource{}
 
 type DataConnectorMicrosoftThreatProtectionModel struct {
 	Name                    string `tfschema:"name"`
@@ -70,6 +74,10 @@ func (r DataConnectorMicrosoftThreatProtectionResource) IDValidationFunc() plugi
 	return validate.DataConnectorID
 }
 
+func (r DataConnectorMicrosoftThreatProtectionResource) Identity() resourceids.ResourceId {
+	return &parse.DataConnectorId{}
+}
+
 func (r DataConnectorMicrosoftThreatProtectionResource) CustomImporter() sdk.ResourceRunFunc {
 	return importDataConnectorTyped(securityinsight.DataConnectorKindMicrosoftThreatProtection)
 }
@@ -168,6 +176,10 @@ func (r DataConnectorMicrosoftThreatProtectionResource) Read() sdk.ResourceFunc
 				TenantId:                tenantId,
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&model)
 		},
 	}
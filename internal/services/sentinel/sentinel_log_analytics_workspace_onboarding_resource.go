@@ -10,12 +10,15 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-11-01/sentinelonboardingstates"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name sentinel_log_analytics_workspace_onboarding -service-package-name sentinel -compare-values "subscription_id:id,resource_group_name:id,workspace_name:id,name:id" -test-resource-type SecurityInsightsSentinelOnboardingStateResource
+
 type SecurityInsightsSentinelOnboardingStateModel struct {
 	CustomerManagedKeyEnabled bool   `tfschema:"customer_managed_key_enabled"`
 	WorkspaceId               string `tfschema:"workspace_id"`
@@ -24,6 +27,11 @@ type SecurityInsightsSentinelOnboardingStateModel struct {
 type LogAnalyticsWorkspaceOnboardResource struct{}
 
 var _ sdk.Resource = LogAnalyticsWorkspaceOnboardResource{}
+var _ sdk.ResourceWithIdentity = LogAnalyticsWorkspaceOnboardResource{}
+
+func (r LogAnalyticsWorkspaceOnboardResource) Identity() resourceids.ResourceId {
+	return &sentinelonboardingstates.OnboardingStateId{}
+}
 
 func (r LogAnalyticsWorkspaceOnboardResource) ResourceType() string {
 	return "azurerm_sentinel_log_analytics_workspace_onboarding"
@@ -172,6 +180,10 @@ func (r LogAnalyticsWorkspaceOnboardResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
 	sentinelmetadata "github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-10-01-preview/metadata"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name sentinel_metadata -service-package-name sentinel -properties "name" -compare-values "subscription_id:id,resource_group_name:id,workspace_name:id" -test-resource-type MetadataResource
+
 type MetadataModel struct {
 	Name                     string                  `tfschema:"name"`
 	WorkspaceId              string                  `tfschema:"workspace_id"`
@@ -71,6 +74,11 @@ type MetadataCategoryModel struct {
 type MetadataResource struct{}
 
 var _ sdk.ResourceWithUpdate = MetadataResource{}
+var _ sdk.ResourceWithIdentity = MetadataResource{}
+
+func (a MetadataResource) Identity() resourceids.ResourceId {
+	return &sentinelmetadata.MetadataId{}
+}
 
 func (a MetadataResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -531,6 +539,10 @@ func (a MetadataResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -91,9 +92,12 @@ type patternTypeValuesModel struct {
 	ValueType string `tfschema:"value_type"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name sentinel_threat_intelligence_indicator -service-package-name sentinel -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,workspace_name:id,threat_intelligence_name:id,name:id" -test-params "domain-name,http://test.com" -test-resource-type SecurityInsightsIndicatorResource
+
 type ThreatIntelligenceIndicator struct{}
 
 var _ sdk.ResourceWithUpdate = ThreatIntelligenceIndicator{}
+var _ sdk.ResourceWithIdentity = ThreatIntelligenceIndicator{}
 
 func (r ThreatIntelligenceIndicator) ResourceType() string {
 	return "azurerm_sentinel_threat_intelligence_indicator"
@@ -107,6 +111,10 @@ func (r ThreatIntelligenceIndicator) IDValidationFunc() pluginsdk.SchemaValidate
 	return validate.ThreatIntelligenceIndicatorID
 }
 
+func (r ThreatIntelligenceIndicator) Identity() resourceids.ResourceId {
+	return &parse.ThreatIntelligenceIndicatorId{}
+}
+
 func (r ThreatIntelligenceIndicator) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"workspace_id": {
@@ -794,6 +802,10 @@ func (r ThreatIntelligenceIndicator) Read() sdk.ResourceFunc {
 
 			state.ParsedPattern = flattenIndicatorParsedPattern(model.ParsedPattern)
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
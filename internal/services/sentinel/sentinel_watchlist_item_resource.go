@@ -10,6 +10,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-11-01/watchlistitems"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-11-01/watchlists"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -17,9 +18,16 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name sentinel_watchlist_item -service-package-name sentinel -properties "watchlist_item_id:name" -compare-values "subscription_id:id,resource_group_name:id,workspace_name:id,watchlist_alias:id" -test-resource-type WatchlistItemResource
+
 type WatchlistItemResource struct{}
 
 var _ sdk.ResourceWithUpdate = WatchlistItemResource{}
+var _ sdk.ResourceWithIdentity = WatchlistItemResource{}
+
+func (r WatchlistItemResource) Identity() resourceids.ResourceId {
+	return &watchlistitems.WatchlistItemId{}
+}
 
 type WatchlistItemModel struct {
 	Name        string                 `tfschema:"name"`
@@ -152,6 +160,10 @@ func (r WatchlistItemResource) Read() sdk.ResourceFunc {
 				Name:        id.WatchlistItemId,
 				Properties:  properties,
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&watchListItem)
 		},
 	}
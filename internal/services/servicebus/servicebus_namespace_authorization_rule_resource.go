@@ -29,7 +29,7 @@ func resourceServiceBusNamespaceAuthorizationRule() *pluginsdk.Resource {
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := namespacesauthorizationrule.ParseAuthorizationRuleID(id)
 			return err
-		}),
+		}, &namespacesauthorizationrule.AuthorizationRuleId{}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
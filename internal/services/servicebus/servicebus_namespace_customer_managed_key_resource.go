@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/namespaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name servicebus_namespace_customer_managed_key -service-package-name servicebus -compare-values "subscription_id:id,resource_group_name:id,name:id"
+
 type ServiceBusNamespaceCustomerManagedKeyResource struct{}
 
 type ServiceBusNamespaceCustomerManagedKeyModel struct {
@@ -27,6 +30,11 @@ type ServiceBusNamespaceCustomerManagedKeyModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = ServiceBusNamespaceCustomerManagedKeyResource{}
+var _ sdk.ResourceWithIdentity = ServiceBusNamespaceCustomerManagedKeyResource{}
+
+func (r ServiceBusNamespaceCustomerManagedKeyResource) Identity() resourceids.ResourceId {
+	return &namespaces.NamespaceId{}
+}
 
 func (r ServiceBusNamespaceCustomerManagedKeyResource) ModelObject() interface{} {
 	return &ServiceBusNamespaceCustomerManagedKeyModel{}
@@ -163,6 +171,10 @@ func (r ServiceBusNamespaceCustomerManagedKeyResource) Read() sdk.ResourceFunc {
 					state.InfrastructureEncryptionEnabled = pointer.From(encryption.RequireInfrastructureEncryption)
 				}
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
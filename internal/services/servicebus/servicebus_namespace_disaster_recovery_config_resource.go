@@ -34,7 +34,7 @@ func resourceServiceBusNamespaceDisasterRecoveryConfig() *pluginsdk.Resource {
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := disasterrecoveryconfigs.ParseDisasterRecoveryConfigID(id)
 			return err
-		}),
+		}, &disasterrecoveryconfigs.DisasterRecoveryConfigId{}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/namespaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/queues"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -23,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name servicebus_queue -service-package-name servicebus -properties "name" -compare-values "subscription_id:id,resource_group_name:id,namespace_name:id" -test-resource-type ServiceBusQueueResource
+
 func resourceServiceBusQueue() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceServiceBusQueueCreateUpdate,
@@ -30,10 +33,11 @@ func resourceServiceBusQueue() *pluginsdk.Resource {
 		Update: resourceServiceBusQueueCreateUpdate,
 		Delete: resourceServiceBusQueueDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := queues.ParseQueueID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&queues.QueueId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&queues.QueueId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -421,7 +425,7 @@ func resourceServiceBusQueueRead(d *pluginsdk.ResourceData, meta interface{}) er
 			}
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceServiceBusQueueDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/subscriptions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/topics"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/migration"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name servicebus_subscription -service-package-name servicebus -properties "name" -compare-values "subscription_id:id,resource_group_name:id,namespace_name:id,topic_name:id" -test-resource-type ServiceBusSubscriptionResource
+
 func resourceServiceBusSubscription() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceServiceBusSubscriptionCreateUpdate,
@@ -34,10 +37,11 @@ func resourceServiceBusSubscription() *pluginsdk.Resource {
 			0: migration.ServiceBusSubscriptionV0ToV1{},
 		}),
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := subscriptions.ParseSubscriptions2ID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&subscriptions.Subscriptions2Id{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&subscriptions.Subscriptions2Id{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -320,7 +324,7 @@ func resourceServiceBusSubscriptionRead(d *pluginsdk.ResourceData, meta interfac
 	}
 	d.Set("name", userAssignedName)
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceServiceBusSubscriptionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicelinker/2022-05-01/links"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicelinker/2024-04-01/servicelinker"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name spring_cloud_connection -service-package-name serviceconnector -properties "name" -compare-values "resource_uri:id" -test-name cosmosdbBasic -test-resource-type ServiceConnectorSpringCloudResource
+
 type SpringCloudConnectorResource struct{}
 
 type SpringCloudConnectorResourceModel struct {
@@ -34,6 +37,8 @@ type SpringCloudConnectorResourceModel struct {
 	SecretStore      []SecretStoreModel `tfschema:"secret_store"`
 }
 
+var _ sdk.ResourceWithIdentity = SpringCloudConnectorResource{}
+
 func (r SpringCloudConnectorResource) Arguments() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"name": {
@@ -310,3 +315,7 @@ func (r SpringCloudConnectorResource) Update() sdk.ResourceFunc {
 func (r SpringCloudConnectorResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return servicelinker.ValidateScopedLinkerID
 }
+
+func (r SpringCloudConnectorResource) Identity() resourceids.ResourceId {
+	return &servicelinker.ScopedLinkerId{}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicenetworking/2025-01-01/trafficcontrollerinterface"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name application_load_balancer -service-package-name servicenetworking -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type ApplicationLoadBalancerResource struct{}
 
 type ApplicationLoadBalancerModel struct {
@@ -32,6 +35,7 @@ type ApplicationLoadBalancerModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = ApplicationLoadBalancerResource{}
+var _ sdk.ResourceWithIdentity = ApplicationLoadBalancerResource{}
 
 func (t ApplicationLoadBalancerResource) Arguments() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
@@ -71,6 +75,10 @@ func (t ApplicationLoadBalancerResource) IDValidationFunc() pluginsdk.SchemaVali
 	return trafficcontrollerinterface.ValidateTrafficControllerID
 }
 
+func (t ApplicationLoadBalancerResource) Identity() resourceids.ResourceId {
+	return &trafficcontrollerinterface.TrafficControllerId{}
+}
+
 func (t ApplicationLoadBalancerResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -145,6 +153,10 @@ func (t ApplicationLoadBalancerResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
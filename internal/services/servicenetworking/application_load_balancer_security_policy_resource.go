@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/webapplicationfirewallpolicies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicenetworking/2025-01-01/securitypoliciesinterface"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,6 +23,9 @@ import (
 )
 
 var _ sdk.ResourceWithUpdate = SecurityPoliciesResource{}
+var _ sdk.ResourceWithIdentity = SecurityPoliciesResource{}
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name application_load_balancer_security_policy -service-package-name servicenetworking -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,traffic_controller_name:id" -test-resource-type ApplicationLoadBalancerSecurityPoliciesResource
 
 type SecurityPoliciesResource struct{}
 
@@ -71,6 +75,10 @@ func (f SecurityPoliciesResource) IDValidationFunc() pluginsdk.SchemaValidateFun
 	return securitypoliciesinterface.ValidateSecurityPolicyID
 }
 
+func (f SecurityPoliciesResource) Identity() resourceids.ResourceId {
+	return &securitypoliciesinterface.SecurityPolicyId{}
+}
+
 func (f SecurityPoliciesResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -159,6 +167,10 @@ func (f SecurityPoliciesResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/signalr/2024-03-01/signalr"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name signalr_service_custom_certificate -service-package-name signalr -properties "name" -compare-values "subscription_id:id,resource_group_name:id,signal_r_name:id" -test-resource-type CustomCertSignalrServiceResource
+
 type CustomCertSignalrServiceResourceModel struct {
 	Name               string `tfschema:"name"`
 	SignalRServiceId   string `tfschema:"signalr_service_id"`
@@ -30,6 +33,11 @@ type CustomCertSignalrServiceResourceModel struct {
 type CustomCertSignalrServiceResource struct{}
 
 var _ sdk.Resource = CustomCertSignalrServiceResource{}
+var _ sdk.ResourceWithIdentity = CustomCertSignalrServiceResource{}
+
+func (r CustomCertSignalrServiceResource) Identity() resourceids.ResourceId {
+	return &signalr.CustomCertificateId{}
+}
 
 func (r CustomCertSignalrServiceResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -182,6 +190,10 @@ func (r CustomCertSignalrServiceResource) Read() sdk.ResourceFunc {
 				CertificateVersion: pointer.From(resp.Model.Properties.KeyVaultSecretVersion),
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/signalr/2024-03-01/signalr"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -17,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name signalr_service_custom_domain -service-package-name signalr -properties "name" -compare-values "subscription_id:id,resource_group_name:id,signal_r_name:id"
+
 type CustomDomainSignalrServiceModel struct {
 	Name                       string `tfschema:"name"`
 	SignalRServiceId           string `tfschema:"signalr_service_id"`
@@ -27,6 +30,11 @@ type CustomDomainSignalrServiceModel struct {
 type CustomDomainSignalrServiceResource struct{}
 
 var _ sdk.Resource = CustomDomainSignalrServiceResource{}
+var _ sdk.ResourceWithIdentity = CustomDomainSignalrServiceResource{}
+
+func (r CustomDomainSignalrServiceResource) Identity() resourceids.ResourceId {
+	return &signalr.CustomDomainId{}
+}
 
 func (r CustomDomainSignalrServiceResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -186,6 +194,10 @@ func (r CustomDomainSignalrServiceResource) Read() sdk.ResourceFunc {
 				state.DomainName = props.DomainName
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
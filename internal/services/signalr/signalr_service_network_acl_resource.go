@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/privateendpoints"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/signalr/2024-03-01/signalr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/migration"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name signalr_service_network_acl -service-package-name signalr -compare-values "subscription_id:id,resource_group_name:id,name:id" -test-resource-type SignalRServiceNetworkACLResource
+
 func resourceArmSignalRServiceNetworkACL() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceSignalRServiceNetworkACLCreateUpdate,
@@ -38,10 +41,11 @@ func resourceArmSignalRServiceNetworkACL() *pluginsdk.Resource {
 		}),
 		SchemaVersion: 1,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := signalr.ParseSignalRID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&signalr.SignalRId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&signalr.SignalRId{}),
+		},
 
 		Schema: map[string]*pluginsdk.Schema{
 			"signalr_service_id": {
@@ -247,7 +251,7 @@ func resourceSignalRServiceNetworkACLRead(d *pluginsdk.ResourceData, meta interf
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceSignalRServiceNetworkACLDelete(d *pluginsdk.ResourceData, meta interface{}) error {
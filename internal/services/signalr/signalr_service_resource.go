@@ -31,6 +31,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name signalr_service -service-package-name signalr -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type SignalRServiceResource
+
 func resourceArmSignalRService() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceArmSignalRServiceCreate,
@@ -50,10 +52,11 @@ func resourceArmSignalRService() *pluginsdk.Resource {
 		}),
 		SchemaVersion: 1,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := signalr.ParseSignalRID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&signalr.SignalRId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&signalr.SignalRId{}),
+		},
 
 		Schema: resourceArmSignalRServiceSchema(),
 	}
@@ -317,7 +320,7 @@ func resourceArmSignalRServiceRead(d *pluginsdk.ResourceData, meta interface{})
 		d.Set("secondary_connection_string", model.SecondaryConnectionString)
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceArmSignalRServiceUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/webpubsub/2024-03-01/webpubsub"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name web_pubsub_custom_certificate -service-package-name signalr -properties "name" -compare-values "subscription_id:id,resource_group_name:id,web_pub_sub_name:id" -test-resource-type CustomCertWebPubsubResource
+
 type CustomCertWebPubsubModel struct {
 	Name               string `tfschema:"name"`
 	WebPubsubId        string `tfschema:"web_pubsub_id"`
@@ -31,6 +34,11 @@ type CustomCertWebPubsubModel struct {
 type CustomCertWebPubsubResource struct{}
 
 var _ sdk.Resource = CustomCertWebPubsubResource{}
+var _ sdk.ResourceWithIdentity = CustomCertWebPubsubResource{}
+
+func (r CustomCertWebPubsubResource) Identity() resourceids.ResourceId {
+	return &webpubsub.CustomCertificateId{}
+}
 
 func (r CustomCertWebPubsubResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -189,6 +197,10 @@ func (r CustomCertWebPubsubResource) Read() sdk.ResourceFunc {
 				CertificateVersion: pointer.From(resp.Model.Properties.KeyVaultSecretVersion),
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
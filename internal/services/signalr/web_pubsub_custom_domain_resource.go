@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/webpubsub/2024-03-01/webpubsub"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -17,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name web_pubsub_custom_domain -service-package-name signalr -properties "name" -compare-values "subscription_id:id,resource_group_name:id,web_pub_sub_name:id"
+
 type CustomDomainWebPubsubModel struct {
 	Name                         string `tfschema:"name"`
 	WebPubsubId                  string `tfschema:"web_pubsub_id"`
@@ -27,6 +30,11 @@ type CustomDomainWebPubsubModel struct {
 type CustomDomainWebPubsubResource struct{}
 
 var _ sdk.Resource = CustomDomainWebPubsubResource{}
+var _ sdk.ResourceWithIdentity = CustomDomainWebPubsubResource{}
+
+func (r CustomDomainWebPubsubResource) Identity() resourceids.ResourceId {
+	return &webpubsub.CustomDomainId{}
+}
 
 func (r CustomDomainWebPubsubResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -187,6 +195,10 @@ func (r CustomDomainWebPubsubResource) Read() sdk.ResourceFunc {
 				state.DomainName = props.DomainName
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -21,6 +21,24 @@ func TestSpringCloudAcceleratorIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSpringCloudAcceleratorIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&SpringCloudAcceleratorId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AppPlatform/spring/spring1/applicationAccelerators/default", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual SpringCloudAcceleratorId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AppPlatform/spring/spring1/applicationAccelerators/default"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestSpringCloudAcceleratorID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &SpringCloudAPIPortalId{}
+
 type SpringCloudAPIPortalId struct {
 	SubscriptionId string
 	ResourceGroup  string
@@ -44,6 +46,45 @@ func (id SpringCloudAPIPortalId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SpringName, id.ApiPortalName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SpringCloudAPIPortal ID
+func (id SpringCloudAPIPortalId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resourceGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAppPlatform", "Microsoft.AppPlatform", "Microsoft.AppPlatform"),
+		resourceids.StaticSegment("staticSpring", "spring", "spring"),
+		resourceids.UserSpecifiedSegment("springName", "service1"),
+		resourceids.StaticSegment("staticApiPortals", "apiPortals", "apiPortals"),
+		resourceids.UserSpecifiedSegment("apiPortalName", "apiPortal1"),
+	}
+}
+
+// FromParseResult populates the fields of this SpringCloudAPIPortal ID from the ParseResult, for use with resourceids.Parser
+func (id *SpringCloudAPIPortalId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.SpringName, ok = input.Parsed["springName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "springName", input)
+	}
+
+	if id.ApiPortalName, ok = input.Parsed["apiPortalName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "apiPortalName", input)
+	}
+
+	return nil
+}
+
 // SpringCloudAPIPortalID parses a SpringCloudAPIPortal ID into an SpringCloudAPIPortalId struct
 func SpringCloudAPIPortalID(input string) (*SpringCloudAPIPortalId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -21,6 +21,24 @@ func TestSpringCloudAPIPortalIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSpringCloudAPIPortalIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&SpringCloudAPIPortalId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.AppPlatform/spring/service1/apiPortals/apiPortal1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual SpringCloudAPIPortalId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.AppPlatform/spring/service1/apiPortals/apiPortal1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestSpringCloudAPIPortalID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,24 @@ func TestSpringCloudAppIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSpringCloudAppIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&SpringCloudAppId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AppPlatform/spring/spring1/apps/app1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual SpringCloudAppId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AppPlatform/spring/spring1/apps/app1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestSpringCloudAppID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &SpringCloudApplicationLiveViewId{}
+
 type SpringCloudApplicationLiveViewId struct {
 	SubscriptionId          string
 	ResourceGroup           string
@@ -44,6 +46,45 @@ func (id SpringCloudApplicationLiveViewId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SpringName, id.ApplicationLiveViewName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SpringCloudApplicationLiveView ID
+func (id SpringCloudApplicationLiveViewId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resourceGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAppPlatform", "Microsoft.AppPlatform", "Microsoft.AppPlatform"),
+		resourceids.StaticSegment("staticSpring", "spring", "spring"),
+		resourceids.UserSpecifiedSegment("springName", "service1"),
+		resourceids.StaticSegment("staticApplicationLiveViews", "applicationLiveViews", "applicationLiveViews"),
+		resourceids.UserSpecifiedSegment("applicationLiveViewName", "default"),
+	}
+}
+
+// FromParseResult populates the fields of this SpringCloudApplicationLiveView ID from the ParseResult, for use with resourceids.Parser
+func (id *SpringCloudApplicationLiveViewId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.SpringName, ok = input.Parsed["springName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "springName", input)
+	}
+
+	if id.ApplicationLiveViewName, ok = input.Parsed["applicationLiveViewName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "applicationLiveViewName", input)
+	}
+
+	return nil
+}
+
 // SpringCloudApplicationLiveViewID parses a SpringCloudApplicationLiveView ID into an SpringCloudApplicationLiveViewId struct
 func SpringCloudApplicationLiveViewID(input string) (*SpringCloudApplicationLiveViewId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
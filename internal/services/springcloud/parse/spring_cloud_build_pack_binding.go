@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &SpringCloudBuildPackBindingId{}
+
 type SpringCloudBuildPackBindingId struct {
 	SubscriptionId       string
 	ResourceGroup        string
@@ -50,6 +52,57 @@ func (id SpringCloudBuildPackBindingId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SpringName, id.BuildServiceName, id.BuilderName, id.BuildPackBindingName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SpringCloudBuildPackBinding ID
+func (id SpringCloudBuildPackBindingId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resourceGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAppPlatform", "Microsoft.AppPlatform", "Microsoft.AppPlatform"),
+		resourceids.StaticSegment("staticSpring", "spring", "spring"),
+		resourceids.UserSpecifiedSegment("springName", "service1"),
+		resourceids.StaticSegment("staticBuildServices", "buildServices", "buildServices"),
+		resourceids.UserSpecifiedSegment("buildServiceName", "buildService1"),
+		resourceids.StaticSegment("staticBuilders", "builders", "builders"),
+		resourceids.UserSpecifiedSegment("builderName", "builder1"),
+		resourceids.StaticSegment("staticBuildPackBindings", "buildPackBindings", "buildPackBindings"),
+		resourceids.UserSpecifiedSegment("buildPackBindingName", "buildPackBinding1"),
+	}
+}
+
+// FromParseResult populates the fields of this SpringCloudBuildPackBinding ID from the ParseResult, for use with resourceids.Parser
+func (id *SpringCloudBuildPackBindingId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.SpringName, ok = input.Parsed["springName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "springName", input)
+	}
+
+	if id.BuildServiceName, ok = input.Parsed["buildServiceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "buildServiceName", input)
+	}
+
+	if id.BuilderName, ok = input.Parsed["builderName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "builderName", input)
+	}
+
+	if id.BuildPackBindingName, ok = input.Parsed["buildPackBindingName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "buildPackBindingName", input)
+	}
+
+	return nil
+}
+
 // SpringCloudBuildPackBindingID parses a SpringCloudBuildPackBinding ID into an SpringCloudBuildPackBindingId struct
 func SpringCloudBuildPackBindingID(input string) (*SpringCloudBuildPackBindingId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
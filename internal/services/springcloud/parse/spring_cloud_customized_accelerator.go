@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &SpringCloudCustomizedAcceleratorId{}
+
 type SpringCloudCustomizedAcceleratorId struct {
 	SubscriptionId             string
 	ResourceGroup              string
@@ -47,6 +49,51 @@ func (id SpringCloudCustomizedAcceleratorId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SpringName, id.ApplicationAcceleratorName, id.CustomizedAcceleratorName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SpringCloudCustomizedAccelerator ID
+func (id SpringCloudCustomizedAcceleratorId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAppPlatform", "Microsoft.AppPlatform", "Microsoft.AppPlatform"),
+		resourceids.StaticSegment("staticSpring", "spring", "spring"),
+		resourceids.UserSpecifiedSegment("springName", "spring1"),
+		resourceids.StaticSegment("staticApplicationAccelerators", "applicationAccelerators", "applicationAccelerators"),
+		resourceids.UserSpecifiedSegment("applicationAcceleratorName", "default"),
+		resourceids.StaticSegment("staticCustomizedAccelerators", "customizedAccelerators", "customizedAccelerators"),
+		resourceids.UserSpecifiedSegment("customizedAcceleratorName", "customizedAccelerator1"),
+	}
+}
+
+// FromParseResult populates the fields of this SpringCloudCustomizedAccelerator ID from the ParseResult, for use with resourceids.Parser
+func (id *SpringCloudCustomizedAcceleratorId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.SpringName, ok = input.Parsed["springName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "springName", input)
+	}
+
+	if id.ApplicationAcceleratorName, ok = input.Parsed["applicationAcceleratorName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "applicationAcceleratorName", input)
+	}
+
+	if id.CustomizedAcceleratorName, ok = input.Parsed["customizedAcceleratorName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "customizedAcceleratorName", input)
+	}
+
+	return nil
+}
+
 // SpringCloudCustomizedAcceleratorID parses a SpringCloudCustomizedAccelerator ID into an SpringCloudCustomizedAcceleratorId struct
 func SpringCloudCustomizedAcceleratorID(input string) (*SpringCloudCustomizedAcceleratorId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &SpringCloudDevToolPortalId{}
+
 type SpringCloudDevToolPortalId struct {
 	SubscriptionId    string
 	ResourceGroup     string
@@ -44,6 +46,45 @@ func (id SpringCloudDevToolPortalId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SpringName, id.DevToolPortalName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SpringCloudDevToolPortal ID
+func (id SpringCloudDevToolPortalId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resourceGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAppPlatform", "Microsoft.AppPlatform", "Microsoft.AppPlatform"),
+		resourceids.StaticSegment("staticSpring", "Spring", "Spring"),
+		resourceids.UserSpecifiedSegment("springName", "service1"),
+		resourceids.StaticSegment("staticDevToolPortals", "DevToolPortals", "DevToolPortals"),
+		resourceids.UserSpecifiedSegment("devToolPortalName", "default"),
+	}
+}
+
+// FromParseResult populates the fields of this SpringCloudDevToolPortal ID from the ParseResult, for use with resourceids.Parser
+func (id *SpringCloudDevToolPortalId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.SpringName, ok = input.Parsed["springName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "springName", input)
+	}
+
+	if id.DevToolPortalName, ok = input.Parsed["devToolPortalName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "devToolPortalName", input)
+	}
+
+	return nil
+}
+
 // SpringCloudDevToolPortalID parses a SpringCloudDevToolPortal ID into an SpringCloudDevToolPortalId struct
 func SpringCloudDevToolPortalID(input string) (*SpringCloudDevToolPortalId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &SpringCloudServiceRegistryId{}
+
 type SpringCloudServiceRegistryId struct {
 	SubscriptionId      string
 	ResourceGroup       string
@@ -44,6 +46,45 @@ func (id SpringCloudServiceRegistryId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SpringName, id.ServiceRegistryName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SpringCloudServiceRegistry ID
+func (id SpringCloudServiceRegistryId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAppPlatform", "Microsoft.AppPlatform", "Microsoft.AppPlatform"),
+		resourceids.StaticSegment("staticSpring", "spring", "spring"),
+		resourceids.UserSpecifiedSegment("springName", "spring1"),
+		resourceids.StaticSegment("staticServiceRegistries", "serviceRegistries", "serviceRegistries"),
+		resourceids.UserSpecifiedSegment("serviceRegistryName", "serviceRegistry1"),
+	}
+}
+
+// FromParseResult populates the fields of this SpringCloudServiceRegistry ID from the ParseResult, for use with resourceids.Parser
+func (id *SpringCloudServiceRegistryId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.SpringName, ok = input.Parsed["springName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "springName", input)
+	}
+
+	if id.ServiceRegistryName, ok = input.Parsed["serviceRegistryName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "serviceRegistryName", input)
+	}
+
+	return nil
+}
+
 // SpringCloudServiceRegistryID parses a SpringCloudServiceRegistry ID into an SpringCloudServiceRegistryId struct
 func SpringCloudServiceRegistryID(input string) (*SpringCloudServiceRegistryId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
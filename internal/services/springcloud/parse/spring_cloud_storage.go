@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &SpringCloudStorageId{}
+
 type SpringCloudStorageId struct {
 	SubscriptionId string
 	ResourceGroup  string
@@ -44,6 +46,45 @@ func (id SpringCloudStorageId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SpringName, id.StorageName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SpringCloudStorage ID
+func (id SpringCloudStorageId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resourceGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAppPlatform", "Microsoft.AppPlatform", "Microsoft.AppPlatform"),
+		resourceids.StaticSegment("staticSpring", "spring", "spring"),
+		resourceids.UserSpecifiedSegment("springName", "service1"),
+		resourceids.StaticSegment("staticStorages", "storages", "storages"),
+		resourceids.UserSpecifiedSegment("storageName", "storage1"),
+	}
+}
+
+// FromParseResult populates the fields of this SpringCloudStorage ID from the ParseResult, for use with resourceids.Parser
+func (id *SpringCloudStorageId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.SpringName, ok = input.Parsed["springName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "springName", input)
+	}
+
+	if id.StorageName, ok = input.Parsed["storageName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "storageName", input)
+	}
+
+	return nil
+}
+
 // SpringCloudStorageID parses a SpringCloudStorage ID into an SpringCloudStorageId struct
 func SpringCloudStorageID(input string) (*SpringCloudStorageId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
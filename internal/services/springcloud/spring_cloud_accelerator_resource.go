@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -26,6 +27,8 @@ type SpringCloudAcceleratorModel struct {
 	SpringCloudServiceId string `tfschema:"spring_cloud_service_id"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name spring_cloud_accelerator -service-package-name springcloud -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,spring_name:id"
+
 type SpringCloudAcceleratorResource struct{}
 
 func (s SpringCloudAcceleratorResource) DeprecationMessage() string {
@@ -36,6 +39,7 @@ var (
 	_ sdk.Resource                                = SpringCloudAcceleratorResource{}
 	_ sdk.ResourceWithStateMigration              = SpringCloudAcceleratorResource{}
 	_ sdk.ResourceWithDeprecationAndNoReplacement = SpringCloudAcceleratorResource{}
+	_ sdk.ResourceWithIdentity                    = SpringCloudAcceleratorResource{}
 )
 
 func (s SpringCloudAcceleratorResource) ResourceType() string {
@@ -50,6 +54,10 @@ func (s SpringCloudAcceleratorResource) IDValidationFunc() pluginsdk.SchemaValid
 	return validate.SpringCloudAcceleratorID
 }
 
+func (s SpringCloudAcceleratorResource) Identity() resourceids.ResourceId {
+	return &parse.SpringCloudAcceleratorId{}
+}
+
 func (s SpringCloudAcceleratorResource) StateUpgraders() sdk.StateUpgradeData {
 	return sdk.StateUpgradeData{
 		SchemaVersion: 1,
@@ -144,6 +152,10 @@ func (s SpringCloudAcceleratorResource) Read() sdk.ResourceFunc {
 				Name:                 id.ApplicationAcceleratorName,
 				SpringCloudServiceId: parse.NewSpringCloudServiceID(id.SubscriptionId, id.ResourceGroup, id.SpringName).ID(),
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -9,6 +9,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -20,6 +21,8 @@ import (
 	"github.com/jackofallops/kermit/sdk/appplatform/2023-05-01-preview/appplatform"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name spring_cloud_active_deployment -service-package-name springcloud -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,spring_name:id,name:id"
+
 func resourceSpringCloudActiveDeployment() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		DeprecationMessage: features.DeprecatedInFivePointOh("Azure Spring Apps is now deprecated and will be retired on 2028-05-31 - as such the `azurerm_spring_cloud_active_deployment` resource is deprecated and will be removed in a future major version of the AzureRM Provider. See https://aka.ms/asaretirement for more information."),
@@ -34,10 +37,11 @@ func resourceSpringCloudActiveDeployment() *pluginsdk.Resource {
 			0: migration.SpringCloudActiveDeploymentV0ToV1{},
 		}),
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.SpringCloudAppID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.SpringCloudAppId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.SpringCloudAppId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -147,7 +151,7 @@ func resourceSpringCloudActiveDeploymentRead(d *pluginsdk.ResourceData, meta int
 	d.Set("spring_cloud_app_id", id.ID())
 	d.Set("deployment_name", activeDeployments[0])
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceSpringCloudActiveDeploymentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
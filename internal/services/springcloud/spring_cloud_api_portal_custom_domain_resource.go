@@ -8,6 +8,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -20,6 +21,8 @@ import (
 	"github.com/jackofallops/kermit/sdk/appplatform/2023-05-01-preview/appplatform"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name spring_cloud_api_portal_custom_domain -service-package-name springcloud -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,spring_name:id,api_portal_name:id" -test-resource-type SpringCloudAPIPortalCustomDomainResource
+
 func resourceSpringCloudAPIPortalCustomDomain() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		DeprecationMessage: features.DeprecatedInFivePointOh("Azure Spring Apps is now deprecated and will be retired on 2028-05-31 - as such the `azurerm_spring_cloud_api_portal_custom_domain` resource is deprecated and will be removed in a future major version of the AzureRM Provider. See https://aka.ms/asaretirement for more information."),
@@ -41,10 +44,11 @@ func resourceSpringCloudAPIPortalCustomDomain() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.SpringCloudAPIPortalCustomDomainID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.SpringCloudAPIPortalCustomDomainId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.SpringCloudAPIPortalCustomDomainId{}),
+		},
 
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
@@ -134,7 +138,7 @@ func resourceSpringCloudAPIPortalCustomDomainRead(d *pluginsdk.ResourceData, met
 	if props := resp.Properties; props != nil {
 		d.Set("thumbprint", props.Thumbprint)
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceSpringCloudAPIPortalCustomDomainDelete(d *pluginsdk.ResourceData, meta interface{}) error {
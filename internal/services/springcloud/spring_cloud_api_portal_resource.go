@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/appplatform/2024-01-01-preview/appplatform"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name spring_cloud_api_portal -service-package-name springcloud -properties "name" -compare-values "subscription_id:id,resource_group_name:id,spring_name:id" -test-resource-type SpringCloudAPIPortalResource
+
 type SpringCloudAPIPortalModel struct {
 	Name                       string              `tfschema:"name"`
 	SpringCloudServiceId       string              `tfschema:"spring_cloud_service_id"`
@@ -48,8 +51,13 @@ var (
 	_ sdk.ResourceWithUpdate                      = SpringCloudAPIPortalResource{}
 	_ sdk.ResourceWithStateMigration              = SpringCloudAPIPortalResource{}
 	_ sdk.ResourceWithDeprecationAndNoReplacement = SpringCloudAPIPortalResource{}
+	_ sdk.ResourceWithIdentity                    = SpringCloudAPIPortalResource{}
 )
 
+func (s SpringCloudAPIPortalResource) Identity() resourceids.ResourceId {
+	return &appplatform.ApiPortalId{}
+}
+
 func (s SpringCloudAPIPortalResource) ResourceType() string {
 	return "azurerm_spring_cloud_api_portal"
 }
@@ -347,6 +355,10 @@ func (s SpringCloudAPIPortalResource) Read() sdk.ResourceFunc {
 					state.InstanceCount = pointer.From(sku.Capacity)
 				}
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -8,6 +8,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -35,6 +36,8 @@ const (
 	springCloudAppCosmosDbAssociationAPITypeTable     = "table"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name spring_cloud_app_cosmosdb_association -service-package-name springcloud -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,spring_name:id,app_name:id" -test-name cassandra_basic -test-resource-type SpringCloudAppCosmosDbAssociationResource
+
 func resourceSpringCloudAppCosmosDBAssociation() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		DeprecationMessage: features.DeprecatedInFivePointOh("Azure Spring Apps is now deprecated and will be retired on 2028-05-31 - as such the `azurerm_spring_cloud_app_cosmosdb_association` resource is deprecated and will be removed in a future major version of the AzureRM Provider. See https://aka.ms/asaretirement for more information."),
@@ -49,10 +52,11 @@ func resourceSpringCloudAppCosmosDBAssociation() *pluginsdk.Resource {
 			0: migration.SpringCloudAppCosmosDbAssociationV0ToV1{},
 		}),
 
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := parse.SpringCloudAppAssociationID(id)
-			return err
-		}, importSpringCloudAppAssociation(springCloudAppAssociationTypeCosmosDb)),
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&parse.SpringCloudAppAssociationId{}, importSpringCloudAppAssociation(springCloudAppAssociationTypeCosmosDb)),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.SpringCloudAppAssociationId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -284,7 +288,7 @@ func resourceSpringCloudAppCosmosDBAssociationRead(d *pluginsdk.ResourceData, me
 		}
 		d.Set("cosmosdb_gremlin_graph_name", gremlinGraphName)
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceSpringCloudAppCosmosDBAssociationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
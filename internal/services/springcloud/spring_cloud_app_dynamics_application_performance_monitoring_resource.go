@@ -21,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name spring_cloud_app_dynamics_application_performance_monitoring -service-package-name springcloud -properties "name" -compare-values "subscription_id:id,resource_group_name:id,spring_name:id"
+
 type SpringCloudAppDynamicsApplicationPerformanceMonitoringModel struct {
 	Name                  string `tfschema:"name"`
 	SpringCloudServiceId  string `tfschema:"spring_cloud_service_id"`
@@ -45,8 +47,13 @@ func (s SpringCloudAppDynamicsApplicationPerformanceMonitoringResource) Deprecat
 var (
 	_ sdk.ResourceWithUpdate                      = SpringCloudAppDynamicsApplicationPerformanceMonitoringResource{}
 	_ sdk.ResourceWithDeprecationAndNoReplacement = SpringCloudAppDynamicsApplicationPerformanceMonitoringResource{}
+	_ sdk.ResourceWithIdentity                    = SpringCloudAppDynamicsApplicationPerformanceMonitoringResource{}
 )
 
+func (s SpringCloudAppDynamicsApplicationPerformanceMonitoringResource) Identity() resourceids.ResourceId {
+	return &appplatform.ApmId{}
+}
+
 func (s SpringCloudAppDynamicsApplicationPerformanceMonitoringResource) ResourceType() string {
 	return "azurerm_spring_cloud_app_dynamics_application_performance_monitoring"
 }
@@ -380,6 +387,10 @@ func (s SpringCloudAppDynamicsApplicationPerformanceMonitoringResource) Read() s
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
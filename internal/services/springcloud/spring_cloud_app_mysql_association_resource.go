@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2017-12-01/servers"
 	flexibleServers "github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/servers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -28,6 +29,8 @@ const (
 	springCloudAppMysqlAssociationKeyUsername = "username"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name spring_cloud_app_mysql_association -service-package-name springcloud -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,spring_name:id,app_name:id"
+
 func resourceSpringCloudAppMysqlAssociation() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		DeprecationMessage: features.DeprecatedInFivePointOh("Azure Spring Apps is now deprecated and will be retired on 2028-05-31 - as such the `azurerm_spring_cloud_app_mysql_association` resource is deprecated and will be removed in a future major version of the AzureRM Provider. See https://aka.ms/asaretirement for more information."),
@@ -42,10 +45,11 @@ func resourceSpringCloudAppMysqlAssociation() *pluginsdk.Resource {
 			0: migration.SpringCloudAppMySqlAssociationV0ToV1{},
 		}),
 
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := parse.SpringCloudAppAssociationID(id)
-			return err
-		}, importSpringCloudAppAssociation(springCloudAppAssociationTypeMysql)),
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&parse.SpringCloudAppAssociationId{}, importSpringCloudAppAssociation(springCloudAppAssociationTypeMysql)),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.SpringCloudAppAssociationId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -184,7 +188,7 @@ func resourceSpringCloudAppMysqlAssociationRead(d *pluginsdk.ResourceData, meta
 		}
 		d.Set("username", username)
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceSpringCloudAppMysqlAssociationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
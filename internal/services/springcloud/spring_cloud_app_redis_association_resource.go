@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2024-03-01/redis"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -25,6 +26,8 @@ import (
 
 const springCloudAppRedisAssociationKeySSL = "useSsl"
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name spring_cloud_app_redis_association -service-package-name springcloud -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,spring_name:id,app_name:id"
+
 func resourceSpringCloudAppRedisAssociation() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		DeprecationMessage: features.DeprecatedInFivePointOh("Azure Spring Apps is now deprecated and will be retired on 2028-05-31 - as such the `azurerm_spring_cloud_app_redis_association` resource is deprecated and will be removed in a future major version of the AzureRM Provider. See https://aka.ms/asaretirement for more information."),
@@ -39,10 +42,11 @@ func resourceSpringCloudAppRedisAssociation() *pluginsdk.Resource {
 			0: migration.SpringCloudAppRedisAssociationV0ToV1{},
 		}),
 
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := parse.SpringCloudAppAssociationID(id)
-			return err
-		}, importSpringCloudAppAssociation(springCloudAppAssociationTypeRedis)),
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&parse.SpringCloudAppAssociationId{}, importSpringCloudAppAssociation(springCloudAppAssociationTypeRedis)),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.SpringCloudAppAssociationId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -164,7 +168,7 @@ func resourceSpringCloudAppRedisAssociationRead(d *pluginsdk.ResourceData, meta
 		}
 		d.Set("ssl_enabled", strings.EqualFold(enableSSL, "true"))
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceSpringCloudAppRedisAssociationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
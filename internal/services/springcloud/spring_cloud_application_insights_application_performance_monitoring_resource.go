@@ -33,6 +33,8 @@ type SpringCloudApplicationInsightsApplicationPerformanceMonitoringModel struct
 	SamplingPercentage        int64  `tfschema:"sampling_percentage"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name spring_cloud_application_insights_application_performance_monitoring -service-package-name springcloud -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,spring_name:id"
+
 type SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource struct{}
 
 func (s SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource) DeprecationMessage() string {
@@ -42,6 +44,7 @@ func (s SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource)
 var (
 	_ sdk.ResourceWithUpdate                      = SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource{}
 	_ sdk.ResourceWithDeprecationAndNoReplacement = SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource{}
+	_ sdk.ResourceWithIdentity                    = SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource{}
 )
 
 func (s SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource) ResourceType() string {
@@ -56,6 +59,10 @@ func (s SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource)
 	return appplatform.ValidateApmID
 }
 
+func (s SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource) Identity() resourceids.ResourceId {
+	return &appplatform.ApmId{}
+}
+
 func (s SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource) Arguments() map[string]*schema.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -320,6 +327,10 @@ func (s SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource)
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -25,6 +26,8 @@ type SpringCloudApplicationLiveViewModel struct {
 	SpringCloudServiceId string `tfschema:"spring_cloud_service_id"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name spring_cloud_application_live_view -service-package-name springcloud -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,spring_name:id"
+
 type SpringCloudApplicationLiveViewResource struct{}
 
 func (s SpringCloudApplicationLiveViewResource) DeprecationMessage() string {
@@ -34,6 +37,7 @@ func (s SpringCloudApplicationLiveViewResource) DeprecationMessage() string {
 var (
 	_ sdk.Resource                                = SpringCloudApplicationLiveViewResource{}
 	_ sdk.ResourceWithDeprecationAndNoReplacement = SpringCloudApplicationLiveViewResource{}
+	_ sdk.ResourceWithIdentity                    = SpringCloudApplicationLiveViewResource{}
 )
 
 func (s SpringCloudApplicationLiveViewResource) ResourceType() string {
@@ -48,6 +52,10 @@ func (s SpringCloudApplicationLiveViewResource) IDValidationFunc() pluginsdk.Sch
 	return validate.SpringCloudApplicationLiveViewID
 }
 
+func (s SpringCloudApplicationLiveViewResource) Identity() resourceids.ResourceId {
+	return &parse.SpringCloudApplicationLiveViewId{}
+}
+
 func (s SpringCloudApplicationLiveViewResource) Arguments() map[string]*schema.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -133,6 +141,10 @@ func (s SpringCloudApplicationLiveViewResource) Read() sdk.ResourceFunc {
 				Name:                 id.ApplicationLiveViewName,
 				SpringCloudServiceId: parse.NewSpringCloudServiceID(id.SubscriptionId, id.ResourceGroup, id.SpringName).ID(),
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
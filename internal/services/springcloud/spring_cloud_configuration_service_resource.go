@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/appplatform/2024-01-01-preview/appplatform"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -44,6 +45,8 @@ type SpringCloudRepositoryModel struct {
 	Username              string   `tfschema:"username"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name spring_cloud_configuration_service -service-package-name springcloud -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,spring_name:id"
+
 type SpringCloudConfigurationServiceResource struct{}
 
 func (s SpringCloudConfigurationServiceResource) DeprecationMessage() string {
@@ -54,6 +57,7 @@ var (
 	_ sdk.ResourceWithUpdate                      = SpringCloudConfigurationServiceResource{}
 	_ sdk.ResourceWithStateMigration              = SpringCloudConfigurationServiceResource{}
 	_ sdk.ResourceWithDeprecationAndNoReplacement = SpringCloudConfigurationServiceResource{}
+	_ sdk.ResourceWithIdentity                    = SpringCloudConfigurationServiceResource{}
 )
 
 func (s SpringCloudConfigurationServiceResource) ResourceType() string {
@@ -194,6 +198,10 @@ func (s SpringCloudConfigurationServiceResource) IDValidationFunc() pluginsdk.Sc
 	return validate.SpringCloudConfigurationServiceID
 }
 
+func (s SpringCloudConfigurationServiceResource) Identity() resourceids.ResourceId {
+	return &appplatform.ConfigurationServiceId{}
+}
+
 func (s SpringCloudConfigurationServiceResource) StateUpgraders() sdk.StateUpgradeData {
 	return sdk.StateUpgradeData{
 		SchemaVersion: 1,
@@ -344,6 +352,10 @@ func (s SpringCloudConfigurationServiceResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/appplatform/2024-01-01-preview/appplatform"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -56,6 +57,8 @@ type SshAuthModel struct {
 	PrivateKeyAlgorithm string `tfschema:"host_key_algorithm"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name spring_cloud_customized_accelerator -service-package-name springcloud -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,spring_name:id,application_accelerator_name:id"
+
 type SpringCloudCustomizedAcceleratorResource struct{}
 
 func (s SpringCloudCustomizedAcceleratorResource) DeprecationMessage() string {
@@ -66,6 +69,7 @@ var (
 	_ sdk.ResourceWithUpdate                      = SpringCloudCustomizedAcceleratorResource{}
 	_ sdk.ResourceWithStateMigration              = SpringCloudCustomizedAcceleratorResource{}
 	_ sdk.ResourceWithDeprecationAndNoReplacement = SpringCloudCustomizedAcceleratorResource{}
+	_ sdk.ResourceWithIdentity                    = SpringCloudCustomizedAcceleratorResource{}
 )
 
 func (s SpringCloudCustomizedAcceleratorResource) ResourceType() string {
@@ -80,6 +84,10 @@ func (s SpringCloudCustomizedAcceleratorResource) IDValidationFunc() pluginsdk.S
 	return appplatform.ValidateCustomizedAcceleratorID
 }
 
+func (s SpringCloudCustomizedAcceleratorResource) Identity() resourceids.ResourceId {
+	return &appplatform.CustomizedAcceleratorId{}
+}
+
 func (s SpringCloudCustomizedAcceleratorResource) StateUpgraders() sdk.StateUpgradeData {
 	return sdk.StateUpgradeData{
 		SchemaVersion: 1,
@@ -409,6 +417,10 @@ func (s SpringCloudCustomizedAcceleratorResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
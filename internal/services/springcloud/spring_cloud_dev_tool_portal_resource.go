@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -36,6 +37,8 @@ type SsoModel struct {
 	Scope        []string `tfschema:"scope"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name spring_cloud_dev_tool_portal -service-package-name springcloud -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,spring_name:id"
+
 type SpringCloudDevToolPortalResource struct{}
 
 func (s SpringCloudDevToolPortalResource) DeprecationMessage() string {
@@ -45,6 +48,7 @@ func (s SpringCloudDevToolPortalResource) DeprecationMessage() string {
 var (
 	_ sdk.ResourceWithUpdate                      = SpringCloudDevToolPortalResource{}
 	_ sdk.ResourceWithDeprecationAndNoReplacement = SpringCloudDevToolPortalResource{}
+	_ sdk.ResourceWithIdentity                    = SpringCloudDevToolPortalResource{}
 )
 
 func (s SpringCloudDevToolPortalResource) ResourceType() string {
@@ -59,6 +63,10 @@ func (s SpringCloudDevToolPortalResource) IDValidationFunc() pluginsdk.SchemaVal
 	return validate.SpringCloudDevToolPortalID
 }
 
+func (s SpringCloudDevToolPortalResource) Identity() resourceids.ResourceId {
+	return &parse.SpringCloudDevToolPortalId{}
+}
+
 func (s SpringCloudDevToolPortalResource) Arguments() map[string]*schema.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -266,6 +274,10 @@ func (s SpringCloudDevToolPortalResource) Read() sdk.ResourceFunc {
 					}
 				}
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -20,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name spring_cloud_dynatrace_application_performance_monitoring -service-package-name springcloud -properties "name" -compare-values "subscription_id:id,resource_group_name:id,spring_name:id"
+
 type SpringCloudDynatraceApplicationPerformanceMonitoringModel struct {
 	Name                 string `tfschema:"name"`
 	SpringCloudServiceId string `tfschema:"spring_cloud_service_id"`
@@ -41,8 +43,13 @@ func (s SpringCloudDynatraceApplicationPerformanceMonitoringResource) Deprecatio
 var (
 	_ sdk.ResourceWithUpdate                      = SpringCloudDynatraceApplicationPerformanceMonitoringResource{}
 	_ sdk.ResourceWithDeprecationAndNoReplacement = SpringCloudDynatraceApplicationPerformanceMonitoringResource{}
+	_ sdk.ResourceWithIdentity                    = SpringCloudDynatraceApplicationPerformanceMonitoringResource{}
 )
 
+func (s SpringCloudDynatraceApplicationPerformanceMonitoringResource) Identity() resourceids.ResourceId {
+	return &appplatform.ApmId{}
+}
+
 func (s SpringCloudDynatraceApplicationPerformanceMonitoringResource) ResourceType() string {
 	return "azurerm_spring_cloud_dynatrace_application_performance_monitoring"
 }
@@ -333,6 +340,10 @@ func (s SpringCloudDynatraceApplicationPerformanceMonitoringResource) Read() sdk
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
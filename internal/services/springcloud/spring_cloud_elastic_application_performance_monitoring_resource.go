@@ -21,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name spring_cloud_elastic_application_performance_monitoring -service-package-name springcloud -properties "name" -compare-values "subscription_id:id,resource_group_name:id,spring_name:id"
+
 type SpringCloudElasticApplicationPerformanceMonitoringModel struct {
 	Name                 string   `tfschema:"name"`
 	SpringCloudServiceId string   `tfschema:"spring_cloud_service_id"`
@@ -39,8 +41,13 @@ func (s SpringCloudElasticApplicationPerformanceMonitoringResource) DeprecationM
 var (
 	_ sdk.ResourceWithUpdate                      = SpringCloudElasticApplicationPerformanceMonitoringResource{}
 	_ sdk.ResourceWithDeprecationAndNoReplacement = SpringCloudElasticApplicationPerformanceMonitoringResource{}
+	_ sdk.ResourceWithIdentity                    = SpringCloudElasticApplicationPerformanceMonitoringResource{}
 )
 
+func (s SpringCloudElasticApplicationPerformanceMonitoringResource) Identity() resourceids.ResourceId {
+	return &appplatform.ApmId{}
+}
+
 func (s SpringCloudElasticApplicationPerformanceMonitoringResource) ResourceType() string {
 	return "azurerm_spring_cloud_elastic_application_performance_monitoring"
 }
@@ -295,6 +302,10 @@ func (s SpringCloudElasticApplicationPerformanceMonitoringResource) Read() sdk.R
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
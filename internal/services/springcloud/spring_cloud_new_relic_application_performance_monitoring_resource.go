@@ -23,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name spring_cloud_new_relic_application_performance_monitoring -service-package-name springcloud -properties "name" -compare-values "subscription_id:id,resource_group_name:id,spring_name:id"
+
 type SpringCloudNewRelicApplicationPerformanceMonitoringModel struct {
 	Name                         string            `tfschema:"name"`
 	SpringCloudServiceId         string            `tfschema:"spring_cloud_service_id"`
@@ -47,8 +49,13 @@ func (s SpringCloudNewRelicApplicationPerformanceMonitoringResource) Deprecation
 var (
 	_ sdk.ResourceWithUpdate                      = SpringCloudNewRelicApplicationPerformanceMonitoringResource{}
 	_ sdk.ResourceWithDeprecationAndNoReplacement = SpringCloudNewRelicApplicationPerformanceMonitoringResource{}
+	_ sdk.ResourceWithIdentity                    = SpringCloudNewRelicApplicationPerformanceMonitoringResource{}
 )
 
+func (s SpringCloudNewRelicApplicationPerformanceMonitoringResource) Identity() resourceids.ResourceId {
+	return &appplatform.ApmId{}
+}
+
 func (s SpringCloudNewRelicApplicationPerformanceMonitoringResource) ResourceType() string {
 	return "azurerm_spring_cloud_new_relic_application_performance_monitoring"
 }
@@ -384,6 +391,10 @@ func (s SpringCloudNewRelicApplicationPerformanceMonitoringResource) Read() sdk.
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
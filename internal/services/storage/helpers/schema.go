@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -39,3 +40,22 @@ func ImporterValidatingStorageResourceIdThen(validateFunc StorageIDValidationFun
 		},
 	}
 }
+
+// ImporterValidatingStorageResourceIdOrIdentity is similar to ImporterValidatingStorageResourceId whilst additionally
+// supporting importing using the resource identity data, which is validated against the Resource Manager ID type `id`.
+func ImporterValidatingStorageResourceIdOrIdentity(validateFunc StorageIDValidationFunc, id resourceids.ResourceId) *schema.ResourceImporter {
+	importer := ImporterValidatingStorageResourceId(validateFunc)
+
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+			if d.Id() == "" {
+				log.Printf("[DEBUG] Importing Storage Resource - using the resource identity")
+				if err := pluginsdk.ValidateResourceIdentityData(d, id); err != nil {
+					return nil, err
+				}
+			}
+
+			return importer.StateContext(ctx, d, meta)
+		},
+	}
+}
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &StorageAccountDefaultBlobId{}
+
 type StorageAccountDefaultBlobId struct {
 	SubscriptionId     string
 	ResourceGroup      string
@@ -44,6 +46,45 @@ func (id StorageAccountDefaultBlobId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.BlobServiceName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this StorageAccountDefaultBlob ID
+func (id StorageAccountDefaultBlobId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftStorage", "Microsoft.Storage", "Microsoft.Storage"),
+		resourceids.StaticSegment("staticStorageAccounts", "storageAccounts", "storageAccounts"),
+		resourceids.UserSpecifiedSegment("storageAccountName", "storageAccount1"),
+		resourceids.StaticSegment("staticBlobServices", "blobServices", "blobServices"),
+		resourceids.UserSpecifiedSegment("blobServiceName", "default"),
+	}
+}
+
+// FromParseResult populates the fields of this StorageAccountDefaultBlob ID from the ParseResult, for use with resourceids.Parser
+func (id *StorageAccountDefaultBlobId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.StorageAccountName, ok = input.Parsed["storageAccountName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "storageAccountName", input)
+	}
+
+	if id.BlobServiceName, ok = input.Parsed["blobServiceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "blobServiceName", input)
+	}
+
+	return nil
+}
+
 // StorageAccountDefaultBlobID parses a StorageAccountDefaultBlob ID into an StorageAccountDefaultBlobId struct
 func StorageAccountDefaultBlobID(input string) (*StorageAccountDefaultBlobId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
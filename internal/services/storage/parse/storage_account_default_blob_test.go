@@ -21,6 +21,24 @@ func TestStorageAccountDefaultBlobIDFormatter(t *testing.T) {
 	}
 }
 
+func TestStorageAccountDefaultBlobIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&StorageAccountDefaultBlobId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/blobServices/default", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual StorageAccountDefaultBlobId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/blobServices/default"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestStorageAccountDefaultBlobID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &StorageContainerImmutabilityPolicyId{}
+
 type StorageContainerImmutabilityPolicyId struct {
 	SubscriptionId         string
 	ResourceGroup          string
@@ -50,6 +52,57 @@ func (id StorageContainerImmutabilityPolicyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.BlobServiceName, id.ContainerName, id.ImmutabilityPolicyName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this StorageContainerImmutabilityPolicy ID
+func (id StorageContainerImmutabilityPolicyId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftStorage", "Microsoft.Storage", "Microsoft.Storage"),
+		resourceids.StaticSegment("staticStorageAccounts", "storageAccounts", "storageAccounts"),
+		resourceids.UserSpecifiedSegment("storageAccountName", "storageAccount1"),
+		resourceids.StaticSegment("staticBlobServices", "blobServices", "blobServices"),
+		resourceids.UserSpecifiedSegment("blobServiceName", "default"),
+		resourceids.StaticSegment("staticContainers", "containers", "containers"),
+		resourceids.UserSpecifiedSegment("containerName", "container1"),
+		resourceids.StaticSegment("staticImmutabilityPolicies", "immutabilityPolicies", "immutabilityPolicies"),
+		resourceids.UserSpecifiedSegment("immutabilityPolicyName", "default"),
+	}
+}
+
+// FromParseResult populates the fields of this StorageContainerImmutabilityPolicy ID from the ParseResult, for use with resourceids.Parser
+func (id *StorageContainerImmutabilityPolicyId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.StorageAccountName, ok = input.Parsed["storageAccountName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "storageAccountName", input)
+	}
+
+	if id.BlobServiceName, ok = input.Parsed["blobServiceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "blobServiceName", input)
+	}
+
+	if id.ContainerName, ok = input.Parsed["containerName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "containerName", input)
+	}
+
+	if id.ImmutabilityPolicyName, ok = input.Parsed["immutabilityPolicyName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "immutabilityPolicyName", input)
+	}
+
+	return nil
+}
+
 // StorageContainerImmutabilityPolicyID parses a StorageContainerImmutabilityPolicy ID into an StorageContainerImmutabilityPolicyId struct
 func StorageContainerImmutabilityPolicyID(input string) (*StorageContainerImmutabilityPolicyId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
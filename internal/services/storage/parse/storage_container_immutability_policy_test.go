@@ -21,6 +21,24 @@ func TestStorageContainerImmutabilityPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestStorageContainerImmutabilityPolicyIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&StorageContainerImmutabilityPolicyId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/blobServices/default/containers/container1/immutabilityPolicies/default", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual StorageContainerImmutabilityPolicyId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/blobServices/default/containers/container1/immutabilityPolicies/default"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestStorageContainerImmutabilityPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &StorageQueueResourceManagerId{}
+
 type StorageQueueResourceManagerId struct {
 	SubscriptionId     string
 	ResourceGroup      string
@@ -47,6 +49,51 @@ func (id StorageQueueResourceManagerId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.QueueServiceName, id.QueueName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this StorageQueueResourceManager ID
+func (id StorageQueueResourceManagerId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftStorage", "Microsoft.Storage", "Microsoft.Storage"),
+		resourceids.StaticSegment("staticStorageAccounts", "storageAccounts", "storageAccounts"),
+		resourceids.UserSpecifiedSegment("storageAccountName", "storageAccount1"),
+		resourceids.StaticSegment("staticQueueServices", "queueServices", "queueServices"),
+		resourceids.UserSpecifiedSegment("queueServiceName", "default"),
+		resourceids.StaticSegment("staticQueues", "queues", "queues"),
+		resourceids.UserSpecifiedSegment("queueName", "queue1"),
+	}
+}
+
+// FromParseResult populates the fields of this StorageQueueResourceManager ID from the ParseResult, for use with resourceids.Parser
+func (id *StorageQueueResourceManagerId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.StorageAccountName, ok = input.Parsed["storageAccountName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "storageAccountName", input)
+	}
+
+	if id.QueueServiceName, ok = input.Parsed["queueServiceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "queueServiceName", input)
+	}
+
+	if id.QueueName, ok = input.Parsed["queueName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "queueName", input)
+	}
+
+	return nil
+}
+
 // StorageQueueResourceManagerID parses a StorageQueueResourceManager ID into an StorageQueueResourceManagerId struct
 func StorageQueueResourceManagerID(input string) (*StorageQueueResourceManagerId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -21,6 +21,24 @@ func TestStorageQueueResourceManagerIDFormatter(t *testing.T) {
 	}
 }
 
+func TestStorageQueueResourceManagerIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&StorageQueueResourceManagerId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/queueServices/default/queues/queue1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual StorageQueueResourceManagerId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/queueServices/default/queues/queue1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestStorageQueueResourceManagerID(t *testing.T) {
 	testData := []struct {
 		Input    string
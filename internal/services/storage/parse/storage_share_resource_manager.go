@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &StorageShareResourceManagerId{}
+
 type StorageShareResourceManagerId struct {
 	SubscriptionId     string
 	ResourceGroup      string
@@ -47,6 +49,51 @@ func (id StorageShareResourceManagerId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.FileServiceName, id.FileshareName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this StorageShareResourceManager ID
+func (id StorageShareResourceManagerId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftStorage", "Microsoft.Storage", "Microsoft.Storage"),
+		resourceids.StaticSegment("staticStorageAccounts", "storageAccounts", "storageAccounts"),
+		resourceids.UserSpecifiedSegment("storageAccountName", "storageAccount1"),
+		resourceids.StaticSegment("staticFileServices", "fileServices", "fileServices"),
+		resourceids.UserSpecifiedSegment("fileServiceName", "fileService1"),
+		resourceids.StaticSegment("staticFileshares", "fileshares", "fileshares"),
+		resourceids.UserSpecifiedSegment("fileshareName", "share1"),
+	}
+}
+
+// FromParseResult populates the fields of this StorageShareResourceManager ID from the ParseResult, for use with resourceids.Parser
+func (id *StorageShareResourceManagerId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.StorageAccountName, ok = input.Parsed["storageAccountName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "storageAccountName", input)
+	}
+
+	if id.FileServiceName, ok = input.Parsed["fileServiceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "fileServiceName", input)
+	}
+
+	if id.FileshareName, ok = input.Parsed["fileshareName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "fileshareName", input)
+	}
+
+	return nil
+}
+
 // StorageShareResourceManagerID parses a StorageShareResourceManager ID into an StorageShareResourceManagerId struct
 func StorageShareResourceManagerID(input string) (*StorageShareResourceManagerId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
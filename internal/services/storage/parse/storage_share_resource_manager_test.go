@@ -21,6 +21,24 @@ func TestStorageShareResourceManagerIDFormatter(t *testing.T) {
 	}
 }
 
+func TestStorageShareResourceManagerIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&StorageShareResourceManagerId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/fileServices/fileService1/fileshares/share1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual StorageShareResourceManagerId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/fileServices/fileService1/fileshares/share1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestStorageShareResourceManagerID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &StorageTableResourceManagerId{}
+
 type StorageTableResourceManagerId struct {
 	SubscriptionId     string
 	ResourceGroup      string
@@ -47,6 +49,51 @@ func (id StorageTableResourceManagerId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.TableServiceName, id.TableName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this StorageTableResourceManager ID
+func (id StorageTableResourceManagerId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftStorage", "Microsoft.Storage", "Microsoft.Storage"),
+		resourceids.StaticSegment("staticStorageAccounts", "storageAccounts", "storageAccounts"),
+		resourceids.UserSpecifiedSegment("storageAccountName", "storageAccount1"),
+		resourceids.StaticSegment("staticTableServices", "tableServices", "tableServices"),
+		resourceids.UserSpecifiedSegment("tableServiceName", "tableService1"),
+		resourceids.StaticSegment("staticTables", "tables", "tables"),
+		resourceids.UserSpecifiedSegment("tableName", "table1"),
+	}
+}
+
+// FromParseResult populates the fields of this StorageTableResourceManager ID from the ParseResult, for use with resourceids.Parser
+func (id *StorageTableResourceManagerId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.StorageAccountName, ok = input.Parsed["storageAccountName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "storageAccountName", input)
+	}
+
+	if id.TableServiceName, ok = input.Parsed["tableServiceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "tableServiceName", input)
+	}
+
+	if id.TableName, ok = input.Parsed["tableName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "tableName", input)
+	}
+
+	return nil
+}
+
 // StorageTableResourceManagerID parses a StorageTableResourceManager ID into an StorageTableResourceManagerId struct
 func StorageTableResourceManagerID(input string) (*StorageTableResourceManagerId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -21,6 +21,24 @@ func TestStorageTableResourceManagerIDFormatter(t *testing.T) {
 	}
 }
 
+func TestStorageTableResourceManagerIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&StorageTableResourceManagerId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/tableServices/tableService1/tables/table1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual StorageTableResourceManagerId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/tableServices/tableService1/tables/table1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestStorageTableResourceManagerID(t *testing.T) {
 	testData := []struct {
 		Input    string
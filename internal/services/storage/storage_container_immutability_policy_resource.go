@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/blobcontainers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
@@ -20,9 +21,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name storage_container_immutability_policy -service-package-name storage -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,storage_account_name:id,blob_service_name:id,container_name:id,name:id"
+
 type StorageContainerImmutabilityPolicyResource struct{}
 
 var _ sdk.ResourceWithUpdate = StorageContainerImmutabilityPolicyResource{}
+var _ sdk.ResourceWithIdentity = StorageContainerImmutabilityPolicyResource{}
 
 type ContainerImmutabilityPolicyModel struct {
 	StorageContainerResourceManagerId string `tfschema:"storage_container_resource_manager_id"`
@@ -40,6 +44,10 @@ func (r StorageContainerImmutabilityPolicyResource) IDValidationFunc() pluginsdk
 	return validate.StorageContainerImmutabilityPolicyID
 }
 
+func (r StorageContainerImmutabilityPolicyResource) Identity() resourceids.ResourceId {
+	return &parse.StorageContainerImmutabilityPolicyId{}
+}
+
 func (r StorageContainerImmutabilityPolicyResource) ModelObject() interface{} {
 	return &ContainerImmutabilityPolicyModel{}
 }
@@ -300,6 +308,10 @@ func (r StorageContainerImmutabilityPolicyResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/blobcontainers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -38,6 +39,8 @@ var containerAccessTypeConversionMap = map[string]string{
 	"":          "private",
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name storage_container -service-package-name storage -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:storage_account_id,storage_account_name:storage_account_id"
+
 func resourceStorageContainer() *pluginsdk.Resource {
 	r := &pluginsdk.Resource{
 		Create: resourceStorageContainerCreate,
@@ -45,7 +48,7 @@ func resourceStorageContainer() *pluginsdk.Resource {
 		Delete: resourceStorageContainerDelete,
 		Update: resourceStorageContainerUpdate,
 
-		Importer: helpers.ImporterValidatingStorageResourceId(func(id, storageDomainSuffix string) error {
+		Importer: helpers.ImporterValidatingStorageResourceIdOrIdentity(func(id, storageDomainSuffix string) error {
 			if !features.FivePointOh() {
 				if strings.HasPrefix(id, "/subscriptions/") {
 					_, err := commonids.ParseStorageContainerID(id)
@@ -57,7 +60,11 @@ func resourceStorageContainer() *pluginsdk.Resource {
 
 			_, err := commonids.ParseStorageContainerID(id)
 			return err
-		}),
+		}, &commonids.StorageContainerId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&commonids.StorageContainerId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -435,7 +442,7 @@ func resourceStorageContainerRead(d *pluginsdk.ResourceData, meta interface{}) e
 		resourceManagerId := commonids.NewStorageContainerID(account.StorageAccountId.SubscriptionId, account.StorageAccountId.ResourceGroupName, id.AccountId.AccountName, id.ContainerName)
 		d.Set("resource_manager_id", resourceManagerId.ID())
 
-		return nil
+		return pluginsdk.SetResourceIdentityData(d, &resourceManagerId)
 	}
 
 	if !features.FivePointOh() {
@@ -484,7 +491,7 @@ func resourceStorageContainerRead(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceStorageContainerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
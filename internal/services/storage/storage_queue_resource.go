@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/queueservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -28,6 +29,8 @@ import (
 	"github.com/jackofallops/giovanni/storage/2023-11-03/queue/queues"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name storage_queue -service-package-name storage -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:storage_account_id,storage_account_name:storage_account_id"
+
 func resourceStorageQueue() *pluginsdk.Resource {
 	r := &pluginsdk.Resource{
 		Create: resourceStorageQueueCreate,
@@ -35,7 +38,7 @@ func resourceStorageQueue() *pluginsdk.Resource {
 		Update: resourceStorageQueueUpdate,
 		Delete: resourceStorageQueueDelete,
 
-		Importer: helpers.ImporterValidatingStorageResourceId(func(id, storageDomainSuffix string) error {
+		Importer: helpers.ImporterValidatingStorageResourceIdOrIdentity(func(id, storageDomainSuffix string) error {
 			if !features.FivePointOh() {
 				if strings.HasPrefix(id, "/subscriptions/") {
 					_, err := queueservice.ParseQueueID(id)
@@ -47,7 +50,11 @@ func resourceStorageQueue() *pluginsdk.Resource {
 
 			_, err := queueservice.ParseQueueID(id)
 			return err
-		}),
+		}, &queueservice.QueueId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&queueservice.QueueId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -346,7 +353,7 @@ func resourceStorageQueueRead(d *pluginsdk.ResourceData, meta interface{}) error
 				d.Set("resource_manager_id", resourceManagerId.ID())
 				d.Set("url", id.ID())
 
-				return nil
+				return pluginsdk.SetResourceIdentityData(d, pointer.To(queueservice.NewQueueID(account.StorageAccountId.SubscriptionId, account.StorageAccountId.ResourceGroupName, id.AccountId.AccountName, id.QueueName)))
 			} else {
 				// Deal with the ID changing if the user changes from `storage_account_name` to `storage_account_id`
 				accountId, err := commonids.ParseStorageAccountID(said)
@@ -410,7 +417,7 @@ func resourceStorageQueueRead(d *pluginsdk.ResourceData, meta interface{}) error
 		d.Set("storage_account_name", "")
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceStorageQueueDelete(d *pluginsdk.ResourceData, meta interface{}) error {
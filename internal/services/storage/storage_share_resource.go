@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/fileshares"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/storageaccounts"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -32,6 +33,8 @@ import (
 	"github.com/jackofallops/giovanni/storage/2023-11-03/file/shares"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name storage_share -service-package-name storage -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:storage_account_id,storage_account_name:storage_account_id"
+
 func resourceStorageShare() *pluginsdk.Resource {
 	r := &pluginsdk.Resource{
 		Create: resourceStorageShareCreate,
@@ -39,7 +42,7 @@ func resourceStorageShare() *pluginsdk.Resource {
 		Update: resourceStorageShareUpdate,
 		Delete: resourceStorageShareDelete,
 
-		Importer: helpers.ImporterValidatingStorageResourceId(func(id, storageDomainSuffix string) error {
+		Importer: helpers.ImporterValidatingStorageResourceIdOrIdentity(func(id, storageDomainSuffix string) error {
 			if !features.FivePointOh() {
 				if strings.HasPrefix(id, "/subscriptions") {
 					_, err := fileshares.ParseShareID(id)
@@ -51,7 +54,11 @@ func resourceStorageShare() *pluginsdk.Resource {
 
 			_, err := fileshares.ParseShareID(id)
 			return err
-		}),
+		}, &fileshares.ShareId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&fileshares.ShareId{}),
+		},
 
 		SchemaVersion: 2,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -402,7 +409,7 @@ func resourceStorageShareRead(d *pluginsdk.ResourceData, meta interface{}) error
 		resourceManagerId := parse.NewStorageShareResourceManagerID(account.StorageAccountId.SubscriptionId, account.StorageAccountId.ResourceGroupName, account.StorageAccountId.StorageAccountName, "default", id.ShareName)
 		d.Set("resource_manager_id", resourceManagerId.ID())
 
-		return nil
+		return pluginsdk.SetResourceIdentityData(d, pointer.To(fileshares.NewShareID(account.StorageAccountId.SubscriptionId, account.StorageAccountId.ResourceGroupName, account.StorageAccountId.StorageAccountName, id.ShareName)))
 	}
 
 	if !features.FivePointOh() {
@@ -479,7 +486,7 @@ func resourceStorageShareRead(d *pluginsdk.ResourceData, meta interface{}) error
 
 	d.Set("url", shares.NewShareID(*accountId, id.ShareName).ID())
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceStorageShareUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
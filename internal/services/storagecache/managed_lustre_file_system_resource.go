@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2024-07-01/amlfilesystems"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name managed_lustre_file_system -service-package-name storagecache -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type ManagedLustreFileSystemModel struct {
 	Name                string                       `tfschema:"name"`
 	ResourceGroupName   string                       `tfschema:"resource_group_name"`
@@ -72,8 +75,13 @@ type ManagedLustreFileSystemResource struct{}
 var (
 	_ sdk.ResourceWithUpdate        = ManagedLustreFileSystemResource{}
 	_ sdk.ResourceWithCustomizeDiff = ManagedLustreFileSystemResource{}
+	_ sdk.ResourceWithIdentity      = ManagedLustreFileSystemResource{}
 )
 
+func (r ManagedLustreFileSystemResource) Identity() resourceids.ResourceId {
+	return &amlfilesystems.AmlFilesystemId{}
+}
+
 func GetSkuPropertiesByName(skuName string) *SkuProperties {
 	for _, sku := range PossibleSkuProperties() {
 		if skuName == sku.Name {
@@ -468,6 +476,10 @@ func (r ManagedLustreFileSystemResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
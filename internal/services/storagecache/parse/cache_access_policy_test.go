@@ -21,6 +21,24 @@ func TestCacheAccessPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestCacheAccessPolicyIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&CacheAccessPolicyId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.StorageCache/caches/cache1/cacheAccessPolicies/policy1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual CacheAccessPolicyId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.StorageCache/caches/cache1/cacheAccessPolicies/policy1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestCacheAccessPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/agents"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/storagemovers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name storage_mover_agent -service-package-name storagemover -properties "name" -compare-values "subscription_id:id,resource_group_name:id,storage_mover_name:id" -test-resource-type StorageMoverAgentTestResource
+
 type StorageMoverAgentResourceModel struct {
 	Name                string `tfschema:"name"`
 	StorageMoverId      string `tfschema:"storage_mover_id"`
@@ -29,6 +32,11 @@ type StorageMoverAgentResourceModel struct {
 type StorageMoverAgentResource struct{}
 
 var _ sdk.ResourceWithUpdate = StorageMoverAgentResource{}
+var _ sdk.ResourceWithIdentity = StorageMoverAgentResource{}
+
+func (r StorageMoverAgentResource) Identity() resourceids.ResourceId {
+	return &agents.AgentId{}
+}
 
 func (r StorageMoverAgentResource) ResourceType() string {
 	return "azurerm_storage_mover_agent"
@@ -201,6 +209,10 @@ func (r StorageMoverAgentResource) Read() sdk.ResourceFunc {
 				state.Description = pointer.From(model.Properties.Description)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
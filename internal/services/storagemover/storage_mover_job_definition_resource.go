@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/jobdefinitions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/projects"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name storage_mover_job_definition -service-package-name storagemover -properties "name" -compare-values "subscription_id:id,resource_group_name:id,storage_mover_name:id,project_name:id" -test-resource-type StorageMoverJobDefinitionTestResource
+
 type StorageMoverJobDefinitionResourceModel struct {
 	Name                  string                  `tfschema:"name"`
 	StorageMoverProjectId string                  `tfschema:"storage_mover_project_id"`
@@ -33,6 +36,11 @@ type StorageMoverJobDefinitionResourceModel struct {
 type StorageMoverJobDefinitionResource struct{}
 
 var _ sdk.ResourceWithUpdate = StorageMoverJobDefinitionResource{}
+var _ sdk.ResourceWithIdentity = StorageMoverJobDefinitionResource{}
+
+func (r StorageMoverJobDefinitionResource) Identity() resourceids.ResourceId {
+	return &jobdefinitions.JobDefinitionId{}
+}
 
 func (r StorageMoverJobDefinitionResource) ResourceType() string {
 	return "azurerm_storage_mover_job_definition"
@@ -274,6 +282,10 @@ func (r StorageMoverJobDefinitionResource) Read() sdk.ResourceFunc {
 
 				state.TargetSubpath = pointer.From(v.Properties.TargetSubpath)
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/projects"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/storagemovers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name storage_mover_project -service-package-name storagemover -properties "name" -compare-values "subscription_id:id,resource_group_name:id,storage_mover_name:id" -test-resource-type StorageMoverProjectTestResource
+
 type StorageMoverProjectResourceModel struct {
 	Name           string `tfschema:"name"`
 	StorageMoverId string `tfschema:"storage_mover_id"`
@@ -27,6 +30,11 @@ type StorageMoverProjectResourceModel struct {
 type StorageMoverProjectResource struct{}
 
 var _ sdk.ResourceWithUpdate = StorageMoverProjectResource{}
+var _ sdk.ResourceWithIdentity = StorageMoverProjectResource{}
+
+func (r StorageMoverProjectResource) Identity() resourceids.ResourceId {
+	return &projects.ProjectId{}
+}
 
 func (r StorageMoverProjectResource) ResourceType() string {
 	return "azurerm_storage_mover_project"
@@ -184,6 +192,10 @@ func (r StorageMoverProjectResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
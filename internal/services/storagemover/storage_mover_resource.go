@@ -11,12 +11,15 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/storagemovers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name storage_mover -service-package-name storagemover -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type StorageMoverTestResource
+
 type StorageMoverModel struct {
 	Name              string            `tfschema:"name"`
 	ResourceGroupName string            `tfschema:"resource_group_name"`
@@ -28,6 +31,11 @@ type StorageMoverModel struct {
 type StorageMoverResource struct{}
 
 var _ sdk.ResourceWithUpdate = StorageMoverResource{}
+var _ sdk.ResourceWithIdentity = StorageMoverResource{}
+
+func (r StorageMoverResource) Identity() resourceids.ResourceId {
+	return &storagemovers.StorageMoverId{}
+}
 
 func (r StorageMoverResource) ResourceType() string {
 	return "azurerm_storage_mover"
@@ -198,6 +206,10 @@ func (r StorageMoverResource) Read() sdk.ResourceFunc {
 				state.Tags = *model.Tags
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/endpoints"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/storagemovers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name storage_mover_source_endpoint -service-package-name storagemover -properties "name" -compare-values "subscription_id:id,resource_group_name:id,storage_mover_name:id" -test-resource-type StorageMoverSourceEndpointTestResource
+
 type StorageMoverSourceEndpointModel struct {
 	Name           string               `tfschema:"name"`
 	StorageMoverId string               `tfschema:"storage_mover_id"`
@@ -30,6 +33,11 @@ type StorageMoverSourceEndpointModel struct {
 type StorageMoverSourceEndpointResource struct{}
 
 var _ sdk.ResourceWithUpdate = StorageMoverSourceEndpointResource{}
+var _ sdk.ResourceWithIdentity = StorageMoverSourceEndpointResource{}
+
+func (r StorageMoverSourceEndpointResource) Identity() resourceids.ResourceId {
+	return &endpoints.EndpointId{}
+}
 
 func (r StorageMoverSourceEndpointResource) ResourceType() string {
 	return "azurerm_storage_mover_source_endpoint"
@@ -234,6 +242,10 @@ func (r StorageMoverSourceEndpointResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
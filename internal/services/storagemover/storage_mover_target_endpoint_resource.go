@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/endpoints"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/storagemovers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name storage_mover_target_endpoint -service-package-name storagemover -properties "name" -compare-values "subscription_id:id,resource_group_name:id,storage_mover_name:id" -test-resource-type StorageMoverTargetEndpointTestResource
+
 type StorageMoverTargetEndpointModel struct {
 	Name                 string `tfschema:"name"`
 	StorageMoverId       string `tfschema:"storage_mover_id"`
@@ -31,6 +34,11 @@ type StorageMoverTargetEndpointModel struct {
 type StorageMoverTargetEndpointResource struct{}
 
 var _ sdk.ResourceWithUpdate = StorageMoverTargetEndpointResource{}
+var _ sdk.ResourceWithIdentity = StorageMoverTargetEndpointResource{}
+
+func (r StorageMoverTargetEndpointResource) Identity() resourceids.ResourceId {
+	return &endpoints.EndpointId{}
+}
 
 func (r StorageMoverTargetEndpointResource) ResourceType() string {
 	return "azurerm_storage_mover_target_endpoint"
@@ -218,6 +226,10 @@ func (r StorageMoverTargetEndpointResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -21,6 +21,24 @@ func TestStreamingJobScheduleIDFormatter(t *testing.T) {
 	}
 }
 
+func TestStreamingJobScheduleIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&StreamingJobScheduleId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StreamAnalytics/streamingJobs/streamingJob1/schedule/default", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual StreamingJobScheduleId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StreamAnalytics/streamingJobs/streamingJob1/schedule/default"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestStreamingJobScheduleID(t *testing.T) {
 	testData := []struct {
 		Input    string
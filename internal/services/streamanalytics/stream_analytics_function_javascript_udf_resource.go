@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2020-03-01/functions"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/migration"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name stream_analytics_function_javascript_udf -service-package-name streamanalytics -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "streaming_job_name:id" -test-resource-type StreamAnalyticsFunctionJavaScriptUDFResource
+
 func resourceStreamAnalyticsFunctionUDF() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceStreamAnalyticsFunctionUDFCreateUpdate,
@@ -27,10 +30,11 @@ func resourceStreamAnalyticsFunctionUDF() *pluginsdk.Resource {
 		Update: resourceStreamAnalyticsFunctionUDFCreateUpdate,
 		Delete: resourceStreamAnalyticsFunctionUDFDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := functions.ParseFunctionID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&functions.FunctionId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&functions.FunctionId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -227,7 +231,7 @@ func resourceStreamAnalyticsFunctionUDFRead(d *pluginsdk.ResourceData, meta inte
 			}
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceStreamAnalyticsFunctionUDFDelete(d *pluginsdk.ResourceData, meta interface{}) error {
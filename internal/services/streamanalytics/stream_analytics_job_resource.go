@@ -28,6 +28,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name stream_analytics_job -service-package-name streamanalytics -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceStreamAnalyticsJob() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceStreamAnalyticsJobCreate,
@@ -35,10 +37,11 @@ func resourceStreamAnalyticsJob() *pluginsdk.Resource {
 		Update: resourceStreamAnalyticsJobUpdate,
 		Delete: resourceStreamAnalyticsJobDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := streamingjobs.ParseStreamingJobID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&streamingjobs.StreamingJobId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&streamingjobs.StreamingJobId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -413,10 +416,12 @@ func resourceStreamAnalyticsJobRead(d *pluginsdk.ResourceData, meta interface{})
 					d.Set("transformation_query", pointer.From(transformProps.Query))
 				}
 			}
-			return tags.FlattenAndSet(d, model.Tags)
+			if err := tags.FlattenAndSet(d, model.Tags); err != nil {
+				return err
+			}
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceStreamAnalyticsJobUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -11,6 +11,7 @@ import (
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2021-10-01-preview/streamingjobs"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name stream_analytics_job_schedule -service-package-name streamanalytics -known-values "subscription_id:data.Subscriptions.Primary,name:default" -compare-values "resource_group_name:stream_analytics_job_id,streaming_job_name:stream_analytics_job_id" -test-resource-type StreamAnalyticsJobScheduleResource
+
 type JobScheduleResource struct{}
 
 type JobScheduleResourceModel struct {
@@ -33,6 +36,8 @@ type JobScheduleResourceModel struct {
 
 var _ sdk.ResourceWithStateMigration = JobScheduleResource{}
 
+var _ sdk.ResourceWithIdentity = JobScheduleResource{}
+
 func (r JobScheduleResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"stream_analytics_job_id": {
@@ -83,6 +88,10 @@ func (r JobScheduleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return streamAnalyticsValidate.StreamingJobScheduleID
 }
 
+func (r JobScheduleResource) Identity() resourceids.ResourceId {
+	return &parse.StreamingJobScheduleId{}
+}
+
 func (r JobScheduleResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2020-03-01/privateendpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/migration"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name stream_analytics_managed_private_endpoint -service-package-name streamanalytics -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "cluster_name:id" -test-sequential
+
 type ManagedPrivateEndpointResource struct{}
 
 type ManagedPrivateEndpointModel struct {
@@ -30,6 +33,11 @@ type ManagedPrivateEndpointModel struct {
 }
 
 var _ sdk.ResourceWithStateMigration = ManagedPrivateEndpointResource{}
+var _ sdk.ResourceWithIdentity = ManagedPrivateEndpointResource{}
+
+func (r ManagedPrivateEndpointResource) Identity() resourceids.ResourceId {
+	return &privateendpoints.PrivateEndpointId{}
+}
 
 func (r ManagedPrivateEndpointResource) ModelObject() interface{} {
 	return &ManagedPrivateEndpointModel{}
@@ -164,6 +172,10 @@ func (r ManagedPrivateEndpointResource) Read() sdk.ResourceFunc {
 					}
 				}
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2020-03-01/streamingjobs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2021-10-01-preview/outputs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,13 +23,20 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name stream_analytics_output_cosmosdb -service-package-name streamanalytics -properties "name" -compare-values "subscription_id:id,resource_group_name:id,streaming_job_name:id" -test-resource-type StreamAnalyticsOutputCosmosDBResource
+
 type OutputCosmosDBResource struct{}
 
 var (
 	_ sdk.ResourceWithCustomImporter = OutputCosmosDBResource{}
 	_ sdk.ResourceWithStateMigration = OutputCosmosDBResource{}
+	_ sdk.ResourceWithIdentity       = OutputCosmosDBResource{}
 )
 
+func (r OutputCosmosDBResource) Identity() resourceids.ResourceId {
+	return &outputs.OutputId{}
+}
+
 type OutputCosmosDBResourceModel struct {
 	Name               string `tfschema:"name"`
 	StreamAnalyticsJob string `tfschema:"stream_analytics_job_id"`
@@ -230,6 +238,10 @@ func (r OutputCosmosDBResource) Read() sdk.ResourceFunc {
 
 					state.AuthenticationMode = string(pointer.From(output.Properties.AuthenticationMode))
 
+					if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+						return err
+					}
+
 					return metadata.Encode(&state)
 				}
 			}
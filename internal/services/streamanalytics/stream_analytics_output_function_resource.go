@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2021-10-01-preview/outputs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/migration"
@@ -19,13 +20,20 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name stream_analytics_output_function -service-package-name streamanalytics -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "streaming_job_name:id"
+
 type OutputFunctionResource struct{}
 
 var (
 	_ sdk.ResourceWithCustomImporter = OutputFunctionResource{}
 	_ sdk.ResourceWithStateMigration = OutputFunctionResource{}
+	_ sdk.ResourceWithIdentity       = OutputFunctionResource{}
 )
 
+func (r OutputFunctionResource) Identity() resourceids.ResourceId {
+	return &outputs.OutputId{}
+}
+
 type OutputFunctionResourceModel struct {
 	Name               string `tfschema:"name"`
 	StreamAnalyticsJob string `tfschema:"stream_analytics_job_name"`
@@ -195,6 +203,10 @@ func (r OutputFunctionResource) Read() sdk.ResourceFunc {
 							BatchMaxCount:      int64(pointer.From(output.Properties.MaxBatchCount)),
 						}
 
+						if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+							return err
+						}
+
 						return metadata.Encode(&state)
 					}
 				}
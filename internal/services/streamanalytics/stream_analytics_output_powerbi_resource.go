@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2020-03-01/streamingjobs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2021-10-01-preview/outputs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,13 +20,20 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name stream_analytics_output_powerbi -service-package-name streamanalytics -properties "name" -compare-values "subscription_id:id,resource_group_name:id,streaming_job_name:id" -test-resource-type StreamAnalyticsOutputPowerBIResource
+
 type OutputPowerBIResource struct{}
 
 var (
 	_ sdk.ResourceWithCustomImporter = OutputPowerBIResource{}
 	_ sdk.ResourceWithStateMigration = OutputPowerBIResource{}
+	_ sdk.ResourceWithIdentity       = OutputPowerBIResource{}
 )
 
+func (r OutputPowerBIResource) Identity() resourceids.ResourceId {
+	return &outputs.OutputId{}
+}
+
 type OutputPowerBIResourceModel struct {
 	Name                   string `tfschema:"name"`
 	StreamAnalyticsJob     string `tfschema:"stream_analytics_job_id"`
@@ -300,6 +308,10 @@ func (r OutputPowerBIResource) Read() sdk.ResourceFunc {
 					state.TokenUserDisplayName = metadata.ResourceData.Get("token_user_display_name").(string)
 					state.TokenUserPrincipalName = metadata.ResourceData.Get("token_user_principal_name").(string)
 
+					if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+						return err
+					}
+
 					return metadata.Encode(&state)
 				}
 			}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2021-10-01-preview/outputs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/migration"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name stream_analytics_output_servicebus_queue -service-package-name streamanalytics -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "streaming_job_name:id" -test-name avro -test-resource-type StreamAnalyticsOutputServiceBusQueueResource
+
 func resourceStreamAnalyticsOutputServiceBusQueue() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceStreamAnalyticsOutputServiceBusQueueCreateUpdate,
@@ -28,10 +31,11 @@ func resourceStreamAnalyticsOutputServiceBusQueue() *pluginsdk.Resource {
 		Update: resourceStreamAnalyticsOutputServiceBusQueueCreateUpdate,
 		Delete: resourceStreamAnalyticsOutputServiceBusQueueDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := outputs.ParseOutputID(id)
-			return err
-		}, importStreamAnalyticsOutput(outputs.ServiceBusQueueOutputDataSource{})),
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&outputs.OutputId{}, importStreamAnalyticsOutput(outputs.ServiceBusQueueOutputDataSource{})),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&outputs.OutputId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -266,7 +270,7 @@ func resourceStreamAnalyticsOutputServiceBusQueueRead(d *pluginsdk.ResourceData,
 			}
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceStreamAnalyticsOutputServiceBusQueueDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2021-10-01-preview/outputs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/migration"
@@ -18,13 +19,20 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name stream_analytics_output_table -service-package-name streamanalytics -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "streaming_job_name:id"
+
 type OutputTableResource struct{}
 
 var (
 	_ sdk.ResourceWithCustomImporter = OutputTableResource{}
 	_ sdk.ResourceWithStateMigration = OutputTableResource{}
+	_ sdk.ResourceWithIdentity       = OutputTableResource{}
 )
 
+func (r OutputTableResource) Identity() resourceids.ResourceId {
+	return &outputs.OutputId{}
+}
+
 type OutputTableResourceModel struct {
 	Name               string   `tfschema:"name"`
 	StreamAnalyticsJob string   `tfschema:"stream_analytics_job_name"`
@@ -250,6 +258,10 @@ func (r OutputTableResource) Read() sdk.ResourceFunc {
 						}
 						state.ColumnsToRemove = columnsToRemove
 
+						if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+							return err
+						}
+
 						return metadata.Encode(&state)
 					}
 				}
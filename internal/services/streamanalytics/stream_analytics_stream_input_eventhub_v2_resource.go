@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2020-03-01/inputs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2020-03-01/streamingjobs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,11 +20,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name stream_analytics_stream_input_eventhub_v2 -service-package-name streamanalytics -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,streaming_job_name:id" -test-name avro -test-resource-type StreamAnalyticsStreamInputEventHubV2Resource
+
 type StreamInputEventHubV2Resource struct{}
 
 var (
 	_ sdk.ResourceWithCustomImporter = StreamInputEventHubV2Resource{}
 	_ sdk.ResourceWithStateMigration = StreamInputEventHubV2Resource{}
+	_ sdk.ResourceWithIdentity       = StreamInputEventHubV2Resource{}
 )
 
 type StreamInputEventHubV2ResourceModel struct {
@@ -361,6 +365,10 @@ func (r StreamInputEventHubV2Resource) IDValidationFunc() pluginsdk.SchemaValida
 	return inputs.ValidateInputID
 }
 
+func (r StreamInputEventHubV2Resource) Identity() resourceids.ResourceId {
+	return &inputs.InputId{}
+}
+
 func (r StreamInputEventHubV2Resource) CustomImporter() sdk.ResourceRunFunc {
 	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 		id, err := inputs.ParseInputID(metadata.ResourceData.Id())
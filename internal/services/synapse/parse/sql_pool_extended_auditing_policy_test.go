@@ -21,6 +21,24 @@ func TestSqlPoolExtendedAuditingPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSqlPoolExtendedAuditingPolicyIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&SqlPoolExtendedAuditingPolicyId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Synapse/workspaces/workspace1/sqlPools/sqlPool1/extendedAuditingSettings/default", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual SqlPoolExtendedAuditingPolicyId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Synapse/workspaces/workspace1/sqlPools/sqlPool1/extendedAuditingSettings/default"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestSqlPoolExtendedAuditingPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
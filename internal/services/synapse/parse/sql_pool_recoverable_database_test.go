@@ -21,6 +21,24 @@ func TestSqlPoolRecoverableDatabaseIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSqlPoolRecoverableDatabaseIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&SqlPoolRecoverableDatabaseId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Synapse/workspaces/workspace1/recoverableDatabases/database", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual SqlPoolRecoverableDatabaseId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Synapse/workspaces/workspace1/recoverableDatabases/database"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestSqlPoolRecoverableDatabaseID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,24 @@ func TestSqlPoolSecurityAlertPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSqlPoolSecurityAlertPolicyIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&SqlPoolSecurityAlertPolicyId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Synapse/workspaces/workspace1/sqlPools/sqlPool1/securityAlertPolicies/Default", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual SqlPoolSecurityAlertPolicyId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Synapse/workspaces/workspace1/sqlPools/sqlPool1/securityAlertPolicies/Default"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestSqlPoolSecurityAlertPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &SqlPoolVulnerabilityAssessmentBaselineId{}
+
 type SqlPoolVulnerabilityAssessmentBaselineId struct {
 	SubscriptionId              string
 	ResourceGroup               string
@@ -53,6 +55,63 @@ func (id SqlPoolVulnerabilityAssessmentBaselineId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.SqlPoolName, id.VulnerabilityAssessmentName, id.RuleName, id.BaselineName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SqlPoolVulnerabilityAssessmentBaseline ID
+func (id SqlPoolVulnerabilityAssessmentBaselineId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSynapse", "Microsoft.Synapse", "Microsoft.Synapse"),
+		resourceids.StaticSegment("staticWorkspaces", "workspaces", "workspaces"),
+		resourceids.UserSpecifiedSegment("workspaceName", "workspace1"),
+		resourceids.StaticSegment("staticSqlPools", "sqlPools", "sqlPools"),
+		resourceids.UserSpecifiedSegment("sqlPoolName", "sqlPool1"),
+		resourceids.StaticSegment("staticVulnerabilityAssessments", "vulnerabilityAssessments", "vulnerabilityAssessments"),
+		resourceids.UserSpecifiedSegment("vulnerabilityAssessmentName", "default"),
+		resourceids.StaticSegment("staticRules", "rules", "rules"),
+		resourceids.UserSpecifiedSegment("ruleName", "rule1"),
+		resourceids.StaticSegment("staticBaselines", "baselines", "baselines"),
+		resourceids.UserSpecifiedSegment("baselineName", "baseline1"),
+	}
+}
+
+// FromParseResult populates the fields of this SqlPoolVulnerabilityAssessmentBaseline ID from the ParseResult, for use with resourceids.Parser
+func (id *SqlPoolVulnerabilityAssessmentBaselineId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.WorkspaceName, ok = input.Parsed["workspaceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "workspaceName", input)
+	}
+
+	if id.SqlPoolName, ok = input.Parsed["sqlPoolName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "sqlPoolName", input)
+	}
+
+	if id.VulnerabilityAssessmentName, ok = input.Parsed["vulnerabilityAssessmentName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "vulnerabilityAssessmentName", input)
+	}
+
+	if id.RuleName, ok = input.Parsed["ruleName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "ruleName", input)
+	}
+
+	if id.BaselineName, ok = input.Parsed["baselineName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "baselineName", input)
+	}
+
+	return nil
+}
+
 // SqlPoolVulnerabilityAssessmentBaselineID parses a SqlPoolVulnerabilityAssessmentBaseline ID into an SqlPoolVulnerabilityAssessmentBaselineId struct
 func SqlPoolVulnerabilityAssessmentBaselineID(input string) (*SqlPoolVulnerabilityAssessmentBaselineId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
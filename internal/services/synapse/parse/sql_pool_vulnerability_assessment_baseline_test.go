@@ -21,6 +21,24 @@ func TestSqlPoolVulnerabilityAssessmentBaselineIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSqlPoolVulnerabilityAssessmentBaselineIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&SqlPoolVulnerabilityAssessmentBaselineId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Synapse/workspaces/workspace1/sqlPools/sqlPool1/vulnerabilityAssessments/default/rules/rule1/baselines/baseline1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual SqlPoolVulnerabilityAssessmentBaselineId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Synapse/workspaces/workspace1/sqlPools/sqlPool1/vulnerabilityAssessments/default/rules/rule1/baselines/baseline1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestSqlPoolVulnerabilityAssessmentBaselineID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,24 @@ func TestWorkspaceAADAdminIDFormatter(t *testing.T) {
 	}
 }
 
+func TestWorkspaceAADAdminIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&WorkspaceAADAdminId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Synapse/workspaces/workspace1/administrators/activeDirectory", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual WorkspaceAADAdminId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Synapse/workspaces/workspace1/administrators/activeDirectory"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestWorkspaceAADAdminID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,24 @@ func TestWorkspaceExtendedAuditingPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestWorkspaceExtendedAuditingPolicyIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&WorkspaceExtendedAuditingPolicyId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Synapse/workspaces/workspace1/extendedAuditingSettings/default", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual WorkspaceExtendedAuditingPolicyId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Synapse/workspaces/workspace1/extendedAuditingSettings/default"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestWorkspaceExtendedAuditingPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,24 @@ func TestWorkspaceKeysIDFormatter(t *testing.T) {
 	}
 }
 
+func TestWorkspaceKeysIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&WorkspaceKeysId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Synapse/workspaces/workspace1/keys/key1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual WorkspaceKeysId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Synapse/workspaces/workspace1/keys/key1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestWorkspaceKeysID(t *testing.T) {
 	testData := []struct {
 		Input    string
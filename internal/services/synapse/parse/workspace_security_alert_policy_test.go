@@ -21,6 +21,24 @@ func TestWorkspaceSecurityAlertPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestWorkspaceSecurityAlertPolicyIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&WorkspaceSecurityAlertPolicyId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Synapse/workspaces/workspace1/securityAlertPolicies/Default", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual WorkspaceSecurityAlertPolicyId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Synapse/workspaces/workspace1/securityAlertPolicies/Default"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestWorkspaceSecurityAlertPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &WorkspaceSqlAADAdminId{}
+
 type WorkspaceSqlAADAdminId struct {
 	SubscriptionId       string
 	ResourceGroup        string
@@ -44,6 +46,45 @@ func (id WorkspaceSqlAADAdminId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.SqlAdministratorName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this WorkspaceSqlAADAdmin ID
+func (id WorkspaceSqlAADAdminId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resourceGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSynapse", "Microsoft.Synapse", "Microsoft.Synapse"),
+		resourceids.StaticSegment("staticWorkspaces", "workspaces", "workspaces"),
+		resourceids.UserSpecifiedSegment("workspaceName", "workspace1"),
+		resourceids.StaticSegment("staticSqlAdministrators", "sqlAdministrators", "sqlAdministrators"),
+		resourceids.UserSpecifiedSegment("sqlAdministratorName", "activeDirectory"),
+	}
+}
+
+// FromParseResult populates the fields of this WorkspaceSqlAADAdmin ID from the ParseResult, for use with resourceids.Parser
+func (id *WorkspaceSqlAADAdminId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.WorkspaceName, ok = input.Parsed["workspaceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "workspaceName", input)
+	}
+
+	if id.SqlAdministratorName, ok = input.Parsed["sqlAdministratorName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "sqlAdministratorName", input)
+	}
+
+	return nil
+}
+
 // WorkspaceSqlAADAdminID parses a WorkspaceSqlAADAdmin ID into an WorkspaceSqlAADAdminId struct
 func WorkspaceSqlAADAdminID(input string) (*WorkspaceSqlAADAdminId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/migration"
@@ -23,6 +24,8 @@ import (
 	artifacts "github.com/jackofallops/kermit/sdk/synapse/2021-06-01-preview/synapse"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name synapse_linked_service -service-package-name synapse -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,workspace_name:id" -test-resource-type LinkedServiceResource
+
 func resourceSynapseLinkedService() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceSynapseLinkedServiceCreateUpdate,
@@ -30,10 +33,11 @@ func resourceSynapseLinkedService() *pluginsdk.Resource {
 		Update: resourceSynapseLinkedServiceCreateUpdate,
 		Delete: resourceSynapseLinkedServiceDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.LinkedServiceID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.LinkedServiceId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.LinkedServiceId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -431,7 +435,7 @@ func resourceSynapseLinkedServiceRead(d *pluginsdk.ResourceData, meta interface{
 	}
 	d.Set("additional_properties", additionalProperties)
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceSynapseLinkedServiceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/synapse/mgmt/v2.0/synapse" // nolint: staticcheck
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name synapse_sql_pool_extended_auditing_policy -service-package-name synapse -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,workspace_name:id,sql_pool_name:id,name:id"
+
 func resourceSynapseSqlPoolExtendedAuditingPolicy() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceSynapseSqlPoolExtendedAuditingPolicyCreateUpdate,
@@ -33,10 +36,11 @@ func resourceSynapseSqlPoolExtendedAuditingPolicy() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.SqlPoolExtendedAuditingPolicyID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.SqlPoolExtendedAuditingPolicyId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.SqlPoolExtendedAuditingPolicyId{}),
+		},
 
 		Schema: map[string]*pluginsdk.Schema{
 			"sql_pool_id": {
@@ -163,7 +167,7 @@ func resourceSynapseSqlPoolExtendedAuditingPolicyRead(d *pluginsdk.ResourceData,
 		d.Set("log_monitoring_enabled", props.IsAzureMonitorTargetEnabled)
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceSynapseSqlPoolExtendedAuditingPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/synapse/mgmt/v2.0/synapse" // nolint: staticcheck
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/validate"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name synapse_sql_pool_security_alert_policy -service-package-name synapse -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,workspace_name:id,sql_pool_name:id,name:id"
+
 func resourceSynapseSqlPoolSecurityAlertPolicy() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceSynapseSqlPoolSecurityAlertPolicyCreateUpdate,
@@ -32,10 +35,11 @@ func resourceSynapseSqlPoolSecurityAlertPolicy() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.SqlPoolSecurityAlertPolicyID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.SqlPoolSecurityAlertPolicyId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.SqlPoolSecurityAlertPolicyId{}),
+		},
 
 		Schema: map[string]*pluginsdk.Schema{
 			"sql_pool_id": {
@@ -199,7 +203,7 @@ func resourceSynapseSqlPoolSecurityAlertPolicyRead(d *pluginsdk.ResourceData, me
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceSynapseSqlPoolSecurityAlertPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/synapse/mgmt/v2.0/synapse" // nolint: staticcheck
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name synapse_sql_pool_vulnerability_assessment_baseline -service-package-name synapse -properties "rule_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,workspace_name:id,sql_pool_name:id,vulnerability_assessment_name:id"
+
 func resourceSynapseSqlPoolVulnerabilityAssessmentBaseline() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceSynapseSqlPoolVulnerabilityAssessmentBaselineCreateUpdate,
@@ -33,10 +36,11 @@ func resourceSynapseSqlPoolVulnerabilityAssessmentBaseline() *pluginsdk.Resource
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.SqlPoolVulnerabilityAssessmentBaselineID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.SqlPoolVulnerabilityAssessmentBaselineId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.SqlPoolVulnerabilityAssessmentBaselineId{}),
+		},
 
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
@@ -145,7 +149,7 @@ func resourceSynapseSqlPoolVulnerabilityAssessmentBaselineRead(d *pluginsdk.Reso
 			return fmt.Errorf("setting `baseline`: %+v", err)
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceSynapseSqlPoolVulnerabilityAssessmentBaselineDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/synapse/mgmt/v2.0/synapse" // nolint: staticcheck
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name synapse_sql_pool_workload_classifier -service-package-name synapse -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,workspace_name:id,sql_pool_name:id,workload_group_name:id" -test-resource-type SynapseWorkloadClassifierResource
+
 func resourceSynapseSQLPoolWorkloadClassifier() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceSynapseSQLPoolWorkloadClassifierCreateUpdate,
@@ -34,10 +37,11 @@ func resourceSynapseSQLPoolWorkloadClassifier() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.SqlPoolWorkloadClassifierID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.SqlPoolWorkloadClassifierId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.SqlPoolWorkloadClassifierId{}),
+		},
 
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
@@ -180,7 +184,7 @@ func resourceSynapseSQLPoolWorkloadClassifierRead(d *pluginsdk.ResourceData, met
 		d.Set("member_name", props.MemberName)
 		d.Set("start_time", props.StartTime)
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceSynapseSQLPoolWorkloadClassifierDelete(d *pluginsdk.ResourceData, meta interface{}) error {
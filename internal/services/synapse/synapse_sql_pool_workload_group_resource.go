@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/synapse/mgmt/v2.0/synapse" // nolint: staticcheck
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name synapse_sql_pool_workload_group -service-package-name synapse -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,workspace_name:id,sql_pool_name:id" -test-resource-type SynapseWorkloadGroupResource
+
 func resourceSynapseSQLPoolWorkloadGroup() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceSynapseSQLPoolWorkloadGroupCreateUpdate,
@@ -33,10 +36,11 @@ func resourceSynapseSQLPoolWorkloadGroup() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.SqlPoolWorkloadGroupID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.SqlPoolWorkloadGroupId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.SqlPoolWorkloadGroupId{}),
+		},
 
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
@@ -172,7 +176,7 @@ func resourceSynapseSQLPoolWorkloadGroupRead(d *pluginsdk.ResourceData, meta int
 		d.Set("min_resource_percent_per_request", props.MinResourcePercentPerRequest)
 		d.Set("query_execution_timeout_in_seconds", props.QueryExecutionTimeout)
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceSynapseSQLPoolWorkloadGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/clusters"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/datastores"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -24,8 +25,12 @@ type NetappFileVolumeAttachment struct {
 	VmwareClusterId string `tfschema:"vmware_cluster_id"`
 }
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name vmware_netapp_volume_attachment -service-package-name vmware -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,private_cloud_name:id,cluster_name:id" -test-resource-type VmwareNetappFileVolumeAttachmentResource
+
 type NetappFileVolumeAttachmentResource struct{}
 
+var _ sdk.ResourceWithIdentity = NetappFileVolumeAttachmentResource{}
+
 func (r NetappFileVolumeAttachmentResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -67,6 +72,10 @@ func (r NetappFileVolumeAttachmentResource) IDValidationFunc() pluginsdk.SchemaV
 	return datastores.ValidateDataStoreID
 }
 
+func (r NetappFileVolumeAttachmentResource) Identity() resourceids.ResourceId {
+	return &datastores.DataStoreId{}
+}
+
 func (r NetappFileVolumeAttachmentResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/voiceservices/2023-04-03/communicationsgateways"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name voice_services_communications_gateway -service-package-name voiceservices -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type VoiceServicesCommunicationsGatewayTestResource
+
 type CommunicationsGatewayModel struct {
 	Name                               string                                                   `tfschema:"name"`
 	ResourceGroupName                  string                                                   `tfschema:"resource_group_name"`
@@ -53,6 +56,11 @@ type CommunicationsGatewayResource struct{}
 var _ sdk.ResourceWithUpdate = CommunicationsGatewayResource{}
 
 var _ sdk.ResourceWithCustomizeDiff = CommunicationsGatewayResource{}
+var _ sdk.ResourceWithIdentity = CommunicationsGatewayResource{}
+
+func (r CommunicationsGatewayResource) Identity() resourceids.ResourceId {
+	return &communicationsgateways.CommunicationsGatewayId{}
+}
 
 func (r CommunicationsGatewayResource) ResourceType() string {
 	return "azurerm_voice_services_communications_gateway"
@@ -464,6 +472,10 @@ func (r CommunicationsGatewayResource) Read() sdk.ResourceFunc {
 				state.Tags = *model.Tags
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
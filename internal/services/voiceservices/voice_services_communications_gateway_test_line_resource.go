@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/voiceservices/2023-04-03/communicationsgateways"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/voiceservices/2023-04-03/testlines"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name voice_services_communications_gateway_test_line -service-package-name voiceservices -properties "name" -compare-values "subscription_id:id,resource_group_name:id,communications_gateway_name:id" -test-resource-type CommunicationsGatewayTestLineTestResource
+
 type CommunicationsGatewayTestLineResourceModel struct {
 	Name                                 string                    `tfschema:"name"`
 	Location                             string                    `tfschema:"location"`
@@ -31,6 +34,11 @@ type CommunicationsGatewayTestLineResourceModel struct {
 type CommunicationsGatewayTestLineResource struct{}
 
 var _ sdk.ResourceWithUpdate = CommunicationsGatewayTestLineResource{}
+var _ sdk.ResourceWithIdentity = CommunicationsGatewayTestLineResource{}
+
+func (r CommunicationsGatewayTestLineResource) Identity() resourceids.ResourceId {
+	return &testlines.TestLineId{}
+}
 
 func (r CommunicationsGatewayTestLineResource) ResourceType() string {
 	return "azurerm_voice_services_communications_gateway_test_line"
@@ -218,6 +226,10 @@ func (r CommunicationsGatewayTestLineResource) Read() sdk.ResourceFunc {
 				state.Tags = *model.Tags
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
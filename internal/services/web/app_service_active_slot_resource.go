@@ -10,6 +10,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -17,16 +18,20 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name app_service_active_slot -service-package-name web -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,name:id"
+
 func resourceAppServiceActiveSlot() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceAppServiceActiveSlotCreateUpdate,
 		Read:   resourceAppServiceActiveSlotRead,
 		Update: resourceAppServiceActiveSlotCreateUpdate,
 		Delete: resourceAppServiceActiveSlotDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.AppServiceID(id)
-			return err
-		}),
+
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.AppServiceId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.AppServiceId{}),
+		},
 
 		DeprecationMessage: "The `azurerm_app_service_active_slot` resource has been superseded by the `azurerm_web_app_active_slot` and `azurerm_function_app_active_slot` resources. Whilst this resource will continue to be available in the 2.x and 3.x releases it is feature-frozen for compatibility purposes, will no longer receive any updates and will be removed in a future major release of the Azure Provider.",
 
@@ -121,7 +126,7 @@ func resourceAppServiceActiveSlotRead(d *pluginsdk.ResourceData, meta interface{
 	d.Set("app_service_name", resp.Name)
 	d.Set("resource_group_name", resp.ResourceGroup)
 	d.Set("app_service_slot_name", resp.SlotSwapStatus.SourceSlotName)
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceAppServiceActiveSlotDelete(_ *pluginsdk.ResourceData, _ interface{}) error {
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/hybridconnections"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/namespaces"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -26,6 +27,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name app_service_hybrid_connection -service-package-name web -properties "resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "site_name:id,hybrid_connection_namespace_name:id,name:id"
+
 func resourceAppServiceHybridConnection() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceAppServiceHybridConnectionCreateUpdate,
@@ -33,10 +36,11 @@ func resourceAppServiceHybridConnection() *pluginsdk.Resource {
 		Update: resourceAppServiceHybridConnectionCreateUpdate,
 		Delete: resourceAppServiceHybridConnectionDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.HybridConnectionID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.HybridConnectionId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.HybridConnectionId{}),
+		},
 
 		DeprecationMessage: "The `azurerm_app_service_hybrid_connection` resource has been superseded by the `azurerm_function_app_hybrid_connection` and `azurerm_web_app_hybrid_connection` resources. Whilst this resource will continue to be available in the 2.x and 3.x releases it is feature-frozen for compatibility purposes, will no longer receive any updates and will be removed in a future major release of the Azure Provider.",
 
@@ -206,7 +210,7 @@ func resourceAppServiceHybridConnectionRead(d *pluginsdk.ResourceData, meta inte
 
 		if err == nil && accessKeys.Model != nil {
 			d.Set("send_key_value", accessKeys.Model.PrimaryKey)
-			return nil
+			return pluginsdk.SetResourceIdentityData(d, id)
 		}
 
 		connAccessKeys, err := relayClient.ListKeys(ctx, hybridconnections.NewHybridConnectionAuthorizationRuleID(id.SubscriptionId, *relayNamespaceRG, *resp.ServiceBusNamespace, *resp.Name, *resp.SendKeyName))
@@ -218,7 +222,7 @@ func resourceAppServiceHybridConnectionRead(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceAppServiceHybridConnectionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -11,6 +11,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -25,16 +26,20 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name app_service_plan -service-package-name web -properties "resource_group_name,name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name basicWindows
+
 func resourceAppServicePlan() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceAppServicePlanCreateUpdate,
 		Read:   resourceAppServicePlanRead,
 		Update: resourceAppServicePlanCreateUpdate,
 		Delete: resourceAppServicePlanDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.AppServicePlanID(id)
-			return err
-		}),
+
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.AppServicePlanId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.AppServicePlanId{}),
+		},
 
 		DeprecationMessage: "The `azurerm_app_service_plan` resource has been superseded by the `azurerm_service_plan` resource. Whilst this resource will continue to be available in the 2.x and 3.x releases it is feature-frozen for compatibility purposes, will no longer receive any updates and will be removed in a future major release of the Azure Provider.",
 
@@ -306,7 +311,11 @@ func resourceAppServicePlanRead(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("setting `sku`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	if err := tags.FlattenAndSet(d, resp.Tags); err != nil {
+		return err
+	}
+
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceAppServicePlanDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -20,15 +21,18 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name app_service_public_certificate -service-package-name web -properties "resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "site_name:id,name:id" -test-name basicAppService
+
 func resourceAppServicePublicCertificate() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceAppServicePublicCertificateCreate,
-		Read:   resourceAppServicePublicCertificateRead,
-		Delete: resourceAppServicePublicCertificateDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := webapps.ParsePublicCertificateID(id)
-			return err
-		}),
+		Create:   resourceAppServicePublicCertificateCreate,
+		Read:     resourceAppServicePublicCertificateRead,
+		Delete:   resourceAppServicePublicCertificateDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&webapps.PublicCertificateId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&webapps.PublicCertificateId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -206,7 +210,7 @@ func resourceAppServicePublicCertificateRead(d *pluginsdk.ResourceData, meta int
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceAppServicePublicCertificateDelete(d *pluginsdk.ResourceData, meta interface{}) error {
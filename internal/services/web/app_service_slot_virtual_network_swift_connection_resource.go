@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/subnets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name app_service_slot_virtual_network_swift_connection -service-package-name web -properties "slot_name" -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,site_name:id,name:id" -test-name app_basic
+
 func resourceAppServiceSlotVirtualNetworkSwiftConnection() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceAppServiceSlotVirtualNetworkSwiftConnectionCreateUpdate,
@@ -29,10 +32,11 @@ func resourceAppServiceSlotVirtualNetworkSwiftConnection() *pluginsdk.Resource {
 		Update: resourceAppServiceSlotVirtualNetworkSwiftConnectionCreateUpdate,
 		Delete: resourceAppServiceSlotVirtualNetworkSwiftConnectionDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.SlotVirtualNetworkSwiftConnectionID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.SlotVirtualNetworkSwiftConnectionId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.SlotVirtualNetworkSwiftConnectionId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -216,7 +220,7 @@ func resourceAppServiceSlotVirtualNetworkSwiftConnectionRead(d *pluginsdk.Resour
 	d.Set("subnet_id", subnetID)
 	d.Set("app_service_id", appService.ID)
 	d.Set("slot_name", id.SlotName)
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceAppServiceSlotVirtualNetworkSwiftConnectionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
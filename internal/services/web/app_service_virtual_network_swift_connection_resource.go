@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/subnets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name app_service_virtual_network_swift_connection -service-package-name web -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,site_name:id,name:id"
+
 func resourceAppServiceVirtualNetworkSwiftConnection() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceAppServiceVirtualNetworkSwiftConnectionCreateUpdate,
@@ -29,10 +32,11 @@ func resourceAppServiceVirtualNetworkSwiftConnection() *pluginsdk.Resource {
 		Update: resourceAppServiceVirtualNetworkSwiftConnectionCreateUpdate,
 		Delete: resourceAppServiceVirtualNetworkSwiftConnectionDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.VirtualNetworkSwiftConnectionID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&parse.VirtualNetworkSwiftConnectionId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.VirtualNetworkSwiftConnectionId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -191,7 +195,7 @@ func resourceAppServiceVirtualNetworkSwiftConnectionRead(d *pluginsdk.ResourceDa
 	}
 	d.Set("subnet_id", subnetID)
 	d.Set("app_service_id", appService.ID)
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceAppServiceVirtualNetworkSwiftConnectionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &AppServiceSlotCustomHostnameBindingId{}
+
 type AppServiceSlotCustomHostnameBindingId struct {
 	SubscriptionId      string
 	ResourceGroup       string
@@ -47,6 +49,51 @@ func (id AppServiceSlotCustomHostnameBindingId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SiteName, id.SlotName, id.HostNameBindingName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this AppServiceSlotCustomHostnameBinding ID
+func (id AppServiceSlotCustomHostnameBindingId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftWeb", "Microsoft.Web", "Microsoft.Web"),
+		resourceids.StaticSegment("staticSites", "sites", "sites"),
+		resourceids.UserSpecifiedSegment("siteName", "site1"),
+		resourceids.StaticSegment("staticSlots", "slots", "slots"),
+		resourceids.UserSpecifiedSegment("slotName", "slot1"),
+		resourceids.StaticSegment("staticHostNameBindings", "hostNameBindings", "hostNameBindings"),
+		resourceids.UserSpecifiedSegment("hostNameBindingName", "binding1"),
+	}
+}
+
+// FromParseResult populates the fields of this AppServiceSlotCustomHostnameBinding ID from the ParseResult, for use with resourceids.Parser
+func (id *AppServiceSlotCustomHostnameBindingId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.SiteName, ok = input.Parsed["siteName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "siteName", input)
+	}
+
+	if id.SlotName, ok = input.Parsed["slotName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "slotName", input)
+	}
+
+	if id.HostNameBindingName, ok = input.Parsed["hostNameBindingName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "hostNameBindingName", input)
+	}
+
+	return nil
+}
+
 // AppServiceSlotCustomHostnameBindingID parses a AppServiceSlotCustomHostnameBinding ID into an AppServiceSlotCustomHostnameBindingId struct
 func AppServiceSlotCustomHostnameBindingID(input string) (*AppServiceSlotCustomHostnameBindingId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
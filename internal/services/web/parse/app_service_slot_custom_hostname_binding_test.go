@@ -21,6 +21,24 @@ func TestAppServiceSlotCustomHostnameBindingIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAppServiceSlotCustomHostnameBindingIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&AppServiceSlotCustomHostnameBindingId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/slots/slot1/hostNameBindings/binding1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual AppServiceSlotCustomHostnameBindingId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/slots/slot1/hostNameBindings/binding1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestAppServiceSlotCustomHostnameBindingID(t *testing.T) {
 	testData := []struct {
 		Input    string
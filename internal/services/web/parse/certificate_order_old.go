@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
+var _ resourceids.ResourceId = &CertificateOrderOldId{}
+
 type CertificateOrderOldId struct {
 	SubscriptionId       string
 	ResourceGroup        string
@@ -41,6 +43,39 @@ func (id CertificateOrderOldId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.CertificateOrderName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this CertificateOrderOld ID
+func (id CertificateOrderOldId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftWeb", "Microsoft.Web", "Microsoft.Web"),
+		resourceids.StaticSegment("staticCertificateOrders", "certificateOrders", "certificateOrders"),
+		resourceids.UserSpecifiedSegment("certificateOrderName", "order1"),
+	}
+}
+
+// FromParseResult populates the fields of this CertificateOrderOld ID from the ParseResult, for use with resourceids.Parser
+func (id *CertificateOrderOldId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.CertificateOrderName, ok = input.Parsed["certificateOrderName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "certificateOrderName", input)
+	}
+
+	return nil
+}
+
 // CertificateOrderOldID parses a CertificateOrderOld ID into an CertificateOrderOldId struct
 func CertificateOrderOldID(input string) (*CertificateOrderOldId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
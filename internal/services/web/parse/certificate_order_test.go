@@ -21,6 +21,24 @@ func TestCertificateOrderIDFormatter(t *testing.T) {
 	}
 }
 
+func TestCertificateOrderIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&CertificateOrderId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.CertificateRegistration/certificateOrders/order1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual CertificateOrderId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.CertificateRegistration/certificateOrders/order1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestCertificateOrderID(t *testing.T) {
 	testData := []struct {
 		Input    string
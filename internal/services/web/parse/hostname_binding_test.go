@@ -21,6 +21,24 @@ func TestHostnameBindingIDFormatter(t *testing.T) {
 	}
 }
 
+func TestHostnameBindingIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&HostnameBindingId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/mygroup1/providers/Microsoft.Web/sites/site1/hostNameBindings/binding1", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual HostnameBindingId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/mygroup1/providers/Microsoft.Web/sites/site1/hostNameBindings/binding1"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestHostnameBindingID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,24 @@ func TestSlotVirtualNetworkSwiftConnectionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSlotVirtualNetworkSwiftConnectionIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&SlotVirtualNetworkSwiftConnectionId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/slots/slot1/config/virtualNetwork", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual SlotVirtualNetworkSwiftConnectionId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/slots/slot1/config/virtualNetwork"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestSlotVirtualNetworkSwiftConnectionID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,24 @@ func TestVirtualNetworkSwiftConnectionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestVirtualNetworkSwiftConnectionIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&VirtualNetworkSwiftConnectionId{})
+	parsed, err := parser.Parse("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/config/virtualNetwork", false)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	var actual VirtualNetworkSwiftConnectionId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %+v", err)
+	}
+
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/config/virtualNetwork"
+	if actual.ID() != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual.ID())
+	}
+}
+
 func TestVirtualNetworkSwiftConnectionID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -10,6 +10,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
@@ -25,16 +26,19 @@ const (
 	cnameValidationType = "cname-delegation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name static_site_custom_domain -service-package-name web -known-values "subscription_id:data.Subscriptions.Primary" -compare-values "resource_group_name:id,static_site_name:id,name:id"
+
 func resourceStaticSiteCustomDomain() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		DeprecationMessage: "This resource has been deprecated in favour of `azurerm_static_web_app_custom_domain` and will be removed in a future release.",
 		Create:             resourceStaticSiteCustomDomainCreate,
 		Read:               resourceStaticSiteCustomDomainRead,
 		Delete:             resourceStaticSiteCustomDomainDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.StaticSiteCustomDomainID(id)
-			return err
-		}),
+		Importer:           pluginsdk.ImporterValidatingIdentity(&parse.StaticSiteCustomDomainId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.StaticSiteCustomDomainId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -198,7 +202,7 @@ func resourceStaticSiteCustomDomainRead(d *pluginsdk.ResourceData, meta interfac
 	d.Set("domain_name", id.CustomDomainName)
 	d.Set("static_site_id", parse.NewStaticSiteID(id.SubscriptionId, id.ResourceGroup, id.StaticSiteName).ID())
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceStaticSiteCustomDomainDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -16,17 +16,20 @@ type IDValidationFunc func(id string) error
 type ImporterFunc = func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error)
 
 // ImporterValidatingResourceId validates the ID provided at import time is valid
-// using the validateFunc.
-func ImporterValidatingResourceId(validateFunc IDValidationFunc) *schema.ResourceImporter {
+// using the validateFunc. When the Resource ID type is specified in `shortFormId` the ID
+// can also be specified in a short form, see expandShortFormResourceId.
+func ImporterValidatingResourceId(validateFunc IDValidationFunc, shortFormId ...resourceids.ResourceId) *schema.ResourceImporter {
 	thenFunc := func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error) {
 		return []*ResourceData{d}, nil
 	}
-	return ImporterValidatingResourceIdThen(validateFunc, thenFunc)
+	return ImporterValidatingResourceIdThen(validateFunc, thenFunc, shortFormId...)
 }
 
 // ImporterValidatingResourceIdThen validates the ID provided at import time is valid
 // using the validateFunc then runs the 'thenFunc', allowing the import to be customised.
-func ImporterValidatingResourceIdThen(validateFunc IDValidationFunc, thenFunc ImporterFunc) *schema.ResourceImporter {
+// When the Resource ID type is specified in `shortFormId` the ID can also be specified
+// in a short form, see expandShortFormResourceId.
+func ImporterValidatingResourceIdThen(validateFunc IDValidationFunc, thenFunc ImporterFunc, shortFormId ...resourceids.ResourceId) *schema.ResourceImporter {
 	return &schema.ResourceImporter{
 		StateContext: func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error) {
 			log.Printf("[DEBUG] Importing Resource - parsing %q", d.Id())
@@ -38,8 +41,19 @@ func ImporterValidatingResourceIdThen(validateFunc IDValidationFunc, thenFunc Im
 			}
 
 			if err := validateFunc(d.Id()); err != nil {
-				// NOTE: we're intentionally not wrapping this error, since it's prefixed with `parsing %q:`
-				return []*ResourceData{d}, err
+				if len(shortFormId) == 0 {
+					// NOTE: we're intentionally not wrapping this error, since it's prefixed with `parsing %q:`
+					return []*ResourceData{d}, err
+				}
+
+				// the short form of the Resource ID (e.g. `{resourceGroupName}/{name}`) can also be used
+				longForm, ok := expandShortFormResourceId(shortFormId[0], d.Id(), defaultSubscriptionId(meta))
+				if !ok || validateFunc(*longForm) != nil {
+					return []*ResourceData{d}, shortFormResourceIdError(shortFormId[0], err)
+				}
+
+				log.Printf("[DEBUG] Importing Resource - expanded the short form %q to %q", d.Id(), *longForm)
+				d.SetId(*longForm)
 			}
 
 			return thenFunc(ctx, d, meta)
@@ -48,7 +62,7 @@ func ImporterValidatingResourceIdThen(validateFunc IDValidationFunc, thenFunc Im
 }
 
 // ImporterValidatingIdentity validates the ID provided at import time is valid or that the resource identity data provided in the import block is valid
-// based on the expected resource ID type.
+// based on the expected resource ID type. The ID can also be specified in a short form, see expandShortFormResourceId.
 func ImporterValidatingIdentity(id resourceids.ResourceId, idType ...ResourceTypeForIdentity) *schema.ResourceImporter {
 	thenFunc := func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error) {
 		return []*ResourceData{d}, nil
@@ -73,6 +87,56 @@ func ImporterValidatingIdentityThen(id resourceids.ResourceId, thenFunc Importer
 			if d.Id() != "" {
 				parser := resourceids.NewParserFromResourceIdType(id)
 				if _, err := parser.Parse(d.Id(), false); err != nil {
+					// the short form of the Resource ID (e.g. `{resourceGroupName}/{name}`) can also be used
+					longForm, ok := expandShortFormResourceId(id, d.Id(), defaultSubscriptionId(meta))
+					if !ok {
+						// NOTE: we're intentionally not wrapping this error, since it's prefixed with `parsing %q:`
+						return []*ResourceData{d}, shortFormResourceIdError(id, err)
+					}
+
+					log.Printf("[DEBUG] Importing Resource - expanded the short form %q to %q", d.Id(), *longForm)
+					d.SetId(*longForm)
+				}
+				return thenFunc(ctx, d, meta)
+			}
+
+			if err := ValidateResourceIdentityData(d, id, idType...); err != nil {
+				return nil, err
+			}
+
+			return thenFunc(ctx, d, meta)
+		},
+	}
+}
+
+// ImporterValidatingResourceIdOrIdentity validates the ID provided at import time is valid using the validateFunc, or
+// that the resource identity data provided in the import block is valid based on the expected resource ID type.
+func ImporterValidatingResourceIdOrIdentity(validateFunc IDValidationFunc, id resourceids.ResourceId, idType ...ResourceTypeForIdentity) *schema.ResourceImporter {
+	thenFunc := func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error) {
+		return []*ResourceData{d}, nil
+	}
+
+	return ImporterValidatingResourceIdOrIdentityThen(validateFunc, id, thenFunc, idType...)
+}
+
+// ImporterValidatingResourceIdOrIdentityThen validates the ID provided at import time is valid using the validateFunc,
+// or that the resource identity data provided in the import block is valid based on the expected resource ID type, then
+// runs the 'thenFunc'. This is intended for resources where the Terraform ID is not the Resource ID used for the resource
+// identity (e.g. Role Assignments, which can be suffixed with a Tenant ID) - when importing using the resource identity
+// the ID is set to the Resource ID, and the 'thenFunc' is responsible for converting it to the Terraform ID if required.
+func ImporterValidatingResourceIdOrIdentityThen(validateFunc IDValidationFunc, id resourceids.ResourceId, thenFunc ImporterFunc, idType ...ResourceTypeForIdentity) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error) {
+			log.Printf("[DEBUG] Importing Resource - parsing %q", d.Id())
+
+			if _, ok := ctx.Deadline(); !ok {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+				defer cancel()
+			}
+
+			if d.Id() != "" {
+				if err := validateFunc(d.Id()); err != nil {
 					// NOTE: we're intentionally not wrapping this error, since it's prefixed with `parsing %q:`
 					return []*ResourceData{d}, err
 				}
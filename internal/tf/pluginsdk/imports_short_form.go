@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pluginsdk
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+// defaultSubscriptionIdProvider is implemented by the Provider's Client, exposing the Subscription ID which
+// the Provider is configured to use by default
+type defaultSubscriptionIdProvider interface {
+	DefaultSubscriptionId() string
+}
+
+func defaultSubscriptionId(meta interface{}) string {
+	if v, ok := meta.(defaultSubscriptionIdProvider); ok {
+		return v.DefaultSubscriptionId()
+	}
+	return ""
+}
+
+// expandShortFormResourceId attempts to expand the short form of a Resource ID into its long form, which is
+// supported for Resource IDs which don't contain a Scope. The short form is either:
+//
+//   - each of the user-specified segments, excluding the Subscription ID which is taken from the Provider,
+//     e.g. `{resourceGroupName}/{storageAccountName}/{containerName}`
+//   - the ID of the Parent Resource followed by the name of this Resource, e.g. `{storageAccountId}/{containerName}`
+//
+// The expanded Resource ID is returned only when it's valid for the Resource ID type `id`.
+func expandShortFormResourceId(id resourceids.ResourceId, input, subscriptionId string) (*string, bool) {
+	segments := id.Segments()
+	for _, segment := range segments {
+		if segment.Type == resourceids.ScopeSegmentType {
+			return nil, false
+		}
+	}
+
+	var output *string
+	if strings.HasPrefix(input, "/") {
+		output = expandParentIdAndName(segments, input)
+	} else {
+		output = expandUserSpecifiedSegments(segments, input, subscriptionId)
+	}
+	if output == nil {
+		return nil, false
+	}
+
+	parser := resourceids.NewParserFromResourceIdType(id)
+	if _, err := parser.Parse(*output, false); err != nil {
+		return nil, false
+	}
+
+	return output, true
+}
+
+// expandParentIdAndName expands `{parentId}/{name}` by inserting the static segments between the last
+// user-specified segment of the Parent Resource ID and the name of this Resource
+func expandParentIdAndName(segments []resourceids.Segment, input string) *string {
+	index := strings.LastIndex(input, "/")
+	parentId := input[:index]
+	name := input[index+1:]
+	if parentId == "" || name == "" || len(segments) == 0 || !segmentIsUserSpecified(segments[len(segments)-1]) {
+		return nil
+	}
+
+	staticValues := make([]string, 0)
+	for i := len(segments) - 2; i >= 0; i-- {
+		segment := segments[i]
+		if segmentIsUserSpecified(segment) || segment.Type == resourceids.SubscriptionIdSegmentType {
+			break
+		}
+
+		// the value of this segment can't be inferred, so the short form isn't supported
+		if segment.FixedValue == nil {
+			return nil
+		}
+		staticValues = append([]string{*segment.FixedValue}, staticValues...)
+	}
+	if len(staticValues) == 0 {
+		return nil
+	}
+
+	return pointer.To(fmt.Sprintf("%s/%s/%s", parentId, strings.Join(staticValues, "/"), name))
+}
+
+// expandUserSpecifiedSegments expands `{resourceGroupName}/{name}` (and so on) into a Resource ID, using the
+// default Subscription ID configured for the Provider
+func expandUserSpecifiedSegments(segments []resourceids.Segment, input, subscriptionId string) *string {
+	values := strings.Split(input, "/")
+	valueIndex := 0
+
+	output := ""
+	for _, segment := range segments {
+		value := ""
+		switch {
+		case segment.Type == resourceids.SubscriptionIdSegmentType:
+			value = subscriptionId
+
+		case segmentIsUserSpecified(segment):
+			if valueIndex >= len(values) {
+				return nil
+			}
+			value = values[valueIndex]
+			valueIndex++
+
+		case segment.FixedValue != nil:
+			value = *segment.FixedValue
+		}
+
+		if value == "" {
+			return nil
+		}
+		output += "/" + value
+	}
+
+	if valueIndex != len(values) {
+		return nil
+	}
+
+	return &output
+}
+
+func segmentIsUserSpecified(segment resourceids.Segment) bool {
+	switch segment.Type {
+	case resourceids.ConstantSegmentType, resourceids.ResourceGroupSegmentType, resourceids.UserSpecifiedSegmentType:
+		return true
+	}
+	return false
+}
+
+// shortFormResourceIdError appends a description of the supported short forms of the Resource ID `id` to the
+// error `err` returned when parsing the long form of the Resource ID
+func shortFormResourceIdError(id resourceids.ResourceId, err error) error {
+	segments := id.Segments()
+	userSpecified := make([]string, 0)
+	for _, segment := range segments {
+		if segment.Type == resourceids.ScopeSegmentType {
+			return err
+		}
+		if segmentIsUserSpecified(segment) {
+			userSpecified = append(userSpecified, fmt.Sprintf("{%s}", segment.Name))
+		}
+	}
+	if len(userSpecified) == 0 {
+		return err
+	}
+
+	shortForms := []string{
+		strings.Join(userSpecified, "/"),
+	}
+	parentForm := "{parentId}/" + userSpecified[len(userSpecified)-1]
+	if expandParentIdAndName(segments, parentForm) != nil {
+		shortForms = append(shortForms, parentForm)
+	}
+
+	return fmt.Errorf("%w\nAlternatively the Resource ID can be specified using the short form `%s`", err, strings.Join(shortForms, "` or `"))
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pluginsdk
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+func TestExpandShortFormResourceId(t *testing.T) {
+	subscriptionId := "00000000-0000-0000-0000-000000000000"
+	testData := []struct {
+		name     string
+		id       resourceids.ResourceId
+		input    string
+		expected *string
+	}{
+		{
+			name:     "resource group name and name",
+			id:       &commonids.StorageAccountId{},
+			input:    "example-resources/example",
+			expected: pointer.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources/providers/Microsoft.Storage/storageAccounts/example"),
+		},
+		{
+			name:     "resource group name and nested names",
+			id:       &commonids.StorageContainerId{},
+			input:    "example-resources/example/container",
+			expected: pointer.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources/providers/Microsoft.Storage/storageAccounts/example/blobServices/default/containers/container"),
+		},
+		{
+			name:  "too few names",
+			id:    &commonids.StorageContainerId{},
+			input: "example-resources/example",
+		},
+		{
+			name:  "too many names",
+			id:    &commonids.StorageAccountId{},
+			input: "example-resources/example/container",
+		},
+		{
+			name:     "parent id and name",
+			id:       &commonids.StorageContainerId{},
+			input:    "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example-resources/providers/Microsoft.Storage/storageAccounts/example/container",
+			expected: pointer.To("/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example-resources/providers/Microsoft.Storage/storageAccounts/example/blobServices/default/containers/container"),
+		},
+		{
+			name:     "resource group id and name",
+			id:       &commonids.StorageAccountId{},
+			input:    "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example-resources/example",
+			expected: pointer.To("/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example-resources/providers/Microsoft.Storage/storageAccounts/example"),
+		},
+		{
+			name:  "incorrect parent id",
+			id:    &commonids.StorageContainerId{},
+			input: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example-resources/example/container",
+		},
+		{
+			name:  "scoped resource id",
+			id:    &commonids.ScopeId{},
+			input: "example-resources/example",
+		},
+	}
+
+	for _, test := range testData {
+		t.Logf("[DEBUG] Testing %q..", test.name)
+
+		actual, ok := expandShortFormResourceId(test.id, test.input, subscriptionId)
+		if test.expected == nil {
+			if ok {
+				t.Fatalf("expected no Resource ID but got %q", *actual)
+			}
+			continue
+		}
+
+		if !ok {
+			t.Fatalf("expected %q but got no Resource ID", *test.expected)
+		}
+		if *actual != *test.expected {
+			t.Fatalf("expected %q but got %q", *test.expected, *actual)
+		}
+	}
+}
+
+func TestExpandShortFormResourceIdWithoutSubscriptionId(t *testing.T) {
+	if actual, ok := expandShortFormResourceId(&commonids.StorageAccountId{}, "example-resources/example", ""); ok {
+		t.Fatalf("expected no Resource ID but got %q", *actual)
+	}
+}
+
+func TestShortFormResourceIdError(t *testing.T) {
+	err := shortFormResourceIdError(&commonids.StorageContainerId{}, errors.New("parsing the ID"))
+
+	expected := "`{resourceGroupName}/{storageAccountName}/{containerName}` or `{parentId}/{containerName}`"
+	if !strings.Contains(err.Error(), expected) {
+		t.Fatalf("expected the error to contain %q but got %q", expected, err.Error())
+	}
+}
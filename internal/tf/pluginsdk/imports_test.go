@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pluginsdk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2022-04-01/roleassignments"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestImporterValidatingResourceIdOrIdentityThen(t *testing.T) {
+	id := &roleassignments.ScopedRoleAssignmentId{}
+	identitySchema := GenerateIdentitySchema(id)()
+
+	validateFunc := func(input string) error {
+		if _, err := roleassignments.ParseScopedRoleAssignmentID(strings.Split(input, "|")[0]); err != nil {
+			return err
+		}
+		return nil
+	}
+	thenFunc := func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error) {
+		if !strings.Contains(d.Id(), "|") {
+			d.SetId(fmt.Sprintf("%s|%s", d.Id(), "22222222-2222-2222-2222-222222222222"))
+		}
+		return []*ResourceData{d}, nil
+	}
+	importer := ImporterValidatingResourceIdOrIdentityThen(validateFunc, id, thenFunc)
+
+	testData := []struct {
+		name     string
+		id       string
+		identity map[string]string
+		expected string
+		error    bool
+	}{
+		{
+			name:     "id using the validate func",
+			id:       "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleAssignments/11111111-1111-1111-1111-111111111111|33333333-3333-3333-3333-333333333333",
+			expected: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleAssignments/11111111-1111-1111-1111-111111111111|33333333-3333-3333-3333-333333333333",
+		},
+		{
+			name:  "invalid id",
+			id:    "/subscriptions/00000000-0000-0000-0000-000000000000",
+			error: true,
+		},
+		{
+			name: "identity",
+			identity: map[string]string{
+				"scope": "/subscriptions/00000000-0000-0000-0000-000000000000",
+				"name":  "11111111-1111-1111-1111-111111111111",
+			},
+			expected: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleAssignments/11111111-1111-1111-1111-111111111111|22222222-2222-2222-2222-222222222222",
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			identity := v.identity
+			if identity == nil {
+				identity = map[string]string{}
+			}
+			d := schema.TestResourceDataWithIdentityRaw(t, map[string]*schema.Schema{}, identitySchema, identity)
+			d.SetId(v.id)
+
+			result, err := importer.StateContext(context.Background(), d, nil)
+			if v.error {
+				if err == nil {
+					t.Fatalf("expected an error but got the ID %q", d.Id())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("importing: %+v", err)
+			}
+			if len(result) != 1 || result[0].Id() != v.expected {
+				t.Fatalf("expected the ID %q but got %q", v.expected, d.Id())
+			}
+		})
+	}
+}
+
+type testDefaultSubscriptionIdProvider struct{}
+
+func (testDefaultSubscriptionIdProvider) DefaultSubscriptionId() string {
+	return "00000000-0000-0000-0000-000000000000"
+}
+
+func TestImporterValidatingResourceIdShortForm(t *testing.T) {
+	validateFunc := func(input string) error {
+		_, err := commonids.ParseStorageAccountID(input)
+		return err
+	}
+
+	testData := []struct {
+		name        string
+		id          string
+		shortFormId []resourceids.ResourceId
+		expected    string
+		error       bool
+	}{
+		{
+			name:     "long form",
+			id:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources/providers/Microsoft.Storage/storageAccounts/example",
+			expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources/providers/Microsoft.Storage/storageAccounts/example",
+		},
+		{
+			name:  "short form without an id type",
+			id:    "example-resources/example",
+			error: true,
+		},
+		{
+			name:        "short form",
+			id:          "example-resources/example",
+			shortFormId: []resourceids.ResourceId{&commonids.StorageAccountId{}},
+			expected:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources/providers/Microsoft.Storage/storageAccounts/example",
+		},
+		{
+			name:        "invalid short form",
+			id:          "example-resources/example/container",
+			shortFormId: []resourceids.ResourceId{&commonids.StorageAccountId{}},
+			error:       true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			importer := ImporterValidatingResourceId(validateFunc, v.shortFormId...)
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+			d.SetId(v.id)
+
+			result, err := importer.StateContext(context.Background(), d, testDefaultSubscriptionIdProvider{})
+			if v.error {
+				if err == nil {
+					t.Fatalf("expected an error but got the ID %q", d.Id())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("importing: %+v", err)
+			}
+			if len(result) != 1 || result[0].Id() != v.expected {
+				t.Fatalf("expected the ID %q but got %q", v.expected, d.Id())
+			}
+		})
+	}
+}
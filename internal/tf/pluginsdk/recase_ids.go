@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pluginsdk
+
+import (
+	"context"
+	"log"
+	"reflect"
+	"runtime"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// RecaseResourceIdsOnRead wraps the Read function for the specified Resource so that any attribute containing a
+// Resource ID which is returned by the API using different casing (e.g. `resourcegroups` rather than `resourceGroups`)
+// is re-cased prior to being persisted into the State, avoiding case-only diffs.
+//
+// Only attributes validated using a Resource ID Validation function (from `commonids` or the Resource Manager SDK) are
+// considered, including those nested within blocks. Since these functions are case-sensitive, a value is only re-cased
+// when the re-cased value passes the validation function - as such values which are already correctly cased (including
+// those specified by users) are left unmodified.
+func RecaseResourceIdsOnRead(resource *Resource) {
+	attributes := recasableAttributes(resource.Schema)
+	if len(attributes) == 0 {
+		return
+	}
+
+	if f := resource.ReadContext; f != nil {
+		resource.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			diags := f(ctx, d, meta)
+			if diags.HasError() {
+				return diags
+			}
+
+			if err := recaseResourceIds(d, attributes); err != nil {
+				return append(diags, diag.FromErr(err)...)
+			}
+
+			return diags
+		}
+	}
+
+	if f := resource.Read; f != nil { //nolint:staticcheck
+		resource.Read = func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+			if err := f(d, meta); err != nil {
+				return err
+			}
+
+			return recaseResourceIds(d, attributes)
+		}
+	}
+}
+
+// recasableAttribute is either a String attribute (or List/Set of Strings) containing a Resource ID, in which case
+// validateFunc is set - or a block (List/Set of Resources) containing one or more of these, in which case nested is set
+type recasableAttribute struct {
+	validateFunc SchemaValidateFunc
+	nested       map[string]recasableAttribute
+}
+
+// recasableAttributes returns each attribute within the Schema which contains a Resource ID, keyed by the name of the
+// attribute - including the blocks containing these
+func recasableAttributes(input map[string]*Schema) map[string]recasableAttribute {
+	output := make(map[string]recasableAttribute)
+	for k, v := range input {
+		switch v.Type {
+		case TypeString:
+			if isResourceIdValidateFunc(v.ValidateFunc) {
+				output[k] = recasableAttribute{
+					validateFunc: v.ValidateFunc,
+				}
+			}
+
+		case TypeList, TypeSet:
+			switch elem := v.Elem.(type) {
+			case *Schema:
+				if elem.Type == TypeString && isResourceIdValidateFunc(elem.ValidateFunc) {
+					output[k] = recasableAttribute{
+						validateFunc: elem.ValidateFunc,
+					}
+				}
+
+			case *Resource:
+				if nested := recasableAttributes(elem.Schema); len(nested) > 0 {
+					output[k] = recasableAttribute{
+						nested: nested,
+					}
+				}
+			}
+		}
+	}
+	return output
+}
+
+// isResourceIdValidateFunc returns whether the ValidateFunc validates a Resource ID, which is determined by it being
+// one of the Resource ID Validation functions within `commonids` or a Resource Manager SDK package (for example
+// `commonids.ValidateSubnetID`) - other functions (including those wrapping these) are not considered
+func isResourceIdValidateFunc(input SchemaValidateFunc) bool {
+	if input == nil {
+		return false
+	}
+
+	f := runtime.FuncForPC(reflect.ValueOf(input).Pointer())
+	if f == nil {
+		return false
+	}
+
+	name := f.Name()
+	if !strings.Contains(name, "/go-azure-helpers/resourcemanager/commonids.") && !strings.Contains(name, "/go-azure-sdk/resource-manager/") {
+		return false
+	}
+
+	functionName := name[strings.LastIndex(name, ".")+1:]
+	return strings.HasPrefix(functionName, "Validate") && strings.HasSuffix(functionName, "ID")
+}
+
+func recaseResourceIds(d *schema.ResourceData, attributes map[string]recasableAttribute) error {
+	// the resource has been removed from the State
+	if d.Id() == "" {
+		return nil
+	}
+
+	for key, attribute := range attributes {
+		if recased, ok := recaseValue(d.Get(key), key, attribute); ok {
+			log.Printf("[DEBUG] Re-casing the Resource IDs within %q", key)
+			if err := d.Set(key, recased); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// recaseValue returns the value with any Resource IDs re-cased, and whether any were re-cased
+func recaseValue(input interface{}, key string, attribute recasableAttribute) (interface{}, bool) {
+	switch v := input.(type) {
+	case string:
+		if attribute.validateFunc != nil {
+			return recaseResourceId(v, key, attribute.validateFunc)
+		}
+
+	case []interface{}:
+		return recaseList(v, key, attribute)
+
+	case *schema.Set:
+		return recaseList(v.List(), key, attribute)
+
+	case map[string]interface{}:
+		if attribute.nested != nil {
+			return recaseBlock(v, attribute.nested)
+		}
+	}
+
+	return input, false
+}
+
+func recaseList(input []interface{}, key string, attribute recasableAttribute) ([]interface{}, bool) {
+	output := make([]interface{}, 0, len(input))
+	changed := false
+	for _, item := range input {
+		recased, ok := recaseValue(item, key, attribute)
+		output = append(output, recased)
+		changed = changed || ok
+	}
+	return output, changed
+}
+
+func recaseBlock(input map[string]interface{}, attributes map[string]recasableAttribute) (map[string]interface{}, bool) {
+	output := make(map[string]interface{}, len(input))
+	changed := false
+	for k, v := range input {
+		output[k] = v
+		if attribute, ok := attributes[k]; ok {
+			if recased, ok := recaseValue(v, k, attribute); ok {
+				output[k] = recased
+				changed = true
+			}
+		}
+	}
+	return output, changed
+}
+
+// recaseResourceId returns the re-cased Resource ID when `input` is a known Resource ID using different casing, which
+// passes the ValidateFunc once re-cased
+func recaseResourceId(input, key string, validateFunc SchemaValidateFunc) (string, bool) {
+	if input == "" {
+		return input, false
+	}
+
+	recased, err := recaser.ReCaseKnownId(input)
+	if err != nil || recased == nil || *recased == input {
+		return input, false
+	}
+
+	if _, errs := validateFunc(*recased, key); len(errs) > 0 {
+		return input, false
+	}
+
+	return *recased, true
+}
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pluginsdk
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func TestRecaseResourceIdsOnRead(t *testing.T) {
+	testData := []struct {
+		name     string
+		input    map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name: "correctly cased",
+			input: map[string]interface{}{
+				"resource_group_id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources",
+				"identity_ids": []interface{}{
+					"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources/providers/Microsoft.ManagedIdentity/userAssignedIdentities/example",
+				},
+				"ip_configuration": []interface{}{
+					map[string]interface{}{
+						"name":      "internal",
+						"subnet_id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources/providers/Microsoft.Network/virtualNetworks/example/subnets/internal",
+					},
+				},
+				"name": "resourcegroups",
+			},
+			expected: map[string]interface{}{
+				"resource_group_id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources",
+				"identity_ids": []interface{}{
+					"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources/providers/Microsoft.ManagedIdentity/userAssignedIdentities/example",
+				},
+				"ip_configuration": []interface{}{
+					map[string]interface{}{
+						"name":      "internal",
+						"subnet_id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources/providers/Microsoft.Network/virtualNetworks/example/subnets/internal",
+					},
+				},
+				"name": "resourcegroups",
+			},
+		},
+		{
+			name: "incorrectly cased",
+			input: map[string]interface{}{
+				"resource_group_id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/example-resources",
+				"identity_ids": []interface{}{
+					"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/example-resources/providers/microsoft.managedidentity/userassignedidentities/example",
+				},
+				"ip_configuration": []interface{}{
+					map[string]interface{}{
+						"name":      "internal",
+						"subnet_id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/example-resources/providers/Microsoft.Network/virtualnetworks/example/subnets/internal",
+					},
+				},
+				"name":                      "resourcegroups",
+				"wrapped_resource_group_id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/example-resources",
+			},
+			expected: map[string]interface{}{
+				"resource_group_id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources",
+				"identity_ids": []interface{}{
+					"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources/providers/Microsoft.ManagedIdentity/userAssignedIdentities/example",
+				},
+				"ip_configuration": []interface{}{
+					map[string]interface{}{
+						"name":      "internal",
+						"subnet_id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources/providers/Microsoft.Network/virtualNetworks/example/subnets/internal",
+					},
+				},
+				"name": "resourcegroups",
+				// only attributes using a Resource ID Validation function are re-cased
+				"wrapped_resource_group_id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/example-resources",
+			},
+		},
+		{
+			name: "unknown resource id",
+			input: map[string]interface{}{
+				"resource_group_id": "/some/unknown/resource/id",
+				"identity_ids":      []interface{}{},
+				"name":              "example",
+			},
+			expected: map[string]interface{}{
+				"resource_group_id": "/some/unknown/resource/id",
+				"identity_ids":      []interface{}{},
+				"name":              "example",
+			},
+		},
+	}
+
+	for _, test := range testData {
+		t.Logf("[DEBUG] Testing %q..", test.name)
+
+		resource := &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"resource_group_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: commonids.ValidateResourceGroupID,
+				},
+				"identity_ids": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+					},
+				},
+				"ip_configuration": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"subnet_id": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: commonids.ValidateSubnetID,
+							},
+						},
+					},
+				},
+				"wrapped_resource_group_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.Any(commonids.ValidateResourceGroupID),
+				},
+			},
+			ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+				for k, v := range test.input {
+					if err := d.Set(k, v); err != nil {
+						return diag.FromErr(err)
+					}
+				}
+				return nil
+			},
+		}
+		RecaseResourceIdsOnRead(resource)
+
+		d := resource.TestResourceData()
+		d.SetId("example")
+		if diags := resource.ReadContext(context.TODO(), d, nil); diags.HasError() {
+			t.Fatalf("reading: %+v", diags)
+		}
+
+		for k, v := range test.expected {
+			if actual := d.Get(k); !reflect.DeepEqual(actual, v) {
+				t.Fatalf("expected %q to be %+v but got %+v", k, v, actual)
+			}
+		}
+	}
+}
@@ -16,6 +16,9 @@ import (
 
 // These functions support generating the resource identity schema for the following types of identities and resources
 // * Hierarchical IDs (untyped and typed resources)
+// * Scoped/Extension IDs (e.g. Role Assignments and Management Locks), where the Scope is represented as a single
+//   attribute containing the full Resource ID of the Scope
+// * IDs containing a Constant segment (e.g. DNS Record Sets), where the Constant is represented as an attribute
 
 // ResourceTypeForIdentity is used to select different schema generation behaviours depending on the type of resource/resource ID
 type ResourceTypeForIdentity int
@@ -47,6 +50,8 @@ func segmentTypeSupported(segment resourceids.SegmentType) bool {
 	supportedSegmentTypes := []resourceids.SegmentType{
 		resourceids.SubscriptionIdSegmentType,
 		resourceids.ResourceGroupSegmentType,
+		resourceids.ScopeSegmentType,
+		resourceids.ConstantSegmentType,
 		resourceids.UserSpecifiedSegmentType,
 	}
 
@@ -128,13 +133,25 @@ func ValidateResourceIdentityData(d *schema.ResourceData, id resourceids.Resourc
 				return fmt.Errorf("error setting id: %+v", err)
 			}
 
+			// a Scope is a Resource ID in its own right (e.g. `/subscriptions/00000000-0000-0000-0000-000000000000`)
+			if segment.Type == resourceids.ScopeSegmentType {
+				value = strings.Trim(value, "/")
+				if value == "" {
+					return fmt.Errorf("%q must be a Resource ID", name)
+				}
+			}
+
 			identityString += value + "/"
 		}
 	}
 
 	identityString = strings.TrimRight(identityString, "/")
 
-	// TODO it might be good practice then parse constructed ID string to ensure validity?
+	// since a Scope can contain any value, ensure the constructed ID is valid
+	parser := resourceids.NewParserFromResourceIdType(id)
+	if _, err := parser.Parse(identityString, false); err != nil {
+		return fmt.Errorf("validating the Resource ID built from the resource identity: %+v", err)
+	}
 
 	d.SetId(identityString)
 
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pluginsdk
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2022-04-01/roleassignments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/managementlocks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceIdentityRoundTrip(t *testing.T) {
+	testData := []struct {
+		name     string
+		id       resourceids.ResourceId
+		idType   ResourceTypeForIdentity
+		expected map[string]string
+	}{
+		{
+			name: "hierarchical",
+			id:   pointer.To(commonids.NewUserAssignedIdentityID("00000000-0000-0000-0000-000000000000", "example-resources", "example")),
+			expected: map[string]string{
+				"subscription_id":     "00000000-0000-0000-0000-000000000000",
+				"resource_group_name": "example-resources",
+				"name":                "example",
+			},
+		},
+		{
+			name: "virtual",
+			id:   pointer.To(commonids.NewUserAssignedIdentityID("00000000-0000-0000-0000-000000000000", "example-resources", "example")),
+			expected: map[string]string{
+				"subscription_id":             "00000000-0000-0000-0000-000000000000",
+				"resource_group_name":         "example-resources",
+				"user_assigned_identity_name": "example",
+			},
+			idType: ResourceTypeForIdentityVirtual,
+		},
+		{
+			name: "constant segment",
+			id:   pointer.To(recordsets.NewRecordTypeID("00000000-0000-0000-0000-000000000000", "example-resources", "example.com", recordsets.RecordTypeCNAME, "www")),
+			expected: map[string]string{
+				"subscription_id":     "00000000-0000-0000-0000-000000000000",
+				"resource_group_name": "example-resources",
+				"dns_zone_name":       "example.com",
+				"record_type":         "CNAME",
+				"name":                "www",
+			},
+		},
+		{
+			name: "scoped to a resource group",
+			id:   pointer.To(managementlocks.NewScopedLockID("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources", "example")),
+			expected: map[string]string{
+				"scope": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources",
+				"name":  "example",
+			},
+		},
+		{
+			name: "scoped to a subscription",
+			id:   pointer.To(roleassignments.NewScopedRoleAssignmentID("/subscriptions/00000000-0000-0000-0000-000000000000", "11111111-1111-1111-1111-111111111111")),
+			expected: map[string]string{
+				"scope": "/subscriptions/00000000-0000-0000-0000-000000000000",
+				"name":  "11111111-1111-1111-1111-111111111111",
+			},
+		},
+		{
+			name: "scoped to a nested resource",
+			id:   pointer.To(managementlocks.NewScopedLockID("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources/providers/Microsoft.Network/virtualNetworks/example/subnets/internal", "example")),
+			expected: map[string]string{
+				"scope": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources/providers/Microsoft.Network/virtualNetworks/example/subnets/internal",
+				"name":  "example",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			identitySchema := GenerateIdentitySchema(v.id, v.idType)()
+			actualAttributes := make([]string, 0)
+			for k := range identitySchema {
+				actualAttributes = append(actualAttributes, k)
+			}
+			expectedAttributes := make([]string, 0)
+			for k := range v.expected {
+				expectedAttributes = append(expectedAttributes, k)
+			}
+			sort.Strings(actualAttributes)
+			sort.Strings(expectedAttributes)
+			if !reflect.DeepEqual(actualAttributes, expectedAttributes) {
+				t.Fatalf("expected the identity schema to contain %v but got %v", expectedAttributes, actualAttributes)
+			}
+
+			// setting the identity from the Resource ID
+			d := schema.TestResourceDataWithIdentityRaw(t, map[string]*schema.Schema{}, identitySchema, map[string]string{})
+			if err := SetResourceIdentityData(d, v.id, v.idType); err != nil {
+				t.Fatalf("setting identity: %+v", err)
+			}
+			identity, err := d.Identity()
+			if err != nil {
+				t.Fatalf("retrieving identity: %+v", err)
+			}
+			for key, expected := range v.expected {
+				if actual := identity.Get(key).(string); actual != expected {
+					t.Fatalf("expected the identity attribute %q to be %q but got %q", key, expected, actual)
+				}
+			}
+
+			// and importing using the identity yields the same Resource ID
+			d = schema.TestResourceDataWithIdentityRaw(t, map[string]*schema.Schema{}, identitySchema, v.expected)
+			if err := ValidateResourceIdentityData(d, v.id, v.idType); err != nil {
+				t.Fatalf("validating identity: %+v", err)
+			}
+			if d.Id() != v.id.ID() {
+				t.Fatalf("expected the ID %q but got %q", v.id.ID(), d.Id())
+			}
+		})
+	}
+}
+
+func TestValidateResourceIdentityDataInvalidScope(t *testing.T) {
+	id := &managementlocks.ScopedLockId{}
+	identitySchema := GenerateIdentitySchema(id)()
+
+	d := schema.TestResourceDataWithIdentityRaw(t, map[string]*schema.Schema{}, identitySchema, map[string]string{
+		"scope": "/",
+		"name":  "example",
+	})
+	if err := ValidateResourceIdentityData(d, id); err == nil {
+		t.Fatalf("expected an error for an invalid scope but got the ID %q", d.Id())
+	}
+}
@@ -358,7 +358,9 @@ import (
 %s
 %s
 %s
-`, id.codeForType(), id.codeForConstructor(), id.codeForDescription(), id.codeForFormatter(), id.codeForParser(), id.codeForParserInsensitive())
+%s
+%s
+`, id.codeForType(), id.codeForConstructor(), id.codeForDescription(), id.codeForFormatter(), id.codeForSegments(), id.codeForFromParseResult(), id.codeForParser(), id.codeForParserInsensitive())
 }
 
 func (id ResourceIdGenerator) codeForType() string {
@@ -368,6 +370,8 @@ func (id ResourceIdGenerator) codeForType() string {
 	}
 	fieldStr := strings.Join(fields, "\n")
 	return fmt.Sprintf(`
+var _ resourceids.ResourceId = &%[1]sId{}
+
 type %[1]sId struct {
 %[2]s
 }
@@ -449,6 +453,86 @@ func (id %[1]sId) ID() string {
 `, id.TypeName, id.IDFmt, formatKeysString)
 }
 
+// segmentName returns the name used for this segment within the Segments of the Resource ID, which matches
+// the names used for Resource IDs within `hashicorp/go-azure-sdk` (e.g. `resourceGroupName`)
+func (id ResourceIdGenerator) segmentName(segment ResourceIdSegment) string {
+	switch {
+	case segment.FieldName == "SubscriptionId" && id.HasSubscriptionId:
+		return "subscriptionId"
+	case segment.FieldName == "ResourceGroup" && id.HasResourceGroup:
+		return "resourceGroupName"
+	}
+	return segment.ArgumentName
+}
+
+func (id ResourceIdGenerator) codeForSegments() string {
+	split := strings.Split(strings.TrimPrefix(id.IDRaw, "/"), "/")
+
+	// the names of static segments have to be unique, e.g. when the Resource ID contains `providers` more than once
+	staticNames := make(map[string]int)
+	staticName := func(value string) string {
+		name := "static" + azure.TitleCase(strings.ReplaceAll(value, ".", ""))
+		staticNames[name]++
+		if count := staticNames[name]; count > 1 {
+			name = fmt.Sprintf("%s%d", name, count)
+		}
+		return name
+	}
+
+	segments := make([]string, 0)
+	segmentIndex := 0
+	for i := 0; i < len(split); i += 2 {
+		key := split[i]
+		value := split[i+1]
+		segments = append(segments, fmt.Sprintf("\t\tresourceids.StaticSegment(%q, %q, %q),", staticName(key), key, key))
+
+		if key == "providers" {
+			segments = append(segments, fmt.Sprintf("\t\tresourceids.ResourceProviderSegment(%q, %q, %q),", staticName(value), value, value))
+			continue
+		}
+
+		segment := id.Segments[segmentIndex]
+		segmentIndex++
+		switch id.segmentName(segment) {
+		case "subscriptionId":
+			segments = append(segments, fmt.Sprintf("\t\tresourceids.SubscriptionIdSegment(%q, %q),", "subscriptionId", value))
+		case "resourceGroupName":
+			segments = append(segments, fmt.Sprintf("\t\tresourceids.ResourceGroupSegment(%q, %q),", "resourceGroupName", value))
+		default:
+			segments = append(segments, fmt.Sprintf("\t\tresourceids.UserSpecifiedSegment(%q, %q),", id.segmentName(segment), value))
+		}
+	}
+
+	return fmt.Sprintf(`
+// Segments returns a slice of Resource ID Segments which comprise this %[1]s ID
+func (id %[1]sId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+%[2]s
+	}
+}
+`, id.TypeName, strings.Join(segments, "\n"))
+}
+
+func (id ResourceIdGenerator) codeForFromParseResult() string {
+	assignments := make([]string, 0)
+	for _, segment := range id.Segments {
+		assignments = append(assignments, fmt.Sprintf(`
+	if id.%[1]s, ok = input.Parsed[%[2]q]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, %[2]q, input)
+	}`, segment.FieldName, id.segmentName(segment)))
+	}
+
+	return fmt.Sprintf(`
+// FromParseResult populates the fields of this %[1]s ID from the ParseResult, for use with resourceids.Parser
+func (id *%[1]sId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+%[2]s
+
+	return nil
+}
+`, id.TypeName, strings.Join(assignments, "\n"))
+}
+
 func (id ResourceIdGenerator) codeForParser() string {
 	directAssignments := make([]string, 0)
 	if id.HasSubscriptionId {
@@ -597,7 +681,35 @@ import (
 %s
 %s
 %s
-`, id.TestPackageSuffix, importLine, id.testCodeForFormatter(), id.testCodeForParser(), id.testCodeForParserInsensitive())
+%s
+`, id.TestPackageSuffix, importLine, id.testCodeForFormatter(), id.testCodeForSegments(), id.testCodeForParser(), id.testCodeForParserInsensitive())
+}
+
+func (id ResourceIdGenerator) testCodeForSegments() string {
+	typePrefix := ""
+	if id.TestPackageSuffix != "" {
+		typePrefix = "parse."
+	}
+
+	return fmt.Sprintf(`
+func Test%[1]sIDSegments(t *testing.T) {
+	parser := resourceids.NewParserFromResourceIdType(&%[2]s%[1]sId{})
+	parsed, err := parser.Parse(%[3]q, false)
+	if err != nil {
+		t.Fatalf("parsing: %%+v", err)
+	}
+
+	var actual %[2]s%[1]sId
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the parse result: %%+v", err)
+	}
+
+	expected := %[3]q
+	if actual.ID() != expected {
+		t.Fatalf("Expected %%q but got %%q", expected, actual.ID())
+	}
+}
+`, id.TypeName, typePrefix, id.IDRaw)
 }
 
 func (id ResourceIdGenerator) testCodeForFormatter() string {
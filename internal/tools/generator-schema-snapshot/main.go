@@ -4,13 +4,17 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/dave/jennifer/jen"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/iancoleman/strcase"
 )
 
 const (
@@ -18,21 +22,87 @@ const (
 )
 
 func main() {
-	if len(os.Args) != 2 {
-		log.Fatal("Usage: generator-schema-snapshot <reource_type>")
+	previous := flag.String("previous", "", "the path to the Go source file containing the previous schema snapshot, when specified a State Migration is generated from the differences between this and the current schema")
+	previousFunc := flag.String("previous-func", "", "the name of the function containing the previous schema snapshot within the `-previous` file, defaults to the first schema found")
+	fromVersion := flag.Int("from-version", -1, "the Schema Version of the previous schema snapshot, defaults to the current Schema Version of the resource")
+	name := flag.String("name", "", "the name used as a prefix for the State Upgrader, defaults to the resource type in CamelCase, e.g. `ResourceGroup`")
+	idParser := flag.String("id-parser", "", "the fully qualified function used to re-case the Resource ID (if required), e.g. `github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resourcegroups.ParseResourceGroupIDInsensitively`")
+	packageName := flag.String("package", "migration", "the name of the Go package the State Migration is generated into")
+	outputDir := flag.String("output-dir", ".", "the directory the State Migration is generated into")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		log.Fatal("Usage: generator-schema-snapshot [-previous <path> [options]] <reource_type>")
 	}
-	rt := os.Args[1]
+	rt := flag.Arg(0)
 	res, ok := provider.AzureProvider().ResourcesMap[rt]
 	if !ok {
 		log.Fatalf("unknown resource type %q", rt)
 	}
 
-	f := jen.NewFile("main")
-	f.ImportName("github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk", "")
+	if *previous == "" {
+		f := jen.NewFile("main")
+		f.ImportName("github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk", "")
 
-	f.Var().Id("_").Op("=").Add(SchemaMap(res.Schema))
+		f.Var().Id("_").Op("=").Add(SchemaMap(res.Schema))
 
-	fmt.Printf("%#v", f)
+		fmt.Printf("%#v", f)
+		return
+	}
+
+	snapshot, err := loadPreviousSnapshot(*previous, *previousFunc)
+	if err != nil {
+		log.Fatalf("loading the previous schema snapshot: %+v", err)
+	}
+
+	definition := migrationDefinition{
+		PackageName: *packageName,
+		Name:        *name,
+		FromVersion: *fromVersion,
+		IDParser:    *idParser,
+		Previous:    *snapshot,
+		Changes:     diffSchemas(snapshot.schema, snapshotFromSchema(res.Schema)),
+	}
+	if definition.Name == "" {
+		definition.Name = strcase.ToCamel(strings.TrimPrefix(rt, "azurerm_"))
+	}
+	if definition.FromVersion < 0 {
+		definition.FromVersion = res.SchemaVersion
+	}
+
+	if err := writeMigration(definition, *outputDir); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func writeMigration(definition migrationDefinition, outputDir string) error {
+	upgrader, err := definition.generateUpgrader()
+	if err != nil {
+		return err
+	}
+
+	test, err := definition.generateTest()
+	if err != nil {
+		return err
+	}
+
+	fileName := fmt.Sprintf("%s_v%d_to_v%d", strcase.ToSnake(definition.Name), definition.FromVersion, definition.FromVersion+1)
+	for path, contents := range map[string][]byte{
+		filepath.Join(outputDir, fileName+".go"):      upgrader,
+		filepath.Join(outputDir, fileName+"_test.go"): test,
+	} {
+		if err := os.WriteFile(path, contents, 0o644); err != nil {
+			return fmt.Errorf("writing %q: %+v", path, err)
+		}
+		log.Printf("[INFO] Generated %q", path)
+	}
+
+	for _, change := range definition.Changes {
+		log.Printf("[INFO] %s", change)
+	}
+	log.Printf("[INFO] Update the resource to Schema Version %[1]d and register the State Upgrader for Version %[2]d (`%[2]d: %[3]s.%[4]s{}`)", definition.FromVersion+1, definition.FromVersion, definition.PackageName, definition.upgraderName())
+
+	return nil
 }
 
 func ResourceValue(res *pluginsdk.Resource) jen.Dict {
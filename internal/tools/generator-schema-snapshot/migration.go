@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"path"
+	"sort"
+	"strings"
+)
+
+type migrationDefinition struct {
+	// PackageName is the name of the Go package the State Migration is generated into, e.g. `migration`
+	PackageName string
+
+	// Name is the name used as a prefix for the State Upgrader, e.g. `ResourceGroup`
+	Name string
+
+	// FromVersion is the Schema Version of the previous snapshot
+	FromVersion int
+
+	// IDParser is the fully qualified function used to re-case the Resource ID (if required), for example
+	// `github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resourcegroups.ParseResourceGroupIDInsensitively`
+	IDParser string
+
+	// Previous is the previous schema snapshot
+	Previous previousSnapshot
+
+	// Changes are the changes between the previous schema snapshot and the current schema
+	Changes []schemaChange
+}
+
+func (d migrationDefinition) upgraderName() string {
+	return fmt.Sprintf("%sUpgradeV%dToV%d", d.Name, d.FromVersion, d.FromVersion+1)
+}
+
+func (d migrationDefinition) testName() string {
+	return fmt.Sprintf("Test%sV%dToV%d", d.Name, d.FromVersion, d.FromVersion+1)
+}
+
+// idParser returns the import path, package name and function name for the Resource ID parser
+func (d migrationDefinition) idParser() (importPath string, packageName string, funcName string) {
+	index := strings.LastIndex(d.IDParser, ".")
+	if index == -1 {
+		return "", "", ""
+	}
+
+	importPath = d.IDParser[:index]
+	return importPath, path.Base(importPath), d.IDParser[index+1:]
+}
+
+// manualChanges returns a description of the changes which can't be migrated automatically, which are included
+// as a TODO within the generated State Migration
+func (d migrationDefinition) manualChanges() []string {
+	output := make([]string, 0)
+	for _, change := range d.Changes {
+		if change.TopLevel && (change.Type == schemaChangeRemoved || change.Type == schemaChangeRenamed) {
+			continue
+		}
+
+		// optional/computed properties which are added to the Schema are populated during the next Read
+		if change.Type == schemaChangeAdded && !change.Current.Required {
+			continue
+		}
+
+		output = append(output, change.String())
+	}
+	return output
+}
+
+func (d migrationDefinition) generateUpgrader() ([]byte, error) {
+	idImportPath, idPackageName, idFuncName := d.idParser()
+	name := d.upgraderName()
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, `// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package %s
+
+import (
+	"context"
+`, d.PackageName)
+	if idImportPath != "" {
+		fmt.Fprintf(buf, "\"log\"\n\n%q\n", idImportPath)
+	} else {
+		buf.WriteString("\n")
+	}
+	fmt.Fprintf(buf, `"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ pluginsdk.StateUpgrade = %[1]s{}
+
+type %[1]s struct{}
+
+func (%[1]s) Schema() map[string]*pluginsdk.Schema {
+	return %[2]s
+}
+
+func (%[1]s) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+`, name, d.Previous.source)
+
+	if manualChanges := d.manualChanges(); len(manualChanges) > 0 {
+		buf.WriteString("// TODO: the following changes can't be migrated automatically and need to be handled manually:\n")
+		for _, change := range manualChanges {
+			fmt.Fprintf(buf, "// * %s\n", change)
+		}
+		buf.WriteString("\n")
+	}
+
+	if idImportPath != "" {
+		fmt.Fprintf(buf, `oldIdRaw := rawState["id"].(string)
+id, err := %s.%s(oldIdRaw)
+if err != nil {
+	return rawState, err
+}
+
+newId := id.ID()
+log.Printf("[DEBUG] Updating ID from %%q to %%q", oldIdRaw, newId)
+rawState["id"] = newId
+
+`, idPackageName, idFuncName)
+	}
+
+	for _, change := range d.Changes {
+		if !change.TopLevel {
+			continue
+		}
+
+		switch change.Type {
+		case schemaChangeRenamed:
+			fmt.Fprintf(buf, `// TODO: confirm that %[1]q has been renamed to %[2]q
+if v, ok := rawState[%[1]q]; ok {
+	rawState[%[2]q] = v
+	delete(rawState, %[1]q)
+}
+
+`, change.Key, change.NewKey)
+
+		case schemaChangeRemoved:
+			fmt.Fprintf(buf, "delete(rawState, %q)\n\n", change.Key)
+		}
+	}
+
+	buf.WriteString(`return rawState, nil
+	}
+}
+`)
+
+	output, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting the State Upgrader: %+v", err)
+	}
+	return output, nil
+}
+
+func (d migrationDefinition) generateTest() ([]byte, error) {
+	input := make(map[string]string)
+	for key, property := range d.Previous.schema {
+		input[key] = sampleValue(property)
+	}
+
+	expected := make(map[string]string)
+	for k, v := range input {
+		expected[k] = v
+	}
+	for _, change := range d.Changes {
+		if !change.TopLevel {
+			continue
+		}
+
+		switch change.Type {
+		case schemaChangeRenamed:
+			expected[change.NewKey] = expected[change.Key]
+			delete(expected, change.Key)
+		case schemaChangeRemoved:
+			delete(expected, change.Key)
+		}
+	}
+
+	if idImportPath, _, _ := d.idParser(); idImportPath != "" {
+		input["id"] = `"TODO: a Resource ID using the incorrect casing"`
+		expected["id"] = `"TODO: the Resource ID using the correct casing"`
+	}
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, `// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package %[1]s
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func %[2]s(t *testing.T) {
+	testData := []struct {
+		name     string
+		input    map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name: "v%[3]d state",
+			input: map[string]interface{}{
+%[4]s			},
+			expected: map[string]interface{}{
+%[5]s			},
+		},
+	}
+
+	for _, test := range testData {
+		t.Logf("Testing %%q...", test.name)
+		result, err := %[6]s{}.UpgradeFunc()(context.TODO(), test.input, nil)
+		if err != nil {
+			t.Fatalf("Expected no error but got: %%+v", err)
+		}
+
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Fatalf("expected %%+v but got %%+v!", test.expected, result)
+		}
+	}
+}
+`, d.PackageName, d.testName(), d.FromVersion, mapLiteralBody(input), mapLiteralBody(expected), d.upgraderName())
+
+	output, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting the State Upgrader test: %+v", err)
+	}
+	return output, nil
+}
+
+func mapLiteralBody(input map[string]string) string {
+	keys := make([]string, 0, len(input))
+	for k := range input {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	output := ""
+	for _, k := range keys {
+		output += fmt.Sprintf("%q: %s,\n", k, input[k])
+	}
+	return output
+}
+
+// sampleValue returns a Go literal for a value of the specified property as it'd be represented in the raw state
+func sampleValue(input snapshotProperty) string {
+	switch input.Type {
+	case "TypeBool":
+		return "true"
+	case "TypeInt":
+		return "1"
+	case "TypeFloat":
+		return "1.5"
+	case "TypeString":
+		return `"example"`
+	case "TypeList", "TypeSet":
+		return "[]interface{}{}"
+	case "TypeMap":
+		return "map[string]interface{}{}"
+	}
+
+	return `"TODO"`
+}
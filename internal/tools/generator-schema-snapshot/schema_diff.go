@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+type schemaChangeType string
+
+const (
+	schemaChangeAdded       schemaChangeType = "Added"
+	schemaChangeRemoved     schemaChangeType = "Removed"
+	schemaChangeRenamed     schemaChangeType = "Renamed"
+	schemaChangeTypeChanged schemaChangeType = "TypeChanged"
+)
+
+type schemaChange struct {
+	Type schemaChangeType
+
+	// Path is the path to the property in the previous schema, or the current schema for an added property,
+	// e.g. `name` or `network_profile.subnet_id`
+	Path string
+
+	// NewPath is the path to the property in the current schema for a renamed property
+	NewPath string
+
+	// Key is the name of the property, without the path of any parent blocks
+	Key string
+
+	// NewKey is the name of the property in the current schema for a renamed property
+	NewKey string
+
+	// TopLevel specifies whether this property is defined at the top-level of the Schema (rather than in a nested block)
+	TopLevel bool
+
+	// Previous is the property in the previous schema, this is nil for an added property
+	Previous *snapshotProperty
+
+	// Current is the property in the current schema, this is nil for a removed property
+	Current *snapshotProperty
+}
+
+func (c schemaChange) String() string {
+	switch c.Type {
+	case schemaChangeAdded:
+		return fmt.Sprintf("`%s` has been added", c.Path)
+	case schemaChangeRemoved:
+		return fmt.Sprintf("`%s` has been removed", c.Path)
+	case schemaChangeRenamed:
+		return fmt.Sprintf("`%s` appears to have been renamed to `%s`", c.Path, c.NewPath)
+	case schemaChangeTypeChanged:
+		return fmt.Sprintf("`%s` has changed from a %s to a %s", c.Path, c.Previous.typeDescription(), c.Current.typeDescription())
+	}
+
+	return fmt.Sprintf("`%s` has changed", c.Path)
+}
+
+func (p snapshotProperty) typeDescription() string {
+	if p.ElemType != "" {
+		return fmt.Sprintf("%s of %s", p.Type, p.ElemType)
+	}
+	if p.Elem != nil {
+		return fmt.Sprintf("%s of blocks", p.Type)
+	}
+	return p.Type
+}
+
+func (p snapshotProperty) sameTypeAs(other snapshotProperty) bool {
+	return p.Type == other.Type && p.ElemType == other.ElemType && (p.Elem == nil) == (other.Elem == nil)
+}
+
+// diffSchemas returns the changes between the previous and current schema which need to be accounted for
+// in a State Migration, ordered by the path to the property.
+//
+// A property is considered renamed when it's the only property of that type which has been removed from a block
+// and the only property of that type which has been added to that block - since this is a heuristic these are
+// flagged for review within the generated State Migration.
+func diffSchemas(previous, current snapshotSchema) []schemaChange {
+	output := diffSchemasRecursively("", previous, current)
+	sort.Slice(output, func(i, j int) bool {
+		return output[i].Path < output[j].Path
+	})
+	return output
+}
+
+func diffSchemasRecursively(prefix string, previous, current snapshotSchema) []schemaChange {
+	output := make([]schemaChange, 0)
+	removed := make([]string, 0)
+	added := make([]string, 0)
+
+	for _, key := range sortedKeys(previous) {
+		previousProperty := previous[key]
+		currentProperty, ok := current[key]
+		if !ok {
+			removed = append(removed, key)
+			continue
+		}
+
+		// properties which couldn't be parsed from the previous snapshot can't be compared
+		if previousProperty.Type == "" || currentProperty.Type == "" {
+			continue
+		}
+
+		if !previousProperty.sameTypeAs(currentProperty) {
+			output = append(output, schemaChange{
+				Type:     schemaChangeTypeChanged,
+				Path:     prefix + key,
+				Key:      key,
+				TopLevel: prefix == "",
+				Previous: &previousProperty,
+				Current:  &currentProperty,
+			})
+			continue
+		}
+
+		if previousProperty.Elem != nil {
+			output = append(output, diffSchemasRecursively(prefix+key+".", previousProperty.Elem, currentProperty.Elem)...)
+		}
+	}
+
+	for _, key := range sortedKeys(current) {
+		if _, ok := previous[key]; !ok {
+			added = append(added, key)
+		}
+	}
+
+	renamedFrom := make(map[string]string)
+	renamedTo := make(map[string]struct{})
+	for _, removedKey := range removed {
+		removedProperty := previous[removedKey]
+		if removedProperty.Type == "" {
+			continue
+		}
+
+		candidates := make([]string, 0)
+		for _, addedKey := range added {
+			if current[addedKey].sameTypeAs(removedProperty) {
+				candidates = append(candidates, addedKey)
+			}
+		}
+		if len(candidates) != 1 {
+			continue
+		}
+
+		otherRemovedOfSameType := false
+		for _, otherKey := range removed {
+			if otherKey != removedKey && previous[otherKey].sameTypeAs(removedProperty) {
+				otherRemovedOfSameType = true
+				break
+			}
+		}
+		if !otherRemovedOfSameType {
+			renamedFrom[removedKey] = candidates[0]
+			renamedTo[candidates[0]] = struct{}{}
+		}
+	}
+
+	for _, key := range removed {
+		previousProperty := previous[key]
+		if newKey, ok := renamedFrom[key]; ok {
+			currentProperty := current[newKey]
+			output = append(output, schemaChange{
+				Type:     schemaChangeRenamed,
+				Path:     prefix + key,
+				NewPath:  prefix + newKey,
+				Key:      key,
+				NewKey:   newKey,
+				TopLevel: prefix == "",
+				Previous: &previousProperty,
+				Current:  &currentProperty,
+			})
+			continue
+		}
+
+		output = append(output, schemaChange{
+			Type:     schemaChangeRemoved,
+			Path:     prefix + key,
+			Key:      key,
+			TopLevel: prefix == "",
+			Previous: &previousProperty,
+		})
+	}
+
+	for _, key := range added {
+		if _, ok := renamedTo[key]; ok {
+			continue
+		}
+
+		currentProperty := current[key]
+		output = append(output, schemaChange{
+			Type:     schemaChangeAdded,
+			Path:     prefix + key,
+			Key:      key,
+			TopLevel: prefix == "",
+			Current:  &currentProperty,
+		})
+	}
+
+	return output
+}
+
+func sortedKeys(input snapshotSchema) []string {
+	output := make([]string, 0, len(input))
+	for k := range input {
+		output = append(output, k)
+	}
+	sort.Strings(output)
+	return output
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDiffSchemas(t *testing.T) {
+	previous := snapshotSchema{
+		"name":         {Type: "TypeString", Required: true},
+		"old_name":     {Type: "TypeString", Optional: true},
+		"removed":      {Type: "TypeBool", Optional: true},
+		"changed_type": {Type: "TypeInt", Optional: true},
+		"block": {
+			Type:     "TypeList",
+			Optional: true,
+			Elem: snapshotSchema{
+				"nested_removed": {Type: "TypeString", Optional: true},
+			},
+		},
+	}
+	current := snapshotSchema{
+		"name":         {Type: "TypeString", Required: true},
+		"new_name":     {Type: "TypeString", Optional: true},
+		"added":        {Type: "TypeInt", Optional: true},
+		"changed_type": {Type: "TypeString", Optional: true},
+		"block": {
+			Type:     "TypeList",
+			Optional: true,
+			Elem:     snapshotSchema{},
+		},
+	}
+
+	expected := []schemaChange{
+		{Type: schemaChangeAdded, Path: "added"},
+		{Type: schemaChangeRemoved, Path: "block.nested_removed"},
+		{Type: schemaChangeTypeChanged, Path: "changed_type"},
+		{Type: schemaChangeRenamed, Path: "old_name", NewPath: "new_name"},
+		{Type: schemaChangeRemoved, Path: "removed"},
+	}
+
+	actual := diffSchemas(previous, current)
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d changes but got %d: %+v", len(expected), len(actual), actual)
+	}
+	for i, v := range expected {
+		if actual[i].Type != v.Type || actual[i].Path != v.Path || actual[i].NewPath != v.NewPath {
+			t.Fatalf("expected change %d to be %s %q (%q) but got %s %q (%q)", i, v.Type, v.Path, v.NewPath, actual[i].Type, actual[i].Path, actual[i].NewPath)
+		}
+	}
+}
+
+func TestDiffSchemasAmbiguousRename(t *testing.T) {
+	// when multiple properties of the same type are removed/added we can't determine which was renamed
+	previous := snapshotSchema{
+		"first":  {Type: "TypeString", Optional: true},
+		"second": {Type: "TypeString", Optional: true},
+	}
+	current := snapshotSchema{
+		"third": {Type: "TypeString", Optional: true},
+	}
+
+	for _, v := range diffSchemas(previous, current) {
+		if v.Type == schemaChangeRenamed {
+			t.Fatalf("expected no renames but got %q to %q", v.Path, v.NewPath)
+		}
+	}
+}
+
+func TestLoadPreviousSnapshot(t *testing.T) {
+	src := `package migration
+
+import "github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+
+func exampleSchemaForV0() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"location": commonschema.Location(),
+
+		"block": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"values": {
+						Type:     pluginsdk.TypeSet,
+						Computed: true,
+						Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+					},
+				},
+			},
+		},
+	}
+}
+`
+	path := filepath.Join(t.TempDir(), "example_v0.go")
+	if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+		t.Fatalf("writing file: %+v", err)
+	}
+
+	snapshot, err := loadPreviousSnapshot(path, "exampleSchemaForV0")
+	if err != nil {
+		t.Fatalf("loading snapshot: %+v", err)
+	}
+
+	if v := snapshot.schema["name"]; v.Type != "TypeString" || !v.Required {
+		t.Fatalf("expected `name` to be a required TypeString but got %+v", v)
+	}
+	if v, ok := snapshot.schema["location"]; !ok || v.Type != "" {
+		t.Fatalf("expected `location` to be present without a type but got %+v", v)
+	}
+	values := snapshot.schema["block"].Elem["values"]
+	if values.Type != "TypeSet" || values.ElemType != "TypeString" || !values.Computed {
+		t.Fatalf("expected `block.values` to be a computed TypeSet of TypeString but got %+v", values)
+	}
+
+	if _, err := loadPreviousSnapshot(path, "doesNotExist"); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strconv"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// snapshotSchema is a simplified representation of a Schema, containing only the information required to determine
+// which changes need to be handled in a State Migration.
+type snapshotSchema map[string]snapshotProperty
+
+type snapshotProperty struct {
+	// Type is the name of the Schema Type, e.g. `TypeString` - or an empty string when this couldn't be determined
+	Type string
+
+	Required bool
+	Optional bool
+	Computed bool
+
+	// ElemType is the name of the Schema Type for the elements within a List, Set or Map of primitives
+	ElemType string
+
+	// Elem is the nested Schema for a List or Set of blocks
+	Elem snapshotSchema
+}
+
+// previousSnapshot is a schema snapshot loaded from a Go source file
+type previousSnapshot struct {
+	schema snapshotSchema
+
+	// source is the Go source of the `map[string]*pluginsdk.Schema` literal for this snapshot
+	source string
+}
+
+func snapshotFromSchema(input map[string]*pluginsdk.Schema) snapshotSchema {
+	output := make(snapshotSchema)
+	for k, v := range input {
+		property := snapshotProperty{
+			Type:     schemaTypeName(v.Type),
+			Required: v.Required,
+			Optional: v.Optional,
+			Computed: v.Computed,
+		}
+
+		switch elem := v.Elem.(type) {
+		case *pluginsdk.Schema:
+			property.ElemType = schemaTypeName(elem.Type)
+		case *pluginsdk.Resource:
+			property.Elem = snapshotFromSchema(elem.Schema)
+		}
+
+		output[k] = property
+	}
+	return output
+}
+
+func schemaTypeName(input pluginsdk.ValueType) string {
+	switch input {
+	case pluginsdk.TypeBool:
+		return "TypeBool"
+	case pluginsdk.TypeInt:
+		return "TypeInt"
+	case pluginsdk.TypeFloat:
+		return "TypeFloat"
+	case pluginsdk.TypeString:
+		return "TypeString"
+	case pluginsdk.TypeList:
+		return "TypeList"
+	case pluginsdk.TypeMap:
+		return "TypeMap"
+	case pluginsdk.TypeSet:
+		return "TypeSet"
+	}
+
+	return ""
+}
+
+// loadPreviousSnapshot loads the schema snapshot from the Go source file at `path`. This is the first
+// `map[string]*pluginsdk.Schema` literal within the file, or within the function `funcName` when specified,
+// which allows this to be either the output of this tool or the Schema of an existing State Migration.
+func loadPreviousSnapshot(path, funcName string) (*previousSnapshot, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %q: %+v", path, err)
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, src, 0)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", path, err)
+	}
+
+	var root ast.Node = file
+	if funcName != "" {
+		root = nil
+		for _, decl := range file.Decls {
+			if v, ok := decl.(*ast.FuncDecl); ok && v.Name.Name == funcName {
+				root = v
+				break
+			}
+		}
+		if root == nil {
+			return nil, fmt.Errorf("the function %q was not found in %q", funcName, path)
+		}
+	}
+
+	var literal *ast.CompositeLit
+	ast.Inspect(root, func(node ast.Node) bool {
+		if literal != nil {
+			return false
+		}
+		if v, ok := node.(*ast.CompositeLit); ok && isSchemaMapType(v.Type) {
+			literal = v
+			return false
+		}
+		return true
+	})
+	if literal == nil {
+		return nil, fmt.Errorf("no `map[string]*pluginsdk.Schema` was found in %q", path)
+	}
+
+	return &previousSnapshot{
+		schema: parseSchemaMapLiteral(literal),
+		source: string(src[fset.Position(literal.Pos()).Offset:fset.Position(literal.End()).Offset]),
+	}, nil
+}
+
+func isSchemaMapType(input ast.Expr) bool {
+	mapType, ok := input.(*ast.MapType)
+	if !ok {
+		return false
+	}
+
+	if key, ok := mapType.Key.(*ast.Ident); !ok || key.Name != "string" {
+		return false
+	}
+
+	value, ok := mapType.Value.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+
+	selector, ok := value.X.(*ast.SelectorExpr)
+	return ok && selector.Sel.Name == "Schema"
+}
+
+func parseSchemaMapLiteral(input *ast.CompositeLit) snapshotSchema {
+	output := make(snapshotSchema)
+	for _, item := range input.Elts {
+		kv, ok := item.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+
+		key, ok := kv.Key.(*ast.BasicLit)
+		if !ok || key.Kind != token.STRING {
+			continue
+		}
+		name, err := strconv.Unquote(key.Value)
+		if err != nil {
+			continue
+		}
+
+		// properties defined using a function (e.g. `commonschema.Location()`) can't be parsed, so these
+		// are included without a type
+		property := snapshotProperty{}
+		if lit := compositeLiteral(kv.Value); lit != nil {
+			property = parseSchemaLiteral(lit)
+		}
+		output[name] = property
+	}
+	return output
+}
+
+func parseSchemaLiteral(input *ast.CompositeLit) snapshotProperty {
+	output := snapshotProperty{}
+	for _, item := range input.Elts {
+		kv, ok := item.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		switch key.Name {
+		case "Type":
+			output.Type = selectorName(kv.Value)
+		case "Required":
+			output.Required = isTrue(kv.Value)
+		case "Optional":
+			output.Optional = isTrue(kv.Value)
+		case "Computed":
+			output.Computed = isTrue(kv.Value)
+		case "Elem":
+			lit := compositeLiteral(kv.Value)
+			if lit == nil {
+				continue
+			}
+
+			switch selectorName(lit.Type) {
+			case "Schema":
+				output.ElemType = parseSchemaLiteral(lit).Type
+			case "Resource":
+				for _, resourceItem := range lit.Elts {
+					if rkv, ok := resourceItem.(*ast.KeyValueExpr); ok {
+						if rkey, ok := rkv.Key.(*ast.Ident); ok && rkey.Name == "Schema" {
+							if schemaLit := compositeLiteral(rkv.Value); schemaLit != nil {
+								output.Elem = parseSchemaMapLiteral(schemaLit)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return output
+}
+
+func compositeLiteral(input ast.Expr) *ast.CompositeLit {
+	if v, ok := input.(*ast.UnaryExpr); ok && v.Op == token.AND {
+		input = v.X
+	}
+	v, _ := input.(*ast.CompositeLit)
+	return v
+}
+
+func selectorName(input ast.Expr) string {
+	switch v := input.(type) {
+	case *ast.SelectorExpr:
+		return v.Sel.Name
+	case *ast.Ident:
+		return v.Name
+	}
+	return ""
+}
+
+func isTrue(input ast.Expr) bool {
+	v, ok := input.(*ast.Ident)
+	return ok && v.Name == "true"
+}